@@ -8,6 +8,7 @@ package blockchain
 import (
 	"container/list"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -102,6 +103,8 @@ type BlockChain struct {
 	sigCache            *txscript.SigCache
 	indexManager        IndexManager
 	hashCache           *txscript.HashCache
+	chainLockVerifier   ChainLockVerifier
+	pruneTarget         uint64
 
 	// The following fields are calculated based upon the provided chain
 	// parameters.  They are also set when the instance is created and
@@ -177,6 +180,27 @@ type BlockChain struct {
 	// activated.
 	unknownRulesWarned bool
 
+	// bestChainLock is the ChainLock with the greatest height which was
+	// verified and locks a block of the main chain.  It is protected by the
+	// chain lock.
+	bestChainLock *wire.MsgCLSig
+
+	// mnPayees provides the masternodes which blocks must pay.  It is set
+	// with SetMasternodePayeeSource and protected by the chain lock.
+	mnPayees MasternodePayeeSource
+
+	// utxoStats houses the statistics and the MuHash3072 commitment of the
+	// utxo set at the end of the main chain.  It is replaced along with
+	// stateSnapshot whenever the utxo set is modified and protected by the
+	// state lock.
+	utxoStats *utxoSetStats
+
+	// snapshot tracks the background validation of the blocks below the
+	// base of the utxo set snapshot the chain was started from.  It is nil
+	// when there is no such validation pending and protected by the chain
+	// lock.
+	snapshot *snapshotState
+
 	// The notifications field stores a slice of callbacks to be executed on
 	// certain blockchain events.
 	notificationsLock sync.RWMutex
@@ -590,6 +614,7 @@ func (b *BlockChain) connectBlock(node *blockNode, block *btcutil.Block,
 	// database and later memory if all database updates are successful.
 	b.stateLock.RLock()
 	curTotalTxns := b.stateSnapshot.TotalTxns
+	utxoStats := b.utxoStats.clone()
 	b.stateLock.RUnlock()
 	numTxns := uint64(len(block.MsgBlock().Transactions))
 	blockSize := uint64(block.MsgBlock().SerializeSize())
@@ -612,6 +637,13 @@ func (b *BlockChain) connectBlock(node *blockNode, block *btcutil.Block,
 			return err
 		}
 
+		// Update the statistics of the utxo set with the changes of
+		// the utxo view before they are written.
+		err = dbUpdateUtxoSetStats(dbTx, utxoStats, view)
+		if err != nil {
+			return err
+		}
+
 		// Update the utxo set using the state of the utxo view.  This
 		// entails removing all of the utxos spent and adding the new
 		// ones created by the block.
@@ -657,8 +689,15 @@ func (b *BlockChain) connectBlock(node *blockNode, block *btcutil.Block,
 	// comments on the state variable for more details.
 	b.stateLock.Lock()
 	b.stateSnapshot = state
+	b.utxoStats = utxoStats
 	b.stateLock.Unlock()
 
+	// Delete the oldest blocks now that the block is connected.  The block
+	// is connected regardless, so a failure is only logged.
+	if err := b.pruneBlocks(); err != nil {
+		log.Errorf("Unable to prune blocks: %v", err)
+	}
+
 	// Notify the caller that the block was connected to the main chain.
 	// The caller would typically want to react with actions such as
 	// updating wallets.
@@ -702,6 +741,7 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block *btcutil.Block, view
 	// database and later memory if all database updates are successful.
 	b.stateLock.RLock()
 	curTotalTxns := b.stateSnapshot.TotalTxns
+	utxoStats := b.utxoStats.clone()
 	b.stateLock.RUnlock()
 	numTxns := uint64(len(prevBlock.MsgBlock().Transactions))
 	blockSize := uint64(prevBlock.MsgBlock().SerializeSize())
@@ -724,6 +764,13 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block *btcutil.Block, view
 			return err
 		}
 
+		// Update the statistics of the utxo set with the changes of
+		// the utxo view before they are written.
+		err = dbUpdateUtxoSetStats(dbTx, utxoStats, view)
+		if err != nil {
+			return err
+		}
+
 		// Update the utxo set using the state of the utxo view.  This
 		// entails restoring all of the utxos spent and removing the new
 		// ones created by the block.
@@ -776,6 +823,7 @@ func (b *BlockChain) disconnectBlock(node *blockNode, block *btcutil.Block, view
 	// comments on the state variable for more details.
 	b.stateLock.Lock()
 	b.stateSnapshot = state
+	b.utxoStats = utxoStats
 	b.stateLock.Unlock()
 
 	// Notify the caller that the block was disconnected from the main
@@ -1181,6 +1229,19 @@ func (b *BlockChain) connectBestChain(node *blockNode, block *btcutil.Block, fla
 		return false, nil
 	}
 
+	// A side chain which forks below the best ChainLock conflicts with it
+	// and never becomes the main chain regardless of its work.
+	if b.bestChainLock != nil {
+		fork := b.bestChain.FindFork(node)
+		if fork.height < b.bestChainLock.Height {
+			log.Infof("CHAINLOCK: Block %v extends a side chain which "+
+				"conflicts with the ChainLock at height %d/block %v",
+				node.hash, b.bestChainLock.Height,
+				b.bestChainLock.BlockHash)
+			return false, nil
+		}
+	}
+
 	// We're extending (or creating) a side chain and the cumulative work
 	// for this new side chain is more than the old best chain, so this side
 	// chain needs to become the main chain.  In order to accomplish that,
@@ -1701,6 +1762,23 @@ type Config struct {
 	// This field can be nil if the caller is not interested in using a
 	// signature cache.
 	HashCache *txscript.HashCache
+
+	// Prune is the target size in bytes of the blocks stored in the
+	// database.  When it is not zero, the oldest blocks are deleted as new
+	// blocks are connected, while the last MinBlocksToKeep blocks of the
+	// main chain are always retained.
+	Prune uint64
+
+	// UtxoSnapshot provides a utxo set snapshot created with
+	// DumpUtxoSnapshot to start the chain from when the database does not
+	// contain any blocks beyond the genesis block yet.  The snapshot must
+	// be for one of the AssumeUtxos of the chain parameters.  The blocks
+	// below its base are validated in the background with
+	// ProcessHistoricalBlock afterwards.
+	//
+	// This field can be nil in which case the chain starts from the
+	// genesis block.
+	UtxoSnapshot io.Reader
 }
 
 // New returns a BlockChain instance using the provided configuration details.
@@ -1751,6 +1829,7 @@ func New(config *Config) (*BlockChain, error) {
 		blocksPerRetarget:   int32(targetTimespan / targetTimePerBlock),
 		index:               newBlockIndex(config.DB, params),
 		hashCache:           config.HashCache,
+		pruneTarget:         config.Prune,
 		bestChain:           newChainView(nil),
 		orphans:             make(map[chainhash.Hash]*orphanBlock),
 		prevOrphans:         make(map[chainhash.Hash][]*orphanBlock),
@@ -1779,11 +1858,23 @@ func New(config *Config) (*BlockChain, error) {
 		return nil, err
 	}
 
+	// Load the state of the background validation of the history of a
+	// previously loaded utxo set snapshot or install the provided one.
+	if err := b.initSnapshotState(config.UtxoSnapshot); err != nil {
+		return nil, err
+	}
+
 	// Perform any upgrades to the various chain-specific buckets as needed.
 	if err := b.maybeUpgradeDbBuckets(config.Interrupt); err != nil {
 		return nil, err
 	}
 
+	// Load the statistics of the utxo set or compute them when they are
+	// not available yet.
+	if err := b.initUtxoSetStats(config.Interrupt); err != nil {
+		return nil, err
+	}
+
 	// Initialize and catch up all of the currently active optional indexes
 	// as needed.
 	if config.IndexManager != nil {
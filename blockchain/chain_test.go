@@ -61,9 +61,11 @@ func TestHaveBlock(t *testing.T) {
 		}
 	}
 
-	// Insert an orphan block.
+	// Insert an orphan block.  Block 100000 of the Bitcoin block chain was
+	// mined with double SHA-256 rather than X11, so the proof of work check
+	// is skipped.
 	_, isOrphan, err := chain.ProcessBlock(btcutil.NewBlock(&Block100000),
-		BFNone)
+		BFNoPoWCheck)
 	if err != nil {
 		t.Errorf("Unable to process block: %v", err)
 		return
@@ -82,10 +84,10 @@ func TestHaveBlock(t *testing.T) {
 		{hash: chaincfg.MainNetParams.GenesisHash.String(), want: true},
 
 		// Block 3a should be present (on a side chain).
-		{hash: "00000000474284d20067a4d33f6a02284e6ef70764a3a26d6a5b9df52ef663dd", want: true},
+		{hash: "00000cd14952b37f5864582271e64848ebd3879512eb84793810488f8275951a", want: true},
 
 		// Block 100000 should be present (as an orphan).
-		{hash: "000000000003ba27aa200b1cecaad478d2b00432346c3f1f3986da1afd33e506", want: true},
+		{hash: "86b4763f771e1b63751af737c97f3a62f427201650b439783109404d96c5a550", want: true},
 
 		// Random hashes should not be available.
 		{hash: "123", want: false},
@@ -730,10 +730,19 @@ func dbFetchUtxoEntryByHash(dbTx database.Tx, hash *chainhash.Hash) (*UtxoEntry,
 // When there is no entry for the provided output, nil will be returned for both
 // the entry and the error.
 func dbFetchUtxoEntry(dbTx database.Tx, outpoint wire.OutPoint) (*UtxoEntry, error) {
+	utxoBucket := dbTx.Metadata().Bucket(utxoSetBucketName)
+	return dbFetchUtxoEntryFromBucket(utxoBucket, outpoint)
+}
+
+// dbFetchUtxoEntryFromBucket fetches the specified transaction output from the
+// passed utxo set bucket.
+//
+// When there is no entry for the provided output, nil will be returned for both
+// the entry and the error.
+func dbFetchUtxoEntryFromBucket(utxoBucket database.Bucket, outpoint wire.OutPoint) (*UtxoEntry, error) {
 	// Fetch the unspent transaction output information for the passed
 	// transaction output.  Return now when there is no entry.
 	key := outpointKey(outpoint)
-	utxoBucket := dbTx.Metadata().Bucket(utxoSetBucketName)
 	serializedUtxo := utxoBucket.Get(*key)
 	recycleOutpointKey(key)
 	if serializedUtxo == nil {
@@ -772,6 +781,13 @@ func dbFetchUtxoEntry(dbTx database.Tx, outpoint wire.OutPoint) (*UtxoEntry, err
 // to the database.
 func dbPutUtxoView(dbTx database.Tx, view *UtxoViewpoint) error {
 	utxoBucket := dbTx.Metadata().Bucket(utxoSetBucketName)
+	return dbPutUtxoViewToBucket(utxoBucket, view)
+}
+
+// dbPutUtxoViewToBucket updates the utxo set in the passed bucket based on the
+// provided utxo view contents and state.  Only the entries that have been
+// marked as modified are written.
+func dbPutUtxoViewToBucket(utxoBucket database.Bucket, view *UtxoViewpoint) error {
 	for outpoint, entry := range view.entries {
 		// No need to update the database if the entry was not modified.
 		if entry == nil || !entry.isModified() {
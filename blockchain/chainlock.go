@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// ChainLockVerifier provides a generic interface to verify the quorum signature
+// of a ChainLock.  Implementations select the ChainLocks quorum which was
+// active at the height of the ChainLock, as defined by DIP-7 and DIP-8, and
+// verify the signature of the request id of the ChainLock and the locked block
+// hash against the public key of that quorum.
+type ChainLockVerifier interface {
+	// VerifyChainLock returns an error when the signature of the passed
+	// ChainLock is not a valid signature of the quorum responsible for its
+	// height.
+	VerifyChainLock(clsig *wire.MsgCLSig) error
+}
+
+// SetChainLockVerifier sets the verifier used to check the quorum signature of
+// ChainLocks passed to ProcessChainLock.  All ChainLocks are rejected until it
+// is set.
+//
+// The verifier can't be passed in the Config since it is typically built on
+// top of the chain, such as the quorums of the deterministic masternode list.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) SetChainLockVerifier(verifier ChainLockVerifier) {
+	b.chainLock.Lock()
+	b.chainLockVerifier = verifier
+	b.chainLock.Unlock()
+}
+
+// ProcessChainLock verifies the passed ChainLock and makes it the best
+// ChainLock of the chain when it locks a block of the main chain above the
+// current best ChainLock.  Once locked, the block and all of its ancestors are
+// final: side chains which fork below the locked block never become the main
+// chain.
+//
+// The returned bool indicates whether the ChainLock is the new best ChainLock,
+// in which case an NTChainLocked notification is sent.  ChainLocks at or below
+// the height of the current best ChainLock are ignored without error.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) ProcessChainLock(clsig *wire.MsgCLSig) (bool, error) {
+	b.chainLock.RLock()
+	verifier := b.chainLockVerifier
+	best := b.bestChainLock
+	b.chainLock.RUnlock()
+	if verifier == nil {
+		str := fmt.Sprintf("unable to verify ChainLock for block %v at "+
+			"height %d: no verifier configured", clsig.BlockHash,
+			clsig.Height)
+		return false, ruleError(ErrBadChainLockSig, str)
+	}
+
+	// Skip the costly signature verification when the ChainLock is not
+	// an improvement over the current one.
+	if best != nil && clsig.Height <= best.Height {
+		return false, nil
+	}
+
+	// The signature is verified without holding the chain lock since the
+	// verifier is free to query the chain in order to select the quorum.
+	if err := verifier.VerifyChainLock(clsig); err != nil {
+		str := fmt.Sprintf("invalid ChainLock signature for block %v at "+
+			"height %d: %v", clsig.BlockHash, clsig.Height, err)
+		return false, ruleError(ErrBadChainLockSig, str)
+	}
+
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+
+	// Another ChainLock may have been processed in the mean time.
+	if b.bestChainLock != nil && clsig.Height <= b.bestChainLock.Height {
+		return false, nil
+	}
+
+	node := b.index.LookupNode(&clsig.BlockHash)
+	if node == nil {
+		str := fmt.Sprintf("ChainLock at height %d locks unknown block %v",
+			clsig.Height, clsig.BlockHash)
+		return false, ruleError(ErrUnknownChainLockBlock, str)
+	}
+	if node.height != clsig.Height || !b.bestChain.Contains(node) {
+		str := fmt.Sprintf("ChainLock locks block %v at height %d which "+
+			"is not the main chain block at that height",
+			clsig.BlockHash, clsig.Height)
+		return false, ruleError(ErrChainLockConflict, str)
+	}
+
+	b.bestChainLock = clsig
+	log.Infof("CHAINLOCK: Block %v at height %d is locked", clsig.BlockHash,
+		clsig.Height)
+
+	b.sendNotification(NTChainLocked, clsig)
+
+	return true, nil
+}
+
+// BestChainLock returns the ChainLock with the greatest height which locks a
+// block of the main chain, or nil when no ChainLock has been processed.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) BestChainLock() *wire.MsgCLSig {
+	b.chainLock.RLock()
+	clsig := b.bestChainLock
+	b.chainLock.RUnlock()
+	return clsig
+}
+
+// IsBlockChainLocked returns whether the block with the passed hash is part of
+// the main chain and locked by the best ChainLock, either directly or as an
+// ancestor of the locked block.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) IsBlockChainLocked(hash *chainhash.Hash) bool {
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+
+	if b.bestChainLock == nil {
+		return false
+	}
+	node := b.index.LookupNode(hash)
+	return node != nil && node.height <= b.bestChainLock.Height &&
+		b.bestChain.Contains(node)
+}
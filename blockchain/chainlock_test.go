@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// fakeChainLockVerifier implements ChainLockVerifier by accepting every
+// ChainLock unless err is set.
+type fakeChainLockVerifier struct {
+	err error
+}
+
+// VerifyChainLock returns the configured error.
+func (v *fakeChainLockVerifier) VerifyChainLock(clsig *wire.MsgCLSig) error {
+	return v.err
+}
+
+// TestProcessChainLock ensures ChainLocks are verified, only accepted for
+// blocks of the main chain and notified when they become the best ChainLock.
+func TestProcessChainLock(t *testing.T) {
+	// Construct a main chain of 10 blocks and a side chain which forks at
+	// the fifth block.
+	chain := newFakeChain(&chaincfg.MainNetParams)
+	mainNodes := chainedNodes(chain.bestChain.Genesis(), 10)
+	sideNodes := chainedNodes(mainNodes[4], 2)
+	for _, node := range mainNodes {
+		chain.index.AddNode(node)
+	}
+	for _, node := range sideNodes {
+		chain.index.AddNode(node)
+	}
+	chain.bestChain.SetTip(tstTip(mainNodes))
+
+	var notified []*wire.MsgCLSig
+	chain.Subscribe(func(n *Notification) {
+		if n.Type == NTChainLocked {
+			notified = append(notified, n.Data.(*wire.MsgCLSig))
+		}
+	})
+
+	clsig := func(node *blockNode) *wire.MsgCLSig {
+		return wire.NewMsgCLSig(node.height, &node.hash, [96]byte{})
+	}
+
+	// ChainLocks are rejected when there is no way to verify them.
+	_, err := chain.ProcessChainLock(clsig(mainNodes[3]))
+	if !isRuleError(err, ErrBadChainLockSig) {
+		t.Fatalf("ProcessChainLock: got error %v, want %v", err,
+			ErrBadChainLockSig)
+	}
+
+	verifier := &fakeChainLockVerifier{}
+	chain.chainLockVerifier = verifier
+
+	tests := []struct {
+		name   string
+		clsig  *wire.MsgCLSig
+		verErr error
+		locked bool
+		err    ErrorCode
+	}{{
+		name:   "invalid signature",
+		clsig:  clsig(mainNodes[3]),
+		verErr: errors.New("bad signature"),
+		err:    ErrBadChainLockSig,
+	}, {
+		name:  "unknown block",
+		clsig: wire.NewMsgCLSig(3, &chainedNodes(nil, 1)[0].hash, [96]byte{}),
+		err:   ErrUnknownChainLockBlock,
+	}, {
+		name:  "side chain block",
+		clsig: clsig(sideNodes[1]),
+		err:   ErrChainLockConflict,
+	}, {
+		name:  "wrong height",
+		clsig: wire.NewMsgCLSig(5, &mainNodes[3].hash, [96]byte{}),
+		err:   ErrChainLockConflict,
+	}, {
+		name:   "main chain block",
+		clsig:  clsig(mainNodes[3]),
+		locked: true,
+	}, {
+		name:  "same height",
+		clsig: clsig(mainNodes[3]),
+	}, {
+		name:  "lower height",
+		clsig: clsig(mainNodes[1]),
+	}, {
+		name:   "higher height",
+		clsig:  clsig(mainNodes[7]),
+		locked: true,
+	}}
+
+	var wantNotified int
+	for _, test := range tests {
+		verifier.err = test.verErr
+		locked, err := chain.ProcessChainLock(test.clsig)
+		if test.err != 0 {
+			if !isRuleError(err, test.err) {
+				t.Errorf("%s: got error %v, want %v", test.name,
+					err, test.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if locked != test.locked {
+			t.Errorf("%s: got locked %v, want %v", test.name, locked,
+				test.locked)
+		}
+		if locked {
+			wantNotified++
+			if chain.BestChainLock() != test.clsig {
+				t.Errorf("%s: BestChainLock not updated", test.name)
+			}
+		}
+	}
+
+	if len(notified) != wantNotified {
+		t.Fatalf("got %d notifications, want %d", len(notified),
+			wantNotified)
+	}
+	if notified[len(notified)-1] != chain.BestChainLock() {
+		t.Errorf("last notification is not the best ChainLock")
+	}
+
+	// Blocks up to the locked block of the main chain are locked.
+	if !chain.IsBlockChainLocked(&mainNodes[7].hash) ||
+		!chain.IsBlockChainLocked(&mainNodes[0].hash) {
+
+		t.Errorf("IsBlockChainLocked: locked block is not locked")
+	}
+	if chain.IsBlockChainLocked(&mainNodes[8].hash) ||
+		chain.IsBlockChainLocked(&sideNodes[0].hash) {
+
+		t.Errorf("IsBlockChainLocked: unlocked block is locked")
+	}
+}
+
+// isRuleError returns whether err is a RuleError with the passed error code.
+func isRuleError(err error, code ErrorCode) bool {
+	var rerr RuleError
+	return errors.As(err, &rerr) && rerr.ErrorCode == code
+}
@@ -5,6 +5,7 @@
 package blockchain
 
 import (
+	"math"
 	"math/big"
 	"time"
 
@@ -21,6 +22,12 @@ var (
 	oneLsh256 = new(big.Int).Lsh(bigOne, 256)
 )
 
+const (
+	// dgwPastBlocks is the number of past blocks whose targets and
+	// timestamps Dark Gravity Wave retargets the difficulty from.
+	dgwPastBlocks = 24
+)
+
 // HashToBig converts a chainhash.Hash into a big.Int that can be used to
 // perform math comparisons.
 func HashToBig(hash *chainhash.Hash) *big.Int {
@@ -159,7 +166,17 @@ func CalcWork(bits uint32) *big.Int {
 // can have given starting difficulty bits and a duration.  It is mainly used to
 // verify that claimed proof of work by a block is sane as compared to a
 // known good checkpoint.
+//
+// This function MUST be called with the chain state lock held (for reads).
 func (b *BlockChain) calcEasiestDifficulty(bits uint32, duration time.Duration) uint32 {
+	// Kimoto Gravity Well and Dark Gravity Wave retarget every block based
+	// on the timestamps of the last blocks only, so the difficulty can drop
+	// to the minimum within any duration.  The retarget rules therefore
+	// don't bound the difficulty once they are active.
+	if b.chainParams.PowKGWHeight <= b.bestChain.Height()+1 {
+		return b.chainParams.PowLimitBits
+	}
+
 	// Convert types used in the calculations below.
 	durationVal := int64(duration / time.Second)
 	adjustmentFactor := big.NewInt(b.chainParams.RetargetAdjustmentFactor)
@@ -221,12 +238,41 @@ func (b *BlockChain) findPrevTestNetDifficulty(startNode *blockNode) uint32 {
 // This function differs from the exported CalcNextRequiredDifficulty in that
 // the exported version uses the current best chain as the previous block node
 // while this function accepts any block node.
+//
+// Dash started out with the Bitcoin retarget rules, switched to Kimoto Gravity
+// Well at PowKGWHeight and to Dark Gravity Wave v3 at PowDGWHeight.
 func (b *BlockChain) calcNextRequiredDifficulty(lastNode *blockNode, newBlockTime time.Time) (uint32, error) {
 	// Genesis block.
 	if lastNode == nil {
 		return b.chainParams.PowLimitBits, nil
 	}
 
+	// Devnets mine their first blocks at the minimum difficulty.
+	if lastNode.height < b.chainParams.MinimumDifficultyBlocks {
+		return b.chainParams.PowLimitBits, nil
+	}
+
+	nextHeight := lastNode.height + 1
+	if nextHeight < b.chainParams.PowKGWHeight {
+		return b.calcBitcoinRetarget(lastNode, newBlockTime)
+	}
+
+	// Networks without retargeting stay at the minimum difficulty once the
+	// per-block retargeting is active.
+	if b.chainParams.PowNoRetargeting {
+		return b.chainParams.PowLimitBits, nil
+	}
+
+	if nextHeight < b.chainParams.PowDGWHeight {
+		return b.calcKimotoGravityWell(lastNode), nil
+	}
+	return b.calcDarkGravityWave(lastNode, newBlockTime), nil
+}
+
+// calcBitcoinRetarget calculates the required difficulty for the block after
+// the passed previous block node based on the Bitcoin retarget rules, which
+// only change the difficulty every blocksPerRetarget blocks.
+func (b *BlockChain) calcBitcoinRetarget(lastNode *blockNode, newBlockTime time.Time) (uint32, error) {
 	// Return the previous block's difficulty requirements if this block
 	// is not at a difficulty retarget interval.
 	if (lastNode.height+1)%b.blocksPerRetarget != 0 {
@@ -254,6 +300,12 @@ func (b *BlockChain) calcNextRequiredDifficulty(lastNode *blockNode, newBlockTim
 		return lastNode.bits, nil
 	}
 
+	// Networks without retargeting keep the difficulty of the previous
+	// block.
+	if b.chainParams.PowNoRetargeting {
+		return lastNode.bits, nil
+	}
+
 	// Get the block node at the previous retarget (targetTimespan days
 	// worth of blocks).
 	firstNode := lastNode.RelativeAncestor(b.blocksPerRetarget - 1)
@@ -302,6 +354,163 @@ func (b *BlockChain) calcNextRequiredDifficulty(lastNode *blockNode, newBlockTim
 	return newTargetBits, nil
 }
 
+// calcKimotoGravityWell calculates the required difficulty for the block after
+// the passed previous block node based on the Kimoto Gravity Well rules, which
+// Dash used before Dark Gravity Wave.  The past blocks are examined until their
+// rate deviates from the target rate by more than the event horizon, and the
+// average target of those blocks is scaled by their actual rate.
+//
+// The calculation uses floating point numbers the same way as Dash Core.
+func (b *BlockChain) calcKimotoGravityWell(lastNode *blockNode) uint32 {
+	targetSpacing := int64(b.chainParams.TargetTimePerBlock / time.Second)
+	targetTimespan := int64(b.chainParams.TargetTimespan / time.Second)
+	pastBlocksMin := int64(float64(targetTimespan)*0.025) / targetSpacing
+	pastBlocksMax := targetTimespan * 7 / targetSpacing
+
+	if lastNode.height == 0 || int64(lastNode.height) < pastBlocksMin {
+		return b.chainParams.PowLimitBits
+	}
+
+	var pastBlocksMass, pastRateActualSeconds, pastRateTargetSeconds int64
+	pastDifficultyAverage := new(big.Int)
+	pastDifficultyAveragePrev := new(big.Int)
+	diff := new(big.Int)
+	for i, node := int64(1), lastNode; node != nil && node.height > 0; i++ {
+		if pastBlocksMax > 0 && i > pastBlocksMax {
+			break
+		}
+		pastBlocksMass++
+
+		// Move the average towards the target of the block by 1/i.
+		pastDifficultyAverage.Set(CompactToBig(node.bits))
+		if i > 1 {
+			diff.Sub(pastDifficultyAverage, pastDifficultyAveragePrev)
+			diff.Abs(diff)
+			diff.Div(diff, big.NewInt(i))
+			if pastDifficultyAverage.Cmp(pastDifficultyAveragePrev) >= 0 {
+				pastDifficultyAverage.Add(pastDifficultyAveragePrev, diff)
+			} else {
+				pastDifficultyAverage.Sub(pastDifficultyAveragePrev, diff)
+			}
+		}
+		pastDifficultyAveragePrev.Set(pastDifficultyAverage)
+
+		pastRateActualSeconds = lastNode.timestamp - node.timestamp
+		pastRateTargetSeconds = targetSpacing * pastBlocksMass
+		if pastRateActualSeconds < 0 {
+			pastRateActualSeconds = 0
+		}
+		pastRateAdjustmentRatio := float64(1)
+		if pastRateActualSeconds != 0 && pastRateTargetSeconds != 0 {
+			pastRateAdjustmentRatio = float64(pastRateTargetSeconds) /
+				float64(pastRateActualSeconds)
+		}
+		eventHorizonDeviation := 1 + 0.7084*math.Pow(
+			float64(pastBlocksMass)/28.2, -1.228)
+		eventHorizonDeviationFast := eventHorizonDeviation
+		eventHorizonDeviationSlow := 1 / eventHorizonDeviation
+
+		if pastBlocksMass >= pastBlocksMin &&
+			(pastRateAdjustmentRatio <= eventHorizonDeviationSlow ||
+				pastRateAdjustmentRatio >= eventHorizonDeviationFast) {
+
+			break
+		}
+		if node.parent == nil {
+			break
+		}
+		node = node.parent
+	}
+
+	newTarget := pastDifficultyAverage
+	if pastRateActualSeconds != 0 && pastRateTargetSeconds != 0 {
+		newTarget.Mul(newTarget, big.NewInt(pastRateActualSeconds))
+		newTarget.Div(newTarget, big.NewInt(pastRateTargetSeconds))
+	}
+
+	// Limit new value to the proof of work limit.
+	if newTarget.Cmp(b.chainParams.PowLimit) > 0 {
+		newTarget.Set(b.chainParams.PowLimit)
+	}
+	return BigToCompact(newTarget)
+}
+
+// calcDarkGravityWave calculates the required difficulty for the block after
+// the passed previous block node based on the Dark Gravity Wave v3 rules.  The
+// difficulty is retargeted every block from the weighted average target of the
+// last dgwPastBlocks blocks, scaled by the time it took to mine them compared
+// to the target time, which is limited to a factor of three either way.
+func (b *BlockChain) calcDarkGravityWave(lastNode *blockNode, newBlockTime time.Time) uint32 {
+	// Not enough blocks to average over.
+	if lastNode.height < dgwPastBlocks {
+		return b.chainParams.PowLimitBits
+	}
+
+	// For networks that support it, allow special reduction of the required
+	// difficulty once too much time has elapsed without mining a block.
+	targetSpacing := int64(b.chainParams.TargetTimePerBlock / time.Second)
+	if b.chainParams.ReduceMinDifficulty {
+		// Return minimum difficulty when the last block is more than
+		// two hours old.
+		if newBlockTime.Unix() > lastNode.timestamp+2*60*60 {
+			return b.chainParams.PowLimitBits
+		}
+
+		// Lower the difficulty of the last block tenfold when it is
+		// more than four target spacings old.
+		if newBlockTime.Unix() > lastNode.timestamp+4*targetSpacing {
+			newTarget := CompactToBig(lastNode.bits)
+			newTarget.Mul(newTarget, big.NewInt(10))
+			if newTarget.Cmp(b.chainParams.PowLimit) > 0 {
+				return b.chainParams.PowLimitBits
+			}
+			return BigToCompact(newTarget)
+		}
+	}
+
+	// Calculate the average target of the past blocks.  As in Dash Core,
+	// every block is weighted by 1/(n+1) instead of 1/n, so this is not
+	// quite the mean.
+	pastTargetAvg := new(big.Int)
+	firstNode := lastNode
+	for n := int64(1); n <= dgwPastBlocks; n++ {
+		target := CompactToBig(firstNode.bits)
+		if n == 1 {
+			pastTargetAvg.Set(target)
+		} else {
+			pastTargetAvg.Mul(pastTargetAvg, big.NewInt(n))
+			pastTargetAvg.Add(pastTargetAvg, target)
+			pastTargetAvg.Div(pastTargetAvg, big.NewInt(n+1))
+		}
+
+		if n != dgwPastBlocks {
+			firstNode = firstNode.parent
+		}
+	}
+
+	// Limit the amount of adjustment that can occur.  Note that the
+	// actual timespan only covers dgwPastBlocks-1 intervals.
+	actualTimespan := lastNode.timestamp - firstNode.timestamp
+	targetTimespan := dgwPastBlocks * targetSpacing
+	if actualTimespan < targetTimespan/3 {
+		actualTimespan = targetTimespan / 3
+	}
+	if actualTimespan > targetTimespan*3 {
+		actualTimespan = targetTimespan * 3
+	}
+
+	// Calculate new target difficulty as:
+	//  averageTarget * (actualTimespan / targetTimespan)
+	newTarget := pastTargetAvg.Mul(pastTargetAvg, big.NewInt(actualTimespan))
+	newTarget.Div(newTarget, big.NewInt(targetTimespan))
+
+	// Limit new value to the proof of work limit.
+	if newTarget.Cmp(b.chainParams.PowLimit) > 0 {
+		newTarget.Set(b.chainParams.PowLimit)
+	}
+	return BigToCompact(newTarget)
+}
+
 // CalcNextRequiredDifficulty calculates the required difficulty for the block
 // after the end of the current best chain based on the difficulty retarget
 // rules.
@@ -7,6 +7,9 @@ package blockchain
 import (
 	"math/big"
 	"testing"
+	"time"
+
+	"github.com/dashpay/dashd-go/chaincfg"
 )
 
 // TestBigToCompact ensures BigToCompact converts big integers to the expected
@@ -73,3 +76,199 @@ func TestCalcWork(t *testing.T) {
 		}
 	}
 }
+
+// dashTestParams returns a copy of the main network parameters with the Dash
+// main network proof of work limit.
+func dashTestParams() *chaincfg.Params {
+	params := chaincfg.MainNetParams
+	params.PowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 236), bigOne)
+	params.PowLimitBits = 0x1e0fffff
+	return &params
+}
+
+// retargetTestChain extends the genesis block of the passed fake chain with
+// numBlocks blocks whose targets and timestamps are derived from a linear
+// congruential generator.  Every block is mined minDelta plus up to span-1
+// seconds after its parent.  The same sequence was fed to a transcription of
+// the Dash Core retarget functions to obtain the expected values of the tests.
+func retargetTestChain(chain *BlockChain, numBlocks int, minDelta, span int64) *blockNode {
+	node := chain.bestChain.Tip()
+	x := int64(1)
+	timestamp := int64(1408728000)
+	for i := 0; i < numBlocks; i++ {
+		x = (x*1103515245 + 12345) % (1 << 31)
+		timestamp += minDelta + x%span
+		bits := uint32(0x1b000000 | (0x100000 + x%0x400000))
+		node = newFakeNode(node, 1, bits, time.Unix(timestamp, 0))
+		chain.index.AddNode(node)
+	}
+	return node
+}
+
+// TestDarkGravityWave ensures Dark Gravity Wave v3 calculates the same targets
+// as Dash Core, including the limits of the adjustment and the minimum
+// difficulty rules of test networks.
+func TestDarkGravityWave(t *testing.T) {
+	tests := []struct {
+		name        string
+		numBlocks   int
+		minDelta    int64
+		span        int64
+		reduceMin   bool
+		nextBlockIn int64
+		want        uint32
+	}{
+		{"not enough blocks", 23, 30, 240, false, 150, 0x1e0fffff},
+		{"on target", 40, 30, 240, false, 150, 0x1b2a5723},
+		{"fast blocks", 40, 1, 10, false, 150, 0x1b0f31a4},
+		{"slow blocks", 40, 1000, 500, false, 150, 0x1c0088be},
+		{"testnet on time", 40, 30, 240, true, 600, 0x1b2a5723},
+		{"testnet tenfold", 40, 30, 240, true, 601, 0x1c019762},
+		{"testnet two hours", 40, 30, 240, true, 7201, 0x1e0fffff},
+	}
+
+	for _, test := range tests {
+		params := dashTestParams()
+		params.ReduceMinDifficulty = test.reduceMin
+		chain := newFakeChain(params)
+		node := retargetTestChain(chain, test.numBlocks, test.minDelta,
+			test.span)
+		next := time.Unix(node.timestamp+test.nextBlockIn, 0)
+
+		got := chain.calcDarkGravityWave(node, next)
+		if got != test.want {
+			t.Errorf("%s: got %08x, want %08x", test.name, got,
+				test.want)
+		}
+	}
+}
+
+// TestKimotoGravityWell ensures Kimoto Gravity Well calculates the same
+// targets as Dash Core.
+func TestKimotoGravityWell(t *testing.T) {
+	tests := []struct {
+		name      string
+		numBlocks int
+		minDelta  int64
+		span      int64
+		want      uint32
+	}{
+		{"not enough blocks", 13, 30, 240, 0x1e0fffff},
+		{"on target", 200, 30, 240, 0x1b30d861},
+		{"fast blocks", 200, 100, 20, 0x1b21f4be},
+	}
+
+	for _, test := range tests {
+		chain := newFakeChain(dashTestParams())
+		node := retargetTestChain(chain, test.numBlocks, test.minDelta,
+			test.span)
+
+		got := chain.calcKimotoGravityWell(node)
+		if got != test.want {
+			t.Errorf("%s: got %08x, want %08x", test.name, got,
+				test.want)
+		}
+	}
+}
+
+// TestRetargetActivation ensures the retarget rules are selected by the
+// PowKGWHeight and PowDGWHeight parameters.
+func TestRetargetActivation(t *testing.T) {
+	tests := []struct {
+		name       string
+		kgwHeight  int32
+		dgwHeight  int32
+		noRetarget bool
+		want       uint32
+	}{
+		{"bitcoin", 42, 42, false, 0x1b28bd19},
+		{"kimoto gravity well", 41, 42, false, 0x1b2b42f7},
+		{"dark gravity wave", 41, 41, false, 0x1b2a5723},
+		{"no retargeting", 41, 41, true, 0x1e0fffff},
+	}
+
+	for _, test := range tests {
+		params := dashTestParams()
+		params.PowKGWHeight = test.kgwHeight
+		params.PowDGWHeight = test.dgwHeight
+		params.PowNoRetargeting = test.noRetarget
+		chain := newFakeChain(params)
+		node := retargetTestChain(chain, 40, 30, 240)
+		next := time.Unix(node.timestamp+150, 0)
+
+		got, err := chain.calcNextRequiredDifficulty(node, next)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if got != test.want {
+			t.Errorf("%s: got %08x, want %08x", test.name, got,
+				test.want)
+		}
+	}
+}
+
+// TestRetargetSwitchHeights ensures the retarget rules switch at the heights of
+// the main network and test network parameters.  The test network skips Kimoto
+// Gravity Well and switches from the Bitcoin rules to Dark Gravity Wave.
+func TestRetargetSwitchHeights(t *testing.T) {
+	const (
+		bitcoin = iota
+		kimoto
+		darkGravity
+	)
+	tests := []struct {
+		name       string
+		params     *chaincfg.Params
+		nextHeight int32
+		want       int
+	}{
+		{"mainnet before KGW", &chaincfg.MainNetParams, 15199, bitcoin},
+		{"mainnet KGW", &chaincfg.MainNetParams, 15200, kimoto},
+		{"mainnet before DGW", &chaincfg.MainNetParams, 34139, kimoto},
+		{"mainnet DGW", &chaincfg.MainNetParams, 34140, darkGravity},
+		{"testnet before DGW", &chaincfg.TestNet3Params, 4001, bitcoin},
+		{"testnet DGW", &chaincfg.TestNet3Params, 4002, darkGravity},
+	}
+
+	for _, test := range tests {
+		// Only the last blocks before the switch height are created
+		// since the rules stop at the first block without a parent.
+		chain := newFakeChain(test.params)
+		node := newBlockNode(&test.params.GenesisBlock.Header, nil)
+		node.height = test.nextHeight - 101
+		x := int64(1)
+		timestamp := int64(1408728000)
+		for i := 0; i < 100; i++ {
+			x = (x*1103515245 + 12345) % (1 << 31)
+			timestamp += 30 + x%240
+			bits := uint32(0x1b000000 | (0x100000 + x%0x400000))
+			node = newFakeNode(node, 1, bits, time.Unix(timestamp, 0))
+		}
+		next := time.Unix(node.timestamp+150, 0)
+
+		bitcoinBits, err := chain.calcBitcoinRetarget(node, next)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		results := []uint32{
+			bitcoin:     bitcoinBits,
+			kimoto:      chain.calcKimotoGravityWell(node),
+			darkGravity: chain.calcDarkGravityWave(node, next),
+		}
+		for i := range results {
+			if i != test.want && results[i] == results[test.want] {
+				t.Fatalf("%s: retarget rules %d and %d agree on "+
+					"%08x", test.name, i, test.want, results[i])
+			}
+		}
+
+		got, err := chain.calcNextRequiredDifficulty(node, next)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if got != results[test.want] {
+			t.Errorf("%s: got %08x, want %08x", test.name, got,
+				results[test.want])
+		}
+	}
+}
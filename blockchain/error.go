@@ -220,6 +220,49 @@ const (
 	// current chain tip. This is not a block validation rule, but is required
 	// for block proposals submitted via getblocktemplate RPC.
 	ErrPrevBlockNotBest
+
+	// ErrBadCbTxPayload indicates the extra payload of a coinbase special
+	// transaction is malformed.
+	ErrBadCbTxPayload
+
+	// ErrBadCbTxHeight indicates the height in the payload of a coinbase
+	// special transaction does not match the height of the block.
+	ErrBadCbTxHeight
+
+	// ErrMissingCbTx indicates the coinbase transaction of a block at or
+	// after the DIP0003 activation height is not a coinbase special
+	// transaction.
+	ErrMissingCbTx
+
+	// ErrBadChainLockSig indicates the signature of a ChainLock is not a
+	// valid signature of the quorum responsible for its height or could
+	// not be verified.
+	ErrBadChainLockSig
+
+	// ErrUnknownChainLockBlock indicates a ChainLock locks a block which is
+	// not known.
+	ErrUnknownChainLockBlock
+
+	// ErrChainLockConflict indicates a ChainLock locks a block which is not
+	// part of the main chain at the locked height.
+	ErrChainLockConflict
+
+	// ErrBadMasternodePayee indicates the coinbase transaction of a block
+	// does not pay the expected amount to the masternode selected by the
+	// deterministic masternode list.
+	ErrBadMasternodePayee
+
+	// ErrBadOperatorPayee indicates the coinbase transaction of a block does
+	// not pay the expected operator reward split of the masternode payment.
+	ErrBadOperatorPayee
+
+	// ErrBadPlatformPayment indicates the coinbase transaction of a block
+	// does not lock the expected Platform share of the masternode reward.
+	ErrBadPlatformPayment
+
+	// ErrSuperblockBudgetExceeded indicates the coinbase transaction of a
+	// superblock pays more than the block reward plus the treasury budget.
+	ErrSuperblockBudgetExceeded
 )
 
 // Map of ErrorCode values back to their constant names for pretty printing.
@@ -267,6 +310,16 @@ var errorCodeStrings = map[ErrorCode]string{
 	ErrPreviousBlockUnknown:      "ErrPreviousBlockUnknown",
 	ErrInvalidAncestorBlock:      "ErrInvalidAncestorBlock",
 	ErrPrevBlockNotBest:          "ErrPrevBlockNotBest",
+	ErrBadCbTxPayload:            "ErrBadCbTxPayload",
+	ErrBadCbTxHeight:             "ErrBadCbTxHeight",
+	ErrMissingCbTx:               "ErrMissingCbTx",
+	ErrBadChainLockSig:           "ErrBadChainLockSig",
+	ErrUnknownChainLockBlock:     "ErrUnknownChainLockBlock",
+	ErrChainLockConflict:         "ErrChainLockConflict",
+	ErrBadMasternodePayee:        "ErrBadMasternodePayee",
+	ErrBadOperatorPayee:          "ErrBadOperatorPayee",
+	ErrBadPlatformPayment:        "ErrBadPlatformPayment",
+	ErrSuperblockBudgetExceeded:  "ErrSuperblockBudgetExceeded",
 }
 
 // String returns the ErrorCode as a human-readable name.
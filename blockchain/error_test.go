@@ -58,6 +58,16 @@ func TestErrorCodeStringer(t *testing.T) {
 		{ErrPreviousBlockUnknown, "ErrPreviousBlockUnknown"},
 		{ErrInvalidAncestorBlock, "ErrInvalidAncestorBlock"},
 		{ErrPrevBlockNotBest, "ErrPrevBlockNotBest"},
+		{ErrBadCbTxPayload, "ErrBadCbTxPayload"},
+		{ErrBadCbTxHeight, "ErrBadCbTxHeight"},
+		{ErrMissingCbTx, "ErrMissingCbTx"},
+		{ErrBadChainLockSig, "ErrBadChainLockSig"},
+		{ErrUnknownChainLockBlock, "ErrUnknownChainLockBlock"},
+		{ErrChainLockConflict, "ErrChainLockConflict"},
+		{ErrBadMasternodePayee, "ErrBadMasternodePayee"},
+		{ErrBadOperatorPayee, "ErrBadOperatorPayee"},
+		{ErrBadPlatformPayment, "ErrBadPlatformPayment"},
+		{ErrSuperblockBudgetExceeded, "ErrSuperblockBudgetExceeded"},
 		{0xffff, "Unknown ErrorCode (65535)"},
 	}
 
@@ -69,7 +69,7 @@ func ExampleBlockChain_ProcessBlock() {
 	fmt.Printf("Block accepted. Is it an orphan?: %v", isOrphan)
 
 	// Output:
-	// Failed to process block: already have block 000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f
+	// Failed to process block: already have block 00000ffd590b1485b3caadc19b22e6379c733355108f107a430458cdf3407ab6
 }
 
 // This example demonstrates how to convert the compact "bits" in a block header
@@ -291,8 +291,10 @@ func (g *testGenerator) createCoinbaseTx(blockHeight int32) *wire.MsgTx {
 		Sequence:        wire.MaxTxInSequenceNum,
 		SignatureScript: coinbaseScript,
 	})
+	subsidy := blockchain.CalcBlockSubsidy(blockHeight, g.tip.Header.Bits,
+		g.params)
 	tx.AddTxOut(&wire.TxOut{
-		Value:    blockchain.CalcBlockSubsidy(blockHeight, g.params),
+		Value:    subsidy,
 		PkScript: opTrueScript,
 	})
 	return tx
@@ -342,7 +344,7 @@ func solveBlock(header *wire.BlockHeader) bool {
 				return
 			default:
 				hdr.Nonce = i
-				hash := hdr.BlockHash()
+				hash := hdr.PowHash()
 				if blockchain.HashToBig(&hash).Cmp(
 					targetDifficulty) <= 0 {
 
@@ -1441,11 +1443,11 @@ func Generate(includeLargeReorg bool) (tests [][]TestInstance, err error) {
 	{
 		origHash := b46.BlockHash()
 		for {
-			// Keep incrementing the nonce until the hash treated as
-			// a uint256 is higher than the limit.
+			// Keep incrementing the nonce until the proof of work
+			// hash treated as a uint256 is higher than the limit.
 			b46.Header.Nonce++
-			blockHash := b46.BlockHash()
-			hashNum := blockchain.HashToBig(&blockHash)
+			powHash := b46.Header.PowHash()
+			hashNum := blockchain.HashToBig(&powHash)
 			if hashNum.Cmp(g.params.PowLimit) >= 0 {
 				break
 			}
@@ -53,10 +53,10 @@ var (
 		Header: wire.BlockHeader{
 			Version:    1,
 			PrevBlock:  *newHashFromStr("0000000000000000000000000000000000000000000000000000000000000000"),
-			MerkleRoot: *newHashFromStr("4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b"),
-			Timestamp:  time.Unix(1296688602, 0), // 2011-02-02 23:16:42 +0000 UTC
+			MerkleRoot: *newHashFromStr("e0028eb9648db56b1ac77cf090b99048a8007e2bb64b68f092c03c7f56a662c7"),
+			Timestamp:  time.Unix(1417713337, 0), // 2014-12-04 17:15:37 +0000 UTC
 			Bits:       0x207fffff,               // 545259519 [7fffff0000000000000000000000000000000000000000000000000000000000]
-			Nonce:      2,
+			Nonce:      1096447,
 		},
 		Transactions: []*wire.MsgTx{{
 			Version: 1,
@@ -65,20 +65,21 @@ var (
 					Hash:  chainhash.Hash{},
 					Index: 0xffffffff,
 				},
-				SignatureScript: fromHex("04ffff001d010445" +
-					"5468652054696d65732030332f4a616e2f" +
-					"32303039204368616e63656c6c6f72206f" +
-					"6e206272696e6b206f66207365636f6e64" +
-					"206261696c6f757420666f72206261686b73"),
+				SignatureScript: fromHex("04ffff001d01044c59" +
+					"5769726564203039" +
+					"2f4a616e2f323031342054686520477261" +
+					"6e64204578706572696d656e7420476f65" +
+					"73204c6976653a204f76657273746f636b" +
+					"2e636f6d204973204e6f77204163636570" +
+					"74696e6720426974636f696e73"),
 				Sequence: 0xffffffff,
 			}},
 			TxOut: []*wire.TxOut{{
-				Value: 0,
-				PkScript: fromHex("4104678afdb0fe5548271967f1" +
-					"a67130b7105cd6a828e03909a67962e0ea1f" +
-					"61deb649f6bc3f4cef38c4f35504e51ec138" +
-					"c4f35504e51ec112de5c384df7ba0b8d578a" +
-					"4c702b6bf11d5fac"),
+				Value: 0x12a05f200,
+				PkScript: fromHex("41040184710fa689ad5023690c" +
+					"80f3a49c8f13f8d45b8c857fbcbc8bc4a8e4" +
+					"d3eb4b10f4d4604fa08dce601aaf0f470216" +
+					"fe1b51850b4acf21b179c45070ac7b03a9ac"),
 			}},
 			LockTime: 0,
 		}},
@@ -98,21 +99,36 @@ var regressionNetParams = &chaincfg.Params{
 	DefaultPort: "18444",
 
 	// Chain parameters
-	GenesisBlock:             &regTestGenesisBlock,
-	GenesisHash:              newHashFromStr("5bec7567af40504e0994db3b573c186fffcc4edefe096ff2e58d00523bd7e8a6"),
-	PowLimit:                 regressionPowLimit,
-	PowLimitBits:             0x207fffff,
-	CoinbaseMaturity:         100,
-	BIP0034Height:            100000000, // Not active - Permit ver 1 blocks
-	BIP0065Height:            1351,      // Used by regression tests
-	BIP0066Height:            1251,      // Used by regression tests
-	SubsidyReductionInterval: 150,
-	TargetTimespan:           time.Hour * 24 * 14, // 14 days
-	TargetTimePerBlock:       time.Minute * 10,    // 10 minutes
-	RetargetAdjustmentFactor: 4,                   // 25% less, 400% more
-	ReduceMinDifficulty:      true,
-	MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
-	GenerateSupported:        true,
+	GenesisBlock:                     &regTestGenesisBlock,
+	GenesisHash:                      newHashFromStr("000008ca1832a4baf228eb1553c03d3a2c8e02399550dd6ea8d65cec3ef23d2e"),
+	PowLimit:                         regressionPowLimit,
+	PowLimitBits:                     0x207fffff,
+	CoinbaseMaturity:                 100,
+	BIP0034Height:                    100000000, // Not active - Permit ver 1 blocks
+	BIP0065Height:                    1351,      // Used by regression tests
+	BIP0066Height:                    1251,      // Used by regression tests
+	DIP0003Height:                    100000000, // Not active - Permit normal coinbases
+	DIP0003EnforcementHeight:         100000000, // Not active
+	SubsidyReductionInterval:         150,
+	BudgetPaymentsStartHeight:        1000,
+	SuperblockStartHeight:            1500,
+	SuperblockCycle:                  10,
+	MasternodePaymentsStartHeight:    240,
+	MasternodePaymentsIncreaseHeight: 350,
+	MasternodePaymentsIncreasePeriod: 10,
+	MasternodeMinimumConfirmations:   1,
+	BRRHeight:                        2500,
+	V20Height:                        900,
+	MNRRHeight:                       900,
+	TargetTimespan:                   time.Hour * 24 * 14, // 14 days
+	TargetTimePerBlock:               time.Minute * 10,    // 10 minutes
+	RetargetAdjustmentFactor:         4,                   // 25% less, 400% more
+	ReduceMinDifficulty:              true,
+	MinDiffReductionTime:             time.Minute * 20, // TargetTimePerBlock * 2
+	PowNoRetargeting:                 true,
+	PowKGWHeight:                     15200,
+	PowDGWHeight:                     34140,
+	GenerateSupported:                true,
 
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints: nil,
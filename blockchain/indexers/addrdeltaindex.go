@@ -0,0 +1,539 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dashpay/dashd-go/blockchain"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/txscript"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+const (
+	// addrDeltaIndexName is the human-readable name for the index.
+	addrDeltaIndexName = "address delta index"
+
+	// addrDeltaKeySize is the size of a serialized address delta key.
+	addrDeltaKeySize = addrKeySize + 4 + 4 + chainhash.HashSize + 4 + 1
+
+	// addrUtxoKeySize is the size of a serialized address utxo key.
+	addrUtxoKeySize = addrKeySize + chainhash.HashSize + 4
+
+	// addrUtxoValueHeaderSize is the size of the fixed part of a
+	// serialized address utxo value.
+	addrUtxoValueHeaderSize = 8 + 4
+)
+
+var (
+	// addrDeltaIndexKey is the key of the address delta index and the
+	// parent db bucket of its buckets.
+	addrDeltaIndexKey = []byte("addrdeltaidx")
+
+	// addrDeltaBucketName is the name of the db bucket used to house the
+	// balance changes of each address.
+	addrDeltaBucketName = []byte("addrdeltas")
+
+	// addrUtxoBucketName is the name of the db bucket used to house the
+	// unspent outputs of each address.
+	addrUtxoBucketName = []byte("addrutxos")
+)
+
+// -----------------------------------------------------------------------------
+// The address delta index is the equivalent of the -addressindex of Dash Core.
+// Unlike the address index, which maps addresses to the transactions involving
+// them, it records every change of the balance of an address along with the
+// outputs the address can currently spend.
+//
+// There are two buckets used in total.  The first bucket maps each address to
+// the amounts it received and spent, ordered by block height and position in
+// the block.  The second maps each address to its unspent outputs.  The height
+// and position in the block are serialized big endian so that a cursor visits
+// the entries of an address in chain order.
+//
+// The serialized format for keys and values in the delta bucket is:
+//   <addr><height><tx index><txid><index><spending> = <amount>
+//
+//   Field           Type              Size
+//   addr            [addrKeySize]byte 21 bytes
+//   height          uint32            4 bytes
+//   tx index        uint32            4 bytes
+//   txid            chainhash.Hash    32 bytes
+//   index           uint32            4 bytes
+//   spending        bool              1 byte
+//   amount          int64             8 bytes
+//   -----
+//   Total: 74 bytes
+//
+// The index is the input index for spending entries and the output index
+// otherwise.  The amount is negative for spending entries.
+//
+// The serialized format for keys and values in the utxo bucket is:
+//   <addr><txid><output index> = <amount><height><pkscript>
+//
+//   Field           Type              Size
+//   addr            [addrKeySize]byte 21 bytes
+//   txid            chainhash.Hash    32 bytes
+//   output index    uint32            4 bytes
+//   amount          int64             8 bytes
+//   height          uint32            4 bytes
+//   pkscript        []byte            variable
+//   -----
+//   Total: 69 bytes + len(pkscript)
+// -----------------------------------------------------------------------------
+
+// AddrDelta describes a change of the balance of an address by an input or an
+// output of a transaction in the main chain.
+type AddrDelta struct {
+	// Height is the height of the block containing the transaction.
+	Height int32
+
+	// TxIndex is the position of the transaction in the block.
+	TxIndex uint32
+
+	// TxHash is the hash of the transaction.
+	TxHash chainhash.Hash
+
+	// Index is the index of the input when Spending is set and the index
+	// of the output otherwise.
+	Index uint32
+
+	// Spending is set when the delta was caused by an input.
+	Spending bool
+
+	// Amount is the change of the balance, which is negative for inputs.
+	Amount int64
+}
+
+// AddrUtxo describes an unspent output paying to an address.
+type AddrUtxo struct {
+	// OutPoint is the outpoint of the output.
+	OutPoint wire.OutPoint
+
+	// Amount is the value of the output.
+	Amount int64
+
+	// PkScript is the public key script of the output.
+	PkScript []byte
+
+	// Height is the height of the block containing the output.
+	Height int32
+}
+
+// scriptAddrKey returns the address key of the address the passed public key
+// script pays to.  False is returned when the script does not pay to exactly
+// one supported address.  Like the reference implementation, bare multisig
+// scripts are not indexed since they don't pay to a single address.
+func scriptAddrKey(pkScript []byte, chainParams *chaincfg.Params) ([addrKeySize]byte, bool) {
+	class, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript,
+		chainParams)
+	if err != nil || class == txscript.MultiSigTy || len(addrs) != 1 {
+		return [addrKeySize]byte{}, false
+	}
+	addrKey, err := addrToKey(addrs[0])
+	if err != nil {
+		return [addrKeySize]byte{}, false
+	}
+	return addrKey, true
+}
+
+// addrDeltaKey returns the delta bucket key for the passed values.
+func addrDeltaKey(addrKey [addrKeySize]byte, height int32, txIdx uint32,
+	txHash *chainhash.Hash, index uint32, spending bool) []byte {
+
+	key := make([]byte, addrDeltaKeySize)
+	offset := copy(key, addrKey[:])
+	binary.BigEndian.PutUint32(key[offset:], uint32(height))
+	offset += 4
+	binary.BigEndian.PutUint32(key[offset:], txIdx)
+	offset += 4
+	offset += copy(key[offset:], txHash[:])
+	binary.BigEndian.PutUint32(key[offset:], index)
+	offset += 4
+	if spending {
+		key[offset] = 1
+	}
+	return key
+}
+
+// serializeAddrDeltaAmount returns the delta bucket value for the passed
+// amount.
+func serializeAddrDeltaAmount(amount int64) []byte {
+	serialized := make([]byte, 8)
+	byteOrder.PutUint64(serialized, uint64(amount))
+	return serialized
+}
+
+// deserializeAddrDelta decodes an entry of the delta bucket.
+func deserializeAddrDelta(key, value []byte) (*AddrDelta, error) {
+	if len(key) != addrDeltaKeySize || len(value) < 8 {
+		return nil, database.Error{
+			ErrorCode:   database.ErrCorruption,
+			Description: "corrupt address delta index entry",
+		}
+	}
+
+	var delta AddrDelta
+	offset := addrKeySize
+	delta.Height = int32(binary.BigEndian.Uint32(key[offset:]))
+	offset += 4
+	delta.TxIndex = binary.BigEndian.Uint32(key[offset:])
+	offset += 4
+	offset += copy(delta.TxHash[:], key[offset:offset+chainhash.HashSize])
+	delta.Index = binary.BigEndian.Uint32(key[offset:])
+	offset += 4
+	delta.Spending = key[offset] != 0
+	delta.Amount = int64(byteOrder.Uint64(value))
+	return &delta, nil
+}
+
+// addrUtxoKey returns the utxo bucket key for the passed values.
+func addrUtxoKey(addrKey [addrKeySize]byte, outpoint *wire.OutPoint) []byte {
+	key := make([]byte, addrUtxoKeySize)
+	offset := copy(key, addrKey[:])
+	offset += copy(key[offset:], outpoint.Hash[:])
+	byteOrder.PutUint32(key[offset:], outpoint.Index)
+	return key
+}
+
+// serializeAddrUtxo returns the utxo bucket value for the passed values.
+func serializeAddrUtxo(amount int64, height int32, pkScript []byte) []byte {
+	serialized := make([]byte, addrUtxoValueHeaderSize+len(pkScript))
+	byteOrder.PutUint64(serialized, uint64(amount))
+	byteOrder.PutUint32(serialized[8:], uint32(height))
+	copy(serialized[addrUtxoValueHeaderSize:], pkScript)
+	return serialized
+}
+
+// deserializeAddrUtxo decodes an entry of the utxo bucket.
+func deserializeAddrUtxo(key, value []byte) (*AddrUtxo, error) {
+	if len(key) != addrUtxoKeySize || len(value) < addrUtxoValueHeaderSize {
+		return nil, database.Error{
+			ErrorCode:   database.ErrCorruption,
+			Description: "corrupt address utxo index entry",
+		}
+	}
+
+	var utxo AddrUtxo
+	offset := addrKeySize
+	offset += copy(utxo.OutPoint.Hash[:], key[offset:offset+chainhash.HashSize])
+	utxo.OutPoint.Index = byteOrder.Uint32(key[offset:])
+	utxo.Amount = int64(byteOrder.Uint64(value))
+	utxo.Height = int32(byteOrder.Uint32(value[8:]))
+	utxo.PkScript = make([]byte, len(value)-addrUtxoValueHeaderSize)
+	copy(utxo.PkScript, value[addrUtxoValueHeaderSize:])
+	return &utxo, nil
+}
+
+// AddrDeltaIndex implements an index of the balance changes and unspent
+// outputs of every address.
+type AddrDeltaIndex struct {
+	db          database.DB
+	chainParams *chaincfg.Params
+}
+
+// Ensure the AddrDeltaIndex type implements the Indexer interface.
+var _ Indexer = (*AddrDeltaIndex)(nil)
+
+// Ensure the AddrDeltaIndex type implements the NeedsInputser interface.
+var _ NeedsInputser = (*AddrDeltaIndex)(nil)
+
+// NeedsInputs signals that the index requires the referenced inputs in order
+// to properly create the index.
+//
+// This implements the NeedsInputser interface.
+func (idx *AddrDeltaIndex) NeedsInputs() bool {
+	return true
+}
+
+// Init is only provided to satisfy the Indexer interface as there is nothing to
+// initialize for this index.
+//
+// This is part of the Indexer interface.
+func (idx *AddrDeltaIndex) Init() error {
+	// Nothing to do.
+	return nil
+}
+
+// Key returns the database key to use for the index as a byte slice.
+//
+// This is part of the Indexer interface.
+func (idx *AddrDeltaIndex) Key() []byte {
+	return addrDeltaIndexKey
+}
+
+// Name returns the human-readable name of the index.
+//
+// This is part of the Indexer interface.
+func (idx *AddrDeltaIndex) Name() string {
+	return addrDeltaIndexName
+}
+
+// Create is invoked when the indexer manager determines the index needs
+// to be created for the first time.  It creates the parent bucket and the
+// delta and utxo buckets of the index.
+//
+// This is part of the Indexer interface.
+func (idx *AddrDeltaIndex) Create(dbTx database.Tx) error {
+	parent, err := dbTx.Metadata().CreateBucket(addrDeltaIndexKey)
+	if err != nil {
+		return err
+	}
+	if _, err := parent.CreateBucket(addrDeltaBucketName); err != nil {
+		return err
+	}
+	_, err = parent.CreateBucket(addrUtxoBucketName)
+	return err
+}
+
+// buckets returns the delta and utxo buckets of the index.
+func (idx *AddrDeltaIndex) buckets(dbTx database.Tx) (database.Bucket, database.Bucket) {
+	parent := dbTx.Metadata().Bucket(addrDeltaIndexKey)
+	return parent.Bucket(addrDeltaBucketName), parent.Bucket(addrUtxoBucketName)
+}
+
+// txStxos splits the passed spent outputs of a block into the outputs spent
+// by each of its transactions.
+func txStxos(block *btcutil.Block, stxos []blockchain.SpentTxOut) ([][]blockchain.SpentTxOut, error) {
+	txns := block.Transactions()
+	result := make([][]blockchain.SpentTxOut, len(txns))
+	offset := 0
+	for txIdx, tx := range txns[1:] {
+		numIn := len(tx.MsgTx().TxIn)
+		if offset+numIn > len(stxos) {
+			return nil, fmt.Errorf("missing spent outputs for "+
+				"block %v", block.Hash())
+		}
+		result[txIdx+1] = stxos[offset : offset+numIn]
+		offset += numIn
+	}
+	return result, nil
+}
+
+// ConnectBlock is invoked by the index manager when a new block has been
+// connected to the main chain.  This indexer adds a delta for every input and
+// output of the transactions in the block paying to a supported address and
+// updates the unspent outputs of those addresses.
+//
+// This is part of the Indexer interface.
+func (idx *AddrDeltaIndex) ConnectBlock(dbTx database.Tx, block *btcutil.Block,
+	stxos []blockchain.SpentTxOut) error {
+
+	spent, err := txStxos(block, stxos)
+	if err != nil {
+		return err
+	}
+
+	deltas, utxos := idx.buckets(dbTx)
+	height := block.Height()
+	for txIdx, tx := range block.Transactions() {
+		txHash := tx.Hash()
+		// Coinbases do not reference any inputs.
+		txIns := tx.MsgTx().TxIn
+		if txIdx == 0 {
+			txIns = nil
+		}
+		for txInIdx, txIn := range txIns {
+			stxo := &spent[txIdx][txInIdx]
+			addrKey, ok := scriptAddrKey(stxo.PkScript, idx.chainParams)
+			if !ok {
+				continue
+			}
+
+			key := addrDeltaKey(addrKey, height, uint32(txIdx), txHash,
+				uint32(txInIdx), true)
+			err := deltas.Put(key, serializeAddrDeltaAmount(-stxo.Amount))
+			if err != nil {
+				return err
+			}
+			key = addrUtxoKey(addrKey, &txIn.PreviousOutPoint)
+			if err := utxos.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		for txOutIdx, txOut := range tx.MsgTx().TxOut {
+			addrKey, ok := scriptAddrKey(txOut.PkScript, idx.chainParams)
+			if !ok {
+				continue
+			}
+
+			key := addrDeltaKey(addrKey, height, uint32(txIdx), txHash,
+				uint32(txOutIdx), false)
+			err := deltas.Put(key, serializeAddrDeltaAmount(txOut.Value))
+			if err != nil {
+				return err
+			}
+			outpoint := wire.OutPoint{Hash: *txHash, Index: uint32(txOutIdx)}
+			err = utxos.Put(addrUtxoKey(addrKey, &outpoint),
+				serializeAddrUtxo(txOut.Value, height, txOut.PkScript))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// DisconnectBlock is invoked by the index manager when a block has been
+// disconnected from the main chain.  This indexer removes the deltas of the
+// transactions in the block, removes the outputs they created and restores
+// the outputs they spent.
+//
+// This is part of the Indexer interface.
+func (idx *AddrDeltaIndex) DisconnectBlock(dbTx database.Tx, block *btcutil.Block,
+	stxos []blockchain.SpentTxOut) error {
+
+	spent, err := txStxos(block, stxos)
+	if err != nil {
+		return err
+	}
+
+	// The transactions are undone in reverse order so that outputs created
+	// and spent in the same block are not restored.
+	deltas, utxos := idx.buckets(dbTx)
+	height := block.Height()
+	txns := block.Transactions()
+	for txIdx := len(txns) - 1; txIdx >= 0; txIdx-- {
+		tx := txns[txIdx]
+		txHash := tx.Hash()
+		for txOutIdx, txOut := range tx.MsgTx().TxOut {
+			addrKey, ok := scriptAddrKey(txOut.PkScript, idx.chainParams)
+			if !ok {
+				continue
+			}
+
+			key := addrDeltaKey(addrKey, height, uint32(txIdx), txHash,
+				uint32(txOutIdx), false)
+			if err := deltas.Delete(key); err != nil {
+				return err
+			}
+			outpoint := wire.OutPoint{Hash: *txHash, Index: uint32(txOutIdx)}
+			if err := utxos.Delete(addrUtxoKey(addrKey, &outpoint)); err != nil {
+				return err
+			}
+		}
+
+		if txIdx == 0 {
+			continue
+		}
+		for txInIdx, txIn := range tx.MsgTx().TxIn {
+			stxo := &spent[txIdx][txInIdx]
+			addrKey, ok := scriptAddrKey(stxo.PkScript, idx.chainParams)
+			if !ok {
+				continue
+			}
+
+			key := addrDeltaKey(addrKey, height, uint32(txIdx), txHash,
+				uint32(txInIdx), true)
+			if err := deltas.Delete(key); err != nil {
+				return err
+			}
+			err := utxos.Put(addrUtxoKey(addrKey, &txIn.PreviousOutPoint),
+				serializeAddrUtxo(stxo.Amount, stxo.Height, stxo.PkScript))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddrDeltas returns the balance changes of the passed address in the main
+// chain ordered by height and position in the block.  Only the changes in
+// blocks with a height in the range [start, end] are returned, where an end
+// of zero means there is no upper bound.
+//
+// This function is safe for concurrent access.
+func (idx *AddrDeltaIndex) AddrDeltas(addr btcutil.Address, start, end int32) ([]AddrDelta, error) {
+	addrKey, err := addrToKey(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var seek [addrKeySize + 4]byte
+	copy(seek[:], addrKey[:])
+	binary.BigEndian.PutUint32(seek[addrKeySize:], uint32(start))
+
+	var result []AddrDelta
+	err = idx.db.View(func(dbTx database.Tx) error {
+		deltas, _ := idx.buckets(dbTx)
+		cursor := deltas.Cursor()
+		for ok := cursor.Seek(seek[:]); ok; ok = cursor.Next() {
+			key := cursor.Key()
+			if !bytes.HasPrefix(key, addrKey[:]) {
+				break
+			}
+			delta, err := deserializeAddrDelta(key, cursor.Value())
+			if err != nil {
+				return err
+			}
+			if end != 0 && delta.Height > end {
+				break
+			}
+			result = append(result, *delta)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// AddrUtxos returns the unspent outputs paying to the passed address in the
+// main chain.
+//
+// This function is safe for concurrent access.
+func (idx *AddrDeltaIndex) AddrUtxos(addr btcutil.Address) ([]AddrUtxo, error) {
+	addrKey, err := addrToKey(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []AddrUtxo
+	err = idx.db.View(func(dbTx database.Tx) error {
+		_, utxos := idx.buckets(dbTx)
+		cursor := utxos.Cursor()
+		for ok := cursor.Seek(addrKey[:]); ok; ok = cursor.Next() {
+			key := cursor.Key()
+			if !bytes.HasPrefix(key, addrKey[:]) {
+				break
+			}
+			utxo, err := deserializeAddrUtxo(key, cursor.Value())
+			if err != nil {
+				return err
+			}
+			result = append(result, *utxo)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// NewAddrDeltaIndex returns a new instance of an indexer that is used to
+// create a mapping of all addresses in the blockchain to the changes of their
+// balance and their unspent outputs.
+//
+// It implements the Indexer interface which plugs into the IndexManager that in
+// turn is used by the blockchain package.  This allows the index to be
+// seamlessly maintained along with the chain.
+func NewAddrDeltaIndex(db database.DB, chainParams *chaincfg.Params) *AddrDeltaIndex {
+	return &AddrDeltaIndex{
+		db:          db,
+		chainParams: chainParams,
+	}
+}
+
+// DropAddrDeltaIndex drops the address delta index from the provided database
+// if it exists.
+func DropAddrDeltaIndex(db database.DB, interrupt <-chan struct{}) error {
+	return dropIndex(db, addrDeltaIndexKey, addrDeltaIndexName, interrupt)
+}
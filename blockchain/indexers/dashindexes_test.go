@@ -0,0 +1,339 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/dashpay/dashd-go/blockchain"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	_ "github.com/dashpay/dashd-go/database/ffldb"
+	"github.com/dashpay/dashd-go/txscript"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// createTestIndex creates a database in a temporary directory and creates
+// the passed index in it.
+func createTestIndex(t *testing.T, indexer Indexer) database.DB {
+	t.Helper()
+
+	db, err := database.Create("ffldb", t.TempDir(), wire.MainNet)
+	if err != nil {
+		t.Fatalf("unable to create database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	err = db.Update(func(dbTx database.Tx) error {
+		return indexer.Create(dbTx)
+	})
+	if err != nil {
+		t.Fatalf("unable to create %s: %v", indexer.Name(), err)
+	}
+	return db
+}
+
+// testAddress returns a pay-to-pubkey-hash address and script for the passed
+// hash160 filler byte.
+func testAddress(t *testing.T, b byte) (btcutil.Address, []byte) {
+	t.Helper()
+
+	addr, err := btcutil.NewAddressPubKeyHash(bytes.Repeat([]byte{b}, 20),
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+	return addr, pkScript
+}
+
+// testSpendBlock returns a block at height 100 with a coinbase paying 50 to A,
+// a transaction spending an earlier output of 10 paid to B, which pays 4 to A
+// and 6 to B, and a transaction spending the output paying 4 to A back to B.
+// The outputs spent by the block are returned along with it.
+func testSpendBlock(t *testing.T) (*btcutil.Block, []blockchain.SpentTxOut) {
+	t.Helper()
+
+	_, scriptA := testAddress(t, 0xaa)
+	_, scriptB := testAddress(t, 0xbb)
+
+	coinbase := wire.NewMsgTx(1)
+	coinbase.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{},
+		wire.MaxPrevOutIndex), []byte{0x01, 0x64}, nil))
+	coinbase.AddTxOut(wire.NewTxOut(50, scriptA))
+
+	tx1 := wire.NewMsgTx(1)
+	tx1.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0x01}, 3), nil, nil))
+	tx1.AddTxOut(wire.NewTxOut(4, scriptA))
+	tx1.AddTxOut(wire.NewTxOut(6, scriptB))
+	tx1Hash := tx1.TxHash()
+
+	tx2 := wire.NewMsgTx(1)
+	tx2.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&tx1Hash, 0), nil, nil))
+	tx2.AddTxOut(wire.NewTxOut(4, scriptB))
+
+	msgBlock := wire.NewMsgBlock(wire.NewBlockHeader(1, &chainhash.Hash{0x02},
+		&chainhash.Hash{}, 0, 0))
+	msgBlock.AddTransaction(coinbase)
+	msgBlock.AddTransaction(tx1)
+	msgBlock.AddTransaction(tx2)
+	block := btcutil.NewBlock(msgBlock)
+	block.SetHeight(100)
+
+	stxos := []blockchain.SpentTxOut{
+		{Amount: 10, PkScript: scriptB, Height: 90},
+		{Amount: 4, PkScript: scriptA, Height: 100},
+	}
+	return block, stxos
+}
+
+// TestSpentIndex ensures the spent index records the inputs spending outputs
+// and removes them when the block is disconnected.
+func TestSpentIndex(t *testing.T) {
+	t.Parallel()
+
+	db := createTestIndex(t, NewSpentIndex(nil))
+	idx := NewSpentIndex(db)
+	block, stxos := testSpendBlock(t)
+	err := db.Update(func(dbTx database.Tx) error {
+		return idx.ConnectBlock(dbTx, block, stxos)
+	})
+	if err != nil {
+		t.Fatalf("ConnectBlock: %v", err)
+	}
+
+	txns := block.Transactions()
+	outpoint := wire.OutPoint{Hash: *txns[1].Hash(), Index: 0}
+	info, err := idx.SpentInfo(&outpoint)
+	if err != nil {
+		t.Fatalf("SpentInfo: %v", err)
+	}
+	want := &SpentInfo{TxHash: *txns[2].Hash(), Height: 100, Amount: 4}
+	if info == nil || *info != *want {
+		t.Fatalf("SpentInfo: got %+v, want %+v", info, want)
+	}
+
+	outpoint.Index = 1
+	if info, err := idx.SpentInfo(&outpoint); err != nil || info != nil {
+		t.Fatalf("SpentInfo of unspent output: got %+v, %v", info, err)
+	}
+
+	err = db.Update(func(dbTx database.Tx) error {
+		return idx.DisconnectBlock(dbTx, block, stxos)
+	})
+	if err != nil {
+		t.Fatalf("DisconnectBlock: %v", err)
+	}
+	outpoint = wire.OutPoint{Hash: chainhash.Hash{0x01}, Index: 3}
+	if info, err := idx.SpentInfo(&outpoint); err != nil || info != nil {
+		t.Fatalf("SpentInfo after disconnect: got %+v, %v", info, err)
+	}
+}
+
+// TestAddrDeltaIndex ensures the address delta index records the balance
+// changes and unspent outputs of addresses and restores the unspent outputs
+// when the block is disconnected.
+func TestAddrDeltaIndex(t *testing.T) {
+	t.Parallel()
+
+	db := createTestIndex(t, NewAddrDeltaIndex(nil, nil))
+	idx := NewAddrDeltaIndex(db, &chaincfg.MainNetParams)
+	addrA, _ := testAddress(t, 0xaa)
+	addrB, scriptB := testAddress(t, 0xbb)
+
+	// Seed the utxo spent by the block as if an earlier block created it.
+	prevOut := wire.OutPoint{Hash: chainhash.Hash{0x01}, Index: 3}
+	err := db.Update(func(dbTx database.Tx) error {
+		_, utxos := idx.buckets(dbTx)
+		addrKey, _ := addrToKey(addrB)
+		return utxos.Put(addrUtxoKey(addrKey, &prevOut),
+			serializeAddrUtxo(10, 90, scriptB))
+	})
+	if err != nil {
+		t.Fatalf("unable to seed utxo: %v", err)
+	}
+
+	block, stxos := testSpendBlock(t)
+	err = db.Update(func(dbTx database.Tx) error {
+		return idx.ConnectBlock(dbTx, block, stxos)
+	})
+	if err != nil {
+		t.Fatalf("ConnectBlock: %v", err)
+	}
+
+	txns := block.Transactions()
+	deltas, err := idx.AddrDeltas(addrA, 0, 0)
+	if err != nil {
+		t.Fatalf("AddrDeltas: %v", err)
+	}
+	wantDeltas := []AddrDelta{
+		{Height: 100, TxIndex: 0, TxHash: *txns[0].Hash(), Amount: 50},
+		{Height: 100, TxIndex: 1, TxHash: *txns[1].Hash(), Amount: 4},
+		{Height: 100, TxIndex: 2, TxHash: *txns[2].Hash(), Spending: true,
+			Amount: -4},
+	}
+	if len(deltas) != len(wantDeltas) {
+		t.Fatalf("AddrDeltas: got %d deltas, want %d", len(deltas),
+			len(wantDeltas))
+	}
+	for i := range deltas {
+		if deltas[i] != wantDeltas[i] {
+			t.Fatalf("AddrDeltas #%d: got %+v, want %+v", i,
+				deltas[i], wantDeltas[i])
+		}
+	}
+	if deltas, _ := idx.AddrDeltas(addrA, 101, 0); len(deltas) != 0 {
+		t.Fatalf("AddrDeltas above range: got %d deltas", len(deltas))
+	}
+	if deltas, _ := idx.AddrDeltas(addrA, 1, 99); len(deltas) != 0 {
+		t.Fatalf("AddrDeltas below range: got %d deltas", len(deltas))
+	}
+
+	utxos, err := idx.AddrUtxos(addrB)
+	if err != nil {
+		t.Fatalf("AddrUtxos: %v", err)
+	}
+	var total int64
+	for _, utxo := range utxos {
+		if utxo.OutPoint == prevOut {
+			t.Fatalf("AddrUtxos: spent output %v returned", prevOut)
+		}
+		total += utxo.Amount
+	}
+	if len(utxos) != 2 || total != 10 {
+		t.Fatalf("AddrUtxos: got %d utxos worth %d, want 2 worth 10",
+			len(utxos), total)
+	}
+
+	err = db.Update(func(dbTx database.Tx) error {
+		return idx.DisconnectBlock(dbTx, block, stxos)
+	})
+	if err != nil {
+		t.Fatalf("DisconnectBlock: %v", err)
+	}
+	if deltas, _ := idx.AddrDeltas(addrA, 0, 0); len(deltas) != 0 {
+		t.Fatalf("AddrDeltas after disconnect: got %d deltas",
+			len(deltas))
+	}
+	if utxos, _ := idx.AddrUtxos(addrA); len(utxos) != 0 {
+		t.Fatalf("AddrUtxos after disconnect: got %d utxos", len(utxos))
+	}
+	utxos, err = idx.AddrUtxos(addrB)
+	if err != nil || len(utxos) != 1 || utxos[0].OutPoint != prevOut ||
+		utxos[0].Height != 90 || !bytes.Equal(utxos[0].PkScript, scriptB) {
+
+		t.Fatalf("AddrUtxos after disconnect: got %+v, %v", utxos, err)
+	}
+}
+
+// TestScriptAddrKey ensures only scripts paying to a single supported address
+// are assigned an address key and that bare multisig scripts are skipped.
+func TestScriptAddrKey(t *testing.T) {
+	t.Parallel()
+
+	addr, pkScript := testAddress(t, 0xaa)
+	wantKey, err := addrToKey(addr)
+	if err != nil {
+		t.Fatalf("addrToKey: %v", err)
+	}
+	if key, ok := scriptAddrKey(pkScript, &chaincfg.MainNetParams); !ok ||
+		key != wantKey {
+
+		t.Fatalf("scriptAddrKey pay-to-pubkey-hash: got %x, %v, want "+
+			"%x", key, ok, wantKey)
+	}
+
+	// A 1-of-2 bare multisig script with the compressed public keys of
+	// the private keys 1 and 2.
+	pubKey1, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b0702" +
+		"9bfcdb2dce28d959f2815b16f81798")
+	pubKey2, _ := hex.DecodeString("02c6047f9441ed7d6d3045406e95c07cd85c" +
+		"778e4b8cef3ca7abac09b95c709ee5")
+	multiSigScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_1).AddData(pubKey1).AddData(pubKey2).
+		AddOp(txscript.OP_2).AddOp(txscript.OP_CHECKMULTISIG).Script()
+	if err != nil {
+		t.Fatalf("unable to create multisig script: %v", err)
+	}
+	if class := txscript.GetScriptClass(multiSigScript); class !=
+		txscript.MultiSigTy {
+
+		t.Fatalf("multisig script class: got %v", class)
+	}
+	if key, ok := scriptAddrKey(multiSigScript, &chaincfg.MainNetParams); ok {
+		t.Fatalf("scriptAddrKey bare multisig: got %x, want none", key)
+	}
+
+	nullData := []byte{txscript.OP_RETURN, 0x01, 0x00}
+	if key, ok := scriptAddrKey(nullData, &chaincfg.MainNetParams); ok {
+		t.Fatalf("scriptAddrKey null data: got %x, want none", key)
+	}
+}
+
+// TestTimestampIndex ensures the timestamp index assigns strictly increasing
+// logical timestamps and returns the blocks in the requested range.
+func TestTimestampIndex(t *testing.T) {
+	t.Parallel()
+
+	db := createTestIndex(t, NewTimestampIndex(nil))
+	idx := NewTimestampIndex(db)
+
+	// The second block has the same timestamp as the first and the third
+	// an earlier one, so both are assigned a later logical timestamp.
+	var prevHash chainhash.Hash
+	var blocks []*btcutil.Block
+	for _, timestamp := range []int64{1000, 1000, 900, 2000} {
+		header := wire.NewBlockHeader(1, &prevHash, &chainhash.Hash{}, 0, 0)
+		header.Timestamp = time.Unix(timestamp, 0)
+		block := btcutil.NewBlock(wire.NewMsgBlock(header))
+		err := db.Update(func(dbTx database.Tx) error {
+			return idx.ConnectBlock(dbTx, block, nil)
+		})
+		if err != nil {
+			t.Fatalf("ConnectBlock: %v", err)
+		}
+		blocks = append(blocks, block)
+		prevHash = *block.Hash()
+	}
+
+	result, err := idx.BlockHashes(2000, 1000)
+	if err != nil {
+		t.Fatalf("BlockHashes: %v", err)
+	}
+	want := []BlockTimestamp{
+		{Hash: *blocks[0].Hash(), LogicalTime: 1000},
+		{Hash: *blocks[1].Hash(), LogicalTime: 1001},
+		{Hash: *blocks[2].Hash(), LogicalTime: 1002},
+	}
+	if len(result) != len(want) {
+		t.Fatalf("BlockHashes: got %+v, want %+v", result, want)
+	}
+	for i := range result {
+		if result[i] != want[i] {
+			t.Fatalf("BlockHashes #%d: got %+v, want %+v", i,
+				result[i], want[i])
+		}
+	}
+
+	err = db.Update(func(dbTx database.Tx) error {
+		return idx.DisconnectBlock(dbTx, blocks[3], nil)
+	})
+	if err != nil {
+		t.Fatalf("DisconnectBlock: %v", err)
+	}
+	result, err = idx.BlockHashes(3000, 1001)
+	if err != nil || len(result) != 2 {
+		t.Fatalf("BlockHashes after disconnect: got %+v, %v", result,
+			err)
+	}
+}
@@ -0,0 +1,415 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/dashpay/dashd-go/blockchain"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+const (
+	// specialTxIndexName is the human-readable name for the index.
+	specialTxIndexName = "special transaction index"
+
+	// specialTxLocSize is the size of the serialized location of a
+	// transaction within the index keys.
+	specialTxLocSize = 4 + 4 + chainhash.HashSize
+
+	// specialTxTypeKeySize is the size of a serialized by type key.
+	specialTxTypeKeySize = 2 + specialTxLocSize
+
+	// specialTxProTxKeySize is the size of a serialized by proTxHash key.
+	specialTxProTxKeySize = chainhash.HashSize + specialTxLocSize
+)
+
+var (
+	// specialTxIndexKey is the key of the special transaction index and
+	// the parent db bucket of its buckets.
+	specialTxIndexKey = []byte("specialtxidx")
+
+	// specialTxByTypeBucketName is the name of the db bucket used to house
+	// the special transactions by type.
+	specialTxByTypeBucketName = []byte("bytype")
+
+	// specialTxByProTxBucketName is the name of the db bucket used to
+	// house the special transactions by the proTxHash they reference.
+	specialTxByProTxBucketName = []byte("byprotx")
+)
+
+// -----------------------------------------------------------------------------
+// The special transaction index consists of an entry for every DIP-2 special
+// transaction in the main chain keyed by its type and, for the provider
+// transactions, an entry keyed by the proTxHash of the masternode it refers
+// to.  The proTxHash of a provider registration transaction is its own hash.
+//
+// The location of a transaction is its block height, its position in the
+// block and its hash.  The height and position are serialized big endian so
+// that a cursor visits the entries of a type or masternode in chain order.
+//
+// The serialized format for keys and values in the by type bucket is:
+//   <type><height><tx index><txid> = <>
+//
+//   Field           Type              Size
+//   type            uint16            2 bytes
+//   height          uint32            4 bytes
+//   tx index        uint32            4 bytes
+//   txid            chainhash.Hash    32 bytes
+//   -----
+//   Total: 42 bytes
+//
+// The serialized format for keys and values in the by proTxHash bucket is:
+//   <proTxHash><height><tx index><txid> = <type>
+//
+//   Field           Type              Size
+//   proTxHash       chainhash.Hash    32 bytes
+//   height          uint32            4 bytes
+//   tx index        uint32            4 bytes
+//   txid            chainhash.Hash    32 bytes
+//   type            uint16            2 bytes
+//   -----
+//   Total: 74 bytes
+// -----------------------------------------------------------------------------
+
+// SpecialTxEntry describes the location of a special transaction in the main
+// chain.
+type SpecialTxEntry struct {
+	// Type is the special transaction type.
+	Type wire.TxType
+
+	// Height is the height of the block containing the transaction.
+	Height int32
+
+	// TxIndex is the position of the transaction in the block.
+	TxIndex uint32
+
+	// TxHash is the hash of the transaction.
+	TxHash chainhash.Hash
+}
+
+// putSpecialTxLoc serializes the location of the passed entry into the target
+// byte slice, which must be at least specialTxLocSize bytes.
+func putSpecialTxLoc(target []byte, entry *SpecialTxEntry) {
+	binary.BigEndian.PutUint32(target, uint32(entry.Height))
+	binary.BigEndian.PutUint32(target[4:], entry.TxIndex)
+	copy(target[8:], entry.TxHash[:])
+}
+
+// readSpecialTxLoc deserializes the location of a transaction from the passed
+// byte slice into the entry.
+func readSpecialTxLoc(serialized []byte, entry *SpecialTxEntry) {
+	entry.Height = int32(binary.BigEndian.Uint32(serialized))
+	entry.TxIndex = binary.BigEndian.Uint32(serialized[4:])
+	copy(entry.TxHash[:], serialized[8:specialTxLocSize])
+}
+
+// specialTxTypeKey returns the by type bucket key for the passed entry.
+func specialTxTypeKey(entry *SpecialTxEntry) []byte {
+	key := make([]byte, specialTxTypeKeySize)
+	binary.BigEndian.PutUint16(key, uint16(entry.Type))
+	putSpecialTxLoc(key[2:], entry)
+	return key
+}
+
+// specialTxProTxKey returns the by proTxHash bucket key for the passed entry.
+func specialTxProTxKey(proTxHash *chainhash.Hash, entry *SpecialTxEntry) []byte {
+	key := make([]byte, specialTxProTxKeySize)
+	copy(key, proTxHash[:])
+	putSpecialTxLoc(key[chainhash.HashSize:], entry)
+	return key
+}
+
+// specialTxProTxHash returns the proTxHash of the masternode the passed
+// special transaction refers to.  False is returned for transactions that are
+// not provider transactions.
+func specialTxProTxHash(tx *btcutil.Tx) (chainhash.Hash, bool) {
+	msgTx := tx.MsgTx()
+	switch msgTx.Type {
+	case wire.TxTypeProRegTx:
+		return *tx.Hash(), true
+
+	case wire.TxTypeProUpServTx, wire.TxTypeProUpRegTx,
+		wire.TxTypeProUpRevTx:
+
+	default:
+		return chainhash.Hash{}, false
+	}
+
+	// The block has already been validated, so the payload is only
+	// malformed when the index is out of sync with the validation rules.
+	payload, err := evo.DecodeTxPayload(msgTx)
+	if err != nil {
+		log.Warnf("Unable to decode payload of %v: %v", tx.Hash(), err)
+		return chainhash.Hash{}, false
+	}
+	switch payload := payload.(type) {
+	case *evo.ProUpServTx:
+		return payload.ProTxHash, true
+	case *evo.ProUpRegTx:
+		return payload.ProTxHash, true
+	case *evo.ProUpRevTx:
+		return payload.ProTxHash, true
+	}
+	return chainhash.Hash{}, false
+}
+
+// SpecialTxIndex implements an index of the special transactions in the main
+// chain by type and by the masternode they refer to.
+type SpecialTxIndex struct {
+	db database.DB
+}
+
+// Ensure the SpecialTxIndex type implements the Indexer interface.
+var _ Indexer = (*SpecialTxIndex)(nil)
+
+// Init is only provided to satisfy the Indexer interface as there is nothing to
+// initialize for this index.
+//
+// This is part of the Indexer interface.
+func (idx *SpecialTxIndex) Init() error {
+	// Nothing to do.
+	return nil
+}
+
+// Key returns the database key to use for the index as a byte slice.
+//
+// This is part of the Indexer interface.
+func (idx *SpecialTxIndex) Key() []byte {
+	return specialTxIndexKey
+}
+
+// Name returns the human-readable name of the index.
+//
+// This is part of the Indexer interface.
+func (idx *SpecialTxIndex) Name() string {
+	return specialTxIndexName
+}
+
+// Create is invoked when the indexer manager determines the index needs
+// to be created for the first time.  It creates the parent bucket and the
+// buckets of the index.
+//
+// This is part of the Indexer interface.
+func (idx *SpecialTxIndex) Create(dbTx database.Tx) error {
+	parent, err := dbTx.Metadata().CreateBucket(specialTxIndexKey)
+	if err != nil {
+		return err
+	}
+	if _, err := parent.CreateBucket(specialTxByTypeBucketName); err != nil {
+		return err
+	}
+	_, err = parent.CreateBucket(specialTxByProTxBucketName)
+	return err
+}
+
+// buckets returns the by type and by proTxHash buckets of the index.
+func (idx *SpecialTxIndex) buckets(dbTx database.Tx) (database.Bucket, database.Bucket) {
+	parent := dbTx.Metadata().Bucket(specialTxIndexKey)
+	return parent.Bucket(specialTxByTypeBucketName),
+		parent.Bucket(specialTxByProTxBucketName)
+}
+
+// forEachSpecialTx invokes the passed function with the index keys of every
+// special transaction in the block.
+func forEachSpecialTx(block *btcutil.Block,
+	fn func(typeKey, proTxKey []byte, txType wire.TxType) error) error {
+
+	for txIdx, tx := range block.Transactions() {
+		if !tx.MsgTx().IsSpecial() {
+			continue
+		}
+
+		entry := SpecialTxEntry{
+			Type:    tx.MsgTx().Type,
+			Height:  block.Height(),
+			TxIndex: uint32(txIdx),
+			TxHash:  *tx.Hash(),
+		}
+		var proTxKey []byte
+		if proTxHash, ok := specialTxProTxHash(tx); ok {
+			proTxKey = specialTxProTxKey(&proTxHash, &entry)
+		}
+		err := fn(specialTxTypeKey(&entry), proTxKey, entry.Type)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConnectBlock is invoked by the index manager when a new block has been
+// connected to the main chain.  This indexer adds the entries for every
+// special transaction in the block.
+//
+// This is part of the Indexer interface.
+func (idx *SpecialTxIndex) ConnectBlock(dbTx database.Tx, block *btcutil.Block,
+	_ []blockchain.SpentTxOut) error {
+
+	byType, byProTx := idx.buckets(dbTx)
+	return forEachSpecialTx(block, func(typeKey, proTxKey []byte, txType wire.TxType) error {
+		if err := byType.Put(typeKey, nil); err != nil {
+			return err
+		}
+		if proTxKey == nil {
+			return nil
+		}
+		var serialized [2]byte
+		byteOrder.PutUint16(serialized[:], uint16(txType))
+		return byProTx.Put(proTxKey, serialized[:])
+	})
+}
+
+// DisconnectBlock is invoked by the index manager when a block has been
+// disconnected from the main chain.  This indexer removes the entries for
+// every special transaction in the block.
+//
+// This is part of the Indexer interface.
+func (idx *SpecialTxIndex) DisconnectBlock(dbTx database.Tx, block *btcutil.Block,
+	_ []blockchain.SpentTxOut) error {
+
+	byType, byProTx := idx.buckets(dbTx)
+	return forEachSpecialTx(block, func(typeKey, proTxKey []byte, _ wire.TxType) error {
+		if err := byType.Delete(typeKey); err != nil {
+			return err
+		}
+		if proTxKey == nil {
+			return nil
+		}
+		return byProTx.Delete(proTxKey)
+	})
+}
+
+// fetchEntries returns the entries of the passed bucket with the passed prefix
+// in blocks with a height in the range [start, end], where an end of zero
+// means there is no upper bound.  The filter function, when not nil, selects
+// the entries to return.  The first numToSkip selected entries are skipped and
+// at most numRequested entries are returned.
+func fetchEntries(bucket database.Bucket, prefix []byte, start, end int32,
+	numToSkip, numRequested uint32, decode func(key, value []byte) *SpecialTxEntry,
+	filter func(*SpecialTxEntry) bool) []SpecialTxEntry {
+
+	seek := make([]byte, len(prefix)+4)
+	copy(seek, prefix)
+	binary.BigEndian.PutUint32(seek[len(prefix):], uint32(start))
+
+	var result []SpecialTxEntry
+	cursor := bucket.Cursor()
+	for ok := cursor.Seek(seek); ok; ok = cursor.Next() {
+		if uint32(len(result)) >= numRequested {
+			break
+		}
+		key := cursor.Key()
+		if !bytes.HasPrefix(key, prefix) {
+			break
+		}
+		entry := decode(key, cursor.Value())
+		if entry == nil {
+			continue
+		}
+		if end != 0 && entry.Height > end {
+			break
+		}
+		if filter != nil && !filter(entry) {
+			continue
+		}
+		if numToSkip > 0 {
+			numToSkip--
+			continue
+		}
+		result = append(result, *entry)
+	}
+	return result
+}
+
+// TxnsByType returns the special transactions of the passed type in blocks
+// with a height in the range [start, end] ordered by their position in the
+// chain, where an end of zero means there is no upper bound.  The first
+// numToSkip transactions are skipped and at most numRequested transactions
+// are returned.
+//
+// This function is safe for concurrent access.
+func (idx *SpecialTxIndex) TxnsByType(txType wire.TxType, start, end int32,
+	numToSkip, numRequested uint32) ([]SpecialTxEntry, error) {
+
+	var prefix [2]byte
+	binary.BigEndian.PutUint16(prefix[:], uint16(txType))
+	decode := func(key, _ []byte) *SpecialTxEntry {
+		if len(key) != specialTxTypeKeySize {
+			return nil
+		}
+		entry := SpecialTxEntry{Type: txType}
+		readSpecialTxLoc(key[2:], &entry)
+		return &entry
+	}
+
+	var result []SpecialTxEntry
+	err := idx.db.View(func(dbTx database.Tx) error {
+		byType, _ := idx.buckets(dbTx)
+		result = fetchEntries(byType, prefix[:], start, end, numToSkip,
+			numRequested, decode, nil)
+		return nil
+	})
+	return result, err
+}
+
+// TxnsByProTxHash returns the provider transactions which refer to the
+// masternode with the passed proTxHash in blocks with a height in the range
+// [start, end] ordered by their position in the chain, where an end of zero
+// means there is no upper bound.  Only transactions of the passed type are
+// returned unless it is nil.  The first numToSkip transactions are skipped and
+// at most numRequested transactions are returned.
+//
+// This function is safe for concurrent access.
+func (idx *SpecialTxIndex) TxnsByProTxHash(proTxHash *chainhash.Hash,
+	txType *wire.TxType, start, end int32, numToSkip,
+	numRequested uint32) ([]SpecialTxEntry, error) {
+
+	decode := func(key, value []byte) *SpecialTxEntry {
+		if len(key) != specialTxProTxKeySize || len(value) != 2 {
+			return nil
+		}
+		entry := SpecialTxEntry{Type: wire.TxType(byteOrder.Uint16(value))}
+		readSpecialTxLoc(key[chainhash.HashSize:], &entry)
+		return &entry
+	}
+	var filter func(*SpecialTxEntry) bool
+	if txType != nil {
+		filter = func(entry *SpecialTxEntry) bool {
+			return entry.Type == *txType
+		}
+	}
+
+	var result []SpecialTxEntry
+	err := idx.db.View(func(dbTx database.Tx) error {
+		_, byProTx := idx.buckets(dbTx)
+		result = fetchEntries(byProTx, proTxHash[:], start, end,
+			numToSkip, numRequested, decode, filter)
+		return nil
+	})
+	return result, err
+}
+
+// NewSpecialTxIndex returns a new instance of an indexer that is used to
+// create a mapping of the special transactions in the blockchain by type and
+// by the masternode they refer to.
+//
+// It implements the Indexer interface which plugs into the IndexManager that in
+// turn is used by the blockchain package.  This allows the index to be
+// seamlessly maintained along with the chain.
+func NewSpecialTxIndex(db database.DB) *SpecialTxIndex {
+	return &SpecialTxIndex{db: db}
+}
+
+// DropSpecialTxIndex drops the special transaction index from the provided
+// database if it exists.
+func DropSpecialTxIndex(db database.DB, interrupt <-chan struct{}) error {
+	return dropIndex(db, specialTxIndexKey, specialTxIndexName, interrupt)
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"testing"
+
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+// testSpecialTx returns a special transaction of the passed type with the
+// passed payload.  A nil payload results in a transaction without payload.
+func testSpecialTx(t *testing.T, txType wire.TxType, payload evo.Payload,
+	nonce uint32) *wire.MsgTx {
+
+	t.Helper()
+
+	tx := wire.NewMsgTx(wire.SpecialTxVersion)
+	tx.Type = txType
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0x01}, nonce),
+		nil, nil))
+	if payload != nil {
+		if err := evo.SetTxPayload(tx, payload); err != nil {
+			t.Fatalf("unable to set payload: %v", err)
+		}
+	}
+	return tx
+}
+
+// testSpecialTxBlock returns a block at the passed height with a regular
+// coinbase followed by the passed transactions.
+func testSpecialTxBlock(height int32, txns ...*wire.MsgTx) *btcutil.Block {
+	coinbase := wire.NewMsgTx(1)
+	coinbase.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{},
+		wire.MaxPrevOutIndex), []byte{0x01, byte(height)}, nil))
+	coinbase.AddTxOut(wire.NewTxOut(50, nil))
+
+	msgBlock := wire.NewMsgBlock(wire.NewBlockHeader(1, &chainhash.Hash{},
+		&chainhash.Hash{}, 0, uint32(height)))
+	msgBlock.AddTransaction(coinbase)
+	for _, tx := range txns {
+		msgBlock.AddTransaction(tx)
+	}
+	block := btcutil.NewBlock(msgBlock)
+	block.SetHeight(height)
+	return block
+}
+
+// TestSpecialTxIndex ensures the special transaction index returns the
+// special transactions by type and by proTxHash in chain order and removes
+// them when their block is disconnected.
+func TestSpecialTxIndex(t *testing.T) {
+	t.Parallel()
+
+	db := createTestIndex(t, NewSpecialTxIndex(nil))
+	idx := NewSpecialTxIndex(db)
+
+	regTx := testSpecialTx(t, wire.TxTypeProRegTx, nil, 0)
+	proTxHash := regTx.TxHash()
+	revTx1 := testSpecialTx(t, wire.TxTypeProUpRevTx,
+		&evo.ProUpRevTx{Version: 1, ProTxHash: proTxHash}, 1)
+	otherRevTx := testSpecialTx(t, wire.TxTypeProUpRevTx,
+		&evo.ProUpRevTx{Version: 1, ProTxHash: chainhash.Hash{0x02}}, 2)
+	revTx2 := testSpecialTx(t, wire.TxTypeProUpRevTx,
+		&evo.ProUpRevTx{Version: 1, ProTxHash: proTxHash}, 3)
+
+	blocks := []*btcutil.Block{
+		testSpecialTxBlock(10, regTx, revTx1),
+		testSpecialTxBlock(20, otherRevTx, revTx2),
+	}
+	for _, block := range blocks {
+		err := db.Update(func(dbTx database.Tx) error {
+			return idx.ConnectBlock(dbTx, block, nil)
+		})
+		if err != nil {
+			t.Fatalf("ConnectBlock: %v", err)
+		}
+	}
+
+	revType := wire.TxTypeProUpRevTx
+	tests := []struct {
+		name      string
+		proTxHash *chainhash.Hash
+		txType    wire.TxType
+		typeOnly  bool
+		start     int32
+		end       int32
+		skip      uint32
+		count     uint32
+		want      []SpecialTxEntry
+	}{
+		{
+			name:   "by type",
+			txType: wire.TxTypeProUpRevTx,
+			count:  10,
+			want: []SpecialTxEntry{
+				{revType, 10, 2, revTx1.TxHash()},
+				{revType, 20, 1, otherRevTx.TxHash()},
+				{revType, 20, 2, revTx2.TxHash()},
+			},
+		},
+		{
+			name:   "by type with skip and count",
+			txType: wire.TxTypeProUpRevTx,
+			skip:   1,
+			count:  1,
+			want: []SpecialTxEntry{
+				{revType, 20, 1, otherRevTx.TxHash()},
+			},
+		},
+		{
+			name:   "by type in height range",
+			txType: wire.TxTypeProUpRevTx,
+			start:  11,
+			end:    20,
+			count:  10,
+			want: []SpecialTxEntry{
+				{revType, 20, 1, otherRevTx.TxHash()},
+				{revType, 20, 2, revTx2.TxHash()},
+			},
+		},
+		{
+			name:   "by type below range",
+			txType: wire.TxTypeProRegTx,
+			start:  11,
+			count:  10,
+		},
+		{
+			name:      "by proTxHash",
+			proTxHash: &proTxHash,
+			count:     10,
+			want: []SpecialTxEntry{
+				{wire.TxTypeProRegTx, 10, 1, proTxHash},
+				{revType, 10, 2, revTx1.TxHash()},
+				{revType, 20, 2, revTx2.TxHash()},
+			},
+		},
+		{
+			name:      "by proTxHash and type with skip",
+			proTxHash: &proTxHash,
+			txType:    wire.TxTypeProUpRevTx,
+			typeOnly:  true,
+			skip:      1,
+			count:     10,
+			want: []SpecialTxEntry{
+				{revType, 20, 2, revTx2.TxHash()},
+			},
+		},
+		{
+			name:      "by proTxHash in height range",
+			proTxHash: &proTxHash,
+			end:       10,
+			count:     10,
+			want: []SpecialTxEntry{
+				{wire.TxTypeProRegTx, 10, 1, proTxHash},
+				{revType, 10, 2, revTx1.TxHash()},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		var entries []SpecialTxEntry
+		var err error
+		if test.proTxHash != nil {
+			var txType *wire.TxType
+			if test.typeOnly {
+				txType = &test.txType
+			}
+			entries, err = idx.TxnsByProTxHash(test.proTxHash, txType,
+				test.start, test.end, test.skip, test.count)
+		} else {
+			entries, err = idx.TxnsByType(test.txType, test.start,
+				test.end, test.skip, test.count)
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if len(entries) != len(test.want) {
+			t.Errorf("%s: got %+v, want %+v", test.name, entries,
+				test.want)
+			continue
+		}
+		for i := range entries {
+			if entries[i] != test.want[i] {
+				t.Errorf("%s #%d: got %+v, want %+v", test.name, i,
+					entries[i], test.want[i])
+			}
+		}
+	}
+
+	err := db.Update(func(dbTx database.Tx) error {
+		return idx.DisconnectBlock(dbTx, blocks[1], nil)
+	})
+	if err != nil {
+		t.Fatalf("DisconnectBlock: %v", err)
+	}
+	entries, err := idx.TxnsByType(wire.TxTypeProUpRevTx, 0, 0, 0, 10)
+	if err != nil || len(entries) != 1 || entries[0].TxHash != revTx1.TxHash() {
+		t.Fatalf("TxnsByType after disconnect: got %+v, %v", entries, err)
+	}
+	entries, err = idx.TxnsByProTxHash(&chainhash.Hash{0x02}, nil, 0, 0, 0, 10)
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("TxnsByProTxHash after disconnect: got %+v, %v", entries,
+			err)
+	}
+}
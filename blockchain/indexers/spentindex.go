@@ -0,0 +1,247 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"fmt"
+
+	"github.com/dashpay/dashd-go/blockchain"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+const (
+	// spentIndexName is the human-readable name for the index.
+	spentIndexName = "spent index"
+
+	// spentIndexKeySize is the size of a serialized spent index key.
+	spentIndexKeySize = chainhash.HashSize + 4
+
+	// spentIndexValueSize is the size of a serialized spent index value.
+	spentIndexValueSize = chainhash.HashSize + 4 + 4 + 8
+)
+
+var (
+	// spentIndexKey is the key of the spent index and the db bucket used
+	// to house it.
+	spentIndexKey = []byte("spentidx")
+)
+
+// -----------------------------------------------------------------------------
+// The spent index consists of an entry for every output spent in the main
+// chain which maps the outpoint to the input spending it.  It is the
+// equivalent of the -spentindex of Dash Core.
+//
+// The serialized format for keys and values in the spent index bucket is:
+//   <outpoint> = <spending txid><input index><height><amount>
+//
+//   Field           Type              Size
+//   outpoint hash   chainhash.Hash    32 bytes
+//   outpoint index  uint32            4 bytes
+//   spending txid   chainhash.Hash    32 bytes
+//   input index     uint32            4 bytes
+//   height          uint32            4 bytes
+//   amount          int64             8 bytes
+//   -----
+//   Total: 84 bytes
+// -----------------------------------------------------------------------------
+
+// SpentInfo describes the input which spent an output.
+type SpentInfo struct {
+	// TxHash is the hash of the spending transaction.
+	TxHash chainhash.Hash
+
+	// InputIndex is the index of the spending input.
+	InputIndex uint32
+
+	// Height is the height of the block containing the spending
+	// transaction.
+	Height int32
+
+	// Amount is the value of the spent output.
+	Amount int64
+}
+
+// spentIndexKeyFor returns the spent index key for the passed outpoint.
+func spentIndexKeyFor(outpoint *wire.OutPoint) []byte {
+	key := make([]byte, spentIndexKeySize)
+	copy(key, outpoint.Hash[:])
+	byteOrder.PutUint32(key[chainhash.HashSize:], outpoint.Index)
+	return key
+}
+
+// serializeSpentInfo returns the spent index value for the passed values.
+func serializeSpentInfo(txHash *chainhash.Hash, inputIndex uint32,
+	height int32, amount int64) []byte {
+
+	serialized := make([]byte, spentIndexValueSize)
+	offset := copy(serialized, txHash[:])
+	byteOrder.PutUint32(serialized[offset:], inputIndex)
+	offset += 4
+	byteOrder.PutUint32(serialized[offset:], uint32(height))
+	offset += 4
+	byteOrder.PutUint64(serialized[offset:], uint64(amount))
+	return serialized
+}
+
+// deserializeSpentInfo decodes a spent index value.
+func deserializeSpentInfo(serialized []byte) (*SpentInfo, error) {
+	if len(serialized) < spentIndexValueSize {
+		return nil, database.Error{
+			ErrorCode: database.ErrCorruption,
+			Description: fmt.Sprintf("corrupt spent index entry: "+
+				"unexpected length %d", len(serialized)),
+		}
+	}
+
+	var info SpentInfo
+	offset := copy(info.TxHash[:], serialized[:chainhash.HashSize])
+	info.InputIndex = byteOrder.Uint32(serialized[offset:])
+	offset += 4
+	info.Height = int32(byteOrder.Uint32(serialized[offset:]))
+	offset += 4
+	info.Amount = int64(byteOrder.Uint64(serialized[offset:]))
+	return &info, nil
+}
+
+// SpentIndex implements an index from every spent output to the input that
+// spent it.
+type SpentIndex struct {
+	db database.DB
+}
+
+// Ensure the SpentIndex type implements the Indexer interface.
+var _ Indexer = (*SpentIndex)(nil)
+
+// Ensure the SpentIndex type implements the NeedsInputser interface.
+var _ NeedsInputser = (*SpentIndex)(nil)
+
+// NeedsInputs signals that the index requires the referenced inputs in order
+// to properly create the index.
+//
+// This implements the NeedsInputser interface.
+func (idx *SpentIndex) NeedsInputs() bool {
+	return true
+}
+
+// Init is only provided to satisfy the Indexer interface as there is nothing to
+// initialize for this index.
+//
+// This is part of the Indexer interface.
+func (idx *SpentIndex) Init() error {
+	// Nothing to do.
+	return nil
+}
+
+// Key returns the database key to use for the index as a byte slice.
+//
+// This is part of the Indexer interface.
+func (idx *SpentIndex) Key() []byte {
+	return spentIndexKey
+}
+
+// Name returns the human-readable name of the index.
+//
+// This is part of the Indexer interface.
+func (idx *SpentIndex) Name() string {
+	return spentIndexName
+}
+
+// Create is invoked when the indexer manager determines the index needs
+// to be created for the first time.  It creates the bucket for the spent
+// index.
+//
+// This is part of the Indexer interface.
+func (idx *SpentIndex) Create(dbTx database.Tx) error {
+	_, err := dbTx.Metadata().CreateBucket(spentIndexKey)
+	return err
+}
+
+// ConnectBlock is invoked by the index manager when a new block has been
+// connected to the main chain.  This indexer adds an entry for every output
+// spent by the transactions in the block.
+//
+// This is part of the Indexer interface.
+func (idx *SpentIndex) ConnectBlock(dbTx database.Tx, block *btcutil.Block,
+	stxos []blockchain.SpentTxOut) error {
+
+	bucket := dbTx.Metadata().Bucket(spentIndexKey)
+	stxoIndex := 0
+	for _, tx := range block.Transactions()[1:] {
+		for txInIdx, txIn := range tx.MsgTx().TxIn {
+			stxo := &stxos[stxoIndex]
+			stxoIndex++
+
+			value := serializeSpentInfo(tx.Hash(), uint32(txInIdx),
+				block.Height(), stxo.Amount)
+			err := bucket.Put(spentIndexKeyFor(&txIn.PreviousOutPoint),
+				value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// DisconnectBlock is invoked by the index manager when a block has been
+// disconnected from the main chain.  This indexer removes the entries for
+// the outputs spent by the transactions in the block.
+//
+// This is part of the Indexer interface.
+func (idx *SpentIndex) DisconnectBlock(dbTx database.Tx, block *btcutil.Block,
+	stxos []blockchain.SpentTxOut) error {
+
+	bucket := dbTx.Metadata().Bucket(spentIndexKey)
+	for _, tx := range block.Transactions()[1:] {
+		for _, txIn := range tx.MsgTx().TxIn {
+			err := bucket.Delete(spentIndexKeyFor(&txIn.PreviousOutPoint))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// SpentInfo returns the input which spent the passed outpoint in the main
+// chain.  Nil is returned when the outpoint is unknown or unspent.
+//
+// This function is safe for concurrent access.
+func (idx *SpentIndex) SpentInfo(outpoint *wire.OutPoint) (*SpentInfo, error) {
+	var info *SpentInfo
+	err := idx.db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(spentIndexKey)
+		serialized := bucket.Get(spentIndexKeyFor(outpoint))
+		if serialized == nil {
+			return nil
+		}
+
+		var err error
+		info, err = deserializeSpentInfo(serialized)
+		return err
+	})
+	return info, err
+}
+
+// NewSpentIndex returns a new instance of an indexer that is used to create a
+// mapping of all spent outputs in the blockchain to the inputs spending them.
+//
+// It implements the Indexer interface which plugs into the IndexManager that in
+// turn is used by the blockchain package.  This allows the index to be
+// seamlessly maintained along with the chain.
+func NewSpentIndex(db database.DB) *SpentIndex {
+	return &SpentIndex{db: db}
+}
+
+// DropSpentIndex drops the spent index from the provided database if it
+// exists.
+func DropSpentIndex(db database.DB, interrupt <-chan struct{}) error {
+	return dropIndex(db, spentIndexKey, spentIndexName, interrupt)
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dashpay/dashd-go/blockchain"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+)
+
+const (
+	// timestampIndexName is the human-readable name for the index.
+	timestampIndexName = "timestamp index"
+
+	// timestampKeySize is the size of a serialized timestamp key.
+	timestampKeySize = 4 + chainhash.HashSize
+)
+
+var (
+	// timestampIndexKey is the key of the timestamp index and the parent
+	// db bucket of its buckets.
+	timestampIndexKey = []byte("timestampidx")
+
+	// timestampBucketName is the name of the db bucket used to house the
+	// logical timestamp -> block hash index.
+	timestampBucketName = []byte("tsblocks")
+
+	// blockTimestampBucketName is the name of the db bucket used to house
+	// the block hash -> logical timestamp index.
+	blockTimestampBucketName = []byte("blockts")
+)
+
+// -----------------------------------------------------------------------------
+// The timestamp index is the equivalent of the -timestampindex of Dash Core.
+// It maps the logical timestamp of every block in the main chain to its hash.
+// The logical timestamp of a block is its timestamp unless that is not after
+// the logical timestamp of its parent, in which case it is the logical
+// timestamp of the parent plus one.  This makes the logical timestamps
+// strictly increasing along the chain.
+//
+// There are two buckets used in total.  The first bucket maps each logical
+// timestamp to the block hash and the second maps the block hash back to the
+// logical timestamp, which is needed to compute the logical timestamp of the
+// next block.
+//
+// The serialized format for keys and values in the timestamp bucket is:
+//   <logical timestamp><hash> = <>
+//
+//   Field              Type              Size
+//   logical timestamp  uint32            4 bytes (big endian)
+//   hash               chainhash.Hash    32 bytes
+//   -----
+//   Total: 36 bytes
+//
+// The serialized format for keys and values in the block timestamp bucket is:
+//   <hash> = <logical timestamp>
+//
+//   Field              Type              Size
+//   hash               chainhash.Hash    32 bytes
+//   logical timestamp  uint32            4 bytes
+//   -----
+//   Total: 36 bytes
+// -----------------------------------------------------------------------------
+
+// BlockTimestamp is a block hash along with its logical timestamp.
+type BlockTimestamp struct {
+	Hash        chainhash.Hash
+	LogicalTime uint32
+}
+
+// timestampKey returns the timestamp bucket key for the passed values.
+func timestampKey(logicalTime uint32, hash *chainhash.Hash) []byte {
+	key := make([]byte, timestampKeySize)
+	binary.BigEndian.PutUint32(key, logicalTime)
+	copy(key[4:], hash[:])
+	return key
+}
+
+// TimestampIndex implements an index from the logical timestamp of every
+// block in the main chain to its hash.
+type TimestampIndex struct {
+	db database.DB
+}
+
+// Ensure the TimestampIndex type implements the Indexer interface.
+var _ Indexer = (*TimestampIndex)(nil)
+
+// Init is only provided to satisfy the Indexer interface as there is nothing to
+// initialize for this index.
+//
+// This is part of the Indexer interface.
+func (idx *TimestampIndex) Init() error {
+	// Nothing to do.
+	return nil
+}
+
+// Key returns the database key to use for the index as a byte slice.
+//
+// This is part of the Indexer interface.
+func (idx *TimestampIndex) Key() []byte {
+	return timestampIndexKey
+}
+
+// Name returns the human-readable name of the index.
+//
+// This is part of the Indexer interface.
+func (idx *TimestampIndex) Name() string {
+	return timestampIndexName
+}
+
+// Create is invoked when the indexer manager determines the index needs
+// to be created for the first time.  It creates the parent bucket and the
+// buckets of the index.
+//
+// This is part of the Indexer interface.
+func (idx *TimestampIndex) Create(dbTx database.Tx) error {
+	parent, err := dbTx.Metadata().CreateBucket(timestampIndexKey)
+	if err != nil {
+		return err
+	}
+	if _, err := parent.CreateBucket(timestampBucketName); err != nil {
+		return err
+	}
+	_, err = parent.CreateBucket(blockTimestampBucketName)
+	return err
+}
+
+// ConnectBlock is invoked by the index manager when a new block has been
+// connected to the main chain.  This indexer adds the logical timestamp of the
+// block.
+//
+// This is part of the Indexer interface.
+func (idx *TimestampIndex) ConnectBlock(dbTx database.Tx, block *btcutil.Block,
+	_ []blockchain.SpentTxOut) error {
+
+	parent := dbTx.Metadata().Bucket(timestampIndexKey)
+	blockTimestamps := parent.Bucket(blockTimestampBucketName)
+
+	// The parent of the genesis block is not in the index, which results
+	// in the block timestamp being used as is.
+	header := &block.MsgBlock().Header
+	logicalTime := uint32(header.Timestamp.Unix())
+	if prev := blockTimestamps.Get(header.PrevBlock[:]); len(prev) == 4 {
+		prevLogicalTime := byteOrder.Uint32(prev)
+		if logicalTime <= prevLogicalTime {
+			logicalTime = prevLogicalTime + 1
+		}
+	}
+
+	var serialized [4]byte
+	byteOrder.PutUint32(serialized[:], logicalTime)
+	if err := blockTimestamps.Put(block.Hash()[:], serialized[:]); err != nil {
+		return err
+	}
+	return parent.Bucket(timestampBucketName).Put(
+		timestampKey(logicalTime, block.Hash()), nil)
+}
+
+// DisconnectBlock is invoked by the index manager when a block has been
+// disconnected from the main chain.  This indexer removes the logical
+// timestamp of the block.
+//
+// This is part of the Indexer interface.
+func (idx *TimestampIndex) DisconnectBlock(dbTx database.Tx, block *btcutil.Block,
+	_ []blockchain.SpentTxOut) error {
+
+	parent := dbTx.Metadata().Bucket(timestampIndexKey)
+	blockTimestamps := parent.Bucket(blockTimestampBucketName)
+	serialized := blockTimestamps.Get(block.Hash()[:])
+	if len(serialized) != 4 {
+		return database.Error{
+			ErrorCode: database.ErrCorruption,
+			Description: fmt.Sprintf("missing timestamp index "+
+				"entry for block %v", block.Hash()),
+		}
+	}
+
+	logicalTime := byteOrder.Uint32(serialized)
+	if err := blockTimestamps.Delete(block.Hash()[:]); err != nil {
+		return err
+	}
+	return parent.Bucket(timestampBucketName).Delete(
+		timestampKey(logicalTime, block.Hash()))
+}
+
+// BlockHashes returns the blocks in the main chain with a logical timestamp in
+// the range [low, high) ordered by logical timestamp.
+//
+// This function is safe for concurrent access.
+func (idx *TimestampIndex) BlockHashes(high, low uint32) ([]BlockTimestamp, error) {
+	var seek [4]byte
+	binary.BigEndian.PutUint32(seek[:], low)
+
+	var result []BlockTimestamp
+	err := idx.db.View(func(dbTx database.Tx) error {
+		bucket := dbTx.Metadata().Bucket(timestampIndexKey).
+			Bucket(timestampBucketName)
+		cursor := bucket.Cursor()
+		for ok := cursor.Seek(seek[:]); ok; ok = cursor.Next() {
+			key := cursor.Key()
+			if len(key) != timestampKeySize {
+				return database.Error{
+					ErrorCode:   database.ErrCorruption,
+					Description: "corrupt timestamp index entry",
+				}
+			}
+
+			var entry BlockTimestamp
+			entry.LogicalTime = binary.BigEndian.Uint32(key)
+			if entry.LogicalTime >= high {
+				break
+			}
+			copy(entry.Hash[:], key[4:])
+			result = append(result, entry)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// NewTimestampIndex returns a new instance of an indexer that is used to
+// create a mapping of the logical timestamps of all blocks in the blockchain
+// to their hashes.
+//
+// It implements the Indexer interface which plugs into the IndexManager that in
+// turn is used by the blockchain package.  This allows the index to be
+// seamlessly maintained along with the chain.
+func NewTimestampIndex(db database.DB) *TimestampIndex {
+	return &TimestampIndex{db: db}
+}
+
+// DropTimestampIndex drops the timestamp index from the provided database if
+// it exists.
+func DropTimestampIndex(db database.DB, interrupt <-chan struct{}) error {
+	return dropIndex(db, timestampIndexKey, timestampIndexName, interrupt)
+}
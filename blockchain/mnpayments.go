@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/txscript"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// MasternodePayee describes the masternode a block must pay as selected by the
+// deterministic masternode list of its parent.
+type MasternodePayee struct {
+	// ProTxHash identifies the masternode.
+	ProTxHash chainhash.Hash
+
+	// ScriptPayout is the script the masternode reward is paid to.
+	ScriptPayout []byte
+
+	// ScriptOperatorPayout is the script the operator reward is paid to.
+	// The operator reward is paid to ScriptPayout when it is empty.
+	ScriptOperatorPayout []byte
+
+	// OperatorReward is the share of the masternode reward paid to the
+	// operator in hundredths of a percent.
+	OperatorReward uint16
+}
+
+// MasternodePayeeSource provides a generic interface to look up the masternode
+// which must be paid by a block, such as a deterministic masternode list.
+type MasternodePayeeSource interface {
+	// MasternodePayee returns the masternode which must be paid by the
+	// block following the block with the passed hash, or nil when there is
+	// no valid masternode to pay.  The bool is false when the masternode
+	// list of the block is not known, in which case the masternode payments
+	// are not validated.
+	//
+	// It is invoked with the chain lock held, so implementations must not
+	// call back into the chain.
+	MasternodePayee(prevHash *chainhash.Hash) (*MasternodePayee, bool)
+}
+
+// SetMasternodePayeeSource sets the source of the masternodes which blocks must
+// pay.  Once set, the coinbase transaction of every connected block after
+// DIP0003EnforcementHeight must pay the masternode reward to the masternode
+// provided by the source, including the operator reward split and the Platform
+// share.
+//
+// The source can't be passed in the Config since it is typically built on top
+// of the chain, such as the deterministic masternode list.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) SetMasternodePayeeSource(source MasternodePayeeSource) {
+	b.chainLock.Lock()
+	b.mnPayees = source
+	b.chainLock.Unlock()
+}
+
+// splitMasternodeReward returns the parts of the masternode reward of the
+// passed reward which are paid to the passed masternode and to its operator.
+// The operator reward is only split off when the masternode has an operator
+// payout script.
+func splitMasternodeReward(reward *BlockReward,
+	payee *MasternodePayee) (int64, int64) {
+
+	masternode := reward.Masternode
+	var operator int64
+	if payee.OperatorReward != 0 && len(payee.ScriptOperatorPayout) != 0 {
+		operator = masternode * int64(payee.OperatorReward) / 10000
+		masternode -= operator
+	}
+	return masternode, operator
+}
+
+// MasternodePayments returns the outputs the coinbase transaction of a block
+// with the passed reward must contain to pay the passed masternode.  The
+// payee can be nil when there is no valid masternode, in which case only the
+// Platform share is paid.
+//
+// The Platform share is locked in an OP_RETURN output and the operator reward
+// is paid to the operator payout script of the masternode when it has one.
+// Outputs with a zero value are omitted.
+func MasternodePayments(reward *BlockReward, payee *MasternodePayee) []*wire.TxOut {
+	var outs []*wire.TxOut
+	if reward.Platform > 0 {
+		outs = append(outs, wire.NewTxOut(reward.Platform,
+			[]byte{txscript.OP_RETURN}))
+	}
+	if payee == nil {
+		return outs
+	}
+
+	masternode, operator := splitMasternodeReward(reward, payee)
+	if masternode > 0 {
+		outs = append(outs, wire.NewTxOut(masternode, payee.ScriptPayout))
+	}
+	if operator > 0 {
+		outs = append(outs, wire.NewTxOut(operator,
+			payee.ScriptOperatorPayout))
+	}
+	return outs
+}
+
+// hasTxOut returns whether the passed transaction has an output with the
+// passed value and script.
+func hasTxOut(tx *wire.MsgTx, value int64, pkScript []byte) bool {
+	for _, txOut := range tx.TxOut {
+		if txOut.Value == value && bytes.Equal(txOut.PkScript, pkScript) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkMasternodePayments ensures the passed coinbase transaction of a block at
+// the passed height contains all outputs returned by MasternodePayments for the
+// passed reward and masternode.
+func checkMasternodePayments(coinbase *wire.MsgTx, height int32,
+	reward *BlockReward, payee *MasternodePayee) error {
+
+	if reward.Platform > 0 && !hasTxOut(coinbase, reward.Platform,
+		[]byte{txscript.OP_RETURN}) {
+
+		str := fmt.Sprintf("coinbase transaction for block at height "+
+			"%d does not lock the Platform share of %v", height,
+			reward.Platform)
+		return ruleError(ErrBadPlatformPayment, str)
+	}
+	if payee == nil {
+		return nil
+	}
+
+	masternode, operator := splitMasternodeReward(reward, payee)
+	if masternode > 0 && !hasTxOut(coinbase, masternode, payee.ScriptPayout) {
+		str := fmt.Sprintf("coinbase transaction for block at height "+
+			"%d does not pay %v to masternode %v", height,
+			masternode, payee.ProTxHash)
+		return ruleError(ErrBadMasternodePayee, str)
+	}
+	if operator > 0 && !hasTxOut(coinbase, operator,
+		payee.ScriptOperatorPayout) {
+
+		str := fmt.Sprintf("coinbase transaction for block at height "+
+			"%d does not pay the operator reward of %v of "+
+			"masternode %v", height, operator, payee.ProTxHash)
+		return ruleError(ErrBadOperatorPayee, str)
+	}
+	return nil
+}
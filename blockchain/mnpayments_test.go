@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/txscript"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// TestCheckMasternodePayments ensures coinbase transactions are checked for the
+// masternode, operator and Platform payments.
+func TestCheckMasternodePayments(t *testing.T) {
+	reward := BlockReward{
+		Subsidy:    190639620,
+		Miner:      47684905,
+		Masternode: 89437322,
+		Platform:   53617393,
+	}
+	payout := []byte{txscript.OP_TRUE}
+	operatorPayout := []byte{txscript.OP_2}
+	platform := wire.NewTxOut(53617393, []byte{txscript.OP_RETURN})
+	miner := wire.NewTxOut(47684905, []byte{txscript.OP_3})
+
+	payee := &MasternodePayee{
+		ProTxHash:    chainhash.Hash{1},
+		ScriptPayout: payout,
+	}
+	operatorPayee := &MasternodePayee{
+		ProTxHash:            chainhash.Hash{2},
+		ScriptPayout:         payout,
+		ScriptOperatorPayout: operatorPayout,
+		OperatorReward:       1000,
+	}
+	noOperatorScript := &MasternodePayee{
+		ProTxHash:      chainhash.Hash{3},
+		ScriptPayout:   payout,
+		OperatorReward: 1000,
+	}
+
+	tests := []struct {
+		name  string
+		payee *MasternodePayee
+		outs  []*wire.TxOut
+		want  []*wire.TxOut
+		err   error
+	}{
+		{
+			name:  "masternode paid",
+			payee: payee,
+			outs: []*wire.TxOut{miner, platform,
+				wire.NewTxOut(89437322, payout)},
+			want: []*wire.TxOut{platform,
+				wire.NewTxOut(89437322, payout)},
+		},
+		{
+			name:  "operator paid",
+			payee: operatorPayee,
+			outs: []*wire.TxOut{miner, platform,
+				wire.NewTxOut(8943732, operatorPayout),
+				wire.NewTxOut(80493590, payout)},
+			want: []*wire.TxOut{platform,
+				wire.NewTxOut(80493590, payout),
+				wire.NewTxOut(8943732, operatorPayout)},
+		},
+		{
+			name:  "operator reward without operator script",
+			payee: noOperatorScript,
+			outs: []*wire.TxOut{miner, platform,
+				wire.NewTxOut(89437322, payout)},
+			want: []*wire.TxOut{platform,
+				wire.NewTxOut(89437322, payout)},
+		},
+		{
+			name: "no valid masternode",
+			outs: []*wire.TxOut{miner, platform},
+			want: []*wire.TxOut{platform},
+		},
+		{
+			name:  "wrong masternode script",
+			payee: payee,
+			outs: []*wire.TxOut{miner, platform,
+				wire.NewTxOut(89437322, operatorPayout)},
+			want: []*wire.TxOut{platform,
+				wire.NewTxOut(89437322, payout)},
+			err: RuleError{ErrorCode: ErrBadMasternodePayee},
+		},
+		{
+			name:  "masternode underpaid",
+			payee: payee,
+			outs: []*wire.TxOut{miner, platform,
+				wire.NewTxOut(89437321, payout)},
+			want: []*wire.TxOut{platform,
+				wire.NewTxOut(89437322, payout)},
+			err: RuleError{ErrorCode: ErrBadMasternodePayee},
+		},
+		{
+			name:  "operator reward not split",
+			payee: operatorPayee,
+			outs: []*wire.TxOut{miner, platform,
+				wire.NewTxOut(89437322, payout)},
+			want: []*wire.TxOut{platform,
+				wire.NewTxOut(80493590, payout),
+				wire.NewTxOut(8943732, operatorPayout)},
+			err: RuleError{ErrorCode: ErrBadMasternodePayee},
+		},
+		{
+			name:  "operator not paid",
+			payee: operatorPayee,
+			outs: []*wire.TxOut{miner, platform,
+				wire.NewTxOut(80493590, payout)},
+			want: []*wire.TxOut{platform,
+				wire.NewTxOut(80493590, payout),
+				wire.NewTxOut(8943732, operatorPayout)},
+			err: RuleError{ErrorCode: ErrBadOperatorPayee},
+		},
+		{
+			name:  "platform share not locked",
+			payee: payee,
+			outs: []*wire.TxOut{miner,
+				wire.NewTxOut(89437322, payout)},
+			want: []*wire.TxOut{platform,
+				wire.NewTxOut(89437322, payout)},
+			err: RuleError{ErrorCode: ErrBadPlatformPayment},
+		},
+	}
+
+	for _, test := range tests {
+		got := MasternodePayments(&reward, test.payee)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: MasternodePayments got %v, want %v",
+				test.name, got, test.want)
+		}
+
+		coinbase := &wire.MsgTx{TxOut: test.outs}
+		err := checkMasternodePayments(coinbase, 2200000, &reward,
+			test.payee)
+		if test.err == nil {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.name, err)
+			}
+			continue
+		}
+		rerr, ok := err.(RuleError)
+		if !ok || rerr.ErrorCode != test.err.(RuleError).ErrorCode {
+			t.Errorf("%s: got error %v, want %v", test.name, err,
+				test.err.(RuleError).ErrorCode)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"golang.org/x/crypto/chacha20"
+)
+
+const (
+	// muHashNumSize is the size of a serialized 3072-bit number of a
+	// MuHash3072 accumulator.
+	muHashNumSize = 384
+
+	// muHashModulusOffset is the value subtracted from 2^3072 to obtain
+	// the prime modulus of the MuHash3072 group.
+	muHashModulusOffset = 1103717
+)
+
+var (
+	// muHashModulus is the prime 2^3072 - 1103717 which MuHash3072
+	// operates modulo.
+	muHashModulus = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 3072),
+		big.NewInt(muHashModulusOffset))
+
+	// muHashLowMask masks the lower 3072 bits of a number and is used to
+	// reduce products modulo muHashModulus.
+	muHashLowMask = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 3072),
+		big.NewInt(1))
+
+	// bigMuHashModulusOffset is muHashModulusOffset as a big integer.
+	bigMuHashModulusOffset = big.NewInt(muHashModulusOffset)
+)
+
+// muHash3072 is a rolling hash of a set of byte strings as specified by the
+// MuHash3072 construction used for the utxo set commitment of the reference
+// implementation.  Every element is hashed to a 3072-bit number and the hash of
+// the set is their product modulo a prime.  Elements are added by multiplying
+// the numerator with their number and removed by multiplying the denominator,
+// so the order of additions and removals does not matter and the numerator is
+// only divided by the denominator once the hash is finalized.
+type muHash3072 struct {
+	numerator   *big.Int
+	denominator *big.Int
+}
+
+// newMuHash3072 returns a MuHash3072 accumulator for the empty set.
+func newMuHash3072() *muHash3072 {
+	return &muHash3072{
+		numerator:   big.NewInt(1),
+		denominator: big.NewInt(1),
+	}
+}
+
+// clone returns a copy of the accumulator.
+func (h *muHash3072) clone() *muHash3072 {
+	return &muHash3072{
+		numerator:   new(big.Int).Set(h.numerator),
+		denominator: new(big.Int).Set(h.denominator),
+	}
+}
+
+// muHashReduce reduces the passed non-negative number modulo muHashModulus in
+// place.  Since the modulus is 2^3072 minus a small offset, the bits above the
+// lower 3072 bits are folded back by multiplying them with the offset.
+func muHashReduce(n *big.Int) {
+	var high big.Int
+	for n.BitLen() > 3072 {
+		high.Rsh(n, 3072)
+		n.And(n, muHashLowMask)
+		high.Mul(&high, bigMuHashModulusOffset)
+		n.Add(n, &high)
+	}
+	if n.Cmp(muHashModulus) >= 0 {
+		n.Sub(n, muHashModulus)
+	}
+}
+
+// reverseBytes reverses the passed bytes in place to convert a number between
+// the big endian encoding of big.Int and the little endian encoding MuHash3072
+// uses.
+func reverseBytes(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
+
+// muHashNum hashes the passed data to a 3072-bit number by expanding the sha256
+// hash of the data with the ChaCha20 key stream keyed by it.
+func muHashNum(data []byte) *big.Int {
+	key := sha256.Sum256(data)
+	var nonce [chacha20.NonceSize]byte
+	cipher, err := chacha20.NewUnauthenticatedCipher(key[:], nonce[:])
+	if err != nil {
+		// The key and nonce sizes are always valid.
+		panic(err)
+	}
+	var stream [muHashNumSize]byte
+	cipher.XORKeyStream(stream[:], stream[:])
+
+	// The number is encoded in little endian.
+	reverseBytes(stream[:])
+	return new(big.Int).SetBytes(stream[:])
+}
+
+// Add adds the passed data to the set.
+func (h *muHash3072) Add(data []byte) {
+	h.numerator.Mul(h.numerator, muHashNum(data))
+	muHashReduce(h.numerator)
+}
+
+// Remove removes the passed data from the set.
+func (h *muHash3072) Remove(data []byte) {
+	h.denominator.Mul(h.denominator, muHashNum(data))
+	muHashReduce(h.denominator)
+}
+
+// Finalize returns the hash of the set, which is the sha256 of the quotient of
+// the numerator and the denominator serialized in little endian.
+func (h *muHash3072) Finalize() chainhash.Hash {
+	quotient := new(big.Int).ModInverse(h.denominator, muHashModulus)
+	quotient.Mul(quotient, h.numerator)
+	muHashReduce(quotient)
+
+	var serialized [muHashNumSize]byte
+	quotient.FillBytes(serialized[:])
+	reverseBytes(serialized[:])
+	return chainhash.Hash(sha256.Sum256(serialized[:]))
+}
+
+// serialize returns the numerator and the denominator of the accumulator, each
+// serialized in little endian.
+func (h *muHash3072) serialize() []byte {
+	serialized := make([]byte, muHashNumSize*2)
+	numerator := h.numerator.FillBytes(serialized[:muHashNumSize])
+	denominator := h.denominator.FillBytes(serialized[muHashNumSize:])
+	reverseBytes(numerator)
+	reverseBytes(denominator)
+	return serialized
+}
+
+// deserializeMuHash3072 deserializes an accumulator serialized with serialize.
+func deserializeMuHash3072(serialized []byte) *muHash3072 {
+	num := make([]byte, muHashNumSize*2)
+	copy(num, serialized)
+	numerator, denominator := num[:muHashNumSize], num[muHashNumSize:]
+	reverseBytes(numerator)
+	reverseBytes(denominator)
+	return &muHash3072{
+		numerator:   new(big.Int).SetBytes(numerator),
+		denominator: new(big.Int).SetBytes(denominator),
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+)
+
+// TestMuHash3072 ensures the MuHash3072 accumulator matches the test vector of
+// the reference implementation, does not depend on the order of additions and
+// removals and survives serialization.
+func TestMuHash3072(t *testing.T) {
+	t.Parallel()
+
+	element := func(i byte) []byte {
+		data := make([]byte, 32)
+		data[0] = i
+		return data
+	}
+
+	// The hash of the set {0, 1} with 2 removed from it.
+	const want = "10d312b100cbd32ada024a6646e40d3482fcff103668d2625f10002a607d5863"
+	h := newMuHash3072()
+	h.Add(element(0))
+	h.Add(element(1))
+	h.Remove(element(2))
+	if got := h.Finalize(); got.String() != want {
+		t.Fatalf("Finalize: got %v, want %v", got, want)
+	}
+
+	h = newMuHash3072()
+	h.Remove(element(2))
+	h.Add(element(1))
+	h.Add(element(0))
+	if got := h.Finalize(); got.String() != want {
+		t.Fatalf("Finalize in different order: got %v, want %v", got,
+			want)
+	}
+
+	h = deserializeMuHash3072(h.serialize())
+	if got := h.Finalize(); got.String() != want {
+		t.Fatalf("Finalize after serialization: got %v, want %v", got,
+			want)
+	}
+
+	// Adding and removing the same elements results in the empty set.
+	empty := newMuHash3072().Finalize()
+	h.Add(element(2))
+	h.Remove(element(0))
+	h.Remove(element(1))
+	if got := h.Finalize(); got != empty {
+		t.Fatalf("Finalize of empty set: got %v, want %v", got, empty)
+	}
+}
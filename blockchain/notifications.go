@@ -29,6 +29,11 @@ const (
 	// NTBlockDisconnected indicates the associated block was disconnected
 	// from the main chain.
 	NTBlockDisconnected
+
+	// NTChainLocked indicates the associated ChainLock was verified and
+	// locks a block of the main chain, which makes it and all of its
+	// ancestors final.
+	NTChainLocked
 )
 
 // notificationTypeStrings is a map of notification types back to their constant
@@ -37,6 +42,7 @@ var notificationTypeStrings = map[NotificationType]string{
 	NTBlockAccepted:     "NTBlockAccepted",
 	NTBlockConnected:    "NTBlockConnected",
 	NTBlockDisconnected: "NTBlockDisconnected",
+	NTChainLocked:       "NTChainLocked",
 }
 
 // String returns the NotificationType in human-readable form.
@@ -53,6 +59,7 @@ func (n NotificationType) String() string {
 // 	- NTBlockAccepted:     *btcutil.Block
 // 	- NTBlockConnected:    *btcutil.Block
 // 	- NTBlockDisconnected: *btcutil.Block
+// 	- NTChainLocked:       *wire.MsgCLSig
 type Notification struct {
 	Type NotificationType
 	Data interface{}
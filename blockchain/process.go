@@ -143,6 +143,12 @@ func (b *BlockChain) ProcessBlock(block *btcutil.Block, flags BehaviorFlags) (bo
 	b.chainLock.Lock()
 	defer b.chainLock.Unlock()
 
+	// The chain state built on an invalid utxo set snapshot must not be
+	// extended.
+	if b.snapshot != nil && b.snapshot.invalid {
+		return false, false, ErrInvalidSnapshot
+	}
+
 	fastAdd := flags&BFFastAdd == BFFastAdd
 
 	blockHash := block.Hash()
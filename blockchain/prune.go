@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"github.com/dashpay/dashd-go/database"
+)
+
+// MinBlocksToKeep is the number of blocks at the end of the main chain whose
+// data is never pruned.  It matches the number of recent blocks a node which
+// signals wire.SFNodeNetworkLimited must be able to serve, and bounds the depth
+// of the reorganizations a pruned node is able to perform.
+const MinBlocksToKeep = 288
+
+// pruneBlocks deletes the oldest blocks from the database when the stored
+// blocks exceed the prune target while retaining the last MinBlocksToKeep
+// blocks of the main chain.  The utxo set, the block index and the spend
+// journal are not affected.
+//
+// This function MUST be called with the chain state lock held (for writes).
+func (b *BlockChain) pruneBlocks() error {
+	keepHeight := b.bestChain.Tip().height - MinBlocksToKeep + 1
+	if b.pruneTarget == 0 || keepHeight <= 0 {
+		return nil
+	}
+
+	keepHash := b.bestChain.NodeByHeight(keepHeight).hash
+	var numPruned int
+	err := b.db.Update(func(dbTx database.Tx) error {
+		pruned, err := dbTx.PruneBlocks(b.pruneTarget, &keepHash)
+		numPruned = len(pruned)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if numPruned > 0 {
+		log.Infof("Pruned %d blocks below height %d", numPruned,
+			keepHeight)
+	}
+	return nil
+}
+
+// IsPruned returns whether or not the chain is configured to prune the oldest
+// blocks from the database.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) IsPruned() bool {
+	return b.pruneTarget != 0
+}
+
+// PruneHeight returns the height of the first block in the main chain whose
+// data is still stored in the database.  It is zero when no block has been
+// pruned.
+//
+// Blocks are always stored after their parents and the oldest blocks are
+// pruned first, so the stored blocks of the main chain form a contiguous range
+// which ends at the tip.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) PruneHeight() (int32, error) {
+	if b.pruneTarget == 0 {
+		return 0, nil
+	}
+
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+
+	low, high := int32(0), b.bestChain.Tip().height
+	err := b.db.View(func(dbTx database.Tx) error {
+		for low < high {
+			mid := low + (high-low)/2
+			node := b.bestChain.NodeByHeight(mid)
+			stored, err := dbTx.HasBlock(&node.hash)
+			if err != nil {
+				return err
+			}
+			if stored {
+				high = mid
+			} else {
+				low = mid + 1
+			}
+		}
+		return nil
+	})
+	return low, err
+}
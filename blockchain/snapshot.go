@@ -0,0 +1,828 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+const (
+	// utxoSnapshotVersion is the version of the utxo set snapshot format
+	// written by DumpUtxoSnapshot.
+	utxoSnapshotVersion = 1
+
+	// snapshotStateSize is the size of a serialized snapshot state.
+	snapshotStateSize = chainhash.HashSize*2 + 4 + 4 + 1
+
+	// snapshotLoadBatchSize is the number of block index entries and utxos
+	// written to the database per transaction while a snapshot is loaded.
+	snapshotLoadBatchSize = 100000
+
+	// maxSnapshotUtxoSize is the maximum size of a serialized utxo entry in
+	// a snapshot.
+	maxSnapshotUtxoSize = wire.MaxBlockPayload
+)
+
+// ErrInvalidSnapshot is returned once the utxo set built by validating the
+// blocks below the base of the utxo set snapshot the chain was started from does
+// not match the snapshot.  The chain state built on the snapshot is invalid, so
+// the chain refuses to process any further blocks and to be loaded again.  It
+// must be rebuilt without the snapshot.
+var ErrInvalidSnapshot = errors.New("the utxo set snapshot the chain was " +
+	"started from is invalid")
+
+var (
+	// utxoSnapshotMagic identifies a utxo set snapshot.
+	utxoSnapshotMagic = [5]byte{'u', 't', 'x', 'o', 0xff}
+
+	// snapshotStateKeyName is the name of the db key used to store the
+	// state of the background validation of the history of a utxo set
+	// snapshot.
+	snapshotStateKeyName = []byte("utxosnapshotstate")
+
+	// snapshotUtxoSetBucketName is the name of the db bucket used to house
+	// the utxo set built by the background validation of the history of a
+	// utxo set snapshot.
+	snapshotUtxoSetBucketName = []byte("snapshotutxoset")
+)
+
+// -----------------------------------------------------------------------------
+// A utxo set snapshot contains everything needed to start the chain from its
+// base block instead of the genesis block: the headers of the main chain up to
+// the base block, the base block itself and the utxo set after it.
+//
+// The serialized format is:
+//
+//   <magic><version><network><base hash><base height><chain tx count>
+//   <headers><base block><num utxos><utxos>
+//
+//   Field            Type                Size
+//   magic            [5]byte             5 bytes
+//   version          uint16              2 bytes
+//   network          wire.BitcoinNet     4 bytes
+//   base hash        chainhash.Hash      chainhash.HashSize
+//   base height      uint32              4 bytes
+//   chain tx count   uint64              8 bytes
+//   headers          []wire.BlockHeader  80 bytes * base height
+//   base block       wire.MsgBlock       variable
+//   num utxos        uint64              8 bytes
+//   utxos            []utxo              variable
+//
+// The headers are those from height 1 up to and including the base block.
+//
+// Every utxo consists of its key and value in the utxo set bucket, which are
+// the outpoint key and the entry in the compressed format described above
+// serializeUtxoEntry, each prefixed with their length:
+//
+//   <key length><key><entry length><entry>
+//
+//   Field            Type              Size
+//   key length       VLQ               variable (wire varint)
+//   key              []byte            variable
+//   entry length     VLQ               variable (wire varint)
+//   entry            []byte            variable
+//
+// The utxos are ordered by key.  The hash a snapshot commits to is the double
+// sha256 of its serialized utxos, which makes it independent of everything
+// but the utxo set itself.
+// -----------------------------------------------------------------------------
+
+// snapshotHeader is the fixed size metadata at the start of a utxo set
+// snapshot.
+type snapshotHeader struct {
+	Magic        [5]byte
+	Version      uint16
+	Net          wire.BitcoinNet
+	BaseHash     chainhash.Hash
+	BaseHeight   uint32
+	ChainTxCount uint64
+}
+
+// UtxoSnapshotInfo describes a utxo set snapshot.
+type UtxoSnapshotInfo struct {
+	BaseHash     chainhash.Hash
+	BaseHeight   int32
+	ChainTxCount uint64
+	NumUtxos     uint64
+	UtxoSetHash  chainhash.Hash
+}
+
+// newUtxoSetHasher returns a hash which computes the hash of a utxo set when
+// its serialized utxos are written to it and finished with utxoSetHashSum.
+func newUtxoSetHasher() hash.Hash {
+	return sha256.New()
+}
+
+// utxoSetHashSum returns the hash of the utxo set written to the passed hasher.
+func utxoSetHashSum(hasher hash.Hash) chainhash.Hash {
+	return chainhash.Hash(sha256.Sum256(hasher.Sum(nil)))
+}
+
+// writeSnapshotUtxo serializes the utxo with the passed key and serialized entry
+// to w.
+func writeSnapshotUtxo(w io.Writer, key, serialized []byte) error {
+	if err := wire.WriteVarBytes(w, 0, key); err != nil {
+		return err
+	}
+	return wire.WriteVarBytes(w, 0, serialized)
+}
+
+// writeSnapshotUtxos serializes all utxos of the passed utxo set bucket to w in
+// key order.
+func writeSnapshotUtxos(w io.Writer, utxoBucket database.Bucket) error {
+	cursor := utxoBucket.Cursor()
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		err := writeSnapshotUtxo(w, cursor.Key(), cursor.Value())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readSnapshotUtxo reads the key and the serialized entry of a utxo from r and
+// ensures they are well formed.
+func readSnapshotUtxo(r io.Reader) ([]byte, []byte, error) {
+	maxKeySize := uint32(chainhash.HashSize + maxUint32VLQSerializeSize)
+	key, err := wire.ReadVarBytes(r, 0, maxKeySize, "utxo key")
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := deserializeOutpointKey(key); err != nil {
+		return nil, nil, err
+	}
+
+	serialized, err := wire.ReadVarBytes(r, 0, maxSnapshotUtxoSize,
+		"utxo entry")
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := deserializeUtxoEntry(serialized); err != nil {
+		return nil, nil, fmt.Errorf("malformed utxo entry for key %x: %v",
+			key, err)
+	}
+	return key, serialized, nil
+}
+
+// snapshotState is the state of the background validation of the blocks below
+// the base of a utxo set snapshot.
+//
+// The serialized format is:
+//
+//	<base hash><base height><utxo set hash><validated height><invalid>
+//
+//	Field              Type              Size
+//	base hash          chainhash.Hash    chainhash.HashSize
+//	base height        uint32            4 bytes
+//	utxo set hash      chainhash.Hash    chainhash.HashSize
+//	validated height   uint32            4 bytes
+//	invalid            bool              1 byte
+type snapshotState struct {
+	baseHash        chainhash.Hash
+	baseHeight      int32
+	utxoSetHash     chainhash.Hash
+	validatedHeight int32
+
+	// invalid indicates that the utxo set built by validating the history
+	// does not match the snapshot.
+	invalid bool
+}
+
+// serializeSnapshotState returns the serialization of the passed snapshot
+// state.
+func serializeSnapshotState(state *snapshotState) []byte {
+	serialized := make([]byte, snapshotStateSize)
+	copy(serialized, state.baseHash[:])
+	offset := chainhash.HashSize
+	byteOrder.PutUint32(serialized[offset:], uint32(state.baseHeight))
+	offset += 4
+	copy(serialized[offset:], state.utxoSetHash[:])
+	offset += chainhash.HashSize
+	byteOrder.PutUint32(serialized[offset:], uint32(state.validatedHeight))
+	offset += 4
+	if state.invalid {
+		serialized[offset] = 1
+	}
+	return serialized
+}
+
+// deserializeSnapshotState deserializes the passed serialized snapshot state.
+func deserializeSnapshotState(serialized []byte) (*snapshotState, error) {
+	if len(serialized) != snapshotStateSize {
+		return nil, database.Error{
+			ErrorCode:   database.ErrCorruption,
+			Description: "corrupt utxo set snapshot state",
+		}
+	}
+
+	var state snapshotState
+	copy(state.baseHash[:], serialized)
+	offset := chainhash.HashSize
+	state.baseHeight = int32(byteOrder.Uint32(serialized[offset:]))
+	offset += 4
+	copy(state.utxoSetHash[:], serialized[offset:])
+	offset += chainhash.HashSize
+	state.validatedHeight = int32(byteOrder.Uint32(serialized[offset:]))
+	offset += 4
+	state.invalid = serialized[offset] != 0
+	return &state, nil
+}
+
+// dbPutSnapshotState uses an existing database transaction to store the passed
+// snapshot state.
+func dbPutSnapshotState(dbTx database.Tx, state *snapshotState) error {
+	return dbTx.Metadata().Put(snapshotStateKeyName,
+		serializeSnapshotState(state))
+}
+
+// dbFetchSnapshotState uses an existing database transaction to fetch the
+// snapshot state.  It returns nil when no utxo set snapshot was loaded or its
+// history has been validated.
+func dbFetchSnapshotState(dbTx database.Tx) (*snapshotState, error) {
+	serialized := dbTx.Metadata().Get(snapshotStateKeyName)
+	if serialized == nil {
+		return nil, nil
+	}
+	return deserializeSnapshotState(serialized)
+}
+
+// SnapshotValidationPending returns whether the chain in the passed database
+// was started from a utxo set snapshot whose history has not been validated
+// yet.  The blocks below the base of the snapshot are not all available until
+// then.
+func SnapshotValidationPending(db database.DB) (bool, error) {
+	var pending bool
+	err := db.View(func(dbTx database.Tx) error {
+		state, err := dbFetchSnapshotState(dbTx)
+		pending = state != nil
+		return err
+	})
+	return pending, err
+}
+
+// DumpUtxoSnapshot writes a snapshot of the utxo set at the end of the main
+// chain to w and returns a description of it.  The snapshot can be loaded by
+// other nodes with the UtxoSnapshot config field once its base block and hash
+// are pinned by an AssumeUtxo entry of their chain parameters.
+//
+// The chain lock is only held to capture the end of the main chain along with
+// a read transaction of the database, which provides a consistent view of the
+// utxo set at that block while the chain moves on and the snapshot is written.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) DumpUtxoSnapshot(w io.Writer) (*UtxoSnapshotInfo, error) {
+	b.chainLock.RLock()
+	locked := true
+	defer func() {
+		if locked {
+			b.chainLock.RUnlock()
+		}
+	}()
+
+	var info *UtxoSnapshotInfo
+	err := b.db.View(func(dbTx database.Tx) error {
+		// The read transaction is consistent with the best chain since
+		// blocks are only connected while holding the chain lock, so it
+		// can be released once the tip is captured.
+		tip := b.bestChain.Tip()
+		info = &UtxoSnapshotInfo{
+			BaseHash:     tip.hash,
+			BaseHeight:   tip.height,
+			ChainTxCount: b.stateSnapshot.TotalTxns,
+		}
+		b.chainLock.RUnlock()
+		locked = false
+
+		blockBytes, err := dbTx.FetchBlock(&tip.hash)
+		if err != nil {
+			return err
+		}
+
+		utxoBucket := dbTx.Metadata().Bucket(utxoSetBucketName)
+		cursor := utxoBucket.Cursor()
+		for ok := cursor.First(); ok; ok = cursor.Next() {
+			info.NumUtxos++
+		}
+
+		err = binary.Write(w, byteOrder, &snapshotHeader{
+			Magic:        utxoSnapshotMagic,
+			Version:      utxoSnapshotVersion,
+			Net:          b.chainParams.Net,
+			BaseHash:     tip.hash,
+			BaseHeight:   uint32(tip.height),
+			ChainTxCount: info.ChainTxCount,
+		})
+		if err != nil {
+			return err
+		}
+
+		// The ancestors of the tip never change, so their headers are
+		// collected by following the parents of the tip rather than
+		// through the best chain, which may be reorganized meanwhile.
+		nodes := make([]*blockNode, tip.height)
+		for node := tip; node.height > 0; node = node.parent {
+			nodes[node.height-1] = node
+		}
+		for _, node := range nodes {
+			header := node.Header()
+			if err := header.Serialize(w); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(blockBytes); err != nil {
+			return err
+		}
+		err = binary.Write(w, byteOrder, info.NumUtxos)
+		if err != nil {
+			return err
+		}
+
+		hasher := newUtxoSetHasher()
+		err = writeSnapshotUtxos(io.MultiWriter(w, hasher), utxoBucket)
+		if err != nil {
+			return err
+		}
+		info.UtxoSetHash = utxoSetHashSum(hasher)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// initSnapshotState loads the state of the background validation of the history
+// of a previously loaded utxo set snapshot and then installs the snapshot read
+// from r, if any, when the chain does not contain any blocks beyond the genesis
+// block yet.
+func (b *BlockChain) initSnapshotState(r io.Reader) error {
+	err := b.db.View(func(dbTx database.Tx) error {
+		var err error
+		b.snapshot, err = dbFetchSnapshotState(dbTx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if s := b.snapshot; s != nil {
+		if s.invalid {
+			return fmt.Errorf("%w: the utxo set built by "+
+				"validating the blocks up to its base %v does "+
+				"not match it -- delete the block database and "+
+				"sync from the beginning without the snapshot",
+				ErrInvalidSnapshot, s.baseHash)
+		}
+		log.Infof("Validating the blocks up to the base %v (height "+
+			"%d) of the utxo set snapshot in the background "+
+			"(validated height %d)", s.baseHash, s.baseHeight,
+			s.validatedHeight)
+	}
+
+	if r == nil {
+		return nil
+	}
+	if tip := b.bestChain.Tip(); tip.height != 0 {
+		log.Infof("Not loading the utxo set snapshot since the chain "+
+			"is already at height %d", tip.height)
+		return nil
+	}
+	return b.loadUtxoSnapshot(r)
+}
+
+// assumeUtxo returns the AssumeUtxo entry of the chain parameters for the
+// block with the passed hash or nil when there is none.
+func (b *BlockChain) assumeUtxo(hash *chainhash.Hash) *chaincfg.AssumeUtxo {
+	for i := range b.chainParams.AssumeUtxos {
+		assumeUtxo := &b.chainParams.AssumeUtxos[i]
+		if assumeUtxo.BlockHash.IsEqual(hash) {
+			return assumeUtxo
+		}
+	}
+	return nil
+}
+
+// loadUtxoSnapshot installs the utxo set snapshot read from r and makes its base
+// block the end of the main chain.  The snapshot must be for a block pinned by
+// the chain parameters and match its utxo set hash.  The headers in the snapshot
+// are fully validated, but the blocks below the base are only validated
+// afterwards with ProcessHistoricalBlock.
+//
+// The chain must not contain any blocks beyond the genesis block.
+func (b *BlockChain) loadUtxoSnapshot(r io.Reader) error {
+	var header snapshotHeader
+	if err := binary.Read(r, byteOrder, &header); err != nil {
+		return err
+	}
+	if header.Magic != utxoSnapshotMagic {
+		return fmt.Errorf("not a utxo set snapshot")
+	}
+	if header.Version != utxoSnapshotVersion {
+		return fmt.Errorf("unsupported utxo set snapshot version %d",
+			header.Version)
+	}
+	if header.Net != b.chainParams.Net {
+		return fmt.Errorf("utxo set snapshot is for network %v instead "+
+			"of %v", header.Net, b.chainParams.Net)
+	}
+	assumeUtxo := b.assumeUtxo(&header.BaseHash)
+	if assumeUtxo == nil {
+		return fmt.Errorf("utxo set snapshot base block %v is not a "+
+			"known snapshot block of the %s network", header.BaseHash,
+			b.chainParams.Name)
+	}
+	if int32(header.BaseHeight) != assumeUtxo.Height ||
+		header.ChainTxCount != assumeUtxo.ChainTxCount {
+
+		return fmt.Errorf("utxo set snapshot base height %d and chain "+
+			"tx count %d do not match the expected %d and %d",
+			header.BaseHeight, header.ChainTxCount, assumeUtxo.Height,
+			assumeUtxo.ChainTxCount)
+	}
+
+	log.Infof("Loading utxo set snapshot at height %d (%v)...",
+		assumeUtxo.Height, assumeUtxo.BlockHash)
+
+	// Validate the headers up to the base block and add them to the block
+	// index.  They are only added to the best chain once the snapshot has
+	// been installed.
+	nodes := make([]*blockNode, 0, assumeUtxo.Height)
+	prevNode := b.bestChain.Tip()
+	for height := int32(1); height <= assumeUtxo.Height; height++ {
+		var blockHeader wire.BlockHeader
+		if err := blockHeader.Deserialize(r); err != nil {
+			return err
+		}
+		if blockHeader.PrevBlock != prevNode.hash {
+			return fmt.Errorf("utxo set snapshot header at height %d "+
+				"does not connect to the previous header", height)
+		}
+		err := checkBlockHeaderSanity(&blockHeader,
+			b.chainParams.PowLimit, b.timeSource, BFNone)
+		if err != nil {
+			return err
+		}
+		err = b.checkBlockHeaderContext(&blockHeader, prevNode, BFNone)
+		if err != nil {
+			return err
+		}
+
+		node := newBlockNode(&blockHeader, prevNode)
+		node.status = statusValid
+		b.index.addNode(node)
+		nodes = append(nodes, node)
+		prevNode = node
+	}
+	baseNode := prevNode
+	if baseNode.hash != *assumeUtxo.BlockHash {
+		return fmt.Errorf("utxo set snapshot headers end at block %v "+
+			"instead of %v", baseNode.hash, assumeUtxo.BlockHash)
+	}
+
+	var msgBlock wire.MsgBlock
+	if err := msgBlock.Deserialize(r); err != nil {
+		return err
+	}
+	block := btcutil.NewBlock(&msgBlock)
+	block.SetHeight(baseNode.height)
+	if *block.Hash() != baseNode.hash {
+		return fmt.Errorf("utxo set snapshot base block %v does not "+
+			"match its header %v", block.Hash(), baseNode.hash)
+	}
+	err := checkBlockSanity(block, b.chainParams.PowLimit, b.timeSource,
+		BFNone)
+	if err != nil {
+		return err
+	}
+
+	// Write the utxos to the utxo set in batches while computing the hash
+	// of the utxo set.  The utxo set is emptied again when the snapshot
+	// can't be installed, which leaves the chain at the genesis block.
+	var numUtxos uint64
+	if err := binary.Read(r, byteOrder, &numUtxos); err != nil {
+		return err
+	}
+	hasher := newUtxoSetHasher()
+	var prevKey []byte
+	for remaining := numUtxos; remaining > 0; {
+		batchSize := remaining
+		if batchSize > snapshotLoadBatchSize {
+			batchSize = snapshotLoadBatchSize
+		}
+		err := b.db.Update(func(dbTx database.Tx) error {
+			utxoBucket := dbTx.Metadata().Bucket(utxoSetBucketName)
+			for i := uint64(0); i < batchSize; i++ {
+				key, serialized, err := readSnapshotUtxo(r)
+				if err != nil {
+					return err
+				}
+				if bytes.Compare(key, prevKey) <= 0 {
+					return fmt.Errorf("utxo set snapshot is " +
+						"not ordered by key")
+				}
+				err = writeSnapshotUtxo(hasher, key, serialized)
+				if err != nil {
+					return err
+				}
+				if err := utxoBucket.Put(key, serialized); err != nil {
+					return err
+				}
+				prevKey = key
+			}
+			return nil
+		})
+		if err != nil {
+			b.discardSnapshotUtxos()
+			return err
+		}
+		remaining -= batchSize
+	}
+	utxoSetHash := utxoSetHashSum(hasher)
+	if utxoSetHash != *assumeUtxo.UtxoSetHash {
+		b.discardSnapshotUtxos()
+		return fmt.Errorf("utxo set snapshot hash %v does not match the "+
+			"expected %v", utxoSetHash, assumeUtxo.UtxoSetHash)
+	}
+
+	// Store the block index.  The entries of the headers don't affect the
+	// chain state until the best chain state is updated below, so they
+	// are written in batches as well.
+	for start := 0; start < len(nodes); start += snapshotLoadBatchSize {
+		end := start + snapshotLoadBatchSize
+		if end > len(nodes) {
+			end = len(nodes)
+		}
+		err := b.db.Update(func(dbTx database.Tx) error {
+			for _, node := range nodes[start:end] {
+				if err := dbStoreBlockNode(dbTx, node); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			b.discardSnapshotUtxos()
+			return err
+		}
+	}
+
+	// Make the base block the end of the main chain and start the
+	// background validation of the blocks below it.
+	baseNode.status = statusDataStored | statusValid
+	state := newBestState(baseNode, uint64(msgBlock.SerializeSize()),
+		uint64(GetBlockWeight(block)), uint64(len(msgBlock.Transactions)),
+		header.ChainTxCount, baseNode.CalcPastMedianTime())
+	snapshot := &snapshotState{
+		baseHash:    baseNode.hash,
+		baseHeight:  baseNode.height,
+		utxoSetHash: utxoSetHash,
+	}
+	err = b.db.Update(func(dbTx database.Tx) error {
+		for _, node := range nodes {
+			err := dbPutBlockIndex(dbTx, &node.hash, node.height)
+			if err != nil {
+				return err
+			}
+		}
+		if err := dbStoreBlockNode(dbTx, baseNode); err != nil {
+			return err
+		}
+		if err := dbStoreBlock(dbTx, block); err != nil {
+			return err
+		}
+		_, err := dbTx.Metadata().CreateBucket(snapshotUtxoSetBucketName)
+		if err != nil {
+			return err
+		}
+		if err := dbPutSnapshotState(dbTx, snapshot); err != nil {
+			return err
+		}
+
+		// The statistics of the utxo set are computed from the
+		// installed utxos once the chain is initialized.
+		if err := dbTx.Metadata().Delete(utxoSetStatsKeyName); err != nil {
+			return err
+		}
+		return dbPutBestState(dbTx, state, baseNode.workSum)
+	})
+	if err != nil {
+		b.discardSnapshotUtxos()
+		return err
+	}
+
+	b.bestChain.SetTip(baseNode)
+	b.stateSnapshot = state
+	b.snapshot = snapshot
+
+	log.Infof("Loaded utxo set snapshot with %d utxos at height %d (%v)",
+		numUtxos, baseNode.height, baseNode.hash)
+	return nil
+}
+
+// discardSnapshotUtxos removes the utxos of a utxo set snapshot which could
+// not be installed from the utxo set.
+func (b *BlockChain) discardSnapshotUtxos() {
+	err := b.db.Update(func(dbTx database.Tx) error {
+		meta := dbTx.Metadata()
+		if err := meta.DeleteBucket(utxoSetBucketName); err != nil {
+			return err
+		}
+		_, err := meta.CreateBucket(utxoSetBucketName)
+		return err
+	})
+	if err != nil {
+		log.Errorf("Unable to discard the utxos of the utxo set "+
+			"snapshot: %v", err)
+	}
+}
+
+// SnapshotValidationProgress returns the height of the last block validated in
+// the background below the base of the utxo set snapshot the chain was started
+// from and the height of the base.  The last return value is false when there
+// is no such validation pending.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) SnapshotValidationProgress() (int32, int32, bool) {
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+
+	s := b.snapshot
+	if s == nil || s.invalid {
+		return 0, 0, false
+	}
+	return s.validatedHeight, s.baseHeight, true
+}
+
+// dbFetchSnapshotUtxos uses an existing database transaction to load the
+// entries of the utxo set built by the background validation of the history of
+// a utxo set snapshot for the outputs created and spent by the passed block
+// into the view.  Outputs without an entry are added as nil entries so they
+// are not loaded from the utxo set of the main chain during validation.
+func dbFetchSnapshotUtxos(dbTx database.Tx, view *UtxoViewpoint, block *btcutil.Block) error {
+	utxoBucket := dbTx.Metadata().Bucket(snapshotUtxoSetBucketName)
+	fetch := func(outpoint wire.OutPoint) error {
+		entry, err := dbFetchUtxoEntryFromBucket(utxoBucket, outpoint)
+		if err != nil {
+			return err
+		}
+		view.entries[outpoint] = entry
+		return nil
+	}
+
+	for i, tx := range block.Transactions() {
+		outpoint := wire.OutPoint{Hash: *tx.Hash()}
+		for txOutIdx := range tx.MsgTx().TxOut {
+			outpoint.Index = uint32(txOutIdx)
+			if err := fetch(outpoint); err != nil {
+				return err
+			}
+		}
+
+		// The coinbase transaction is always first and has no inputs.
+		if i == 0 {
+			continue
+		}
+		for _, txIn := range tx.MsgTx().TxIn {
+			if err := fetch(txIn.PreviousOutPoint); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ProcessHistoricalBlock validates the next block below the base of the utxo
+// set snapshot the chain was started from against a separate utxo set built
+// from the genesis block.  The blocks must be passed in order of height, the
+// next one being the block after the validated height returned by
+// SnapshotValidationProgress.  The block is stored unless the chain is pruned.
+//
+// Once the base block is validated, the separate utxo set must match the
+// snapshot.  The history is then fully validated and the separate utxo set is
+// removed.  Otherwise, the chain state was built on an invalid snapshot, so the
+// snapshot is marked as invalid and ErrInvalidSnapshot is returned.  The chain
+// then refuses to process any further blocks and to be loaded again.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) ProcessHistoricalBlock(block *btcutil.Block) error {
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+
+	s := b.snapshot
+	if s != nil && s.invalid {
+		return ErrInvalidSnapshot
+	}
+	if s == nil {
+		return fmt.Errorf("no validation of the history of a utxo set " +
+			"snapshot is pending")
+	}
+	height := s.validatedHeight + 1
+	node := b.bestChain.NodeByHeight(height)
+	if node == nil || node.hash != *block.Hash() {
+		return fmt.Errorf("block %v is not the next block at height %d "+
+			"below the base of the utxo set snapshot", block.Hash(),
+			height)
+	}
+	block.SetHeight(height)
+
+	// Perform the same checks as for a new block connected to the main
+	// chain, except that the utxos are loaded from the separate utxo set.
+	err := checkBlockSanity(block, b.chainParams.PowLimit, b.timeSource,
+		BFNone)
+	if err != nil {
+		return err
+	}
+	if err := b.checkBlockContext(block, node.parent, BFNone); err != nil {
+		return err
+	}
+	view := NewUtxoViewpoint()
+	view.SetBestHash(&node.parent.hash)
+	err = b.db.View(func(dbTx database.Tx) error {
+		return dbFetchSnapshotUtxos(dbTx, view, block)
+	})
+	if err != nil {
+		return err
+	}
+	stxos := make([]SpentTxOut, 0, countSpentOutputs(block))
+	if err := b.checkConnectBlock(node, block, view, &stxos); err != nil {
+		return err
+	}
+
+	next := *s
+	next.validatedHeight = height
+	var utxoSetHash chainhash.Hash
+	storeBlock := !b.IsPruned()
+	err = b.db.Update(func(dbTx database.Tx) error {
+		meta := dbTx.Metadata()
+		utxoBucket := meta.Bucket(snapshotUtxoSetBucketName)
+		if err := dbPutUtxoViewToBucket(utxoBucket, view); err != nil {
+			return err
+		}
+		if storeBlock {
+			if err := dbStoreBlock(dbTx, block); err != nil {
+				return err
+			}
+		}
+		if height < s.baseHeight {
+			return dbPutSnapshotState(dbTx, &next)
+		}
+
+		// The utxo set built from the genesis block must match the
+		// snapshot once the base block is connected.  The utxo set is
+		// no longer needed either way.
+		hasher := newUtxoSetHasher()
+		if err := writeSnapshotUtxos(hasher, utxoBucket); err != nil {
+			return err
+		}
+		utxoSetHash = utxoSetHashSum(hasher)
+		if err := meta.DeleteBucket(snapshotUtxoSetBucketName); err != nil {
+			return err
+		}
+		if utxoSetHash != s.utxoSetHash {
+			next.invalid = true
+			return dbPutSnapshotState(dbTx, &next)
+		}
+		return meta.Delete(snapshotStateKeyName)
+	})
+	if err != nil {
+		return err
+	}
+	if storeBlock {
+		b.index.SetStatusFlags(node, statusDataStored)
+		if err := b.index.flushToDB(); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case height < s.baseHeight:
+		b.snapshot = &next
+
+	case next.invalid:
+		b.snapshot = &next
+		return fmt.Errorf("%w: the utxo set built by validating the "+
+			"blocks up to its base %v has hash %v instead of %v",
+			ErrInvalidSnapshot, s.baseHash, utxoSetHash,
+			s.utxoSetHash)
+
+	default:
+		b.snapshot = nil
+		log.Infof("Validated the blocks up to the base %v (height %d) "+
+			"of the utxo set snapshot", s.baseHash, s.baseHeight)
+	}
+	return nil
+}
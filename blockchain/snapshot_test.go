@@ -0,0 +1,311 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/txscript"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// writerFunc is an io.Writer which writes with the function.
+type writerFunc func(p []byte) (int, error)
+
+// Write calls the function with p.
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
+// newSnapshotChain creates a chain in a new database which is started from the
+// passed utxo set snapshot.
+func newSnapshotChain(t *testing.T, params *chaincfg.Params,
+	snapshot []byte) (*BlockChain, database.DB, error) {
+
+	t.Helper()
+
+	db, err := database.Create(testDbType, t.TempDir(), blockDataNet)
+	if err != nil {
+		t.Fatalf("unable to create database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	chain, err := New(&Config{
+		DB:           db,
+		ChainParams:  params,
+		TimeSource:   NewMedianTime(),
+		SigCache:     txscript.NewSigCache(1000),
+		UtxoSnapshot: bytes.NewReader(snapshot),
+	})
+	return chain, db, err
+}
+
+// TestUtxoSnapshot ensures a utxo set snapshot dumped from a chain can be
+// loaded into a new chain, whose history is then validated in the background.
+func TestUtxoSnapshot(t *testing.T) {
+	blocks, err := loadBlocks("blk_0_to_4.dat.bz2")
+	if err != nil {
+		t.Fatalf("Error loading file: %v", err)
+	}
+
+	chain, teardownFunc, err := chainSetup("utxosnapshot",
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("Failed to setup chain instance: %v", err)
+	}
+	defer teardownFunc()
+	chain.TstSetCoinbaseMaturity(1)
+	for i := 1; i < len(blocks); i++ {
+		if _, _, err := chain.ProcessBlock(blocks[i], BFNone); err != nil {
+			t.Fatalf("ProcessBlock fail on block %v: %v", i, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	info, err := chain.DumpUtxoSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("DumpUtxoSnapshot: %v", err)
+	}
+	best := chain.BestSnapshot()
+	if info.BaseHash != best.Hash || info.BaseHeight != best.Height ||
+		info.ChainTxCount != best.TotalTxns || info.NumUtxos == 0 {
+
+		t.Fatalf("DumpUtxoSnapshot: unexpected info %+v", info)
+	}
+
+	// The chain lock must not be held while the snapshot is written.
+	var unlockedBuf bytes.Buffer
+	unlockedWriter := writerFunc(func(p []byte) (int, error) {
+		if !chain.chainLock.TryLock() {
+			return 0, errors.New("chain lock held while writing")
+		}
+		chain.chainLock.Unlock()
+		return unlockedBuf.Write(p)
+	})
+	unlockedInfo, err := chain.DumpUtxoSnapshot(unlockedWriter)
+	if err != nil {
+		t.Fatalf("DumpUtxoSnapshot: %v", err)
+	}
+	if *unlockedInfo != *info ||
+		!bytes.Equal(unlockedBuf.Bytes(), buf.Bytes()) {
+
+		t.Fatal("DumpUtxoSnapshot: snapshots of the same chain differ")
+	}
+
+	// A snapshot which is not pinned or doesn't match the pinned hash must
+	// be rejected.
+	params := chaincfg.MainNetParams
+	if _, _, err := newSnapshotChain(t, &params, buf.Bytes()); err == nil {
+		t.Fatal("New: loaded snapshot which is not pinned")
+	}
+	params.AssumeUtxos = []chaincfg.AssumeUtxo{{
+		Height:       info.BaseHeight,
+		BlockHash:    &info.BaseHash,
+		UtxoSetHash:  &chainhash.Hash{0x01},
+		ChainTxCount: info.ChainTxCount,
+	}}
+	if _, _, err := newSnapshotChain(t, &params, buf.Bytes()); err == nil {
+		t.Fatal("New: loaded snapshot which doesn't match its hash")
+	}
+
+	params.AssumeUtxos[0].UtxoSetHash = &info.UtxoSetHash
+	snapChain, db, err := newSnapshotChain(t, &params, buf.Bytes())
+	if err != nil {
+		t.Fatalf("New: unable to load snapshot: %v", err)
+	}
+	snapChain.TstSetCoinbaseMaturity(1)
+	snapBest := snapChain.BestSnapshot()
+	if snapBest.Hash != best.Hash || snapBest.Height != best.Height ||
+		snapBest.TotalTxns != best.TotalTxns {
+
+		t.Fatalf("BestSnapshot: got %+v, want %+v", snapBest, best)
+	}
+	coinbase := blocks[len(blocks)-1].Transactions()[0]
+	entry, err := snapChain.FetchUtxoEntry(wire.OutPoint{Hash: *coinbase.Hash()})
+	if err != nil || entry == nil || !entry.IsCoinBase() {
+		t.Fatalf("FetchUtxoEntry: got %v, %v", entry, err)
+	}
+	if pending, err := SnapshotValidationPending(db); err != nil || !pending {
+		t.Fatalf("SnapshotValidationPending: got %v, %v", pending, err)
+	}
+	if got, want := snapChain.UtxoSetStats(), chain.UtxoSetStats(); *got != *want {
+		t.Fatalf("UtxoSetStats: got %+v, want %+v", got, want)
+	}
+
+	// The blocks below the base must be validated in order.
+	if err := snapChain.ProcessHistoricalBlock(blocks[2]); err == nil {
+		t.Fatal("ProcessHistoricalBlock: accepted block out of order")
+	}
+	for i := 1; i < len(blocks); i++ {
+		validated, base, pending := snapChain.SnapshotValidationProgress()
+		if validated != int32(i-1) || base != info.BaseHeight || !pending {
+			t.Fatalf("SnapshotValidationProgress: got %d, %d, %v "+
+				"before block %d", validated, base, pending, i)
+		}
+		if err := snapChain.ProcessHistoricalBlock(blocks[i]); err != nil {
+			t.Fatalf("ProcessHistoricalBlock fail on block %v: %v",
+				i, err)
+		}
+	}
+	if _, _, pending := snapChain.SnapshotValidationProgress(); pending {
+		t.Fatal("SnapshotValidationProgress: validation still pending")
+	}
+	if pending, err := SnapshotValidationPending(db); err != nil || pending {
+		t.Fatalf("SnapshotValidationPending: got %v, %v", pending, err)
+	}
+	if _, err := snapChain.BlockByHeight(1); err != nil {
+		t.Fatalf("BlockByHeight: historical block not stored: %v", err)
+	}
+}
+
+// regtestSnapshotBlocks returns the blocks after the genesis block of the
+// regression test chain the regression test network pins a utxo set snapshot
+// of.  Every block only contains a coinbase paying to OP_TRUE and is spaced the
+// target time per block after its parent, so the chain is always the same.
+func regtestSnapshotBlocks(t *testing.T, numBlocks int32) []*btcutil.Block {
+	t.Helper()
+
+	params := &chaincfg.RegressionNetParams
+	blocks := make([]*btcutil.Block, 0, numBlocks)
+	prevHash := *params.GenesisHash
+	prevTime := params.GenesisBlock.Header.Timestamp
+	for height := int32(1); height <= numBlocks; height++ {
+		coinbaseScript, err := txscript.NewScriptBuilder().
+			AddInt64(int64(height)).AddInt64(0).Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		coinbase := wire.NewMsgTx(wire.TxVersion)
+		coinbase.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
+				wire.MaxPrevOutIndex),
+			SignatureScript: coinbaseScript,
+			Sequence:        wire.MaxTxInSequenceNum,
+		})
+		coinbase.AddTxOut(&wire.TxOut{
+			Value: CalcBlockSubsidy(height, params.PowLimitBits,
+				params),
+			PkScript: []byte{txscript.OP_TRUE},
+		})
+
+		prevTime = prevTime.Add(params.TargetTimePerBlock)
+		msgBlock := wire.MsgBlock{
+			Header: wire.BlockHeader{
+				Version:    4,
+				PrevBlock:  prevHash,
+				MerkleRoot: coinbase.TxHash(),
+				Timestamp:  prevTime,
+				Bits:       params.PowLimitBits,
+			},
+			Transactions: []*wire.MsgTx{coinbase},
+		}
+		target := CompactToBig(params.PowLimitBits)
+		for {
+			powHash := msgBlock.Header.PowHash()
+			if HashToBig(&powHash).Cmp(target) <= 0 {
+				break
+			}
+			msgBlock.Header.Nonce++
+		}
+		block := btcutil.NewBlock(&msgBlock)
+		blocks = append(blocks, block)
+		prevHash = *block.Hash()
+	}
+	return blocks
+}
+
+// TestRegtestAssumeUtxo ensures the utxo set snapshot pinned by the regression
+// test network can be dumped from its chain and loaded into a new chain, and
+// that a chain whose history does not match its snapshot refuses to continue.
+func TestRegtestAssumeUtxo(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+	if len(params.AssumeUtxos) == 0 {
+		t.Fatal("no utxo set snapshot pinned for the regression test " +
+			"network")
+	}
+	assumeUtxo := params.AssumeUtxos[0]
+	blocks := regtestSnapshotBlocks(t, assumeUtxo.Height)
+
+	chain, teardownFunc, err := chainSetup("regtestassumeutxo", params)
+	if err != nil {
+		t.Fatalf("Failed to setup chain instance: %v", err)
+	}
+	defer teardownFunc()
+	for i, block := range blocks {
+		if _, _, err := chain.ProcessBlock(block, BFNone); err != nil {
+			t.Fatalf("ProcessBlock fail on block %v: %v", i+1, err)
+		}
+	}
+	var buf bytes.Buffer
+	info, err := chain.DumpUtxoSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("DumpUtxoSnapshot: %v", err)
+	}
+	if info.BaseHash != *assumeUtxo.BlockHash ||
+		info.BaseHeight != assumeUtxo.Height ||
+		info.ChainTxCount != assumeUtxo.ChainTxCount ||
+		info.UtxoSetHash != *assumeUtxo.UtxoSetHash {
+
+		t.Fatalf("DumpUtxoSnapshot: got %+v, want %+v", info,
+			assumeUtxo)
+	}
+
+	// The pinned snapshot is loaded and its history validates.
+	snapChain, _, err := newSnapshotChain(t, params, buf.Bytes())
+	if err != nil {
+		t.Fatalf("New: unable to load snapshot: %v", err)
+	}
+	for i, block := range blocks {
+		if err := snapChain.ProcessHistoricalBlock(block); err != nil {
+			t.Fatalf("ProcessHistoricalBlock fail on block %v: %v",
+				i+1, err)
+		}
+	}
+	if _, _, pending := snapChain.SnapshotValidationProgress(); pending {
+		t.Fatal("SnapshotValidationProgress: validation still pending")
+	}
+
+	// Pretend the snapshot committed to a different utxo set, which makes
+	// the validation of its history fail at the base block.
+	snapChain, db, err := newSnapshotChain(t, params, buf.Bytes())
+	if err != nil {
+		t.Fatalf("New: unable to load snapshot: %v", err)
+	}
+	snapChain.snapshot.utxoSetHash = chainhash.Hash{0x01}
+	for i, block := range blocks[:len(blocks)-1] {
+		if err := snapChain.ProcessHistoricalBlock(block); err != nil {
+			t.Fatalf("ProcessHistoricalBlock fail on block %v: %v",
+				i+1, err)
+		}
+	}
+	err = snapChain.ProcessHistoricalBlock(blocks[len(blocks)-1])
+	if !errors.Is(err, ErrInvalidSnapshot) {
+		t.Fatalf("ProcessHistoricalBlock: got %v, want %v", err,
+			ErrInvalidSnapshot)
+	}
+
+	// The chain must refuse to continue, also after a restart.
+	next := regtestSnapshotBlocks(t, assumeUtxo.Height+1)[assumeUtxo.Height]
+	_, _, err = snapChain.ProcessBlock(next, BFNone)
+	if !errors.Is(err, ErrInvalidSnapshot) {
+		t.Fatalf("ProcessBlock: got %v, want %v", err,
+			ErrInvalidSnapshot)
+	}
+	_, err = New(&Config{
+		DB:          db,
+		ChainParams: params,
+		TimeSource:  NewMedianTime(),
+		SigCache:    txscript.NewSigCache(1000),
+	})
+	if !errors.Is(err, ErrInvalidSnapshot) {
+		t.Fatalf("New: got %v, want %v", err, ErrInvalidSnapshot)
+	}
+}
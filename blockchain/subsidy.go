@@ -0,0 +1,297 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"math"
+
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+const (
+	// fixedSubsidyBase is the base subsidy in whole coins once the v20 hard
+	// fork is active.
+	fixedSubsidyBase = 5
+
+	// mnrrMasternodeShare is the masternode share of the block value in
+	// percent once the masternode reward reallocation is active, which is
+	// 60% of the subsidy since the treasury takes 20%.
+	mnrrMasternodeShare = 75
+
+	// platformShare is the share of the masternode reward from the subsidy
+	// in per mille which is paid to the Platform credit pool once the
+	// masternode reward reallocation is active.
+	platformShare = 375
+)
+
+// brrPeriods are the masternode shares of the block value in per mille during
+// the periods of the block reward reallocation.  Every period lasts three
+// superblock cycles and the last share applies from then on.
+var brrPeriods = []int64{
+	513, 526, 533, 540, 546, 552, 557, 562, 567, 572, 577, 582, 585, 588,
+	591, 594, 597, 599, 600,
+}
+
+// BlockReward describes how the coins created by a block and the fees it
+// collects are split.
+type BlockReward struct {
+	// Subsidy is the amount paid by the coinbase of the block without the
+	// fees, which excludes the treasury share.
+	Subsidy int64
+
+	// Miner is the amount paid to the miner of the block, which includes
+	// the fees which are not paid to the masternode.
+	Miner int64
+
+	// Masternode is the amount paid to the masternode selected by the
+	// deterministic masternode list.
+	Masternode int64
+
+	// Platform is the share of the masternode reward which is locked in the
+	// Platform credit pool instead of being paid to the masternode.
+	Platform int64
+
+	// Treasury is the amount set aside for the budget paid by superblocks.
+	// It is not paid by the block itself.
+	Treasury int64
+}
+
+// convertBitsToDouble returns the difficulty of the passed compact target
+// relative to the minimum difficulty as a floating point number the same way
+// as Dash Core.
+func convertBitsToDouble(bits uint32) float64 {
+	shift := (bits >> 24) & 0xff
+	diff := float64(0x0000ffff) / float64(bits&0x00ffffff)
+	for ; shift < 29; shift++ {
+		diff *= 256.0
+	}
+	for ; shift > 29; shift-- {
+		diff /= 256.0
+	}
+	return diff
+}
+
+// clampSubsidyBase truncates the passed base subsidy in coins to an integer
+// within the passed bounds.
+func clampSubsidyBase(base float64, min, max int64) int64 {
+	if base > float64(max) {
+		return max
+	}
+	if base < float64(min) || math.IsNaN(base) {
+		return min
+	}
+	return int64(base)
+}
+
+// calcSubsidyBeforeTreasury returns the subsidy of the block after the block
+// with the passed height and target bits, including the treasury share.
+//
+// The base subsidy was derived from the difficulty until the v20 hard fork and
+// is reduced by 1/14 every SubsidyReductionInterval blocks, which is about
+// 7.14% a year.
+func calcSubsidyBeforeTreasury(prevHeight int32, prevBits uint32,
+	chainParams *chaincfg.Params) int64 {
+
+	var base int64
+	switch {
+	case prevHeight+1 >= chainParams.V20Height:
+		base = fixedSubsidyBase
+
+	default:
+		// The main network suffered from a bug which computed the
+		// difficulty without the exponent of the target.
+		var diff float64
+		if prevHeight <= 4500 && chainParams.Net == wire.MainNet {
+			diff = float64(0x0000ffff) / float64(prevBits&0x00ffffff)
+		} else {
+			diff = convertBitsToDouble(prevBits)
+		}
+
+		switch {
+		// Early ages: 1111/((x+1)^2)
+		case prevHeight < 5465:
+			base = clampSubsidyBase(1111.0/math.Pow(diff+1.0, 2.0),
+				1, 500)
+
+		// CPU mining era: 11111/(((x+51)/6)^2)
+		case prevHeight < 17000 || (diff <= 75 && prevHeight < 24000):
+			base = clampSubsidyBase(
+				11111.0/math.Pow((diff+51.0)/6.0, 2.0), 25, 500)
+
+		// GPU/ASIC mining era: 2222222/(((x+2600)/9)^2)
+		default:
+			base = clampSubsidyBase(
+				2222222.0/math.Pow((diff+2600.0)/9.0, 2.0), 5, 25)
+		}
+	}
+
+	subsidy := base * btcutil.SatoshiPerBitcoin
+	interval := chainParams.SubsidyReductionInterval
+	if interval > 0 {
+		for i := interval; i <= prevHeight; i += interval {
+			subsidy -= subsidy / 14
+		}
+	}
+
+	// Devnets pay a higher subsidy for their first blocks.
+	if prevHeight < chainParams.HighSubsidyBlocks {
+		subsidy *= chainParams.HighSubsidyFactor
+	}
+	return subsidy
+}
+
+// calcTreasuryShare returns the part of the passed subsidy of the block at the
+// passed height which is set aside for superblocks.
+func calcTreasuryShare(height int32, subsidy int64,
+	chainParams *chaincfg.Params) int64 {
+
+	if height-1 <= chainParams.BudgetPaymentsStartHeight {
+		return 0
+	}
+	if height >= chainParams.MNRRHeight {
+		return subsidy / 5
+	}
+	return subsidy / 10
+}
+
+// CalcBlockSubsidy returns the subsidy amount a block at the provided height
+// should have given the target bits of its parent.  This is mainly used for
+// determining how much the coinbase for newly generated blocks awards as well
+// as validating the coinbase for blocks has the expected value.
+//
+// The returned subsidy excludes the treasury share, which is only paid by
+// superblocks.  See CalcBlockReward for the details of the reward schedule.
+func CalcBlockSubsidy(height int32, prevBits uint32, chainParams *chaincfg.Params) int64 {
+	subsidy := calcSubsidyBeforeTreasury(height-1, prevBits, chainParams)
+	return subsidy - calcTreasuryShare(height, subsidy, chainParams)
+}
+
+// calcMasternodePayment returns the amount of the passed block value, which is
+// the subsidy and fees of the block at the passed height, that is paid to the
+// masternode including the Platform share.
+func calcMasternodePayment(height int32, blockValue int64,
+	chainParams *chaincfg.Params) int64 {
+
+	if height < chainParams.MasternodePaymentsStartHeight {
+		return 0
+	}
+
+	// The masternode share started at 20% and was increased in steps up
+	// to 50%.
+	payment := blockValue / 5
+	increaseHeight := chainParams.MasternodePaymentsIncreaseHeight
+	period := chainParams.MasternodePaymentsIncreasePeriod
+	steps := []struct {
+		periods int32
+		divisor int64
+	}{
+		{0, 20}, {1, 20}, {2, 20}, {3, 40}, {4, 40}, {5, 40}, {6, 40},
+		{7, 40}, {9, 40},
+	}
+	for _, step := range steps {
+		if height > increaseHeight+period*step.periods {
+			payment += blockValue / step.divisor
+		}
+	}
+
+	if height < chainParams.BRRHeight {
+		return payment
+	}
+
+	// The reallocation starts with the superblock cycle after the one it
+	// activated in.
+	cycle := chainParams.SuperblockCycle
+	if cycle <= 0 {
+		return payment
+	}
+	reallocStart := chainParams.BRRHeight - chainParams.BRRHeight%cycle + cycle
+	if height < reallocStart {
+		return payment
+	}
+
+	if height >= chainParams.MNRRHeight {
+		return blockValue * mnrrMasternodeShare / 100
+	}
+
+	period = (height - reallocStart) / (cycle * 3)
+	if int(period) >= len(brrPeriods) {
+		period = int32(len(brrPeriods) - 1)
+	}
+	return blockValue * brrPeriods[period] / 1000
+}
+
+// CalcBlockReward returns how the subsidy of the block at the passed height and
+// the passed fees it collects are split between the miner, the masternode, the
+// Platform credit pool and the treasury.  The subsidy depends on the target
+// bits of the parent of the block until the v20 hard fork.
+//
+// Dash rewards blocks as follows:
+//   - The base subsidy was derived from the difficulty in the early days and
+//     is fixed at 5 coins since the v20 hard fork.  It is reduced by 1/14 every
+//     SubsidyReductionInterval blocks.
+//   - After BudgetPaymentsStartHeight, 10% of the subsidy is set aside for the
+//     treasury and paid by superblocks.  This increased to 20% with the
+//     masternode reward reallocation at MNRRHeight.
+//   - The masternode share of the remaining subsidy and fees started at 20% and
+//     rose to 50% in steps.  The block reward reallocation at BRRHeight moved
+//     it to 60% over the following superblock cycles, and since MNRRHeight it
+//     is 75%.  37.5% of the masternode share of the subsidy is paid to the
+//     Platform credit pool.
+//   - The miner gets the rest.
+func CalcBlockReward(height int32, prevBits uint32, fees int64,
+	chainParams *chaincfg.Params) BlockReward {
+
+	total := calcSubsidyBeforeTreasury(height-1, prevBits, chainParams)
+	treasury := calcTreasuryShare(height, total, chainParams)
+	subsidy := total - treasury
+
+	// The Platform share is taken from the masternode share of the
+	// subsidy only, so the fees are paid to the masternode in full.
+	blockValue := subsidy + fees
+	masternode := calcMasternodePayment(height, blockValue, chainParams)
+	var platform int64
+	if height >= chainParams.MNRRHeight {
+		platform = calcMasternodePayment(height, subsidy, chainParams) *
+			platformShare / 1000
+	}
+
+	return BlockReward{
+		Subsidy:    subsidy,
+		Miner:      blockValue - masternode,
+		Masternode: masternode - platform,
+		Platform:   platform,
+		Treasury:   treasury,
+	}
+}
+
+// IsSuperblock returns whether the block at the passed height is a superblock,
+// which may pay the treasury budget in addition to its reward.
+func IsSuperblock(height int32, chainParams *chaincfg.Params) bool {
+	return chainParams.SuperblockCycle > 0 &&
+		height >= chainParams.SuperblockStartHeight &&
+		height%chainParams.SuperblockCycle == 0
+}
+
+// CalcSuperblockBudget returns the maximum amount the superblock at the passed
+// height may pay to governance proposals, which is the treasury share of all
+// blocks of a superblock cycle.  It returns zero when the height is not a
+// superblock.
+func CalcSuperblockBudget(height int32, chainParams *chaincfg.Params) int64 {
+	if !IsSuperblock(height, chainParams) {
+		return 0
+	}
+
+	// Like Dash Core, use the lowest possible subsidy on networks without
+	// minimum difficulty blocks and the highest one on the others.
+	bits := uint32(1)
+	if chainParams.ReduceMinDifficulty {
+		bits = chainParams.PowLimitBits
+	}
+	subsidy := calcSubsidyBeforeTreasury(height-1, bits, chainParams)
+	treasury := calcTreasuryShare(height, subsidy, chainParams)
+	return treasury * int64(chainParams.SuperblockCycle)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg"
+)
+
+// TestCalcBlockReward ensures the block reward is split between the miner, the
+// masternode, the Platform credit pool and the treasury according to the
+// schedule of the main network.
+func TestCalcBlockReward(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	tests := []struct {
+		name     string
+		height   int32
+		prevBits uint32
+		fees     int64
+		want     BlockReward
+	}{
+		{
+			name:     "early era capped at 500 coins",
+			height:   2,
+			prevBits: 0x1e0ffff0,
+			want: BlockReward{
+				Subsidy: 50000000000,
+				Miner:   50000000000,
+			},
+		},
+		{
+			name:     "cpu mining era",
+			height:   10000,
+			prevBits: 0x1c0c0000,
+			want: BlockReward{
+				Subsidy: 7600000000,
+				Miner:   7600000000,
+			},
+		},
+		{
+			name:     "gpu mining era",
+			height:   20000,
+			prevBits: 0x1b7b2bfe,
+			want: BlockReward{
+				Subsidy: 1800000000,
+				Miner:   1800000000,
+			},
+		},
+		{
+			name:     "masternode payments increased to 25%",
+			height:   160000,
+			prevBits: 0x1b1441de,
+			fees:     50000,
+			want: BlockReward{
+				Subsidy:    500000000,
+				Miner:      375037500,
+				Masternode: 125012500,
+			},
+		},
+		{
+			name:     "treasury and masternode payments at 50%",
+			height:   1000000,
+			prevBits: 0x1a0ed0e5,
+			want: BlockReward{
+				Subsidy:    334559821,
+				Miner:      167279914,
+				Masternode: 167279907,
+				Treasury:   37173313,
+			},
+		},
+		{
+			name:     "first block reward reallocation period",
+			height:   1380000,
+			prevBits: 0x1927ce8d,
+			want: BlockReward{
+				Subsidy:    288472500,
+				Miner:      140486108,
+				Masternode: 147986392,
+				Treasury:   32052499,
+			},
+		},
+		{
+			name:     "fixed base subsidy after v20",
+			height:   2000000,
+			prevBits: 0x19270000,
+			fees:     12345,
+			want: BlockReward{
+				Subsidy:    230967232,
+				Miner:      95856525,
+				Masternode: 135123052,
+				Treasury:   25663025,
+			},
+		},
+		{
+			name:     "masternode reward reallocation",
+			height:   2200000,
+			prevBits: 0x19270000,
+			fees:     100000,
+			want: BlockReward{
+				Subsidy:    190639620,
+				Miner:      47684905,
+				Masternode: 89437322,
+				Platform:   53617393,
+				Treasury:   47659905,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got := CalcBlockReward(test.height, test.prevBits, test.fees, params)
+		if got != test.want {
+			t.Errorf("%s: got %+v, want %+v", test.name, got, test.want)
+			continue
+		}
+
+		subsidy := CalcBlockSubsidy(test.height, test.prevBits, params)
+		if subsidy != got.Subsidy {
+			t.Errorf("%s: CalcBlockSubsidy got %d, want %d", test.name,
+				subsidy, got.Subsidy)
+		}
+		paid := got.Miner + got.Masternode + got.Platform
+		if paid != got.Subsidy+test.fees {
+			t.Errorf("%s: paid %d, want subsidy and fees %d",
+				test.name, paid, got.Subsidy+test.fees)
+		}
+	}
+}
+
+// TestCalcSuperblockBudget ensures only superblocks have a budget and that it
+// is the treasury share of a full superblock cycle.
+func TestCalcSuperblockBudget(t *testing.T) {
+	params := chaincfg.MainNetParams
+	minDiffParams := params
+	minDiffParams.ReduceMinDifficulty = true
+	minDiffParams.PowLimitBits = 0x1e0fffff
+
+	tests := []struct {
+		name   string
+		params *chaincfg.Params
+		height int32
+		want   int64
+	}{
+		{"start height not on cycle", &params, 614820, 0},
+		{"first superblock", &params, 631408, 665184988184},
+		{"not a superblock", &params, 631409, 0},
+		{"after v20", &params, 2010536, 426416823400},
+		{"after masternode reward reallocation", &params, 2160080,
+			791916981480},
+		{"minimum difficulty subsidy", &minDiffParams, 631408,
+			3325924924304},
+	}
+
+	for _, test := range tests {
+		isSuperblock := IsSuperblock(test.height, test.params)
+		if isSuperblock != (test.want != 0) {
+			t.Errorf("%s: IsSuperblock got %v", test.name, isSuperblock)
+		}
+		got := CalcSuperblockBudget(test.height, test.params)
+		if got != test.want {
+			t.Errorf("%s: got %d, want %d", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,435 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+const (
+	// utxoSetStatsSize is the size of serialized utxo set statistics.
+	utxoSetStatsSize = 8 + 8 + 8 + muHashNumSize*2
+
+	// utxoSetStatsLogInterval is the number of utxos after which the
+	// progress of computing the utxo set statistics is logged.
+	utxoSetStatsLogInterval = 1000000
+)
+
+var (
+	// utxoSetStatsKeyName is the name of the db key used to store the
+	// statistics and the MuHash3072 commitment of the utxo set.
+	utxoSetStatsKeyName = []byte("utxosetstats")
+)
+
+// UtxoSetStats houses statistics about the utxo set at the end of the main
+// chain as returned by UtxoSetStats and ScanUtxoSet.
+type UtxoSetStats struct {
+	Hash   chainhash.Hash // The hash of the block.
+	Height int32          // The height of the block.
+
+	// Transactions is the number of transactions with unspent outputs,
+	// DiskSize the size of the keys and values of the utxo set in the
+	// database and HashSerialized the hash_serialized_2 commitment of the
+	// reference implementation.  They are only set by ScanUtxoSet.
+	Transactions   uint64
+	DiskSize       uint64
+	HashSerialized chainhash.Hash
+
+	TxOuts      uint64         // The number of unspent outputs.
+	BogoSize    uint64         // The estimated size of the utxo set.
+	TotalAmount int64          // The total amount of the unspent outputs.
+	MuHash      chainhash.Hash // The MuHash3072 commitment of the utxo set.
+}
+
+// utxoSetStats houses the statistics of the utxo set which are updated as the
+// utxo set is modified, so they are available without iterating the utxo set.
+//
+// The serialized format is:
+//
+//	<txouts><bogo size><total amount><muhash>
+//
+//	Field          Type         Size
+//	txouts         uint64       8 bytes
+//	bogo size      uint64       8 bytes
+//	total amount   int64        8 bytes
+//	muhash         muHash3072   768 bytes
+type utxoSetStats struct {
+	txOuts      uint64
+	bogoSize    uint64
+	totalAmount int64
+	muHash      *muHash3072
+}
+
+// newUtxoSetStats returns the statistics of an empty utxo set.
+func newUtxoSetStats() *utxoSetStats {
+	return &utxoSetStats{muHash: newMuHash3072()}
+}
+
+// clone returns a copy of the statistics which can be modified without
+// affecting the original.
+func (s *utxoSetStats) clone() *utxoSetStats {
+	return &utxoSetStats{
+		txOuts:      s.txOuts,
+		bogoSize:    s.bogoSize,
+		totalAmount: s.totalAmount,
+		muHash:      s.muHash.clone(),
+	}
+}
+
+// utxoBogoSize returns the estimated size of a utxo with the passed public key
+// script as defined by the reference implementation, which accounts for the
+// hash and index of the outpoint, the height and coinbase flag, the amount and
+// the length of the script.
+func utxoBogoSize(pkScript []byte) uint64 {
+	return chainhash.HashSize + 4 + 4 + 8 + 2 + uint64(len(pkScript))
+}
+
+// serializeMuHashUtxo returns the serialization of the passed utxo which is
+// added to the MuHash3072 commitment of the utxo set.  It is the serialization
+// of the outpoint, the height shifted over one bit with the coinbase flag in
+// the lowest bit as a uint32 and the transaction output.
+func serializeMuHashUtxo(outpoint wire.OutPoint, entry *UtxoEntry) []byte {
+	var buf bytes.Buffer
+	buf.Grow(chainhash.HashSize + 4 + 4 + 8 + wire.MaxVarIntPayload +
+		len(entry.PkScript()))
+
+	var scratch [8]byte
+	buf.Write(outpoint.Hash[:])
+	binary.LittleEndian.PutUint32(scratch[:4], outpoint.Index)
+	buf.Write(scratch[:4])
+	code := uint32(entry.BlockHeight()) << 1
+	if entry.IsCoinBase() {
+		code |= 0x01
+	}
+	binary.LittleEndian.PutUint32(scratch[:4], code)
+	buf.Write(scratch[:4])
+	binary.LittleEndian.PutUint64(scratch[:], uint64(entry.Amount()))
+	buf.Write(scratch[:])
+	// Writing to a bytes.Buffer never fails.
+	_ = wire.WriteVarBytes(&buf, 0, entry.PkScript())
+	return buf.Bytes()
+}
+
+// add adds the passed utxo to the statistics.
+func (s *utxoSetStats) add(outpoint wire.OutPoint, entry *UtxoEntry) {
+	s.txOuts++
+	s.bogoSize += utxoBogoSize(entry.PkScript())
+	s.totalAmount += entry.Amount()
+	s.muHash.Add(serializeMuHashUtxo(outpoint, entry))
+}
+
+// remove removes the passed utxo from the statistics.
+func (s *utxoSetStats) remove(outpoint wire.OutPoint, entry *UtxoEntry) {
+	s.txOuts--
+	s.bogoSize -= utxoBogoSize(entry.PkScript())
+	s.totalAmount -= entry.Amount()
+	s.muHash.Remove(serializeMuHashUtxo(outpoint, entry))
+}
+
+// serializeUtxoSetStats returns the serialization of the passed utxo set
+// statistics.
+func serializeUtxoSetStats(stats *utxoSetStats) []byte {
+	serialized := make([]byte, utxoSetStatsSize)
+	byteOrder.PutUint64(serialized[0:8], stats.txOuts)
+	byteOrder.PutUint64(serialized[8:16], stats.bogoSize)
+	byteOrder.PutUint64(serialized[16:24], uint64(stats.totalAmount))
+	copy(serialized[24:], stats.muHash.serialize())
+	return serialized
+}
+
+// deserializeUtxoSetStats deserializes the passed serialized utxo set
+// statistics.
+func deserializeUtxoSetStats(serialized []byte) (*utxoSetStats, error) {
+	if len(serialized) != utxoSetStatsSize {
+		return nil, database.Error{
+			ErrorCode:   database.ErrCorruption,
+			Description: "corrupt utxo set statistics",
+		}
+	}
+
+	return &utxoSetStats{
+		txOuts:      byteOrder.Uint64(serialized[0:8]),
+		bogoSize:    byteOrder.Uint64(serialized[8:16]),
+		totalAmount: int64(byteOrder.Uint64(serialized[16:24])),
+		muHash:      deserializeMuHash3072(serialized[24:]),
+	}, nil
+}
+
+// dbPutUtxoSetStats uses an existing database transaction to store the passed
+// utxo set statistics.
+func dbPutUtxoSetStats(dbTx database.Tx, stats *utxoSetStats) error {
+	return dbTx.Metadata().Put(utxoSetStatsKeyName,
+		serializeUtxoSetStats(stats))
+}
+
+// dbFetchUtxoSetStats uses an existing database transaction to fetch the utxo
+// set statistics.  It returns nil when they have not been computed yet.
+func dbFetchUtxoSetStats(dbTx database.Tx) (*utxoSetStats, error) {
+	serialized := dbTx.Metadata().Get(utxoSetStatsKeyName)
+	if serialized == nil {
+		return nil, nil
+	}
+	return deserializeUtxoSetStats(serialized)
+}
+
+// dbUpdateUtxoSetStats uses an existing database transaction to apply the
+// modifications of the utxo set in the passed view to the passed statistics
+// and store them.  It must be called before the view is written to the utxo set
+// with dbPutUtxoView, since the utxos replaced or removed by the view are
+// loaded from it.
+func dbUpdateUtxoSetStats(dbTx database.Tx, stats *utxoSetStats, view *UtxoViewpoint) error {
+	utxoBucket := dbTx.Metadata().Bucket(utxoSetBucketName)
+	for outpoint, entry := range view.entries {
+		if entry == nil || !entry.isModified() {
+			continue
+		}
+
+		// Remove the utxo currently in the utxo set, if any.  Outputs
+		// which are created and spent by the same block were never
+		// written to it.
+		oldEntry, err := dbFetchUtxoEntryFromBucket(utxoBucket, outpoint)
+		if err != nil {
+			return err
+		}
+		if oldEntry != nil {
+			stats.remove(outpoint, oldEntry)
+		}
+
+		if !entry.IsSpent() {
+			stats.add(outpoint, entry)
+		}
+	}
+
+	return dbPutUtxoSetStats(dbTx, stats)
+}
+
+// deserializeOutpointKey decodes the outpoint from the passed key of the utxo
+// set bucket.
+func deserializeOutpointKey(key []byte) (wire.OutPoint, error) {
+	var outpoint wire.OutPoint
+	if len(key) <= chainhash.HashSize {
+		return outpoint, errDeserialize(fmt.Sprintf("utxo key %x is "+
+			"too short", key))
+	}
+	index, bytesRead := deserializeVLQ(key[chainhash.HashSize:])
+	if bytesRead != len(key)-chainhash.HashSize || index > math.MaxUint32 {
+		return outpoint, errDeserialize(fmt.Sprintf("malformed utxo "+
+			"key %x", key))
+	}
+	copy(outpoint.Hash[:], key)
+	outpoint.Index = uint32(index)
+	return outpoint, nil
+}
+
+// initUtxoSetStats loads the statistics of the utxo set or computes them by
+// iterating the utxo set when they are not available yet, such as when the
+// database was created before they were maintained or a utxo set snapshot was
+// loaded.
+func (b *BlockChain) initUtxoSetStats(interrupt <-chan struct{}) error {
+	err := b.db.View(func(dbTx database.Tx) error {
+		var err error
+		b.utxoStats, err = dbFetchUtxoSetStats(dbTx)
+		return err
+	})
+	if err != nil || b.utxoStats != nil {
+		return err
+	}
+
+	log.Infof("Computing the utxo set commitment.  This might take a " +
+		"while...")
+	stats := newUtxoSetStats()
+	err = b.db.Update(func(dbTx database.Tx) error {
+		utxoBucket := dbTx.Metadata().Bucket(utxoSetBucketName)
+		cursor := utxoBucket.Cursor()
+		for ok := cursor.First(); ok; ok = cursor.Next() {
+			if interruptRequested(interrupt) {
+				return errInterruptRequested
+			}
+
+			outpoint, err := deserializeOutpointKey(cursor.Key())
+			if err != nil {
+				return err
+			}
+			entry, err := deserializeUtxoEntry(cursor.Value())
+			if err != nil {
+				return err
+			}
+			stats.add(outpoint, entry)
+
+			if stats.txOuts%utxoSetStatsLogInterval == 0 {
+				log.Infof("Added %d utxos to the utxo set "+
+					"commitment", stats.txOuts)
+			}
+		}
+
+		return dbPutUtxoSetStats(dbTx, stats)
+	})
+	if err != nil {
+		return err
+	}
+
+	b.utxoStats = stats
+	log.Infof("Computed the utxo set commitment of %d utxos",
+		stats.txOuts)
+	return nil
+}
+
+// UtxoSetStats returns the statistics and the MuHash3072 commitment of the utxo
+// set at the end of the main chain.  They are maintained as blocks are
+// connected and disconnected, so the utxo set is not iterated and the fields
+// only set by ScanUtxoSet are zero.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) UtxoSetStats() *UtxoSetStats {
+	b.stateLock.RLock()
+	best := b.stateSnapshot
+	stats := b.utxoStats
+	b.stateLock.RUnlock()
+
+	return &UtxoSetStats{
+		Hash:        best.Hash,
+		Height:      best.Height,
+		TxOuts:      stats.txOuts,
+		BogoSize:    stats.bogoSize,
+		TotalAmount: stats.totalAmount,
+		MuHash:      stats.muHash.Finalize(),
+	}
+}
+
+// utxosByIndex sorts the utxos of a transaction by their output index.
+type utxosByIndex struct {
+	outpoints []wire.OutPoint
+	entries   []*UtxoEntry
+}
+
+// Len returns the number of utxos.  It is part of the sort.Interface
+// implementation.
+func (s utxosByIndex) Len() int {
+	return len(s.outpoints)
+}
+
+// Less returns whether the utxo at index i has a lower output index than the
+// one at index j.  It is part of the sort.Interface implementation.
+func (s utxosByIndex) Less(i, j int) bool {
+	return s.outpoints[i].Index < s.outpoints[j].Index
+}
+
+// Swap swaps the utxos at the passed indices.  It is part of the
+// sort.Interface implementation.
+func (s utxosByIndex) Swap(i, j int) {
+	s.outpoints[i], s.outpoints[j] = s.outpoints[j], s.outpoints[i]
+	s.entries[i], s.entries[j] = s.entries[j], s.entries[i]
+}
+
+// writeHashSerializedTx writes the utxos of the transaction with the passed
+// hash to the passed hash_serialized_2 hasher.  The utxos must be ordered by
+// index.
+//
+// NOTE: The reference implementation intends to write the height shifted over
+// one bit with the coinbase flag in the lowest bit, but due to the precedence
+// of its operators it writes 1 unless both are zero.  The same value is written
+// here so the commitments match.
+func writeHashSerializedTx(w io.Writer, hash *chainhash.Hash, outpoints []wire.OutPoint, entries []*UtxoEntry) {
+	scratch := make([]byte, serializeSizeVLQ(math.MaxUint64))
+	putVarint := func(n uint64) {
+		w.Write(scratch[:putVLQ(scratch, n)])
+	}
+
+	w.Write(hash[:])
+	code := uint64(0)
+	if entries[0].BlockHeight() != 0 || entries[0].IsCoinBase() {
+		code = 1
+	}
+	putVarint(code)
+	for i, entry := range entries {
+		putVarint(uint64(outpoints[i].Index) + 1)
+		_ = wire.WriteVarBytes(w, 0, entry.PkScript())
+		putVarint(uint64(entry.Amount()))
+	}
+	putVarint(0)
+}
+
+// ScanUtxoSet iterates the utxo set at the end of the main chain to compute all
+// of its statistics, including the hash_serialized_2 commitment of the
+// reference implementation, which is the double sha256 of the hash of the block
+// followed by the utxos grouped by transaction.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) ScanUtxoSet() (*UtxoSetStats, error) {
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+
+	stats := b.UtxoSetStats()
+	stats.TxOuts = 0
+	stats.BogoSize = 0
+	stats.TotalAmount = 0
+
+	hasher := sha256.New()
+	hasher.Write(stats.Hash[:])
+	var txHash chainhash.Hash
+	var outpoints []wire.OutPoint
+	var entries []*UtxoEntry
+	flushTx := func() {
+		if len(entries) == 0 {
+			return
+		}
+		sort.Sort(utxosByIndex{outpoints, entries})
+		writeHashSerializedTx(hasher, &txHash, outpoints, entries)
+		stats.Transactions++
+		outpoints = outpoints[:0]
+		entries = entries[:0]
+	}
+
+	err := b.db.View(func(dbTx database.Tx) error {
+		utxoBucket := dbTx.Metadata().Bucket(utxoSetBucketName)
+		cursor := utxoBucket.Cursor()
+		for ok := cursor.First(); ok; ok = cursor.Next() {
+			key, value := cursor.Key(), cursor.Value()
+			outpoint, err := deserializeOutpointKey(key)
+			if err != nil {
+				return err
+			}
+			entry, err := deserializeUtxoEntry(value)
+			if err != nil {
+				return err
+			}
+
+			// The keys start with the transaction hash, so all utxos
+			// of a transaction are consecutive.  They are not ordered
+			// by index though, since the VLQ encoded index of 16512
+			// sorts before the one of 16511, so they are sorted by
+			// index before they are hashed.
+			if outpoint.Hash != txHash {
+				flushTx()
+				txHash = outpoint.Hash
+			}
+			outpoints = append(outpoints, outpoint)
+			entries = append(entries, entry)
+
+			stats.TxOuts++
+			stats.BogoSize += utxoBogoSize(entry.PkScript())
+			stats.TotalAmount += entry.Amount()
+			stats.DiskSize += uint64(len(key) + len(value))
+		}
+		flushTx()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats.HashSerialized = chainhash.Hash(sha256.Sum256(hasher.Sum(nil)))
+	return stats, nil
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// recomputeUtxoSetStats discards the stored statistics of the utxo set of the
+// passed chain and computes them again by iterating the utxo set.
+func recomputeUtxoSetStats(t *testing.T, chain *BlockChain) *UtxoSetStats {
+	t.Helper()
+
+	err := chain.db.Update(func(dbTx database.Tx) error {
+		return dbTx.Metadata().Delete(utxoSetStatsKeyName)
+	})
+	if err != nil {
+		t.Fatalf("unable to delete utxo set statistics: %v", err)
+	}
+	if err := chain.initUtxoSetStats(nil); err != nil {
+		t.Fatalf("initUtxoSetStats: %v", err)
+	}
+	return chain.UtxoSetStats()
+}
+
+// TestUtxoSetStats ensures the statistics of the utxo set which are maintained
+// as the utxo set is modified match those computed by iterating it.
+func TestUtxoSetStats(t *testing.T) {
+	blocks, err := loadBlocks("blk_0_to_4.dat.bz2")
+	if err != nil {
+		t.Fatalf("Error loading file: %v", err)
+	}
+
+	chain, teardownFunc, err := chainSetup("utxosetstats",
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("Failed to setup chain instance: %v", err)
+	}
+	defer teardownFunc()
+	chain.TstSetCoinbaseMaturity(1)
+
+	empty := chain.UtxoSetStats()
+	if empty.TxOuts != 0 || empty.MuHash != newMuHash3072().Finalize() {
+		t.Fatalf("UtxoSetStats: unexpected stats %+v for empty utxo set",
+			empty)
+	}
+
+	var totalAmount int64
+	for i := 1; i < len(blocks); i++ {
+		if _, _, err := chain.ProcessBlock(blocks[i], BFNone); err != nil {
+			t.Fatalf("ProcessBlock fail on block %v: %v", i, err)
+		}
+		for _, txOut := range blocks[i].MsgBlock().Transactions[0].TxOut {
+			totalAmount += txOut.Value
+		}
+	}
+
+	// The maintained statistics must match the scanned ones.
+	stats := chain.UtxoSetStats()
+	scan, err := chain.ScanUtxoSet()
+	if err != nil {
+		t.Fatalf("ScanUtxoSet: %v", err)
+	}
+	best := chain.BestSnapshot()
+	if stats.Hash != best.Hash || stats.Height != best.Height {
+		t.Fatalf("UtxoSetStats: got block %v (%d), want %v (%d)",
+			stats.Hash, stats.Height, best.Hash, best.Height)
+	}
+	if stats.TxOuts != uint64(len(blocks)-1) ||
+		stats.TotalAmount != totalAmount {
+
+		t.Fatalf("UtxoSetStats: got %d txouts with %d total, want "+
+			"%d with %d", stats.TxOuts, stats.TotalAmount,
+			len(blocks)-1, totalAmount)
+	}
+	if scan.TxOuts != stats.TxOuts || scan.BogoSize != stats.BogoSize ||
+		scan.TotalAmount != stats.TotalAmount ||
+		scan.MuHash != stats.MuHash || scan.Hash != stats.Hash {
+
+		t.Fatalf("ScanUtxoSet: got %+v, want %+v", scan, stats)
+	}
+	if scan.Transactions != uint64(len(blocks)-1) || scan.DiskSize == 0 {
+		t.Fatalf("ScanUtxoSet: got %d transactions and disk size %d",
+			scan.Transactions, scan.DiskSize)
+	}
+
+	// The commitment computed from scratch must match the maintained one.
+	if got := recomputeUtxoSetStats(t, chain); *got != *stats {
+		t.Fatalf("recomputed stats: got %+v, want %+v", got, stats)
+	}
+
+	// Spend an output and ensure the commitment matches the one computed
+	// from the remaining utxo set.
+	outpoint := wire.OutPoint{
+		Hash: *blocks[1].Transactions()[0].Hash(),
+	}
+	entry, err := chain.FetchUtxoEntry(outpoint)
+	if err != nil || entry == nil {
+		t.Fatalf("FetchUtxoEntry: got %v, %v", entry, err)
+	}
+	view := NewUtxoViewpoint()
+	view.entries[outpoint] = entry
+	entry.Spend()
+	spentStats := chain.utxoStats.clone()
+	err = chain.db.Update(func(dbTx database.Tx) error {
+		if err := dbUpdateUtxoSetStats(dbTx, spentStats, view); err != nil {
+			return err
+		}
+		return dbPutUtxoView(dbTx, view)
+	})
+	if err != nil {
+		t.Fatalf("unable to spend output: %v", err)
+	}
+	chain.utxoStats = spentStats
+	spent := chain.UtxoSetStats()
+	if spent.TxOuts != stats.TxOuts-1 || spent.MuHash == stats.MuHash {
+		t.Fatalf("UtxoSetStats: got %+v after spending an output",
+			spent)
+	}
+	if got := recomputeUtxoSetStats(t, chain); *got != *spent {
+		t.Fatalf("recomputed stats: got %+v, want %+v", got, spent)
+	}
+}
+
+// TestScanUtxoSetIndexOrder ensures the utxos of a transaction are hashed by
+// ScanUtxoSet in the order of their output indexes, including indexes whose
+// outpoint keys do not sort in that order.
+func TestScanUtxoSetIndexOrder(t *testing.T) {
+	chain, teardownFunc, err := chainSetup("scanutxosetindexorder",
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("Failed to setup chain instance: %v", err)
+	}
+	defer teardownFunc()
+
+	// The outpoint key of index 16512 sorts before the one of 16511.
+	txHash := chainhash.Hash{0x01}
+	indexes := []uint32{0, 16511, 16512}
+	key16511 := outpointKey(wire.OutPoint{Hash: txHash, Index: 16511})
+	key16512 := outpointKey(wire.OutPoint{Hash: txHash, Index: 16512})
+	if bytes.Compare(*key16512, *key16511) >= 0 {
+		t.Fatal("outpoint keys of indexes 16511 and 16512 are in order")
+	}
+	view := NewUtxoViewpoint()
+	for i, index := range indexes {
+		outpoint := wire.OutPoint{Hash: txHash, Index: index}
+		view.entries[outpoint] = &UtxoEntry{
+			amount:      int64(i+1) * 1000,
+			pkScript:    []byte{0x51, byte(i)},
+			blockHeight: 1,
+			packedFlags: tfModified,
+		}
+	}
+	err = chain.db.Update(func(dbTx database.Tx) error {
+		return dbPutUtxoView(dbTx, view)
+	})
+	if err != nil {
+		t.Fatalf("unable to store utxos: %v", err)
+	}
+
+	scan, err := chain.ScanUtxoSet()
+	if err != nil {
+		t.Fatalf("ScanUtxoSet: %v", err)
+	}
+
+	// Serialize the transaction like the reference implementation with
+	// its outputs in index order.
+	var buf bytes.Buffer
+	scratch := make([]byte, 10)
+	putVarint := func(n uint64) {
+		buf.Write(scratch[:putVLQ(scratch, n)])
+	}
+	best := chain.BestSnapshot()
+	buf.Write(best.Hash[:])
+	buf.Write(txHash[:])
+	putVarint(1)
+	for i, index := range indexes {
+		putVarint(uint64(index) + 1)
+		err := wire.WriteVarBytes(&buf, 0, []byte{0x51, byte(i)})
+		if err != nil {
+			t.Fatalf("WriteVarBytes: %v", err)
+		}
+		putVarint(uint64(i+1) * 1000)
+	}
+	putVarint(0)
+	first := sha256.Sum256(buf.Bytes())
+	want := chainhash.Hash(sha256.Sum256(first[:]))
+	if scan.HashSerialized != want {
+		t.Fatalf("ScanUtxoSet: got hash_serialized_2 %v, want %v",
+			scan.HashSerialized, want)
+	}
+	if scan.Transactions != 1 || scan.TxOuts != uint64(len(indexes)) {
+		t.Fatalf("ScanUtxoSet: got %d transactions with %d txouts",
+			scan.Transactions, scan.TxOuts)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/dashpay/dashd-go/chaincfg/chainhash"
 	"github.com/dashpay/dashd-go/txscript"
 	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
 )
 
 const (
@@ -37,10 +38,6 @@ const (
 	// serializedHeightVersion is the block version which changed block
 	// coinbases to start with the serialized block height.
 	serializedHeightVersion = 2
-
-	// baseSubsidy is the starting subsidy amount for mined blocks.  This
-	// value is halved every SubsidyHalvingInterval blocks.
-	baseSubsidy = 50 * btcutil.SatoshiPerBitcoin
 )
 
 var (
@@ -181,36 +178,17 @@ func isBIP0030Node(node *blockNode) bool {
 	return false
 }
 
-// CalcBlockSubsidy returns the subsidy amount a block at the provided height
-// should have. This is mainly used for determining how much the coinbase for
-// newly generated blocks awards as well as validating the coinbase for blocks
-// has the expected value.
-//
-// The subsidy is halved every SubsidyReductionInterval blocks.  Mathematically
-// this is: baseSubsidy / 2^(height/SubsidyReductionInterval)
-//
-// At the target block generation rate for the main network, this is
-// approximately every 4 years.
-func CalcBlockSubsidy(height int32, chainParams *chaincfg.Params) int64 {
-	if chainParams.SubsidyReductionInterval == 0 {
-		return baseSubsidy
-	}
-
-	// Equivalent to: baseSubsidy / 2^(height/subsidyHalvingInterval)
-	return baseSubsidy >> uint(height/chainParams.SubsidyReductionInterval)
-}
-
 // CheckTransactionSanity performs some preliminary checks on a transaction to
 // ensure it is sane.  These checks are context free.
 func CheckTransactionSanity(tx *btcutil.Tx) error {
-	// A transaction must have at least one input.
+	// A transaction must have at least one input and one output, except
+	// for final quorum commitments, which neither spend nor create coins.
 	msgTx := tx.MsgTx()
-	if len(msgTx.TxIn) == 0 {
+	isQcTx := msgTx.IsSpecial() && msgTx.Type == wire.TxTypeQuorumCommitment
+	if len(msgTx.TxIn) == 0 && !isQcTx {
 		return ruleError(ErrNoTxInputs, "transaction has no inputs")
 	}
-
-	// A transaction must have at least one output.
-	if len(msgTx.TxOut) == 0 {
+	if len(msgTx.TxOut) == 0 && !isQcTx {
 		return ruleError(ErrNoTxOutputs, "transaction has no outputs")
 	}
 
@@ -298,12 +276,12 @@ func CheckTransactionSanity(tx *btcutil.Tx) error {
 }
 
 // checkProofOfWork ensures the block header bits which indicate the target
-// difficulty is in min/max range and that the block hash is less than the
-// target difficulty as claimed.
+// difficulty is in min/max range and that the X11 proof of work hash of the
+// header is less than the target difficulty as claimed.
 //
 // The flags modify the behavior of this function as follows:
-//   - BFNoPoWCheck: The check to ensure the block hash is less than the target
-//     difficulty is not performed.
+//   - BFNoPoWCheck: The check to ensure the proof of work hash is less than the
+//     target difficulty is not performed.
 func checkProofOfWork(header *wire.BlockHeader, powLimit *big.Int, flags BehaviorFlags) error {
 	// The target difficulty must be larger than zero.
 	target := CompactToBig(header.Bits)
@@ -320,14 +298,15 @@ func checkProofOfWork(header *wire.BlockHeader, powLimit *big.Int, flags Behavio
 		return ruleError(ErrUnexpectedDifficulty, str)
 	}
 
-	// The block hash must be less than the claimed target unless the flag
-	// to avoid proof of work checks is set.
+	// The proof of work hash must be less than the claimed target unless
+	// the flag to avoid proof of work checks is set.
 	if flags&BFNoPoWCheck != BFNoPoWCheck {
-		// The block hash must be less than the claimed target.
-		hash := header.BlockHash()
+		// The X11 hash of the header must be less than the claimed
+		// target.
+		hash := header.PowHash()
 		hashNum := HashToBig(&hash)
 		if hashNum.Cmp(target) > 0 {
-			str := fmt.Sprintf("block hash of %064x is higher than "+
+			str := fmt.Sprintf("block pow hash of %064x is higher than "+
 				"expected max of %064x", hashNum, target)
 			return ruleError(ErrHighHash, str)
 		}
@@ -337,8 +316,8 @@ func checkProofOfWork(header *wire.BlockHeader, powLimit *big.Int, flags Behavio
 }
 
 // CheckProofOfWork ensures the block header bits which indicate the target
-// difficulty is in min/max range and that the block hash is less than the
-// target difficulty as claimed.
+// difficulty is in min/max range and that the X11 proof of work hash of the
+// block header is less than the target difficulty as claimed.
 func CheckProofOfWork(block *btcutil.Block, powLimit *big.Int) error {
 	return checkProofOfWork(&block.MsgBlock().Header, powLimit, BFNone)
 }
@@ -633,6 +612,40 @@ func checkSerializedHeight(coinbaseTx *btcutil.Tx, wantHeight int32) error {
 	return nil
 }
 
+// checkCbTxHeight checks that the passed coinbase transaction, when it is a
+// coinbase special transaction, carries a well-formed payload which commits to
+// wantHeight.  Once DIP0003 is active, as indicated by requireCbTx, the coinbase
+// transaction must be a coinbase special transaction.  Before that, coinbase
+// transactions which are not special transactions are not checked.
+func checkCbTxHeight(coinbaseTx *btcutil.Tx, wantHeight int32, requireCbTx bool) error {
+	msgTx := coinbaseTx.MsgTx()
+	if !msgTx.IsSpecial() || msgTx.Type != wire.TxTypeCoinbase {
+		if requireCbTx {
+			str := fmt.Sprintf("the coinbase transaction of the block "+
+				"at height %d is not a coinbase special "+
+				"transaction", wantHeight)
+			return ruleError(ErrMissingCbTx, str)
+		}
+		return nil
+	}
+
+	payload, err := evo.DecodeTxPayload(msgTx)
+	if err != nil {
+		str := fmt.Sprintf("the coinbase transaction has an invalid "+
+			"payload: %v", err)
+		return ruleError(ErrBadCbTxPayload, str)
+	}
+
+	cbTx := payload.(*evo.CbTx)
+	if cbTx.Height != wantHeight {
+		str := fmt.Sprintf("the coinbase transaction payload block "+
+			"height is %d when %d was expected", cbTx.Height,
+			wantHeight)
+		return ruleError(ErrBadCbTxHeight, str)
+	}
+	return nil
+}
+
 // checkBlockHeaderContext performs several validation checks on the block header
 // which depend on its position within the block chain.
 //
@@ -685,11 +698,16 @@ func (b *BlockChain) checkBlockHeaderContext(header *wire.BlockHeader, prevNode
 	// chain before it.  This prevents storage of new, otherwise valid,
 	// blocks which build off of old blocks that are likely at a much easier
 	// difficulty and therefore could be used to waste cache and disk space.
+	// Blocks of the main chain which are validated after a utxo set
+	// snapshot was loaded don't fork it and are therefore exempt.
 	checkpointNode, err := b.findPreviousCheckpoint()
 	if err != nil {
 		return err
 	}
-	if checkpointNode != nil && blockHeight < checkpointNode.height {
+	mainChainNode := b.bestChain.NodeByHeight(blockHeight)
+	inMainChain := mainChainNode != nil && mainChainNode.hash == blockHash
+	if checkpointNode != nil && blockHeight < checkpointNode.height &&
+		!inMainChain {
 		str := fmt.Sprintf("block at height %d forks the main chain "+
 			"before the previous checkpoint at height %d",
 			blockHeight, checkpointNode.height)
@@ -778,6 +796,16 @@ func (b *BlockChain) checkBlockContext(block *btcutil.Block, prevNode *blockNode
 			}
 		}
 
+		// Ensure the coinbase is a coinbase special transaction once
+		// DIP0003 is active and that its payload commits to the height
+		// of the block.  This is part of DIP0003 and DIP0004.
+		requireCbTx := blockHeight >= b.chainParams.DIP0003Height
+		err = checkCbTxHeight(block.Transactions()[0], blockHeight,
+			requireCbTx)
+		if err != nil {
+			return err
+		}
+
 		// Query for the Version Bits state for the segwit soft-fork
 		// deployment. If segwit is active, we'll switch over to
 		// enforcing all the new rules.
@@ -964,6 +992,44 @@ func CheckTransactionInputs(tx *btcutil.Tx, txHeight int32, utxoView *UtxoViewpo
 	return txFeeInSatoshi, nil
 }
 
+// checkCoinbaseValue ensures the total output value of the passed coinbase
+// transaction of the block at the passed height does not exceed the block
+// subsidy plus the passed transaction fees.  Superblocks may pay the treasury
+// budget on top of that.
+//
+// The governance object triggering a superblock is not tracked, so only the
+// total paid to the treasury proposals is checked, not their outputs.
+func checkCoinbaseValue(coinbase *wire.MsgTx, height int32, prevBits uint32,
+	totalFees int64, chainParams *chaincfg.Params) error {
+
+	// It is safe to ignore overflow and out of range errors here because
+	// those error conditions would have already been caught by
+	// checkTransactionSanity.
+	var totalSatoshiOut int64
+	for _, txOut := range coinbase.TxOut {
+		totalSatoshiOut += txOut.Value
+	}
+	blockValue := CalcBlockSubsidy(height, prevBits, chainParams) + totalFees
+	if IsSuperblock(height, chainParams) {
+		budget := CalcSuperblockBudget(height, chainParams)
+		if totalSatoshiOut > blockValue+budget {
+			str := fmt.Sprintf("coinbase transaction for superblock "+
+				"pays %v which is more than the block value of %v "+
+				"plus the treasury budget of %v", totalSatoshiOut,
+				blockValue, budget)
+			return ruleError(ErrSuperblockBudgetExceeded, str)
+		}
+		return nil
+	}
+	if totalSatoshiOut > blockValue {
+		str := fmt.Sprintf("coinbase transaction for block pays %v "+
+			"which is more than expected value of %v",
+			totalSatoshiOut, blockValue)
+		return ruleError(ErrBadCoinbaseValue, str)
+	}
+	return nil
+}
+
 // checkConnectBlock performs several checks to confirm connecting the passed
 // block to the chain represented by the passed view does not violate any rules.
 // In addition, the passed view is updated to spend all of the referenced
@@ -1124,20 +1190,29 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block *btcutil.Block, vi
 
 	// The total output values of the coinbase transaction must not exceed
 	// the expected subsidy value plus total transaction fees gained from
-	// mining the block.  It is safe to ignore overflow and out of range
-	// errors here because those error conditions would have already been
-	// caught by checkTransactionSanity.
-	var totalSatoshiOut int64
-	for _, txOut := range transactions[0].MsgTx().TxOut {
-		totalSatoshiOut += txOut.Value
+	// mining the block, plus the treasury budget for superblocks.
+	err = checkCoinbaseValue(transactions[0].MsgTx(), node.height,
+		node.parent.bits, totalFees, b.chainParams)
+	if err != nil {
+		return err
 	}
-	expectedSatoshiOut := CalcBlockSubsidy(node.height, b.chainParams) +
-		totalFees
-	if totalSatoshiOut > expectedSatoshiOut {
-		str := fmt.Sprintf("coinbase transaction for block pays %v "+
-			"which is more than expected value of %v",
-			totalSatoshiOut, expectedSatoshiOut)
-		return ruleError(ErrBadCoinbaseValue, str)
+
+	// Once DIP0003 is enforced, the coinbase transaction must pay the
+	// masternode selected by the deterministic masternode list of the
+	// parent block when that list is known.
+	if b.mnPayees != nil &&
+		node.height >= b.chainParams.DIP0003EnforcementHeight {
+
+		payee, ok := b.mnPayees.MasternodePayee(&node.parent.hash)
+		if ok {
+			reward := CalcBlockReward(node.height, node.parent.bits,
+				totalFees, b.chainParams)
+			err := checkMasternodePayments(transactions[0].MsgTx(),
+				node.height, &reward, payee)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	// Don't run scripts if this node is before the latest known good
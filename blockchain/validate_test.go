@@ -5,6 +5,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"math"
 	"reflect"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/dashpay/dashd-go/chaincfg"
 	"github.com/dashpay/dashd-go/chaincfg/chainhash"
 	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
 )
 
 // TestSequenceLocksActive tests the SequenceLockActive function to ensure it
@@ -148,27 +150,226 @@ func TestCheckConnectBlockTemplate(t *testing.T) {
 	}
 }
 
+// TestCheckTransactionSanityEmpty ensures only final quorum commitments may
+// have no inputs and no outputs.
+func TestCheckTransactionSanityEmpty(t *testing.T) {
+	qcTx := wire.NewMsgTx(wire.SpecialTxVersion)
+	qcTx.Type = wire.TxTypeQuorumCommitment
+	if err := evo.SetTxPayload(qcTx, &evo.QcTx{Version: 1}); err != nil {
+		t.Fatalf("SetTxPayload: unexpected error: %v", err)
+	}
+	normalTx := wire.NewMsgTx(wire.SpecialTxVersion)
+
+	err := CheckTransactionSanity(btcutil.NewTx(qcTx))
+	if err != nil {
+		t.Errorf("final commitment: unexpected error: %v", err)
+	}
+	err = CheckTransactionSanity(btcutil.NewTx(normalTx))
+	if rerr, ok := err.(RuleError); !ok || rerr.ErrorCode != ErrNoTxInputs {
+		t.Errorf("normal transaction: got %v, want %v", err,
+			ErrNoTxInputs)
+	}
+}
+
 // TestCheckBlockSanity tests the CheckBlockSanity function to ensure it works
 // as expected.
 func TestCheckBlockSanity(t *testing.T) {
 	powLimit := chaincfg.MainNetParams.PowLimit
 	block := btcutil.NewBlock(&Block100000)
 	timeSource := NewMedianTime()
-	err := CheckBlockSanity(block, powLimit, timeSource)
+
+	// The test block was mined with double SHA-256 rather than X11, so the
+	// proof of work check is skipped.
+	err := checkBlockSanity(block, powLimit, timeSource, BFNoPoWCheck)
 	if err != nil {
 		t.Errorf("CheckBlockSanity: %v", err)
 	}
 
+	// The same block must be rejected when its X11 proof of work hash is
+	// checked against the target.
+	err = CheckBlockSanity(block, powLimit, timeSource)
+	if rerr, ok := err.(RuleError); !ok || rerr.ErrorCode != ErrHighHash {
+		t.Errorf("CheckBlockSanity: expected ErrHighHash, got %v", err)
+	}
+
 	// Ensure a block that has a timestamp with a precision higher than one
 	// second fails.
 	timestamp := block.MsgBlock().Header.Timestamp
 	block.MsgBlock().Header.Timestamp = timestamp.Add(time.Nanosecond)
-	err = CheckBlockSanity(block, powLimit, timeSource)
+	err = checkBlockSanity(block, powLimit, timeSource, BFNoPoWCheck)
 	if err == nil {
 		t.Errorf("CheckBlockSanity: error is nil when it shouldn't be")
 	}
 }
 
+// TestCheckCbTxHeight tests the checkCbTxHeight function with coinbase
+// special transactions carrying various payloads and ensures coinbase
+// transactions which are not special transactions are rejected once DIP0003 is
+// active.
+func TestCheckCbTxHeight(t *testing.T) {
+	// Create an empty coinbase template to be used in the tests below.
+	coinbaseOutpoint := wire.NewOutPoint(&chainhash.Hash{}, math.MaxUint32)
+	coinbaseTx := wire.NewMsgTx(wire.SpecialTxVersion)
+	coinbaseTx.Type = wire.TxTypeCoinbase
+	coinbaseTx.AddTxIn(wire.NewTxIn(coinbaseOutpoint, nil, nil))
+
+	// cbTxPayload returns the serialized payload of a version 2 coinbase
+	// special transaction at the passed height.
+	cbTxPayload := func(height int32) []byte {
+		var buf bytes.Buffer
+		payload := evo.CbTx{
+			Version: evo.CbTxVersionMerkleRootQuorums,
+			Height:  height,
+		}
+		if err := payload.Serialize(&buf); err != nil {
+			t.Fatalf("Serialize: unexpected error: %v", err)
+		}
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name        string
+		txType      wire.TxType
+		payload     []byte
+		wantHeight  int32
+		requireCbTx bool
+		err         error
+	}{{
+		name:       "matching height",
+		txType:     wire.TxTypeCoinbase,
+		payload:    cbTxPayload(1000),
+		wantHeight: 1000,
+	}, {
+		name:       "mismatched height",
+		txType:     wire.TxTypeCoinbase,
+		payload:    cbTxPayload(999),
+		wantHeight: 1000,
+		err:        RuleError{ErrorCode: ErrBadCbTxHeight},
+	}, {
+		name:       "truncated payload",
+		txType:     wire.TxTypeCoinbase,
+		payload:    cbTxPayload(1000)[:10],
+		wantHeight: 1000,
+		err:        RuleError{ErrorCode: ErrBadCbTxPayload},
+	}, {
+		name:       "not a coinbase special transaction",
+		txType:     wire.TxTypeNormal,
+		wantHeight: 1000,
+	}, {
+		name:        "matching height with DIP0003 active",
+		txType:      wire.TxTypeCoinbase,
+		payload:     cbTxPayload(1000),
+		wantHeight:  1000,
+		requireCbTx: true,
+	}, {
+		name:        "not a coinbase special transaction with DIP0003 active",
+		txType:      wire.TxTypeNormal,
+		wantHeight:  1000,
+		requireCbTx: true,
+		err:         RuleError{ErrorCode: ErrMissingCbTx},
+	}, {
+		name:        "other special transaction with DIP0003 active",
+		txType:      wire.TxTypeProRegTx,
+		wantHeight:  1000,
+		requireCbTx: true,
+		err:         RuleError{ErrorCode: ErrMissingCbTx},
+	}}
+
+	for _, test := range tests {
+		msgTx := coinbaseTx.Copy()
+		msgTx.Type = test.txType
+		msgTx.ExtraPayload = test.payload
+		tx := btcutil.NewTx(msgTx)
+
+		err := checkCbTxHeight(tx, test.wantHeight, test.requireCbTx)
+		if reflect.TypeOf(err) != reflect.TypeOf(test.err) {
+			t.Errorf("%s: wrong error type got: %v <%T>, want: %T",
+				test.name, err, err, test.err)
+			continue
+		}
+
+		if rerr, ok := err.(RuleError); ok {
+			trerr := test.err.(RuleError)
+			if rerr.ErrorCode != trerr.ErrorCode {
+				t.Errorf("%s: wrong error code got: %v, want: %v",
+					test.name, rerr.ErrorCode, trerr.ErrorCode)
+			}
+		}
+	}
+}
+
+// TestCheckCoinbaseValue ensures the total value paid by coinbase
+// transactions is limited to the block value, plus the treasury budget for
+// superblocks.
+func TestCheckCoinbaseValue(t *testing.T) {
+	params := &chaincfg.MainNetParams
+	superblock := (params.SuperblockStartHeight/params.SuperblockCycle + 100) *
+		params.SuperblockCycle
+	const fees = 1000
+	prevBits := params.PowLimitBits
+	budget := CalcSuperblockBudget(superblock, params)
+	if budget == 0 {
+		t.Fatalf("no treasury budget for superblock %d", superblock)
+	}
+	blockValue := func(height int32) int64 {
+		return CalcBlockSubsidy(height, prevBits, params) + fees
+	}
+
+	tests := []struct {
+		name   string
+		height int32
+		value  int64
+		err    error
+	}{{
+		name:   "block value",
+		height: superblock + 1,
+		value:  blockValue(superblock + 1),
+	}, {
+		name:   "more than block value",
+		height: superblock + 1,
+		value:  blockValue(superblock+1) + 1,
+		err:    RuleError{ErrorCode: ErrBadCoinbaseValue},
+	}, {
+		name:   "treasury budget outside superblock",
+		height: superblock + 1,
+		value:  blockValue(superblock+1) + budget,
+		err:    RuleError{ErrorCode: ErrBadCoinbaseValue},
+	}, {
+		name:   "superblock paying the treasury budget",
+		height: superblock,
+		value:  blockValue(superblock) + budget,
+	}, {
+		name:   "superblock paying more than the treasury budget",
+		height: superblock,
+		value:  blockValue(superblock) + budget + 1,
+		err:    RuleError{ErrorCode: ErrSuperblockBudgetExceeded},
+	}}
+
+	for _, test := range tests {
+		// Split the value over two outputs like the miner and the
+		// treasury payments.
+		coinbase := wire.NewMsgTx(wire.TxVersion)
+		coinbase.AddTxOut(wire.NewTxOut(test.value/2, nil))
+		coinbase.AddTxOut(wire.NewTxOut(test.value-test.value/2, nil))
+
+		err := checkCoinbaseValue(coinbase, test.height, prevBits, fees,
+			params)
+		if reflect.TypeOf(err) != reflect.TypeOf(test.err) {
+			t.Errorf("%s: wrong error type got: %v <%T>, want: %T",
+				test.name, err, err, test.err)
+			continue
+		}
+
+		if rerr, ok := err.(RuleError); ok {
+			trerr := test.err.(RuleError)
+			if rerr.ErrorCode != trerr.ErrorCode {
+				t.Errorf("%s: wrong error code got: %v, want: %v",
+					test.name, rerr.ErrorCode, trerr.ErrorCode)
+			}
+		}
+	}
+}
+
 // TestCheckSerializedHeight tests the checkSerializedHeight function with
 // various serialized heights and also does negative tests to ensure errors
 // and handled properly.
@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package blscrypto implements the BLS12-381 signatures used by Dash for
+masternode operator keys and long living masternode quorums (LLMQs).
+
+Dash uses the "minimal public key size" variant of BLS: secret keys are 32
+byte scalars, public keys are 48 byte compressed G1 points and signatures are
+96 byte compressed G2 points.  The package is written in pure Go on top of the
+github.com/kilic/bls12-381 curve implementation, so keys can be created and
+quorum signatures verified without calling a node.
+
+# Schemes
+
+Dash serializes points in one of two schemes.  The legacy scheme was used until
+the v19 hard fork and is still needed to parse old operator keys and quorum
+commitments.  The basic scheme follows the serialization of the IETF BLS
+signature draft and is used since.  Points are always held in memory in the
+same form and only differ when serialized, so the scheme is passed to the
+functions which serialize or parse them.
+
+Messages are hashed to the curve following the basic scheme of the IETF BLS
+signature draft with the BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_
+ciphersuite, which is what Dash signs with since the v19 hard fork.  The
+pre-v19 hash to the curve is not implemented, so signatures created with it
+can be parsed but don't verify.
+
+# Aggregation
+
+Signatures and public keys can be aggregated by adding the points.  An
+aggregated signature of the same message verifies against the aggregated
+public key of its signers.  Signatures of distinct messages are verified with
+VerifyAggregate.
+
+The members signature of a final quorum commitment is instead aggregated with
+AggregateSignaturesSecure, which multiplies every signature by a coefficient
+derived from the public keys of all signers so that a signer can't cancel the
+keys of the others.  It is checked with Signature.VerifySecureAggregated.
+
+# Threshold Signatures
+
+LLMQs use Shamir secret sharing: every member holds a share of the quorum
+secret key which is the evaluation of a secret polynomial at the member ID.
+Any threshold number of signature shares are combined into the quorum
+signature with RecoverSignature using Lagrange interpolation.  Member IDs are
+derived from the hash of the registration transaction of the masternode with
+NewIDFromHash.
+*/
+package blscrypto
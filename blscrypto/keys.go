@@ -0,0 +1,171 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blscrypto
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+var (
+	// ErrInvalidSecretKey describes an error where a secret key could not
+	// be parsed or is not a valid scalar.
+	ErrInvalidSecretKey = errors.New("invalid BLS secret key")
+
+	// ErrInvalidPublicKey describes an error where a public key could not
+	// be parsed or is the point at infinity.
+	ErrInvalidPublicKey = errors.New("invalid BLS public key")
+)
+
+// curveOrder is the order r of the G1 and G2 subgroups, which is the modulus
+// of secret keys.
+var curveOrder = bls12381.NewG1().Q()
+
+// SecretKey is a BLS secret key, which is a non-zero scalar modulo the order of
+// the curve subgroups.
+type SecretKey struct {
+	k *big.Int
+}
+
+// GenerateKey returns a new secret key read from the passed source of
+// randomness, which should be crypto/rand.Reader outside of tests.
+func GenerateKey(rand io.Reader) (*SecretKey, error) {
+	var b [SecretKeySize]byte
+	for {
+		if _, err := io.ReadFull(rand, b[:]); err != nil {
+			return nil, err
+		}
+
+		// Reducing the 256 bit value modulo the 255 bit order is
+		// slightly biased, which is irrelevant for keys.
+		k := new(big.Int).SetBytes(b[:])
+		k.Mod(k, curveOrder)
+		if k.Sign() != 0 {
+			return &SecretKey{k: k}, nil
+		}
+	}
+}
+
+// SecretKeyFromBytes parses a big endian serialized secret key.  The value must
+// be non-zero and lower than the order of the curve subgroups.  Secret keys are
+// serialized the same way in both schemes.
+func SecretKeyFromBytes(b []byte) (*SecretKey, error) {
+	if len(b) != SecretKeySize {
+		return nil, fmt.Errorf("%w: invalid length %d, want %d",
+			ErrInvalidSecretKey, len(b), SecretKeySize)
+	}
+	k := new(big.Int).SetBytes(b)
+	if k.Sign() == 0 || k.Cmp(curveOrder) >= 0 {
+		return nil, fmt.Errorf("%w: value out of range", ErrInvalidSecretKey)
+	}
+	return &SecretKey{k: k}, nil
+}
+
+// SecretKeyFromString parses a hex encoded secret key as returned by the bls
+// generate RPC.
+func SecretKeyFromString(s string) (*SecretKey, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSecretKey, err)
+	}
+	return SecretKeyFromBytes(b)
+}
+
+// Serialize returns the big endian serialization of the secret key.
+func (sk *SecretKey) Serialize() []byte {
+	b := make([]byte, SecretKeySize)
+	return sk.k.FillBytes(b)
+}
+
+// String returns the hex encoded serialization of the secret key.
+func (sk *SecretKey) String() string {
+	return hex.EncodeToString(sk.Serialize())
+}
+
+// PublicKey returns the public key of the secret key.
+func (sk *SecretKey) PublicKey() *PublicKey {
+	g := bls12381.NewG1()
+	p := g.MulScalarBig(g.New(), g.One(), sk.k)
+	return &PublicKey{p: p}
+}
+
+// IsEqual returns whether the secret keys are the same.
+func (sk *SecretKey) IsEqual(other *SecretKey) bool {
+	return sk.k.Cmp(other.k) == 0
+}
+
+// PublicKey is a BLS public key, which is a point of the G1 subgroup.
+type PublicKey struct {
+	p *bls12381.PointG1
+}
+
+// PublicKeyFromBytes parses a public key serialized in the passed scheme.  The
+// point at infinity is rejected since it verifies any signature of the point at
+// infinity.
+func PublicKeyFromBytes(b []byte, scheme Scheme) (*PublicKey, error) {
+	p, err := decodeG1(b, scheme)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+	if bls12381.NewG1().IsZero(p) {
+		return nil, fmt.Errorf("%w: point at infinity", ErrInvalidPublicKey)
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// PublicKeyFromString parses a hex encoded public key serialized in the passed
+// scheme.
+func PublicKeyFromString(s string, scheme Scheme) (*PublicKey, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+	return PublicKeyFromBytes(b, scheme)
+}
+
+// Serialize returns the serialization of the public key in the passed scheme.
+func (pk *PublicKey) Serialize(scheme Scheme) []byte {
+	return encodeG1(pk.p, scheme)
+}
+
+// String returns the hex encoded serialization of the public key in the basic
+// scheme.
+func (pk *PublicKey) String() string {
+	return hex.EncodeToString(pk.Serialize(SchemeBasic))
+}
+
+// IsEqual returns whether the public keys are the same.
+func (pk *PublicKey) IsEqual(other *PublicKey) bool {
+	return bls12381.NewG1().Equal(pk.p, other.p)
+}
+
+// AggregatePublicKeys returns the sum of the passed public keys.  A signature of
+// a message aggregated from the signatures of the same keys verifies against
+// the returned key.
+//
+// The keys must have been checked to be backed by their secret keys, which is
+// the case for registered operator keys and quorum members, since aggregating
+// keys of unknown origin permits rogue key attacks.
+func AggregatePublicKeys(pks []*PublicKey) (*PublicKey, error) {
+	if len(pks) == 0 {
+		return nil, fmt.Errorf("%w: no public keys to aggregate",
+			ErrInvalidPublicKey)
+	}
+	g := bls12381.NewG1()
+	p := g.New().Set(pks[0].p)
+	for _, pk := range pks[1:] {
+		g.Add(p, p, pk.p)
+	}
+	if g.IsZero(p) {
+		return nil, fmt.Errorf("%w: aggregated key is the point at "+
+			"infinity", ErrInvalidPublicKey)
+	}
+	return &PublicKey{p: p}, nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blscrypto
+
+import (
+	"fmt"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+// Scheme identifies how public keys and signatures are serialized.
+type Scheme int
+
+const (
+	// SchemeLegacy is the serialization used by Dash until the v19 hard
+	// fork.  The most significant bit of the first byte holds the sign of
+	// the y coordinate, G2 points start with the real part of the x
+	// coordinate and the point at infinity is all zeros.
+	SchemeLegacy Scheme = iota
+
+	// SchemeBasic is the serialization of the IETF BLS signature draft,
+	// which is the same as the one of the ZCash BLS12-381 library.  The
+	// three most significant bits of the first byte flag compression, the
+	// point at infinity and the sign of the y coordinate, and G2 points
+	// start with the imaginary part of the x coordinate.
+	SchemeBasic
+)
+
+// String returns the Scheme in human-readable form.
+func (s Scheme) String() string {
+	switch s {
+	case SchemeLegacy:
+		return "legacy"
+	case SchemeBasic:
+		return "basic"
+	}
+	return fmt.Sprintf("Unknown Scheme (%d)", int(s))
+}
+
+const (
+	// SecretKeySize is the size of a serialized secret key.
+	SecretKeySize = 32
+
+	// PublicKeySize is the size of a serialized public key.
+	PublicKeySize = 48
+
+	// SignatureSize is the size of a serialized signature.
+	SignatureSize = 96
+
+	// Flag bits of the first byte of a point in the basic scheme.
+	flagCompressed = 0x80
+	flagInfinity   = 0x40
+	flagSign       = 0x20
+
+	// flagLegacySign is the sign bit of the first byte of a point in the
+	// legacy scheme.
+	flagLegacySign = 0x80
+
+	// flagsMask selects the flag bits of the first byte of a point.
+	flagsMask = 0xe0
+)
+
+// fromLegacy converts the legacy serialization of a point with coordinates of
+// fpSize bytes each to the basic serialization.  The order of the fpSize
+// chunks of the x coordinate is reversed for G2 points.
+func fromLegacy(b []byte, fpSize int) ([]byte, error) {
+	out := make([]byte, len(b))
+	if isZero(b) {
+		out[0] = flagCompressed | flagInfinity
+		return out, nil
+	}
+	if b[0]&flagsMask&^flagLegacySign != 0 {
+		return nil, fmt.Errorf("invalid legacy flags %#x", b[0]&flagsMask)
+	}
+
+	reverseChunks(out, b, fpSize)
+	out[0] &^= flagsMask
+	out[0] |= flagCompressed
+	if b[0]&flagLegacySign != 0 {
+		out[0] |= flagSign
+	}
+	return out, nil
+}
+
+// toLegacy converts the basic serialization of a point with coordinates of
+// fpSize bytes each to the legacy serialization.
+func toLegacy(b []byte, fpSize int) []byte {
+	out := make([]byte, len(b))
+	if b[0]&flagInfinity != 0 {
+		return out
+	}
+
+	reverseChunks(out, b, fpSize)
+	out[0] &^= flagsMask
+	if b[0]&flagSign != 0 {
+		out[0] |= flagLegacySign
+	}
+	return out
+}
+
+// reverseChunks copies src to dst while reversing the order of its chunks of
+// size bytes.  The flags of the first chunk stay in the first byte of dst.
+func reverseChunks(dst, src []byte, size int) {
+	n := len(src) / size
+	for i := 0; i < n; i++ {
+		copy(dst[i*size:(i+1)*size], src[(n-1-i)*size:(n-i)*size])
+	}
+	if n > 1 {
+		dst[0] = dst[0]&^flagsMask | src[0]&flagsMask
+		dst[(n-1)*size] &^= flagsMask
+	}
+}
+
+// isZero returns whether all bytes of b are zero.
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeG1 returns the serialization of p in the passed scheme.  The point is
+// copied since converting it to affine coordinates modifies it, which would
+// race with concurrent readers.
+func encodeG1(p *bls12381.PointG1, scheme Scheme) []byte {
+	g := bls12381.NewG1()
+	b := g.ToCompressed(g.New().Set(p))
+	if scheme == SchemeLegacy {
+		return toLegacy(b, PublicKeySize)
+	}
+	return b
+}
+
+// decodeG1 parses a G1 point serialized in the passed scheme.  The point is
+// checked to be in the correct subgroup.
+func decodeG1(b []byte, scheme Scheme) (*bls12381.PointG1, error) {
+	if len(b) != PublicKeySize {
+		return nil, fmt.Errorf("invalid length %d, want %d", len(b),
+			PublicKeySize)
+	}
+	if scheme == SchemeLegacy {
+		var err error
+		if b, err = fromLegacy(b, PublicKeySize); err != nil {
+			return nil, err
+		}
+	}
+	return bls12381.NewG1().FromCompressed(b)
+}
+
+// encodeG2 returns the serialization of p in the passed scheme.  The point is
+// copied for the same reason as in encodeG1.
+func encodeG2(p *bls12381.PointG2, scheme Scheme) []byte {
+	g := bls12381.NewG2()
+	b := g.ToCompressed(g.New().Set(p))
+	if scheme == SchemeLegacy {
+		return toLegacy(b, SignatureSize/2)
+	}
+	return b
+}
+
+// decodeG2 parses a G2 point serialized in the passed scheme.  The point is
+// checked to be in the correct subgroup.
+func decodeG2(b []byte, scheme Scheme) (*bls12381.PointG2, error) {
+	if len(b) != SignatureSize {
+		return nil, fmt.Errorf("invalid length %d, want %d", len(b),
+			SignatureSize)
+	}
+	if scheme == SchemeLegacy {
+		var err error
+		if b, err = fromLegacy(b, SignatureSize/2); err != nil {
+			return nil, err
+		}
+	}
+	return bls12381.NewG2().FromCompressed(b)
+}
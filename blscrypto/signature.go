@@ -0,0 +1,252 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blscrypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+var (
+	// ErrInvalidSignature describes an error where a signature could not be
+	// parsed or does not verify.
+	ErrInvalidSignature = errors.New("invalid BLS signature")
+
+	// ErrDuplicateMessage describes an error where an aggregated signature
+	// is verified against the same message more than once, which is not
+	// supported since it permits rogue key attacks.
+	ErrDuplicateMessage = errors.New("duplicate message in aggregated " +
+		"signature")
+)
+
+// basicDST is the domain separation tag of the basic scheme of the IETF BLS
+// signature draft, which Dash signs with.
+var basicDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+
+// Signature is a BLS signature, which is a point of the G2 subgroup.
+type Signature struct {
+	p *bls12381.PointG2
+}
+
+// SignatureFromBytes parses a signature serialized in the passed scheme.
+func SignatureFromBytes(b []byte, scheme Scheme) (*Signature, error) {
+	p, err := decodeG2(b, scheme)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return &Signature{p: p}, nil
+}
+
+// SignatureFromString parses a hex encoded signature serialized in the passed
+// scheme.
+func SignatureFromString(s string, scheme Scheme) (*Signature, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return SignatureFromBytes(b, scheme)
+}
+
+// Serialize returns the serialization of the signature in the passed scheme.
+func (sig *Signature) Serialize(scheme Scheme) []byte {
+	return encodeG2(sig.p, scheme)
+}
+
+// String returns the hex encoded serialization of the signature in the basic
+// scheme.
+func (sig *Signature) String() string {
+	return hex.EncodeToString(sig.Serialize(SchemeBasic))
+}
+
+// IsEqual returns whether the signatures are the same.
+func (sig *Signature) IsEqual(other *Signature) bool {
+	return bls12381.NewG2().Equal(sig.p, other.p)
+}
+
+// hashToG2 hashes the message to a point of the G2 subgroup with the passed
+// domain separation tag.
+func hashToG2(msg, dst []byte) (*bls12381.PointG2, error) {
+	return bls12381.NewG2().HashToCurve(msg, dst)
+}
+
+// sign signs the message with the passed domain separation tag.
+func (sk *SecretKey) sign(msg, dst []byte) (*Signature, error) {
+	g := bls12381.NewG2()
+	p, err := hashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	g.MulScalarBig(p, p, sk.k)
+	return &Signature{p: p}, nil
+}
+
+// Sign returns the signature of the message.  Dash signs 32 byte hashes, such
+// as the sign hash of LLMQ signing sessions, but messages of any length are
+// supported.
+func (sk *SecretKey) Sign(msg []byte) (*Signature, error) {
+	return sk.sign(msg, basicDST)
+}
+
+// verify returns whether the signature of the message verifies against the
+// public key with the passed domain separation tag.
+func (sig *Signature) verify(pk *PublicKey, msg, dst []byte) bool {
+	if bls12381.NewG1().IsZero(pk.p) {
+		return false
+	}
+	h, err := hashToG2(msg, dst)
+	if err != nil {
+		return false
+	}
+
+	// e(pk, H(msg)) == e(g1, sig).  The engine converts the points to
+	// affine coordinates in place, so copies are passed.
+	e := bls12381.NewEngine()
+	e.AddPair(e.G1.New().Set(pk.p), h)
+	e.AddPairInv(e.G1.One(), e.G2.New().Set(sig.p))
+	return e.Check()
+}
+
+// Verify returns whether the signature of the message verifies against the
+// public key.
+func (sig *Signature) Verify(pk *PublicKey, msg []byte) bool {
+	return sig.verify(pk, msg, basicDST)
+}
+
+// AggregateSignatures returns the sum of the passed signatures.  The result
+// verifies against the aggregated public keys of the signers when they all
+// signed the same message, or with VerifyAggregate otherwise.
+func AggregateSignatures(sigs []*Signature) (*Signature, error) {
+	if len(sigs) == 0 {
+		return nil, fmt.Errorf("%w: no signatures to aggregate",
+			ErrInvalidSignature)
+	}
+	g := bls12381.NewG2()
+	p := g.New().Set(sigs[0].p)
+	for _, sig := range sigs[1:] {
+		g.Add(p, p, sig.p)
+	}
+	return &Signature{p: p}, nil
+}
+
+// VerifyAggregate verifies a signature aggregated from the signatures of
+// distinct messages, where msgs[i] was signed by the secret key of pks[i].
+func VerifyAggregate(sig *Signature, pks []*PublicKey, msgs [][]byte) error {
+	if len(pks) != len(msgs) || len(pks) == 0 {
+		return fmt.Errorf("%w: %d public keys for %d messages",
+			ErrInvalidSignature, len(pks), len(msgs))
+	}
+
+	seen := make(map[string]struct{}, len(msgs))
+	e := bls12381.NewEngine()
+	for i, msg := range msgs {
+		if _, ok := seen[string(msg)]; ok {
+			return fmt.Errorf("%w: message %x", ErrDuplicateMessage, msg)
+		}
+		seen[string(msg)] = struct{}{}
+
+		if e.G1.IsZero(pks[i].p) {
+			return fmt.Errorf("%w: public key %d is the point at "+
+				"infinity", ErrInvalidPublicKey, i)
+		}
+		h, err := hashToG2(msg, basicDST)
+		if err != nil {
+			return err
+		}
+		e.AddPair(e.G1.New().Set(pks[i].p), h)
+	}
+	e.AddPairInv(e.G1.One(), e.G2.New().Set(sig.p))
+	if !e.Check() {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// secureCoeffs returns the coefficients with which the public keys and the
+// signatures of their secret keys are multiplied by secure aggregation, which
+// protects against rogue key attacks.  The i-th coefficient belongs to the i-th
+// key of the keys sorted by their serialization in the passed scheme and is
+// the sha256 of i and of the sha256 of the sorted keys.  The order of the keys
+// is returned along with the coefficients.
+func secureCoeffs(pks []*PublicKey, scheme Scheme) ([]int, []*big.Int) {
+	serialized := make([][]byte, len(pks))
+	order := make([]int, len(pks))
+	for i, pk := range pks {
+		serialized[i] = pk.Serialize(scheme)
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return bytes.Compare(serialized[order[i]],
+			serialized[order[j]]) < 0
+	})
+
+	h := sha256.New()
+	for _, i := range order {
+		h.Write(serialized[i])
+	}
+	var buf [4 + sha256.Size]byte
+	h.Sum(buf[4:4])
+
+	coeffs := make([]*big.Int, len(pks))
+	for i := range coeffs {
+		binary.BigEndian.PutUint32(buf[:4], uint32(i))
+		t := sha256.Sum256(buf[:])
+		coeffs[i] = new(big.Int).SetBytes(t[:])
+		coeffs[i].Mod(coeffs[i], curveOrder)
+	}
+	return order, coeffs
+}
+
+// AggregateSignaturesSecure returns the secure aggregation of the signatures of
+// the same message, where sigs[i] was created by the secret key of pks[i].
+// This is how the members of an LLMQ aggregate their signatures of a final
+// quorum commitment.  The scheme is the one the keys are serialized in by the
+// commitment, since the coefficients depend on it.
+func AggregateSignaturesSecure(sigs []*Signature, pks []*PublicKey,
+	scheme Scheme) (*Signature, error) {
+
+	if len(sigs) != len(pks) || len(sigs) == 0 {
+		return nil, fmt.Errorf("%w: %d signatures for %d public keys",
+			ErrInvalidSignature, len(sigs), len(pks))
+	}
+
+	order, coeffs := secureCoeffs(pks, scheme)
+	g := bls12381.NewG2()
+	p := g.Zero()
+	term := g.New()
+	for i, j := range order {
+		g.MulScalarBig(term, sigs[j].p, coeffs[i])
+		g.Add(p, p, term)
+	}
+	return &Signature{p: p}, nil
+}
+
+// VerifySecureAggregated returns whether the signature is the secure
+// aggregation of the signatures of the message by the secret keys of the
+// passed public keys.  See AggregateSignaturesSecure.
+func (sig *Signature) VerifySecureAggregated(pks []*PublicKey, msg []byte,
+	scheme Scheme) bool {
+
+	if len(pks) == 0 {
+		return false
+	}
+
+	order, coeffs := secureCoeffs(pks, scheme)
+	g := bls12381.NewG1()
+	p := g.Zero()
+	term := g.New()
+	for i, j := range order {
+		g.MulScalarBig(term, pks[j].p, coeffs[i])
+		g.Add(p, p, term)
+	}
+	return sig.verify(&PublicKey{p: p}, msg, basicDST)
+}
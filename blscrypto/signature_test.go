@@ -0,0 +1,305 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blscrypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// hexToBytes converts the passed hex string into bytes and will panic if there
+// is an error.  This is only provided for the hard-coded constants so errors in
+// the source code can be detected.  It will only (and must only) be called with
+// hard-coded values.
+func hexToBytes(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic("invalid hex in source file: " + s)
+	}
+	return b
+}
+
+// testKeys returns n random secret keys.
+func testKeys(t *testing.T, n int) []*SecretKey {
+	t.Helper()
+	sks := make([]*SecretKey, n)
+	for i := range sks {
+		sk, err := GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatalf("GenerateKey: unexpected error: %v", err)
+		}
+		sks[i] = sk
+	}
+	return sks
+}
+
+// TestSignGolden ensures signatures match the test vectors of the Ethereum
+// consensus specification, which signs with the proof of possession scheme of
+// the IETF BLS signature draft.  The schemes only differ by the domain
+// separation tag, so this validates the hash to the curve and the
+// serialization of the basic scheme.
+func TestSignGolden(t *testing.T) {
+	popDST := []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	sk, err := SecretKeyFromString("263dbd792f5b1be47ed85f8938c0f29586af" +
+		"0d3ac7b977f21c278fe1462040e3")
+	if err != nil {
+		t.Fatalf("SecretKeyFromString: unexpected error: %v", err)
+	}
+	msg := make([]byte, 32)
+	want := hexToBytes("b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a38" +
+		"3940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c92" +
+		"74504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458" +
+		"c0cfc9ab380b55285a55")
+
+	sig, err := sk.sign(msg, popDST)
+	if err != nil {
+		t.Fatalf("sign: unexpected error: %v", err)
+	}
+	if got := sig.Serialize(SchemeBasic); !bytes.Equal(got, want) {
+		t.Fatalf("sign: got %x, want %x", got, want)
+	}
+	if !sig.verify(sk.PublicKey(), msg, popDST) {
+		t.Fatalf("verify: valid signature does not verify")
+	}
+}
+
+// TestSignVerify ensures signatures only verify against the signed message and
+// the public key of the signer.
+func TestSignVerify(t *testing.T) {
+	sks := testKeys(t, 2)
+	msg := []byte("message")
+
+	sig, err := sks[0].Sign(msg)
+	if err != nil {
+		t.Fatalf("Sign: unexpected error: %v", err)
+	}
+	if !sig.Verify(sks[0].PublicKey(), msg) {
+		t.Fatalf("Verify: valid signature does not verify")
+	}
+	if sig.Verify(sks[1].PublicKey(), msg) {
+		t.Fatalf("Verify: signature verifies against another key")
+	}
+	if sig.Verify(sks[0].PublicKey(), []byte("other message")) {
+		t.Fatalf("Verify: signature verifies against another message")
+	}
+}
+
+// TestSerialization ensures keys and signatures round trip through both
+// schemes and that the schemes only differ in the layout of the flags and
+// coordinates.
+func TestSerialization(t *testing.T) {
+	sk := testKeys(t, 1)[0]
+	pk := sk.PublicKey()
+	sig, err := sk.Sign([]byte("message"))
+	if err != nil {
+		t.Fatalf("Sign: unexpected error: %v", err)
+	}
+
+	sk2, err := SecretKeyFromBytes(sk.Serialize())
+	if err != nil || !sk2.IsEqual(sk) {
+		t.Fatalf("SecretKeyFromBytes: round trip failed (err %v)", err)
+	}
+
+	for _, scheme := range []Scheme{SchemeLegacy, SchemeBasic} {
+		b := pk.Serialize(scheme)
+		if len(b) != PublicKeySize {
+			t.Fatalf("%v: public key is %d bytes", scheme, len(b))
+		}
+		pk2, err := PublicKeyFromBytes(b, scheme)
+		if err != nil || !pk2.IsEqual(pk) {
+			t.Fatalf("%v: public key round trip failed (err %v)",
+				scheme, err)
+		}
+
+		b = sig.Serialize(scheme)
+		if len(b) != SignatureSize {
+			t.Fatalf("%v: signature is %d bytes", scheme, len(b))
+		}
+		sig2, err := SignatureFromBytes(b, scheme)
+		if err != nil || !sig2.IsEqual(sig) {
+			t.Fatalf("%v: signature round trip failed (err %v)",
+				scheme, err)
+		}
+	}
+
+	// Legacy points carry the sign in the top bit and no other flags.
+	basic, legacy := pk.Serialize(SchemeBasic), pk.Serialize(SchemeLegacy)
+	if basic[0]&flagSign != 0 != (legacy[0]&flagLegacySign != 0) {
+		t.Fatalf("legacy public key sign bit mismatch")
+	}
+	if !bytes.Equal(basic[1:], legacy[1:]) ||
+		basic[0]&^flagsMask != legacy[0]&^flagsMask {
+
+		t.Fatalf("legacy public key x coordinate mismatch")
+	}
+
+	// Legacy G2 points have the halves of the x coordinate swapped.
+	basic, legacy = sig.Serialize(SchemeBasic), sig.Serialize(SchemeLegacy)
+	half := SignatureSize / 2
+	swapped := append(append([]byte{}, legacy[half:]...), legacy[:half]...)
+	swapped[0] |= basic[0] & flagsMask
+	swapped[half] &^= flagsMask
+	if !bytes.Equal(swapped, basic) {
+		t.Fatalf("legacy signature halves are not swapped")
+	}
+
+	// The point at infinity is all zeros in the legacy scheme.
+	inf, err := SignatureFromBytes(make([]byte, SignatureSize), SchemeLegacy)
+	if err != nil {
+		t.Fatalf("SignatureFromBytes: unexpected error for infinity: %v",
+			err)
+	}
+	if b := inf.Serialize(SchemeBasic); b[0] != flagCompressed|flagInfinity {
+		t.Fatalf("infinity serialized as %x", b)
+	}
+	_, err = PublicKeyFromBytes(make([]byte, PublicKeySize), SchemeLegacy)
+	if !errors.Is(err, ErrInvalidPublicKey) {
+		t.Fatalf("PublicKeyFromBytes: got %v for infinity, want %v", err,
+			ErrInvalidPublicKey)
+	}
+}
+
+// TestSecretKeyFromBytesErrors ensures out of range secret keys are rejected.
+func TestSecretKeyFromBytesErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{"short", make([]byte, SecretKeySize-1)},
+		{"zero", make([]byte, SecretKeySize)},
+		{"order", curveOrder.FillBytes(make([]byte, SecretKeySize))},
+		{"max", bytes.Repeat([]byte{0xff}, SecretKeySize)},
+	}
+	for _, test := range tests {
+		_, err := SecretKeyFromBytes(test.b)
+		if !errors.Is(err, ErrInvalidSecretKey) {
+			t.Errorf("%s: got %v, want %v", test.name, err,
+				ErrInvalidSecretKey)
+		}
+	}
+}
+
+// TestAggregate ensures aggregated signatures of the same message verify
+// against the aggregated public keys and aggregated signatures of distinct
+// messages verify with VerifyAggregate.
+func TestAggregate(t *testing.T) {
+	sks := testKeys(t, 3)
+	pks := make([]*PublicKey, len(sks))
+	sigs := make([]*Signature, len(sks))
+	msgs := make([][]byte, len(sks))
+	msg := []byte("message")
+	for i, sk := range sks {
+		pks[i] = sk.PublicKey()
+		var err error
+		if sigs[i], err = sk.Sign(msg); err != nil {
+			t.Fatalf("Sign: unexpected error: %v", err)
+		}
+		msgs[i] = []byte{byte(i)}
+	}
+
+	aggSig, err := AggregateSignatures(sigs)
+	if err != nil {
+		t.Fatalf("AggregateSignatures: unexpected error: %v", err)
+	}
+	aggPk, err := AggregatePublicKeys(pks)
+	if err != nil {
+		t.Fatalf("AggregatePublicKeys: unexpected error: %v", err)
+	}
+	if !aggSig.Verify(aggPk, msg) {
+		t.Fatalf("Verify: aggregated signature does not verify")
+	}
+	if aggSig.Verify(aggPk, []byte("other message")) {
+		t.Fatalf("Verify: aggregated signature verifies against another " +
+			"message")
+	}
+
+	for i, sk := range sks {
+		if sigs[i], err = sk.Sign(msgs[i]); err != nil {
+			t.Fatalf("Sign: unexpected error: %v", err)
+		}
+	}
+	aggSig, err = AggregateSignatures(sigs)
+	if err != nil {
+		t.Fatalf("AggregateSignatures: unexpected error: %v", err)
+	}
+	if err := VerifyAggregate(aggSig, pks, msgs); err != nil {
+		t.Fatalf("VerifyAggregate: unexpected error: %v", err)
+	}
+	pks[0], pks[1] = pks[1], pks[0]
+	err = VerifyAggregate(aggSig, pks, msgs)
+	if !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("VerifyAggregate: got %v for swapped keys, want %v", err,
+			ErrInvalidSignature)
+	}
+	msgs[1] = msgs[0]
+	err = VerifyAggregate(aggSig, pks, msgs)
+	if !errors.Is(err, ErrDuplicateMessage) {
+		t.Fatalf("VerifyAggregate: got %v for duplicate messages, want %v",
+			err, ErrDuplicateMessage)
+	}
+}
+
+// TestAggregateSecure ensures securely aggregated signatures verify regardless
+// of the order of the signers, but not as plain aggregated signatures or
+// against a subset of the signers.
+func TestAggregateSecure(t *testing.T) {
+	sks := testKeys(t, 4)
+	pks := make([]*PublicKey, len(sks))
+	sigs := make([]*Signature, len(sks))
+	msg := []byte("commitment hash")
+	for i, sk := range sks {
+		pks[i] = sk.PublicKey()
+		var err error
+		if sigs[i], err = sk.Sign(msg); err != nil {
+			t.Fatalf("Sign: unexpected error: %v", err)
+		}
+	}
+
+	for _, scheme := range []Scheme{SchemeLegacy, SchemeBasic} {
+		aggSig, err := AggregateSignaturesSecure(sigs, pks, scheme)
+		if err != nil {
+			t.Fatalf("AggregateSignaturesSecure: unexpected error: %v",
+				err)
+		}
+		if !aggSig.VerifySecureAggregated(pks, msg, scheme) {
+			t.Fatalf("%v: secure aggregated signature does not "+
+				"verify", scheme)
+		}
+
+		// The order of the signers does not matter.
+		reversed := []*PublicKey{pks[3], pks[2], pks[1], pks[0]}
+		if !aggSig.VerifySecureAggregated(reversed, msg, scheme) {
+			t.Fatalf("%v: secure aggregated signature does not "+
+				"verify against reordered keys", scheme)
+		}
+
+		if aggSig.VerifySecureAggregated(pks[:3], msg, scheme) {
+			t.Fatalf("%v: secure aggregated signature verifies "+
+				"against a subset of the signers", scheme)
+		}
+		if aggSig.VerifySecureAggregated(pks, []byte("other"), scheme) {
+			t.Fatalf("%v: secure aggregated signature verifies "+
+				"against another message", scheme)
+		}
+		aggPk, err := AggregatePublicKeys(pks)
+		if err != nil {
+			t.Fatalf("AggregatePublicKeys: unexpected error: %v", err)
+		}
+		if aggSig.Verify(aggPk, msg) {
+			t.Fatalf("%v: secure aggregated signature verifies as "+
+				"a plain aggregated signature", scheme)
+		}
+	}
+
+	if _, err := AggregateSignaturesSecure(sigs, pks[:3],
+		SchemeBasic); !errors.Is(err, ErrInvalidSignature) {
+
+		t.Fatalf("AggregateSignaturesSecure: got %v for mismatched "+
+			"keys, want %v", err, ErrInvalidSignature)
+	}
+}
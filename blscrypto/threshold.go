@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blscrypto
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+// ErrInvalidShares describes an error where shares can't be combined, such as
+// when the same member ID is used twice.
+var ErrInvalidShares = errors.New("invalid threshold shares")
+
+// ID identifies a member of a threshold group.  It is the point at which the
+// secret polynomial of the group is evaluated to obtain the share of the
+// member.
+type ID struct {
+	x *big.Int
+}
+
+// NewIDFromHash returns the member ID derived from the passed hash, which is the
+// pro registration transaction hash for LLMQ members.  The bytes of the hash are
+// interpreted as a big endian number modulo the order of the curve subgroups.
+func NewIDFromHash(hash *chainhash.Hash) ID {
+	x := new(big.Int).SetBytes(hash[:])
+	return ID{x: x.Mod(x, curveOrder)}
+}
+
+// polyEval evaluates the polynomial with the passed coefficients at x modulo
+// the order of the curve subgroups using Horner's method.
+func polyEval(coeffs []*big.Int, x *big.Int) *big.Int {
+	res := new(big.Int)
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		res.Mul(res, x)
+		res.Add(res, coeffs[i])
+		res.Mod(res, curveOrder)
+	}
+	return res
+}
+
+// SecretKeyShare returns the secret key share of the member with the passed ID
+// of the polynomial whose coefficients are the passed secret keys.  The first
+// key is the secret key of the group and any len(msk) shares recover it.
+func SecretKeyShare(msk []*SecretKey, id ID) (*SecretKey, error) {
+	if len(msk) == 0 {
+		return nil, fmt.Errorf("%w: no coefficients", ErrInvalidShares)
+	}
+	if id.x.Sign() == 0 {
+		return nil, fmt.Errorf("%w: zero member ID", ErrInvalidShares)
+	}
+	coeffs := make([]*big.Int, len(msk))
+	for i, sk := range msk {
+		coeffs[i] = sk.k
+	}
+	k := polyEval(coeffs, id.x)
+	if k.Sign() == 0 {
+		return nil, fmt.Errorf("%w: zero secret key share", ErrInvalidShares)
+	}
+	return &SecretKey{k: k}, nil
+}
+
+// PublicKeyShare returns the public key share of the member with the passed ID
+// of the polynomial whose coefficients are the passed public keys, which are the
+// verification vector of the group.  It is the public key of the secret key
+// share of the member.
+func PublicKeyShare(mpk []*PublicKey, id ID) (*PublicKey, error) {
+	if len(mpk) == 0 {
+		return nil, fmt.Errorf("%w: no coefficients", ErrInvalidShares)
+	}
+	if id.x.Sign() == 0 {
+		return nil, fmt.Errorf("%w: zero member ID", ErrInvalidShares)
+	}
+	g := bls12381.NewG1()
+	p := g.New().Set(mpk[len(mpk)-1].p)
+	for i := len(mpk) - 2; i >= 0; i-- {
+		g.MulScalarBig(p, p, id.x)
+		g.Add(p, p, mpk[i].p)
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// lagrangeCoeffs returns the Lagrange coefficients which interpolate the value
+// at zero of a polynomial from its values at the passed IDs.
+func lagrangeCoeffs(ids []ID) ([]*big.Int, error) {
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if id.x.Sign() == 0 {
+			return nil, fmt.Errorf("%w: zero member ID", ErrInvalidShares)
+		}
+		key := string(id.x.Bytes())
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("%w: duplicate member ID %x",
+				ErrInvalidShares, id.x)
+		}
+		seen[key] = struct{}{}
+	}
+
+	// λ_i = Π_{j≠i} x_j / (x_j - x_i)
+	coeffs := make([]*big.Int, len(ids))
+	num := new(big.Int)
+	den := new(big.Int)
+	diff := new(big.Int)
+	for i := range ids {
+		num.SetInt64(1)
+		den.SetInt64(1)
+		for j := range ids {
+			if i == j {
+				continue
+			}
+			num.Mul(num, ids[j].x)
+			num.Mod(num, curveOrder)
+			diff.Sub(ids[j].x, ids[i].x)
+			den.Mul(den, diff)
+			den.Mod(den, curveOrder)
+		}
+		den.ModInverse(den, curveOrder)
+		coeffs[i] = new(big.Int).Mul(num, den)
+		coeffs[i].Mod(coeffs[i], curveOrder)
+	}
+	return coeffs, nil
+}
+
+// checkShares returns an error when the number of shares doesn't match the
+// number of IDs.
+func checkShares(numShares int, ids []ID) error {
+	if numShares == 0 {
+		return fmt.Errorf("%w: no shares", ErrInvalidShares)
+	}
+	if numShares != len(ids) {
+		return fmt.Errorf("%w: %d shares for %d member IDs",
+			ErrInvalidShares, numShares, len(ids))
+	}
+	return nil
+}
+
+// RecoverSecretKey recovers the secret key of the group from the secret key
+// shares of the members with the passed IDs.  The number of shares must be the
+// threshold of the group, any other number yields a wrong key.
+func RecoverSecretKey(sks []*SecretKey, ids []ID) (*SecretKey, error) {
+	if err := checkShares(len(sks), ids); err != nil {
+		return nil, err
+	}
+	coeffs, err := lagrangeCoeffs(ids)
+	if err != nil {
+		return nil, err
+	}
+	k := new(big.Int)
+	term := new(big.Int)
+	for i, sk := range sks {
+		term.Mul(sk.k, coeffs[i])
+		k.Add(k, term)
+	}
+	k.Mod(k, curveOrder)
+	if k.Sign() == 0 {
+		return nil, fmt.Errorf("%w: recovered zero secret key",
+			ErrInvalidShares)
+	}
+	return &SecretKey{k: k}, nil
+}
+
+// RecoverPublicKey recovers the public key of the group from the public key
+// shares of the members with the passed IDs.
+func RecoverPublicKey(pks []*PublicKey, ids []ID) (*PublicKey, error) {
+	if err := checkShares(len(pks), ids); err != nil {
+		return nil, err
+	}
+	coeffs, err := lagrangeCoeffs(ids)
+	if err != nil {
+		return nil, err
+	}
+	g := bls12381.NewG1()
+	p := g.Zero()
+	term := g.New()
+	for i, pk := range pks {
+		g.MulScalarBig(term, pk.p, coeffs[i])
+		g.Add(p, p, term)
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// RecoverSignature recovers the signature of the group from the signature
+// shares of the members with the passed IDs, which is how the signature of an
+// LLMQ is created from the signature shares of its members.
+func RecoverSignature(sigs []*Signature, ids []ID) (*Signature, error) {
+	if err := checkShares(len(sigs), ids); err != nil {
+		return nil, err
+	}
+	coeffs, err := lagrangeCoeffs(ids)
+	if err != nil {
+		return nil, err
+	}
+	g := bls12381.NewG2()
+	p := g.Zero()
+	term := g.New()
+	for i, sig := range sigs {
+		g.MulScalarBig(term, sig.p, coeffs[i])
+		g.Add(p, p, term)
+	}
+	return &Signature{p: p}, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blscrypto
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+// TestThresholdRecovery ensures any threshold number of shares recover the
+// secret key, public key and signature of the group.
+func TestThresholdRecovery(t *testing.T) {
+	const threshold, members = 3, 5
+
+	msk := testKeys(t, threshold)
+	mpk := make([]*PublicKey, threshold)
+	for i, sk := range msk {
+		mpk[i] = sk.PublicKey()
+	}
+	msg := chainhash.DoubleHashB([]byte("message"))
+	groupSig, err := msk[0].Sign(msg)
+	if err != nil {
+		t.Fatalf("Sign: unexpected error: %v", err)
+	}
+
+	ids := make([]ID, members)
+	skShares := make([]*SecretKey, members)
+	pkShares := make([]*PublicKey, members)
+	sigShares := make([]*Signature, members)
+	for i := range ids {
+		ids[i] = NewIDFromHash(&chainhash.Hash{byte(i + 1)})
+		skShares[i], err = SecretKeyShare(msk, ids[i])
+		if err != nil {
+			t.Fatalf("SecretKeyShare: unexpected error: %v", err)
+		}
+		pkShares[i], err = PublicKeyShare(mpk, ids[i])
+		if err != nil {
+			t.Fatalf("PublicKeyShare: unexpected error: %v", err)
+		}
+		if !pkShares[i].IsEqual(skShares[i].PublicKey()) {
+			t.Fatalf("PublicKeyShare: share %d does not match the "+
+				"secret key share", i)
+		}
+		sigShares[i], err = skShares[i].Sign(msg)
+		if err != nil {
+			t.Fatalf("Sign: unexpected error: %v", err)
+		}
+	}
+
+	// Every subset of threshold members recovers the group values.
+	subsets := [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}}
+	for _, subset := range subsets {
+		var subIDs []ID
+		var subSks []*SecretKey
+		var subPks []*PublicKey
+		var subSigs []*Signature
+		for _, i := range subset {
+			subIDs = append(subIDs, ids[i])
+			subSks = append(subSks, skShares[i])
+			subPks = append(subPks, pkShares[i])
+			subSigs = append(subSigs, sigShares[i])
+		}
+
+		sk, err := RecoverSecretKey(subSks, subIDs)
+		if err != nil || !sk.IsEqual(msk[0]) {
+			t.Fatalf("RecoverSecretKey %v: wrong key (err %v)", subset,
+				err)
+		}
+		pk, err := RecoverPublicKey(subPks, subIDs)
+		if err != nil || !pk.IsEqual(mpk[0]) {
+			t.Fatalf("RecoverPublicKey %v: wrong key (err %v)", subset,
+				err)
+		}
+		sig, err := RecoverSignature(subSigs, subIDs)
+		if err != nil || !sig.IsEqual(groupSig) {
+			t.Fatalf("RecoverSignature %v: wrong signature (err %v)",
+				subset, err)
+		}
+		if !sig.Verify(mpk[0], msg) {
+			t.Fatalf("RecoverSignature %v: signature does not verify",
+				subset)
+		}
+	}
+
+	// Less than threshold shares recover a wrong signature.
+	sig, err := RecoverSignature(sigShares[:threshold-1], ids[:threshold-1])
+	if err != nil {
+		t.Fatalf("RecoverSignature: unexpected error: %v", err)
+	}
+	if sig.Verify(mpk[0], msg) {
+		t.Fatalf("RecoverSignature: signature recovered below threshold")
+	}
+
+	// Duplicate IDs and mismatched lengths are rejected.
+	_, err = RecoverSignature(sigShares[:2], []ID{ids[0], ids[0]})
+	if !errors.Is(err, ErrInvalidShares) {
+		t.Fatalf("RecoverSignature: got %v for duplicate IDs, want %v",
+			err, ErrInvalidShares)
+	}
+	_, err = RecoverSignature(sigShares[:2], ids[:3])
+	if !errors.Is(err, ErrInvalidShares) {
+		t.Fatalf("RecoverSignature: got %v for mismatched lengths, want "+
+			"%v", err, ErrInvalidShares)
+	}
+	_, err = SecretKeyShare(msk, NewIDFromHash(&chainhash.Hash{}))
+	if !errors.Is(err, ErrInvalidShares) {
+		t.Fatalf("SecretKeyShare: got %v for zero ID, want %v", err,
+			ErrInvalidShares)
+	}
+}
@@ -135,6 +135,38 @@ func btcdMain(serverChan chan<- *server) error {
 
 		return nil
 	}
+	if cfg.DropAddressIndex {
+		if err := indexers.DropAddrDeltaIndex(db, interrupt); err != nil {
+			btcdLog.Errorf("%v", err)
+			return err
+		}
+
+		return nil
+	}
+	if cfg.DropSpecialTxIndex {
+		if err := indexers.DropSpecialTxIndex(db, interrupt); err != nil {
+			btcdLog.Errorf("%v", err)
+			return err
+		}
+
+		return nil
+	}
+	if cfg.DropSpentIndex {
+		if err := indexers.DropSpentIndex(db, interrupt); err != nil {
+			btcdLog.Errorf("%v", err)
+			return err
+		}
+
+		return nil
+	}
+	if cfg.DropTimestampIndex {
+		if err := indexers.DropTimestampIndex(db, interrupt); err != nil {
+			btcdLog.Errorf("%v", err)
+			return err
+		}
+
+		return nil
+	}
 	if cfg.DropCfIndex {
 		if err := indexers.DropCfIndex(db, interrupt); err != nil {
 			btcdLog.Errorf("%v", err)
@@ -144,6 +176,28 @@ func btcdMain(serverChan chan<- *server) error {
 		return nil
 	}
 
+	// Blocks which have been pruned can't be restored, so pruning can't be
+	// disabled afterwards.  Along with the checks of the configuration, this
+	// also keeps the indexes and the masternode list which require all
+	// blocks from being enabled.
+	var beenPruned bool
+	err = db.View(func(dbTx database.Tx) error {
+		var err error
+		beenPruned, err = dbTx.BeenPruned()
+		return err
+	})
+	if err != nil {
+		btcdLog.Errorf("%v", err)
+		return err
+	}
+	if beenPruned && cfg.Prune == 0 {
+		err := fmt.Errorf("--prune cannot be disabled as the node has "+
+			"already been pruned -- delete the block database in %q and "+
+			"sync from the beginning to disable pruning", cfg.DataDir)
+		btcdLog.Errorf("%v", err)
+		return err
+	}
+
 	// Create server and start it.
 	server, err := newServer(cfg.Listeners, cfg.AgentBlacklist,
 		cfg.AgentWhitelist, db, activeNetParams.Params, interrupt)
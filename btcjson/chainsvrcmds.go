@@ -122,6 +122,19 @@ func NewDeriveAddressesCmd(descriptor string, descriptorRange *DescriptorRange)
 	}
 }
 
+// DumpTxOutSetCmd defines the dumptxoutset JSON-RPC command.
+type DumpTxOutSetCmd struct {
+	Path string
+}
+
+// NewDumpTxOutSetCmd returns a new instance which can be used to issue a
+// dumptxoutset JSON-RPC command.
+func NewDumpTxOutSetCmd(path string) *DumpTxOutSetCmd {
+	return &DumpTxOutSetCmd{
+		Path: path,
+	}
+}
+
 // ChangeType defines the different output types to use for the change address
 // of a transaction built by the node.
 type ChangeType string
@@ -703,15 +716,38 @@ func NewGetTxOutProofCmd(txIDs []string, blockHash *string) *GetTxOutProofCmd {
 	}
 }
 
+// TxOutSetHashType defines the different commitments of the unspent
+// transaction output set available for the gettxoutsetinfo JSON-RPC command.
+type TxOutSetHashType string
+
+var (
+	TxOutSetHashSerialized TxOutSetHashType = "hash_serialized_2"
+	TxOutSetHashMuHash     TxOutSetHashType = "muhash"
+	TxOutSetHashNone       TxOutSetHashType = "none"
+)
+
 // GetTxOutSetInfoCmd defines the gettxoutsetinfo JSON-RPC command.
-type GetTxOutSetInfoCmd struct{}
+type GetTxOutSetInfoCmd struct {
+	HashType *TxOutSetHashType `jsonrpcdefault:"\"hash_serialized_2\""`
+}
 
 // NewGetTxOutSetInfoCmd returns a new instance which can be used to issue a
-// gettxoutsetinfo JSON-RPC command.
+// gettxoutsetinfo JSON-RPC command with the default hash type.
 func NewGetTxOutSetInfoCmd() *GetTxOutSetInfoCmd {
 	return &GetTxOutSetInfoCmd{}
 }
 
+// NewGetTxOutSetInfoCmdWithHashType returns a new instance which can be used
+// to issue a gettxoutsetinfo JSON-RPC command for the passed hash type.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetTxOutSetInfoCmdWithHashType(hashType *TxOutSetHashType) *GetTxOutSetInfoCmd {
+	return &GetTxOutSetInfoCmd{
+		HashType: hashType,
+	}
+}
+
 // GetWorkCmd defines the getwork JSON-RPC command.
 type GetWorkCmd struct {
 	Data *string
@@ -1051,6 +1087,7 @@ func init() {
 	MustRegisterCmd("decoderawtransaction", (*DecodeRawTransactionCmd)(nil), flags)
 	MustRegisterCmd("decodescript", (*DecodeScriptCmd)(nil), flags)
 	MustRegisterCmd("deriveaddresses", (*DeriveAddressesCmd)(nil), flags)
+	MustRegisterCmd("dumptxoutset", (*DumpTxOutSetCmd)(nil), flags)
 	MustRegisterCmd("fundrawtransaction", (*FundRawTransactionCmd)(nil), flags)
 	MustRegisterCmd("getaddednodeinfo", (*GetAddedNodeInfoCmd)(nil), flags)
 	MustRegisterCmd("getbestblockhash", (*GetBestBlockHashCmd)(nil), flags)
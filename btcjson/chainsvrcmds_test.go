@@ -266,6 +266,19 @@ func TestChainSvrCmds(t *testing.T) {
 				Range:      &btcjson.DescriptorRange{Value: []int{0, 2}},
 			},
 		},
+		{
+			name: "dumptxoutset",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("dumptxoutset", "utxo.dat")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewDumpTxOutSetCmd("utxo.dat")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"dumptxoutset","params":["utxo.dat"],"id":1}`,
+			unmarshalled: &btcjson.DumpTxOutSetCmd{
+				Path: "utxo.dat",
+			},
+		},
 		{
 			name: "getaddednodeinfo",
 			newCmd: func() (interface{}, error) {
@@ -958,8 +971,23 @@ func TestChainSvrCmds(t *testing.T) {
 			staticCmd: func() interface{} {
 				return btcjson.NewGetTxOutSetInfoCmd()
 			},
-			marshalled:   `{"jsonrpc":"1.0","method":"gettxoutsetinfo","params":[],"id":1}`,
-			unmarshalled: &btcjson.GetTxOutSetInfoCmd{},
+			marshalled: `{"jsonrpc":"1.0","method":"gettxoutsetinfo","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetTxOutSetInfoCmd{
+				HashType: &btcjson.TxOutSetHashSerialized,
+			},
+		},
+		{
+			name: "gettxoutsetinfo muhash",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gettxoutsetinfo", "muhash")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetTxOutSetInfoCmdWithHashType(&btcjson.TxOutSetHashMuHash)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gettxoutsetinfo","params":["muhash"],"id":1}`,
+			unmarshalled: &btcjson.GetTxOutSetInfoCmd{
+				HashType: &btcjson.TxOutSetHashMuHash,
+			},
 		},
 		{
 			name: "getwork",
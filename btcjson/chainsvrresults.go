@@ -91,6 +91,7 @@ type GetBlockVerboseResult struct {
 	PreviousHash  string        `json:"previousblockhash"`
 	NextHash      string        `json:"nextblockhash,omitempty"`
 	Chainlock     bool          `json:"chainlock"`
+	CbTx          *CbTxResult   `json:"cbTx,omitempty"`
 }
 
 // GetBlockVerboseTxResult models the data from the getblock command when the
@@ -118,6 +119,7 @@ type GetBlockVerboseTxResult struct {
 	PreviousHash  string        `json:"previousblockhash"`
 	NextHash      string        `json:"nextblockhash,omitempty"`
 	Chainlock     bool          `json:"chainlock"`
+	CbTx          *CbTxResult   `json:"cbTx,omitempty"`
 }
 
 // GetChainTxStatsResult models the data from the getchaintxstats command.
@@ -148,6 +150,16 @@ type DecodeScriptResult struct {
 	P2sh      string   `json:"p2sh,omitempty"`
 }
 
+// DumpTxOutSetResult models the data from the dumptxoutset command.
+type DumpTxOutSetResult struct {
+	CoinsWritten uint64 `json:"coins_written"`
+	BaseHash     string `json:"base_hash"`
+	BaseHeight   int32  `json:"base_height"`
+	Path         string `json:"path"`
+	TxOutSetHash string `json:"txoutset_hash"`
+	NChainTx     uint64 `json:"nchaintx"`
+}
+
 // GetAddedNodeInfoResultAddr models the data of the addresses portion of the
 // getaddednodeinfo command.
 type GetAddedNodeInfoResultAddr struct {
@@ -445,6 +457,9 @@ type GetTxOutResult struct {
 }
 
 // GetTxOutSetInfoResult models the data from the gettxoutsetinfo command.
+// Depending on the requested hash type, only one of HashSerialized and MuHash
+// is set.  Transactions and DiskSize are only set when the unspent transaction
+// output set was iterated to compute the hash_serialized_2 commitment.
 type GetTxOutSetInfoResult struct {
 	Height         int64          `json:"height"`
 	BestBlock      chainhash.Hash `json:"bestblock"`
@@ -452,10 +467,45 @@ type GetTxOutSetInfoResult struct {
 	TxOuts         int64          `json:"txouts"`
 	BogoSize       int64          `json:"bogosize"`
 	HashSerialized chainhash.Hash `json:"hash_serialized_2"`
+	MuHash         chainhash.Hash `json:"muhash"`
 	DiskSize       int64          `json:"disk_size"`
 	TotalAmount    btcutil.Amount `json:"total_amount"`
 }
 
+// MarshalJSON marshals the result of the gettxoutsetinfo JSON-RPC call with the
+// hashes as strings and the total amount in BTC.  The commitments which are not
+// set are omitted.
+func (g GetTxOutSetInfoResult) MarshalJSON() ([]byte, error) {
+	optionalHash := func(hash *chainhash.Hash) string {
+		if *hash == (chainhash.Hash{}) {
+			return ""
+		}
+		return hash.String()
+	}
+
+	return json.Marshal(&struct {
+		Height         int64   `json:"height"`
+		BestBlock      string  `json:"bestblock"`
+		Transactions   int64   `json:"transactions,omitempty"`
+		TxOuts         int64   `json:"txouts"`
+		BogoSize       int64   `json:"bogosize"`
+		HashSerialized string  `json:"hash_serialized_2,omitempty"`
+		MuHash         string  `json:"muhash,omitempty"`
+		DiskSize       int64   `json:"disk_size,omitempty"`
+		TotalAmount    float64 `json:"total_amount"`
+	}{
+		Height:         g.Height,
+		BestBlock:      g.BestBlock.String(),
+		Transactions:   g.Transactions,
+		TxOuts:         g.TxOuts,
+		BogoSize:       g.BogoSize,
+		HashSerialized: optionalHash(&g.HashSerialized),
+		MuHash:         optionalHash(&g.MuHash),
+		DiskSize:       g.DiskSize,
+		TotalAmount:    g.TotalAmount.ToBTC(),
+	})
+}
+
 // UnmarshalJSON unmarshals the result of the gettxoutsetinfo JSON-RPC call
 func (g *GetTxOutSetInfoResult) UnmarshalJSON(data []byte) error {
 	// Step 1: Create type aliases of the original struct.
@@ -466,6 +516,7 @@ func (g *GetTxOutSetInfoResult) UnmarshalJSON(data []byte) error {
 	aux := &struct {
 		BestBlock      string  `json:"bestblock"`
 		HashSerialized string  `json:"hash_serialized_2"`
+		MuHash         string  `json:"muhash"`
 		TotalAmount    float64 `json:"total_amount"`
 		*Alias
 	}{
@@ -492,6 +543,13 @@ func (g *GetTxOutSetInfoResult) UnmarshalJSON(data []byte) error {
 
 	g.HashSerialized = *serializedHash
 
+	muHash, err := chainhash.NewHashFromStr(aux.MuHash)
+	if err != nil {
+		return err
+	}
+
+	g.MuHash = *muHash
+
 	amount, err := btcutil.NewAmount(aux.TotalAmount)
 	if err != nil {
 		return err
@@ -727,6 +785,12 @@ type TxRawResult struct {
 	InstantLock         bool   `json:"instantlock,omitempty"`
 	InstantLockInternal bool   `json:"instantlock_internal,omitempty"`
 	ChainLock           bool   `json:"chainlock,omitempty"`
+
+	// The following fields are only set for special transactions.
+	Type             uint16      `json:"type,omitempty"`
+	ExtraPayloadSize int         `json:"extraPayloadSize,omitempty"`
+	ExtraPayload     string      `json:"extraPayload,omitempty"`
+	CbTx             *CbTxResult `json:"cbTx,omitempty"`
 }
 
 // SearchRawTransactionsResult models the data from the searchrawtransaction
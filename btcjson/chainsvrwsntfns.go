@@ -75,6 +75,15 @@ const (
 	// from the chain server that inform a client that a transaction that
 	// matches the loaded filter was accepted by the mempool.
 	RelevantTxAcceptedNtfnMethod = "relevanttxaccepted"
+
+	// ChainLockNtfnMethod is the method used for notifications from the
+	// chain server that a block has been locked by a ChainLock.
+	ChainLockNtfnMethod = "chainlock"
+
+	// TxInstantLockedNtfnMethod is the method used for notifications from
+	// the chain server that a transaction has been locked by an InstantSend
+	// lock.
+	TxInstantLockedNtfnMethod = "txinstantlocked"
 )
 
 // BlockConnectedNtfn defines the blockconnected JSON-RPC notification.
@@ -285,6 +294,38 @@ func NewRelevantTxAcceptedNtfn(txHex string) *RelevantTxAcceptedNtfn {
 	return &RelevantTxAcceptedNtfn{Transaction: txHex}
 }
 
+// ChainLockNtfn defines the chainlock JSON-RPC notification.
+type ChainLockNtfn struct {
+	Height    int32
+	Hash      string
+	Signature string
+}
+
+// NewChainLockNtfn returns a new instance which can be used to issue a
+// chainlock JSON-RPC notification.
+func NewChainLockNtfn(height int32, hash string, signature string) *ChainLockNtfn {
+	return &ChainLockNtfn{
+		Height:    height,
+		Hash:      hash,
+		Signature: signature,
+	}
+}
+
+// TxInstantLockedNtfn defines the txinstantlocked JSON-RPC notification.
+type TxInstantLockedNtfn struct {
+	TxID        string
+	InstantLock string
+}
+
+// NewTxInstantLockedNtfn returns a new instance which can be used to issue a
+// txinstantlocked JSON-RPC notification.
+func NewTxInstantLockedNtfn(txHash string, instantLock string) *TxInstantLockedNtfn {
+	return &TxInstantLockedNtfn{
+		TxID:        txHash,
+		InstantLock: instantLock,
+	}
+}
+
 func init() {
 	// The commands in this file are only usable by websockets and are
 	// notifications.
@@ -301,4 +342,6 @@ func init() {
 	MustRegisterCmd(TxAcceptedNtfnMethod, (*TxAcceptedNtfn)(nil), flags)
 	MustRegisterCmd(TxAcceptedVerboseNtfnMethod, (*TxAcceptedVerboseNtfn)(nil), flags)
 	MustRegisterCmd(RelevantTxAcceptedNtfnMethod, (*RelevantTxAcceptedNtfn)(nil), flags)
+	MustRegisterCmd(ChainLockNtfnMethod, (*ChainLockNtfn)(nil), flags)
+	MustRegisterCmd(TxInstantLockedNtfnMethod, (*TxInstantLockedNtfn)(nil), flags)
 }
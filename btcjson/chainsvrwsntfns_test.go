@@ -225,6 +225,35 @@ func TestChainSvrWsNtfns(t *testing.T) {
 				Transaction: "001122",
 			},
 		},
+		{
+			name: "chainlock",
+			newNtfn: func() (interface{}, error) {
+				return btcjson.NewCmd("chainlock", 100000, "123", "0a0b")
+			},
+			staticNtfn: func() interface{} {
+				return btcjson.NewChainLockNtfn(100000, "123", "0a0b")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"chainlock","params":[100000,"123","0a0b"],"id":null}`,
+			unmarshalled: &btcjson.ChainLockNtfn{
+				Height:    100000,
+				Hash:      "123",
+				Signature: "0a0b",
+			},
+		},
+		{
+			name: "txinstantlocked",
+			newNtfn: func() (interface{}, error) {
+				return btcjson.NewCmd("txinstantlocked", "123", "0a0b")
+			},
+			staticNtfn: func() interface{} {
+				return btcjson.NewTxInstantLockedNtfn("123", "0a0b")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"txinstantlocked","params":["123","0a0b"],"id":null}`,
+			unmarshalled: &btcjson.TxInstantLockedNtfn{
+				TxID:        "123",
+				InstantLock: "0a0b",
+			},
+		},
 	}
 
 	t.Logf("Running %d tests", len(tests))
@@ -0,0 +1,305 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// NOTE: This file is intended to house the Dash specific RPC commands that are
+// supported by a chain server, such as the ChainLock, InstantSend, spork and
+// address index commands.
+
+package btcjson
+
+func init() {
+	// No special flags for commands in this file.
+	flags := UsageFlag(0)
+
+	MustRegisterCmd("getbestchainlock", (*GetBestChainLockCmd)(nil), flags)
+	MustRegisterCmd("getislocks", (*GetISLocksCmd)(nil), flags)
+	MustRegisterCmd("spork", (*SporkCmd)(nil), flags)
+	MustRegisterCmd("mnsync", (*MnSyncCmd)(nil), flags)
+	MustRegisterCmd("getmerkleblocks", (*GetMerkleBlocksCmd)(nil), flags)
+	MustRegisterCmd("getspecialtxes", (*GetSpecialTxesCmd)(nil), flags)
+	MustRegisterCmd("searchspecialtxes", (*SearchSpecialTxesCmd)(nil), flags)
+	MustRegisterCmd("getblockhashes", (*GetBlockHashesCmd)(nil), flags)
+	MustRegisterCmd("getaddressbalance", (*GetAddressBalanceCmd)(nil), flags)
+	MustRegisterCmd("getaddressdeltas", (*GetAddressDeltasCmd)(nil), flags)
+	MustRegisterCmd("getaddressutxos", (*GetAddressUtxosCmd)(nil), flags)
+	MustRegisterCmd("getaddresstxids", (*GetAddressTxIDsCmd)(nil), flags)
+	MustRegisterCmd("getspentinfo", (*GetSpentInfoCmd)(nil), flags)
+}
+
+// GetBestChainLockCmd defines the getbestchainlock JSON-RPC command.
+type GetBestChainLockCmd struct{}
+
+// NewGetBestChainLockCmd returns a new instance which can be used to issue a
+// getbestchainlock JSON-RPC command.
+func NewGetBestChainLockCmd() *GetBestChainLockCmd {
+	return &GetBestChainLockCmd{}
+}
+
+// GetISLocksCmd defines the getislocks JSON-RPC command.
+type GetISLocksCmd struct {
+	TxIDs []string
+}
+
+// NewGetISLocksCmd returns a new instance which can be used to issue a
+// getislocks JSON-RPC command.
+func NewGetISLocksCmd(txIDs []string) *GetISLocksCmd {
+	return &GetISLocksCmd{
+		TxIDs: txIDs,
+	}
+}
+
+// SporkSubCmd defines the sub command used in the spork JSON-RPC command.
+type SporkSubCmd string
+
+// Spork subcommands
+const (
+	SporkShow   SporkSubCmd = "show"
+	SporkActive SporkSubCmd = "active"
+)
+
+// SporkCmd defines the spork JSON-RPC command.
+type SporkCmd struct {
+	SubCmd SporkSubCmd `jsonrpcusage:"\"show|active\""`
+}
+
+// NewSporkCmd returns a new instance which can be used to issue a spork
+// JSON-RPC command.
+func NewSporkCmd(sub SporkSubCmd) *SporkCmd {
+	return &SporkCmd{
+		SubCmd: sub,
+	}
+}
+
+// MnSyncSubCmd defines the sub command used in the mnsync JSON-RPC command.
+type MnSyncSubCmd string
+
+// MnSync subcommands
+const (
+	MnSyncStatus MnSyncSubCmd = "status"
+	MnSyncNext   MnSyncSubCmd = "next"
+	MnSyncReset  MnSyncSubCmd = "reset"
+)
+
+// MnSyncCmd defines the mnsync JSON-RPC command.
+type MnSyncCmd struct {
+	SubCmd MnSyncSubCmd `jsonrpcusage:"\"status|next|reset\""`
+}
+
+// NewMnSyncCmd returns a new instance which can be used to issue a mnsync
+// JSON-RPC command.
+func NewMnSyncCmd(sub MnSyncSubCmd) *MnSyncCmd {
+	return &MnSyncCmd{
+		SubCmd: sub,
+	}
+}
+
+// GetMerkleBlocksCmd defines the getmerkleblocks JSON-RPC command.
+type GetMerkleBlocksCmd struct {
+	Filter    string
+	BlockHash string
+	Count     *int `jsonrpcdefault:"2000"`
+}
+
+// NewGetMerkleBlocksCmd returns a new instance which can be used to issue a
+// getmerkleblocks JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetMerkleBlocksCmd(filter, blockHash string, count *int) *GetMerkleBlocksCmd {
+	return &GetMerkleBlocksCmd{
+		Filter:    filter,
+		BlockHash: blockHash,
+		Count:     count,
+	}
+}
+
+// GetSpecialTxesCmd defines the getspecialtxes JSON-RPC command.
+//
+// A Type of -1 selects special transactions of all types.  A Verbosity of 0
+// returns the transaction ids, 1 the hex-encoded transactions and 2 the
+// transactions as JSON objects.
+type GetSpecialTxesCmd struct {
+	BlockHash string
+	Type      *int `jsonrpcdefault:"-1"`
+	Count     *int `jsonrpcdefault:"10"`
+	Skip      *int `jsonrpcdefault:"0"`
+	Verbosity *int `jsonrpcdefault:"0"`
+}
+
+// NewGetSpecialTxesCmd returns a new instance which can be used to issue a
+// getspecialtxes JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetSpecialTxesCmd(blockHash string, txType, count, skip, verbosity *int) *GetSpecialTxesCmd {
+	return &GetSpecialTxesCmd{
+		BlockHash: blockHash,
+		Type:      txType,
+		Count:     count,
+		Skip:      skip,
+		Verbosity: verbosity,
+	}
+}
+
+// SearchSpecialTxesCmd defines the searchspecialtxes JSON-RPC command.  It is
+// an extension of dashd-go which searches the special transaction index.
+//
+// A Type of -1 selects special transactions of all types, which requires a
+// ProTxHash.  Start and End limit the heights of the blocks searched, where an
+// End of 0 means there is no upper bound.  A Verbosity of 0 returns the
+// transaction ids, 1 the hex-encoded transactions and 2 the transactions as
+// JSON objects.
+type SearchSpecialTxesCmd struct {
+	Type      *int `jsonrpcdefault:"-1"`
+	ProTxHash *string
+	Start     *int `jsonrpcdefault:"0"`
+	End       *int `jsonrpcdefault:"0"`
+	Count     *int `jsonrpcdefault:"100"`
+	Skip      *int `jsonrpcdefault:"0"`
+	Verbosity *int `jsonrpcdefault:"0"`
+}
+
+// NewSearchSpecialTxesCmd returns a new instance which can be used to issue a
+// searchspecialtxes JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSearchSpecialTxesCmd(txType *int, proTxHash *string, start, end, count, skip, verbosity *int) *SearchSpecialTxesCmd {
+	return &SearchSpecialTxesCmd{
+		Type:      txType,
+		ProTxHash: proTxHash,
+		Start:     start,
+		End:       end,
+		Count:     count,
+		Skip:      skip,
+		Verbosity: verbosity,
+	}
+}
+
+// GetBlockHashesOptions are the options of the getblockhashes JSON-RPC
+// command.
+type GetBlockHashesOptions struct {
+	NoOrphans    *bool `json:"noOrphans,omitempty"`
+	LogicalTimes *bool `json:"logicalTimes,omitempty"`
+}
+
+// GetBlockHashesCmd defines the getblockhashes JSON-RPC command, which returns
+// the hashes of the blocks with a timestamp in the range [Low, High).
+type GetBlockHashesCmd struct {
+	High    int64
+	Low     int64
+	Options *GetBlockHashesOptions
+}
+
+// NewGetBlockHashesCmd returns a new instance which can be used to issue a
+// getblockhashes JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetBlockHashesCmd(high, low int64, options *GetBlockHashesOptions) *GetBlockHashesCmd {
+	return &GetBlockHashesCmd{
+		High:    high,
+		Low:     low,
+		Options: options,
+	}
+}
+
+// AddressesParam is the argument of the address index JSON-RPC commands which
+// only take a list of addresses.
+type AddressesParam struct {
+	Addresses []string `json:"addresses"`
+}
+
+// AddressRangeParam is the argument of the address index JSON-RPC commands
+// which take a list of addresses and an optional range of block heights.
+type AddressRangeParam struct {
+	Addresses []string `json:"addresses"`
+	Start     int32    `json:"start,omitempty"`
+	End       int32    `json:"end,omitempty"`
+}
+
+// GetAddressBalanceCmd defines the getaddressbalance JSON-RPC command.
+type GetAddressBalanceCmd struct {
+	Addresses AddressesParam
+}
+
+// NewGetAddressBalanceCmd returns a new instance which can be used to issue a
+// getaddressbalance JSON-RPC command.
+func NewGetAddressBalanceCmd(addresses []string) *GetAddressBalanceCmd {
+	return &GetAddressBalanceCmd{
+		Addresses: AddressesParam{Addresses: addresses},
+	}
+}
+
+// GetAddressDeltasCmd defines the getaddressdeltas JSON-RPC command.
+type GetAddressDeltasCmd struct {
+	Addresses AddressRangeParam
+}
+
+// NewGetAddressDeltasCmd returns a new instance which can be used to issue a
+// getaddressdeltas JSON-RPC command.  The range of block heights is ignored
+// when start or end is zero.
+func NewGetAddressDeltasCmd(addresses []string, start, end int32) *GetAddressDeltasCmd {
+	return &GetAddressDeltasCmd{
+		Addresses: AddressRangeParam{
+			Addresses: addresses,
+			Start:     start,
+			End:       end,
+		},
+	}
+}
+
+// GetAddressUtxosCmd defines the getaddressutxos JSON-RPC command.
+type GetAddressUtxosCmd struct {
+	Addresses AddressesParam
+}
+
+// NewGetAddressUtxosCmd returns a new instance which can be used to issue a
+// getaddressutxos JSON-RPC command.
+func NewGetAddressUtxosCmd(addresses []string) *GetAddressUtxosCmd {
+	return &GetAddressUtxosCmd{
+		Addresses: AddressesParam{Addresses: addresses},
+	}
+}
+
+// GetAddressTxIDsCmd defines the getaddresstxids JSON-RPC command.
+type GetAddressTxIDsCmd struct {
+	Addresses AddressRangeParam
+}
+
+// NewGetAddressTxIDsCmd returns a new instance which can be used to issue a
+// getaddresstxids JSON-RPC command.  The range of block heights is ignored
+// when start or end is zero.
+func NewGetAddressTxIDsCmd(addresses []string, start, end int32) *GetAddressTxIDsCmd {
+	return &GetAddressTxIDsCmd{
+		Addresses: AddressRangeParam{
+			Addresses: addresses,
+			Start:     start,
+			End:       end,
+		},
+	}
+}
+
+// SpentInfoParam is the argument of the getspentinfo JSON-RPC command, which
+// identifies an output.
+type SpentInfoParam struct {
+	TxID  string `json:"txid"`
+	Index uint32 `json:"index"`
+}
+
+// GetSpentInfoCmd defines the getspentinfo JSON-RPC command.
+type GetSpentInfoCmd struct {
+	Outpoint SpentInfoParam
+}
+
+// NewGetSpentInfoCmd returns a new instance which can be used to issue a
+// getspentinfo JSON-RPC command.
+func NewGetSpentInfoCmd(txID string, index uint32) *GetSpentInfoCmd {
+	return &GetSpentInfoCmd{
+		Outpoint: SpentInfoParam{
+			TxID:  txID,
+			Index: index,
+		},
+	}
+}
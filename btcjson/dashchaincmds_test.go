@@ -0,0 +1,378 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package btcjson_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/btcjson"
+)
+
+// TestDashChainCmds tests all of the dash chain commands marshal and unmarshal
+// into valid results include handling of optional fields being omitted in the
+// marshalled command.
+func TestDashChainCmds(t *testing.T) {
+	t.Parallel()
+
+	const (
+		blockHash = "000000000000001c172f518793c3b9e83f202284615592f87fe3506ce964dcd4"
+		txID      = "d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357"
+		address   = "XwnLY9Tf7Zsef8gMGL2fhWA9ZmMjt4KPwg"
+	)
+
+	testID := 1
+	tests := []struct {
+		name         string
+		newCmd       func() (interface{}, error)
+		staticCmd    func() interface{}
+		marshalled   string
+		unmarshalled interface{}
+	}{
+		{
+			name: "getbestchainlock",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getbestchainlock")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetBestChainLockCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getbestchainlock","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetBestChainLockCmd{},
+		},
+		{
+			name: "getislocks",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getislocks", []string{txID})
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetISLocksCmd([]string{txID})
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getislocks","params":[["` + txID + `"]],"id":1}`,
+			unmarshalled: &btcjson.GetISLocksCmd{TxIDs: []string{txID}},
+		},
+		{
+			name: "spork show",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("spork", "show")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSporkCmd(btcjson.SporkShow)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"spork","params":["show"],"id":1}`,
+			unmarshalled: &btcjson.SporkCmd{SubCmd: btcjson.SporkShow},
+		},
+		{
+			name: "mnsync status",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("mnsync", "status")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewMnSyncCmd(btcjson.MnSyncStatus)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"mnsync","params":["status"],"id":1}`,
+			unmarshalled: &btcjson.MnSyncCmd{SubCmd: btcjson.MnSyncStatus},
+		},
+		{
+			name: "getmerkleblocks",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getmerkleblocks", "00", blockHash)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetMerkleBlocksCmd("00", blockHash, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmerkleblocks","params":["00","` + blockHash + `"],"id":1}`,
+			unmarshalled: &btcjson.GetMerkleBlocksCmd{
+				Filter:    "00",
+				BlockHash: blockHash,
+				Count:     btcjson.Int(2000),
+			},
+		},
+		{
+			name: "getmerkleblocks optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getmerkleblocks", "00", blockHash, 5)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetMerkleBlocksCmd("00", blockHash, btcjson.Int(5))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmerkleblocks","params":["00","` + blockHash + `",5],"id":1}`,
+			unmarshalled: &btcjson.GetMerkleBlocksCmd{
+				Filter:    "00",
+				BlockHash: blockHash,
+				Count:     btcjson.Int(5),
+			},
+		},
+		{
+			name: "getspecialtxes",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getspecialtxes", blockHash)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetSpecialTxesCmd(blockHash, nil, nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getspecialtxes","params":["` + blockHash + `"],"id":1}`,
+			unmarshalled: &btcjson.GetSpecialTxesCmd{
+				BlockHash: blockHash,
+				Type:      btcjson.Int(-1),
+				Count:     btcjson.Int(10),
+				Skip:      btcjson.Int(0),
+				Verbosity: btcjson.Int(0),
+			},
+		},
+		{
+			name: "getspecialtxes optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getspecialtxes", blockHash, 5, 20, 1, 2)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetSpecialTxesCmd(blockHash, btcjson.Int(5),
+					btcjson.Int(20), btcjson.Int(1), btcjson.Int(2))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getspecialtxes","params":["` + blockHash + `",5,20,1,2],"id":1}`,
+			unmarshalled: &btcjson.GetSpecialTxesCmd{
+				BlockHash: blockHash,
+				Type:      btcjson.Int(5),
+				Count:     btcjson.Int(20),
+				Skip:      btcjson.Int(1),
+				Verbosity: btcjson.Int(2),
+			},
+		},
+		{
+			name: "searchspecialtxes",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("searchspecialtxes")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSearchSpecialTxesCmd(nil, nil, nil,
+					nil, nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"searchspecialtxes","params":[],"id":1}`,
+			unmarshalled: &btcjson.SearchSpecialTxesCmd{
+				Type:      btcjson.Int(-1),
+				Start:     btcjson.Int(0),
+				End:       btcjson.Int(0),
+				Count:     btcjson.Int(100),
+				Skip:      btcjson.Int(0),
+				Verbosity: btcjson.Int(0),
+			},
+		},
+		{
+			name: "searchspecialtxes optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("searchspecialtxes", 1, blockHash,
+					1000, 2000, 20, 1, 2)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSearchSpecialTxesCmd(btcjson.Int(1),
+					btcjson.String(blockHash), btcjson.Int(1000),
+					btcjson.Int(2000), btcjson.Int(20), btcjson.Int(1),
+					btcjson.Int(2))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"searchspecialtxes","params":[1,"` + blockHash + `",1000,2000,20,1,2],"id":1}`,
+			unmarshalled: &btcjson.SearchSpecialTxesCmd{
+				Type:      btcjson.Int(1),
+				ProTxHash: btcjson.String(blockHash),
+				Start:     btcjson.Int(1000),
+				End:       btcjson.Int(2000),
+				Count:     btcjson.Int(20),
+				Skip:      btcjson.Int(1),
+				Verbosity: btcjson.Int(2),
+			},
+		},
+		{
+			name: "getblockhashes",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getblockhashes", 1633024800, 1633021200)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetBlockHashesCmd(1633024800, 1633021200, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getblockhashes","params":[1633024800,1633021200],"id":1}`,
+			unmarshalled: &btcjson.GetBlockHashesCmd{
+				High: 1633024800,
+				Low:  1633021200,
+			},
+		},
+		{
+			name: "getblockhashes optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getblockhashes", 1633024800, 1633021200,
+					`{"noOrphans":true,"logicalTimes":true}`)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetBlockHashesCmd(1633024800, 1633021200,
+					&btcjson.GetBlockHashesOptions{
+						NoOrphans:    btcjson.Bool(true),
+						LogicalTimes: btcjson.Bool(true),
+					})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getblockhashes","params":[1633024800,1633021200,{"noOrphans":true,"logicalTimes":true}],"id":1}`,
+			unmarshalled: &btcjson.GetBlockHashesCmd{
+				High: 1633024800,
+				Low:  1633021200,
+				Options: &btcjson.GetBlockHashesOptions{
+					NoOrphans:    btcjson.Bool(true),
+					LogicalTimes: btcjson.Bool(true),
+				},
+			},
+		},
+		{
+			name: "getaddressbalance",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddressbalance", `{"addresses":["`+address+`"]}`)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetAddressBalanceCmd([]string{address})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressbalance","params":[{"addresses":["` + address + `"]}],"id":1}`,
+			unmarshalled: &btcjson.GetAddressBalanceCmd{
+				Addresses: btcjson.AddressesParam{Addresses: []string{address}},
+			},
+		},
+		{
+			name: "getaddressdeltas",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddressdeltas", `{"addresses":["`+address+`"]}`)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetAddressDeltasCmd([]string{address}, 0, 0)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressdeltas","params":[{"addresses":["` + address + `"]}],"id":1}`,
+			unmarshalled: &btcjson.GetAddressDeltasCmd{
+				Addresses: btcjson.AddressRangeParam{Addresses: []string{address}},
+			},
+		},
+		{
+			name: "getaddressdeltas range",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddressdeltas", `{"addresses":["`+address+`"],"start":100,"end":200}`)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetAddressDeltasCmd([]string{address}, 100, 200)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressdeltas","params":[{"addresses":["` + address + `"],"start":100,"end":200}],"id":1}`,
+			unmarshalled: &btcjson.GetAddressDeltasCmd{
+				Addresses: btcjson.AddressRangeParam{
+					Addresses: []string{address},
+					Start:     100,
+					End:       200,
+				},
+			},
+		},
+		{
+			name: "getaddressutxos",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddressutxos", `{"addresses":["`+address+`"]}`)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetAddressUtxosCmd([]string{address})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressutxos","params":[{"addresses":["` + address + `"]}],"id":1}`,
+			unmarshalled: &btcjson.GetAddressUtxosCmd{
+				Addresses: btcjson.AddressesParam{Addresses: []string{address}},
+			},
+		},
+		{
+			name: "getaddresstxids",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddresstxids", `{"addresses":["`+address+`"],"start":100}`)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetAddressTxIDsCmd([]string{address}, 100, 0)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddresstxids","params":[{"addresses":["` + address + `"],"start":100}],"id":1}`,
+			unmarshalled: &btcjson.GetAddressTxIDsCmd{
+				Addresses: btcjson.AddressRangeParam{
+					Addresses: []string{address},
+					Start:     100,
+				},
+			},
+		},
+		{
+			name: "getspentinfo",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getspentinfo", `{"txid":"`+txID+`","index":1}`)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetSpentInfoCmd(txID, 1)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getspentinfo","params":[{"txid":"` + txID + `","index":1}],"id":1}`,
+			unmarshalled: &btcjson.GetSpentInfoCmd{
+				Outpoint: btcjson.SpentInfoParam{TxID: txID, Index: 1},
+			},
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		// Marshal the command as created by the new static command
+		// creation function.
+		marshalled, err := btcjson.MarshalCmd(btcjson.RpcVersion1, testID, test.staticCmd())
+		if err != nil {
+			t.Errorf("MarshalCmd #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+
+		if !bytes.Equal(marshalled, []byte(test.marshalled)) {
+			t.Errorf("Test #%d (%s) unexpected marshalled data - "+
+				"got %s, want %s", i, test.name, marshalled,
+				test.marshalled)
+			continue
+		}
+
+		// Ensure the command is created without error via the generic
+		// new command creation function.
+		cmd, err := test.newCmd()
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected NewCmd error: %v ",
+				i, test.name, err)
+			continue
+		}
+
+		// Marshal the command as created by the generic new command
+		// creation function.
+		marshalled, err = btcjson.MarshalCmd(btcjson.RpcVersion1, testID, cmd)
+		if err != nil {
+			t.Errorf("MarshalCmd #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+
+		if !bytes.Equal(marshalled, []byte(test.marshalled)) {
+			t.Errorf("Test #%d (%s) unexpected marshalled data - "+
+				"got %s, want %s", i, test.name, marshalled,
+				test.marshalled)
+			continue
+		}
+
+		var request btcjson.Request
+		if err := json.Unmarshal(marshalled, &request); err != nil {
+			t.Errorf("Test #%d (%s) unexpected error while "+
+				"unmarshalling JSON-RPC request: %v", i,
+				test.name, err)
+			continue
+		}
+
+		cmd, err = btcjson.UnmarshalCmd(&request)
+		if err != nil {
+			t.Errorf("UnmarshalCmd #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(cmd, test.unmarshalled) {
+			t.Errorf("Test #%d (%s) unexpected unmarshalled command "+
+				"- got %s, want %s", i, test.name,
+				fmt.Sprintf("(%T) %+[1]v", cmd),
+				fmt.Sprintf("(%T) %+[1]v\n", test.unmarshalled))
+			continue
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package btcjson
+
+// CbTxResult models the payload of a coinbase special transaction as returned
+// in the cbTx field of the getblock and getrawtransaction commands.  The fields
+// after MerkleRootMNList depend on the payload version.
+type CbTxResult struct {
+	Version           uint16   `json:"version"`
+	Height            int32    `json:"height"`
+	MerkleRootMNList  string   `json:"merkleRootMNList"`
+	MerkleRootQuorums string   `json:"merkleRootQuorums,omitempty"`
+	BestCLHeightDiff  *uint32  `json:"bestCLHeightDiff,omitempty"`
+	BestCLSignature   string   `json:"bestCLSignature,omitempty"`
+	CreditPoolBalance *float64 `json:"creditPoolBalance,omitempty"`
+}
+
+// GetBestChainLockResult models the data from the getbestchainlock command.
+type GetBestChainLockResult struct {
+	BlockHash  string `json:"blockhash"`
+	Height     int32  `json:"height"`
+	Signature  string `json:"signature"`
+	KnownBlock bool   `json:"known_block"`
+}
+
+// ISLockInput is an outpoint locked by an InstantSend lock.
+type ISLockInput struct {
+	TxID string `json:"txid"`
+	Vout uint32 `json:"vout"`
+}
+
+// ISLockResult models an InstantSend lock returned by the getislocks command.
+// The command returns the string "None" instead for transactions without a
+// known lock.
+type ISLockResult struct {
+	TxID      string        `json:"txid"`
+	Inputs    []ISLockInput `json:"inputs"`
+	CycleHash string        `json:"cycleHash,omitempty"`
+	Signature string        `json:"signature"`
+	Hex       string        `json:"hex,omitempty"`
+}
+
+// MnSyncStatusResult models the data from the mnsync status command.
+type MnSyncStatusResult struct {
+	AssetID            int    `json:"AssetID"`
+	AssetName          string `json:"AssetName"`
+	AssetStartTime     int64  `json:"AssetStartTime"`
+	Attempt            int    `json:"Attempt"`
+	IsBlockchainSynced bool   `json:"IsBlockchainSynced"`
+	IsSynced           bool   `json:"IsSynced"`
+}
+
+// BlockHashLogicalTime models an element of the getblockhashes result when
+// the logicalTimes option is set.
+type BlockHashLogicalTime struct {
+	BlockHash string `json:"blockhash"`
+	LogicalTS int64  `json:"logicalts"`
+}
+
+// GetAddressBalanceResult models the data from the getaddressbalance command.
+// All amounts are in duffs.
+type GetAddressBalanceResult struct {
+	Balance          int64 `json:"balance"`
+	BalanceImmature  int64 `json:"balance_immature"`
+	BalanceSpendable int64 `json:"balance_spendable"`
+	Received         int64 `json:"received"`
+}
+
+// AddressDelta models an element of the getaddressdeltas result, which is a
+// change of the balance of an address by an input or output.
+type AddressDelta struct {
+	Satoshis   int64  `json:"satoshis"`
+	TxID       string `json:"txid"`
+	Index      uint32 `json:"index"`
+	BlockIndex uint32 `json:"blockindex"`
+	Height     int32  `json:"height"`
+	Address    string `json:"address"`
+}
+
+// AddressUtxo models an element of the getaddressutxos result.
+type AddressUtxo struct {
+	Address     string `json:"address"`
+	TxID        string `json:"txid"`
+	OutputIndex uint32 `json:"outputIndex"`
+	Script      string `json:"script"`
+	Satoshis    int64  `json:"satoshis"`
+	Height      int32  `json:"height"`
+}
+
+// GetSpentInfoResult models the data from the getspentinfo command, which is
+// the input spending an output.
+type GetSpentInfoResult struct {
+	TxID   string `json:"txid"`
+	Index  uint32 `json:"index"`
+	Height int32  `json:"height"`
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package btcjson_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/btcjson"
+)
+
+// TestDashChainResults ensures the dash chain results are unmarshalled from
+// the output of Dash Core.
+func TestDashChainResults(t *testing.T) {
+	t.Parallel()
+
+	diff := uint32(0)
+	balance := 1234.5
+	tests := []struct {
+		name   string
+		json   string
+		result interface{}
+		want   interface{}
+	}{
+		{
+			name:   "cbTx version 3",
+			json:   `{"version":3,"height":1950000,"merkleRootMNList":"3b7ab0bd8d8ba0a2b3d9bfc4e4b0e46d6fa6ad6e7f2de04c1b0d8b4dbf1c2d1e","merkleRootQuorums":"8d2b0b4fa7a3c0d0b5c5a0c99f2e0c56a9b1e4a8fd2b1ca6ce1f5a1b3e2c4d5f","bestCLHeightDiff":0,"bestCLSignature":"00","creditPoolBalance":1234.50000000}`,
+			result: &btcjson.CbTxResult{},
+			want: &btcjson.CbTxResult{
+				Version:           3,
+				Height:            1950000,
+				MerkleRootMNList:  "3b7ab0bd8d8ba0a2b3d9bfc4e4b0e46d6fa6ad6e7f2de04c1b0d8b4dbf1c2d1e",
+				MerkleRootQuorums: "8d2b0b4fa7a3c0d0b5c5a0c99f2e0c56a9b1e4a8fd2b1ca6ce1f5a1b3e2c4d5f",
+				BestCLHeightDiff:  &diff,
+				BestCLSignature:   "00",
+				CreditPoolBalance: &balance,
+			},
+		},
+		{
+			name:   "getbestchainlock",
+			json:   `{"blockhash":"000000000000001c172f518793c3b9e83f202284615592f87fe3506ce964dcd4","height":1950000,"signature":"00","known_block":true}`,
+			result: &btcjson.GetBestChainLockResult{},
+			want: &btcjson.GetBestChainLockResult{
+				BlockHash:  "000000000000001c172f518793c3b9e83f202284615592f87fe3506ce964dcd4",
+				Height:     1950000,
+				Signature:  "00",
+				KnownBlock: true,
+			},
+		},
+		{
+			name:   "getislocks",
+			json:   `{"txid":"d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357","inputs":[{"txid":"4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39","vout":1}],"cycleHash":"000000000000001c172f518793c3b9e83f202284615592f87fe3506ce964dcd4","signature":"00","hex":"0100"}`,
+			result: &btcjson.ISLockResult{},
+			want: &btcjson.ISLockResult{
+				TxID: "d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357",
+				Inputs: []btcjson.ISLockInput{{
+					TxID: "4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39",
+					Vout: 1,
+				}},
+				CycleHash: "000000000000001c172f518793c3b9e83f202284615592f87fe3506ce964dcd4",
+				Signature: "00",
+				Hex:       "0100",
+			},
+		},
+		{
+			name:   "mnsync status",
+			json:   `{"AssetID":999,"AssetName":"MASTERNODE_SYNC_FINISHED","AssetStartTime":1633024800,"Attempt":0,"IsBlockchainSynced":true,"IsSynced":true}`,
+			result: &btcjson.MnSyncStatusResult{},
+			want: &btcjson.MnSyncStatusResult{
+				AssetID:            999,
+				AssetName:          "MASTERNODE_SYNC_FINISHED",
+				AssetStartTime:     1633024800,
+				IsBlockchainSynced: true,
+				IsSynced:           true,
+			},
+		},
+		{
+			name:   "getblockhashes logical times",
+			json:   `[{"blockhash":"000000000000001c172f518793c3b9e83f202284615592f87fe3506ce964dcd4","logicalts":1633024800}]`,
+			result: &[]btcjson.BlockHashLogicalTime{},
+			want: &[]btcjson.BlockHashLogicalTime{{
+				BlockHash: "000000000000001c172f518793c3b9e83f202284615592f87fe3506ce964dcd4",
+				LogicalTS: 1633024800,
+			}},
+		},
+		{
+			name:   "getaddressbalance",
+			json:   `{"balance":150000000,"balance_immature":0,"balance_spendable":150000000,"received":250000000}`,
+			result: &btcjson.GetAddressBalanceResult{},
+			want: &btcjson.GetAddressBalanceResult{
+				Balance:          150000000,
+				BalanceSpendable: 150000000,
+				Received:         250000000,
+			},
+		},
+		{
+			name:   "getaddressdeltas",
+			json:   `[{"satoshis":-100000000,"txid":"d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357","index":0,"blockindex":3,"height":1950000,"address":"XwnLY9Tf7Zsef8gMGL2fhWA9ZmMjt4KPwg"}]`,
+			result: &[]btcjson.AddressDelta{},
+			want: &[]btcjson.AddressDelta{{
+				Satoshis:   -100000000,
+				TxID:       "d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357",
+				BlockIndex: 3,
+				Height:     1950000,
+				Address:    "XwnLY9Tf7Zsef8gMGL2fhWA9ZmMjt4KPwg",
+			}},
+		},
+		{
+			name:   "getaddressutxos",
+			json:   `[{"address":"XwnLY9Tf7Zsef8gMGL2fhWA9ZmMjt4KPwg","txid":"d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357","outputIndex":1,"script":"76a914000000000000000000000000000000000000000088ac","satoshis":150000000,"height":1950000}]`,
+			result: &[]btcjson.AddressUtxo{},
+			want: &[]btcjson.AddressUtxo{{
+				Address:     "XwnLY9Tf7Zsef8gMGL2fhWA9ZmMjt4KPwg",
+				TxID:        "d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357",
+				OutputIndex: 1,
+				Script:      "76a914000000000000000000000000000000000000000088ac",
+				Satoshis:    150000000,
+				Height:      1950000,
+			}},
+		},
+		{
+			name:   "getspentinfo",
+			json:   `{"txid":"d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357","index":0,"height":1950010}`,
+			result: &btcjson.GetSpentInfoResult{},
+			want: &btcjson.GetSpentInfoResult{
+				TxID:   "d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357",
+				Height: 1950010,
+			},
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		if err := json.Unmarshal([]byte(test.json), test.result); err != nil {
+			t.Errorf("Test #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(test.result, test.want) {
+			t.Errorf("Test #%d (%s) unexpected result - got %+v, "+
+				"want %+v", i, test.name, test.result, test.want)
+		}
+	}
+}
@@ -9,7 +9,8 @@ package btcjson
 
 import (
 	"errors"
-	"fmt"
+
+	"github.com/dashpay/dashd-go/chaincfg"
 )
 
 func init() {
@@ -119,83 +120,45 @@ const (
 	DetailLevelMembersProTxHashes DetailLevel = 2
 )
 
-// LLMQType is the type of quorum
-type LLMQType int
+// LLMQType is the type of quorum.  It is defined in chaincfg so the network
+// parameters can refer to it.
+type LLMQType = chaincfg.LLMQType
 
 // Enum of LLMQTypes
 // See https://github.com/dashpay/dips/blob/master/dip-0006.md#current-llmq-types and
 // https://github.com/dashpay/dash/blob/master/src/llmq/params.h
 const (
-	LLMQType_50_60            LLMQType = 1   // 50 members, 30 (60%) threshold, one per hour
-	LLMQType_400_60           LLMQType = 2   // 400 members, 240 (60%) threshold, one every 12 hours
-	LLMQType_400_85           LLMQType = 3   // 400 members, 340 (85%) threshold, one every 24 hours
-	LLMQType_100_67           LLMQType = 4   // 100 members, 67 (67%) threshold, one per hour
-	LLMQType_60_75            LLMQType = 5   // 60 members, 45 (75%) threshold, one every 12 hours
-	LLMQType_25_67            LLMQType = 6   // 25 members, 17 (67%) threshold, one per hour
-	LLMQType_TEST             LLMQType = 100 // 3 members, 2 (66%) threshold, one per hour
-	LLMQType_DEVNET           LLMQType = 101 // 12 members, 6 (50%) threshold, one per hour
-	LLMQType_TEST_V17         LLMQType = 102 // 3 members, 2 (66%) threshold, one per hour
-	LLMQType_TEST_DIP0024     LLMQType = 103 // 4 members, 2 (66%) threshold, one per hour
-	LLMQType_TEST_INSTANTSEND LLMQType = 104 // 3 members, 2 (66%) threshold, one per hour
-	LLMQType_DEVNET_DIP0024   LLMQType = 105 // 8 members, 4 (50%) threshold, one per hour
-	LLMQType_TEST_PLATFORM    LLMQType = 106 // 3 members, 2 (66%) threshold, one per hour
-	LLMQType_DEVNET_PLATFORM  LLMQType = 107 // 12 members, 8 (67%) threshold, one per hour
-	LLMQType_SINGLE_NODE      LLMQType = 111 // 1 memeber, 1 threshold, one per hour.
+	LLMQType_50_60            = chaincfg.LLMQType_50_60
+	LLMQType_400_60           = chaincfg.LLMQType_400_60
+	LLMQType_400_85           = chaincfg.LLMQType_400_85
+	LLMQType_100_67           = chaincfg.LLMQType_100_67
+	LLMQType_60_75            = chaincfg.LLMQType_60_75
+	LLMQType_25_67            = chaincfg.LLMQType_25_67
+	LLMQType_TEST             = chaincfg.LLMQType_TEST
+	LLMQType_DEVNET           = chaincfg.LLMQType_DEVNET
+	LLMQType_TEST_V17         = chaincfg.LLMQType_TEST_V17
+	LLMQType_TEST_DIP0024     = chaincfg.LLMQType_TEST_DIP0024
+	LLMQType_TEST_INSTANTSEND = chaincfg.LLMQType_TEST_INSTANTSEND
+	LLMQType_DEVNET_DIP0024   = chaincfg.LLMQType_DEVNET_DIP0024
+	LLMQType_TEST_PLATFORM    = chaincfg.LLMQType_TEST_PLATFORM
+	LLMQType_DEVNET_PLATFORM  = chaincfg.LLMQType_DEVNET_PLATFORM
+	LLMQType_SINGLE_NODE      = chaincfg.LLMQType_SINGLE_NODE
 
 	// LLMQType_5_60 is replaced with LLMQType_TEST to adhere to DIP-0006 naming
-	LLMQType_5_60 LLMQType = LLMQType_TEST
+	LLMQType_5_60 = chaincfg.LLMQType_5_60
 )
 
 var (
 	errWrongSizeOfArgs           = errors.New("wrong size of arguments")
 	errQuorumUnmarshalerNotFound = errors.New("quorum unmarshaler not found")
-
-	llmqTypes = map[string]LLMQType{
-		"llmq_50_60":            LLMQType_50_60,
-		"llmq_400_60":           LLMQType_400_60,
-		"llmq_400_85":           LLMQType_400_85,
-		"llmq_100_67":           LLMQType_100_67,
-		"llmq_60_75":            LLMQType_60_75,
-		"llmq_25_67":            LLMQType_25_67,
-		"llmq_test":             LLMQType_TEST,
-		"llmq_devnet":           LLMQType_DEVNET,
-		"llmq_test_v17":         LLMQType_TEST_V17,
-		"llmq_test_dip0024":     LLMQType_TEST_DIP0024,
-		"llmq_test_instantsend": LLMQType_TEST_INSTANTSEND,
-		"llmq_devnet_dip0024":   LLMQType_DEVNET_DIP0024,
-		"llmq_test_platform":    LLMQType_TEST_PLATFORM,
-		"llmq_devnet_platform":  LLMQType_DEVNET_PLATFORM,
-		"llmq_single_node":      LLMQType_SINGLE_NODE,
-	}
+	errProTxUnmarshalerNotFound  = errors.New("protx unmarshaler not found")
+	errWrongTypeOfArg            = errors.New("wrong type of argument")
 )
 
 // GetLLMQType returns LLMQ type for the given name.
 // Returns 0 when the name is not supported.
 func GetLLMQType(name string) LLMQType {
-	return llmqTypes[name]
-}
-
-// Name returns name of the LLMQType.
-// Returns empty string when the type is invalid.
-// See https://github.com/dashpay/dash/blob/master/src/llmq/params.h
-func (t LLMQType) Name() string {
-	for name, item := range llmqTypes {
-		if t == item {
-			return name
-		}
-	}
-	return ""
-}
-
-// Validate checks if provided LLMQ type is valid, eg. if it's one of LLMQ types
-// defined in accordance with DIP-0006.
-// See https://github.com/dashpay/dips/blob/master/dip-0006/llmq-types.md
-func (t LLMQType) Validate() error {
-	if (t >= LLMQType_50_60 && t <= LLMQType_25_67) || (t >= LLMQType_TEST && t <= LLMQType_DEVNET_PLATFORM) || t == LLMQType_SINGLE_NODE {
-		return nil
-	}
-
-	return fmt.Errorf("unsupported quorum type %d", t)
+	return chaincfg.GetLLMQType(name)
 }
 
 // QuorumCmd defines the quorum JSON-RPC command.
@@ -618,3 +581,103 @@ func withQuorumUnmarshaler(
 	}
 	return unmarshaler
 }
+
+// UnmarshalArgs maps a list of arguments to protx struct.  Only the list, info
+// and diff sub commands are supported.
+func (p *ProTxCmd) UnmarshalArgs(args []interface{}) error {
+	if len(args) == 0 {
+		return errWrongSizeOfArgs
+	}
+	subCmd, ok := args[0].(string)
+	if !ok {
+		return errWrongTypeOfArg
+	}
+	p.SubCmd = ProTxSubCmd(subCmd)
+	unmarshaler, ok := proTxCmdUnmarshalers[p.SubCmd]
+	if !ok {
+		return errProTxUnmarshalerNotFound
+	}
+	return unmarshaler(p, args[1:])
+}
+
+type unmarshalProTxCmdFunc func(*ProTxCmd, []interface{}) error
+
+var proTxCmdUnmarshalers = map[ProTxSubCmd]unmarshalProTxCmdFunc{
+	ProTxList: proTxListUnmarshaler,
+	ProTxInfo: proTxInfoUnmarshaler,
+	ProTxDiff: proTxDiffUnmarshaler,
+}
+
+// unmarshalIntArg returns the integer value of an argument, which is a float64
+// when it was decoded from JSON.
+func unmarshalIntArg(val interface{}) (int, error) {
+	switch tv := val.(type) {
+	case float64:
+		return int(tv), nil
+	case int:
+		return tv, nil
+	}
+	return 0, errWrongTypeOfArg
+}
+
+func proTxListUnmarshaler(p *ProTxCmd, args []interface{}) error {
+	if len(args) > 3 {
+		return errWrongSizeOfArgs
+	}
+	if len(args) > 0 {
+		var listType ProTxListType
+		switch tv := args[0].(type) {
+		case string:
+			listType = ProTxListType(tv)
+		case ProTxListType:
+			listType = tv
+		default:
+			return errWrongTypeOfArg
+		}
+		p.Type = &listType
+	}
+	if len(args) > 1 {
+		detailed, ok := args[1].(bool)
+		if !ok {
+			return errWrongTypeOfArg
+		}
+		p.Detailed = boolPtr(detailed)
+	}
+	if len(args) > 2 {
+		height, err := unmarshalIntArg(args[2])
+		if err != nil {
+			return err
+		}
+		p.Height = &height
+	}
+	return nil
+}
+
+func proTxInfoUnmarshaler(p *ProTxCmd, args []interface{}) error {
+	if len(args) != 1 {
+		return errWrongSizeOfArgs
+	}
+	proTxHash, ok := args[0].(string)
+	if !ok {
+		return errWrongTypeOfArg
+	}
+	p.ProTxHash = strPtr(proTxHash)
+	return nil
+}
+
+func proTxDiffUnmarshaler(p *ProTxCmd, args []interface{}) error {
+	if len(args) != 2 {
+		return errWrongSizeOfArgs
+	}
+	baseBlock, err := unmarshalIntArg(args[0])
+	if err != nil {
+		return err
+	}
+	block, err := unmarshalIntArg(args[1])
+	if err != nil {
+		return err
+	}
+	p.BaseBlock = &baseBlock
+	p.Block = &block
+	return nil
+}
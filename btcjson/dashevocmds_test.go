@@ -19,6 +19,9 @@ import (
 func pString(s string) *string                       { return &s }
 func pBool(b bool) *bool                             { return &b }
 func pLLMQType(l btcjson.LLMQType) *btcjson.LLMQType { return &l }
+func pInt(i int) *int                                { return &i }
+
+func pProTxListType(t btcjson.ProTxListType) *btcjson.ProTxListType { return &t }
 
 // TestdashpayCmds tests all of the dash evo commands marshal and unmarshal
 // into valid results include handling of optional fields being omitted in the
@@ -135,6 +138,65 @@ func TestDashpayCmds(t *testing.T) {
 				QuorumHash:  pString("6f1018f54507606069303fd16257434073c6f374729b0090bb9dbbe629241236"),
 			},
 		},
+		{
+			name: "protx list",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("protx", "list", "valid", true, 1000)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewProTxListCmd(btcjson.ProTxListTypeValid, true, 1000)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"protx","params":["list","valid",true,1000],"id":1}`,
+			unmarshalled: &btcjson.ProTxCmd{
+				SubCmd:   btcjson.ProTxList,
+				Type:     pProTxListType(btcjson.ProTxListTypeValid),
+				Detailed: pBool(true),
+				Height:   pInt(1000),
+			},
+		},
+		{
+			name: "protx list without arguments",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("protx", "list")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewProTxListCmd("", false, 0)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"protx","params":["list"],"id":1}`,
+			unmarshalled: &btcjson.ProTxCmd{
+				SubCmd: btcjson.ProTxList,
+			},
+		},
+		{
+			name: "protx info",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("protx", "info",
+					"0067c4fd779a195a95b267e263c631f71f83f8d5e6191091289d114012b373a1")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewProTxInfoCmd("0067c4fd779a195a95b267e263c631f71f83f8d5e6191091289d114012b373a1")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"protx","params":["info","0067c4fd779a195a95b267e263c631f71f83f8d5e6191091289d114012b373a1"],"id":1}`,
+			unmarshalled: &btcjson.ProTxCmd{
+				SubCmd:    btcjson.ProTxInfo,
+				ProTxHash: pString("0067c4fd779a195a95b267e263c631f71f83f8d5e6191091289d114012b373a1"),
+			},
+		},
+		{
+			name: "protx diff",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("protx", "diff", 100, 200)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewProTxDiffCmd(100, 200)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"protx","params":["diff",100,200],"id":1}`,
+			unmarshalled: &btcjson.ProTxCmd{
+				SubCmd:    btcjson.ProTxDiff,
+				BaseBlock: pInt(100),
+				Block:     pInt(200),
+			},
+		},
 	}
 
 	t.Logf("Running %d tests", len(tests))
@@ -1,8 +1,13 @@
 package btcjson
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"strconv"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
 )
 
 // QuorumSignResult models the data from the quorum sign command.
@@ -165,6 +170,7 @@ type MinableCommitment struct {
 	Version           int    `json:"version"`
 	LLMQType          int    `json:"llmqType"`
 	QuorumHash        string `json:"quorumHash"`
+	QuorumIndex       int    `json:"quorumIndex,omitempty"`
 	SignersCount      int    `json:"signersCount"`
 	ValidMembersCount int    `json:"validMembersCount"`
 	QuorumPublicKey   string `json:"quorumPublicKey"`
@@ -249,6 +255,7 @@ type ProTxInfoResult struct {
 	CollateralIndex   int           `json:"collateralIndex"`
 	CollateralAddress string        `json:"collateralAddress"`
 	OperatorReward    float64       `json:"operatorReward"`
+	Type              string        `json:"type,omitempty"`
 	State             ProTxState    `json:"state"`
 	Confirmations     int           `json:"confirmations"`
 	Wallet            ProTxWallet   `json:"wallet"`
@@ -256,15 +263,20 @@ type ProTxInfoResult struct {
 }
 
 type ProTxState struct {
+	Version               int    `json:"version,omitempty"`
 	Service               string `json:"service"`
 	RegisteredHeight      int    `json:"registeredHeight"`
 	LastPaidHeight        int    `json:"lastPaidHeight"`
+	ConsecutivePayments   int    `json:"consecutivePayments,omitempty"`
 	PoSePenalty           int    `json:"PoSePenalty"`
 	PoSeRevivedHeight     int    `json:"PoSeRevivedHeight"`
 	PoSeBanHeight         int    `json:"PoSeBanHeight"`
 	RevocationReason      int    `json:"revocationReason"`
 	OwnerAddress          string `json:"ownerAddress"`
 	VotingAddress         string `json:"votingAddress"`
+	PlatformNodeID        string `json:"platformNodeID,omitempty"`
+	PlatformP2PPort       int    `json:"platformP2PPort,omitempty"`
+	PlatformHTTPPort      int    `json:"platformHTTPPort,omitempty"`
 	PayoutAddress         string `json:"payoutAddress"`
 	PubKeyOperator        string `json:"pubKeyOperator"`
 	OperatorPayoutAddress string `json:"operatorPayoutAddress"`
@@ -301,12 +313,16 @@ type ProTxDiffResult struct {
 }
 
 type ProTxDiffMN struct {
-	ProRegTxHash   string `json:"proRegTxHash"`
-	ConfirmedHash  string `json:"confirmedHash"`
-	Service        string `json:"service"`
-	PubKeyOperator string `json:"pubKeyOperator"`
-	VotingAddress  string `json:"votingAddress"`
-	IsValid        bool   `json:"isValid"`
+	NVersion         int    `json:"nVersion,omitempty"`
+	NType            int    `json:"nType,omitempty"`
+	ProRegTxHash     string `json:"proRegTxHash"`
+	ConfirmedHash    string `json:"confirmedHash"`
+	Service          string `json:"service"`
+	PubKeyOperator   string `json:"pubKeyOperator"`
+	VotingAddress    string `json:"votingAddress"`
+	IsValid          bool   `json:"isValid"`
+	PlatformHTTPPort int    `json:"platformHTTPPort,omitempty"`
+	PlatformNodeID   string `json:"platformNodeID,omitempty"`
 }
 
 type ProTxDiffDeletedQuorum struct {
@@ -317,6 +333,7 @@ type ProTxDiffNewQuorum struct {
 	Version           int    `json:"version"`
 	LlmqType          int    `json:"llmqType"`
 	QuorumHash        string `json:"quorumHash"`
+	QuorumIndex       int    `json:"quorumIndex,omitempty"`
 	SignersCount      int    `json:"signersCount"`
 	Signers           string `json:"signers"`
 	ValidMembersCount int    `json:"validMembersCount"`
@@ -327,6 +344,88 @@ type ProTxDiffNewQuorum struct {
 	MembersSig        string `json:"membersSig"`
 }
 
+// Commitment converts the new quorum into a typed final commitment, which can
+// be verified without trusting the node that returned it.  The bit sets are
+// sized according to the parameters of the LLMQ type.
+func (q *ProTxDiffNewQuorum) Commitment() (*wire.QuorumCommitment, error) {
+	if q.Version < 0 || q.Version > 0xffff {
+		return nil, fmt.Errorf("invalid version %d", q.Version)
+	}
+	if q.LlmqType < 0 || q.LlmqType > 0xff {
+		return nil, fmt.Errorf("invalid LLMQ type %d", q.LlmqType)
+	}
+	params, ok := LLMQType(q.LlmqType).Params()
+	if !ok {
+		return nil, fmt.Errorf("unknown LLMQ type %d", q.LlmqType)
+	}
+
+	qc := &wire.QuorumCommitment{
+		Version:     uint16(q.Version),
+		LLMQType:    uint8(q.LlmqType),
+		QuorumIndex: int16(q.QuorumIndex),
+	}
+	quorumHash, err := chainhash.NewHashFromStr(q.QuorumHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quorum hash: %v", err)
+	}
+	qc.QuorumHash = *quorumHash
+	vvecHash, err := chainhash.NewHashFromStr(q.QuorumVvecHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quorum vvec hash: %v", err)
+	}
+	qc.QuorumVvecHash = *vvecHash
+
+	qc.Signers, err = decodeBitSet(q.Signers, params.Size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signers: %v", err)
+	}
+	qc.ValidMembers, err = decodeBitSet(q.ValidMembers, params.Size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid valid members: %v", err)
+	}
+
+	fields := []struct {
+		name string
+		hex  string
+		dst  []byte
+	}{
+		{"quorum public key", q.QuorumPublicKey, qc.QuorumPublicKey[:]},
+		{"quorum signature", q.QuorumSig, qc.QuorumSig[:]},
+		{"members signature", q.MembersSig, qc.MembersSig[:]},
+	}
+	for _, field := range fields {
+		b, err := hex.DecodeString(field.hex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", field.name, err)
+		}
+		if len(b) != len(field.dst) {
+			return nil, fmt.Errorf("invalid %s: %d bytes, want %d",
+				field.name, len(b), len(field.dst))
+		}
+		copy(field.dst, b)
+	}
+
+	return qc, nil
+}
+
+// decodeBitSet decodes a hex encoded bit set of the passed size with the bits
+// packed least significant bit first.
+func decodeBitSet(s string, size int) ([]bool, error) {
+	packed, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(packed) != (size+7)/8 {
+		return nil, fmt.Errorf("%d bytes for %d bits", len(packed), size)
+	}
+
+	bits := make([]bool, size)
+	for i := range bits {
+		bits[i] = packed[i/8]&(1<<(i%8)) != 0
+	}
+	return bits, nil
+}
+
 type ProTxRegisterPrepareResult struct {
 	Tx                string `json:"tx"`
 	CollateralAddress string `json:"collateralAddress"`
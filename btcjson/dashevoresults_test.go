@@ -2,9 +2,13 @@ package btcjson_test
 
 import (
 	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
 )
 
 // TestDashQuorumSignResults ensures QuorumSignResults are unmarshalled correctly
@@ -161,3 +165,49 @@ func TestDashQuorumInfoResults(t *testing.T) {
 		}
 	}
 }
+
+// TestProTxDiffNewQuorumCommitment ensures the new quorums of a masternode
+// list diff are converted into typed final commitments.
+func TestProTxDiffNewQuorumCommitment(t *testing.T) {
+	t.Parallel()
+
+	data := `{"version":4,"llmqType":103,"quorumHash":"0000000000000000000000000000000000000000000000000000000000000001","quorumIndex":1,"signersCount":3,"signers":"0b","validMembersCount":4,"validMembers":"0f","quorumPublicKey":"` +
+		strings.Repeat("22", 48) + `","quorumVvecHash":"0000000000000000000000000000000000000000000000000000000000000003","quorumSig":"` +
+		strings.Repeat("44", 96) + `","membersSig":"` +
+		strings.Repeat("55", 96) + `"}`
+
+	var q btcjson.ProTxDiffNewQuorum
+	if err := json.Unmarshal([]byte(data), &q); err != nil {
+		t.Fatalf("Unmarshal: unexpected error: %v", err)
+	}
+	qc, err := q.Commitment()
+	if err != nil {
+		t.Fatalf("Commitment: unexpected error: %v", err)
+	}
+
+	want := &wire.QuorumCommitment{
+		Version:        wire.QuorumCommitmentVersionBasicIndexed,
+		LLMQType:       103,
+		QuorumHash:     chainhash.Hash{0x01},
+		QuorumIndex:    1,
+		Signers:        []bool{true, true, false, true},
+		ValidMembers:   []bool{true, true, true, true},
+		QuorumVvecHash: chainhash.Hash{0x03},
+	}
+	for i := range want.QuorumPublicKey {
+		want.QuorumPublicKey[i] = 0x22
+	}
+	for i := range want.QuorumSig {
+		want.QuorumSig[i] = 0x44
+		want.MembersSig[i] = 0x55
+	}
+	if !reflect.DeepEqual(qc, want) {
+		t.Errorf("Commitment: got %+v, want %+v", qc, want)
+	}
+
+	// The bit sets must match the size of the LLMQ type.
+	q.Signers = "0b00"
+	if _, err := q.Commitment(); err == nil {
+		t.Errorf("Commitment: oversized bit set unexpectedly accepted")
+	}
+}
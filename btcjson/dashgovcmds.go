@@ -0,0 +1,452 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// NOTE: This file is intended to house the governance RPC commands that are
+// supported by a Dash Core chain server.
+
+package btcjson
+
+import "errors"
+
+func init() {
+	// No special flags for commands in this file.
+	flags := UsageFlag(0)
+
+	MustRegisterCmd("gobject", (*GObjectCmd)(nil), flags)
+	MustRegisterCmd("getgovernanceinfo", (*GetGovernanceInfoCmd)(nil), flags)
+	MustRegisterCmd("getsuperblockbudget", (*GetSuperblockBudgetCmd)(nil), flags)
+}
+
+var errGObjectUnmarshalerNotFound = errors.New("gobject unmarshaler not found")
+
+// GObjectSubCmd defines the sub command used in the gobject JSON-RPC command.
+type GObjectSubCmd string
+
+// GObject subcommands https://dashcore.readme.io/docs/core-api-ref-remote-procedure-calls-dash#gobject
+const (
+	GObjectCount           GObjectSubCmd = "count"
+	GObjectList            GObjectSubCmd = "list"
+	GObjectGet             GObjectSubCmd = "get"
+	GObjectGetCurrentVotes GObjectSubCmd = "getcurrentvotes"
+	GObjectPrepare         GObjectSubCmd = "prepare"
+	GObjectSubmit          GObjectSubCmd = "submit"
+	GObjectVoteMany        GObjectSubCmd = "vote-many"
+	GObjectVoteAlias       GObjectSubCmd = "vote-alias"
+	GObjectCheck           GObjectSubCmd = "check"
+)
+
+// GObjectSignal is the signal of a governance vote.  It is also used to filter
+// the objects of gobject list.
+type GObjectSignal string
+
+// Governance vote signals
+const (
+	GObjectSignalFunding  GObjectSignal = "funding"
+	GObjectSignalValid    GObjectSignal = "valid"
+	GObjectSignalDelete   GObjectSignal = "delete"
+	GObjectSignalEndorsed GObjectSignal = "endorsed"
+
+	// GObjectSignalAll only applies to gobject list.
+	GObjectSignalAll GObjectSignal = "all"
+)
+
+// GObjectOutcome is the outcome of a governance vote.
+type GObjectOutcome string
+
+// Governance vote outcomes
+const (
+	GObjectOutcomeYes     GObjectOutcome = "yes"
+	GObjectOutcomeNo      GObjectOutcome = "no"
+	GObjectOutcomeAbstain GObjectOutcome = "abstain"
+)
+
+// GObjectListType selects the type of the objects returned by gobject list.
+type GObjectListType string
+
+// Object types of gobject list
+const (
+	GObjectListTypeProposals GObjectListType = "proposals"
+	GObjectListTypeTriggers  GObjectListType = "triggers"
+	GObjectListTypeAll       GObjectListType = "all"
+)
+
+// GObjectCountMode is the output format of gobject count.
+type GObjectCountMode string
+
+// Output formats of gobject count
+const (
+	GObjectCountModeJSON GObjectCountMode = "json"
+	GObjectCountModeAll  GObjectCountMode = "all"
+)
+
+// GObjectCmd defines the gobject JSON-RPC command.
+//
+// The fields are ordered such that the arguments of every sub command are
+// marshalled in the order expected by Dash Core.
+type GObjectCmd struct {
+	SubCmd GObjectSubCmd `jsonrpcusage:"\"count|list|get|getcurrentvotes|prepare|submit|vote-many|vote-alias|check\""`
+
+	GovernanceHash *string          `json:",omitempty"`
+	Signal         *GObjectSignal   `json:",omitempty"`
+	Type           *GObjectListType `json:",omitempty"`
+	Outcome        *GObjectOutcome  `json:",omitempty"`
+	ProTxHash      *string          `json:",omitempty"`
+	TxID           *string          `json:",omitempty"`
+	Vout           *int             `json:",omitempty"`
+
+	ParentHash  *string `json:",omitempty"`
+	Revision    *int    `json:",omitempty"`
+	Time        *int64  `json:",omitempty"`
+	DataHex     *string `json:",omitempty"`
+	OutputHash  *string `json:",omitempty"`
+	OutputIndex *int    `json:",omitempty"`
+	FeeTxID     *string `json:",omitempty"`
+
+	Mode *GObjectCountMode `json:",omitempty"`
+}
+
+// NewGObjectCountCmd returns a new instance which can be used to issue a
+// gobject count JSON-RPC command.  The mode is omitted when it is empty.
+func NewGObjectCountCmd(mode GObjectCountMode) *GObjectCmd {
+	cmd := &GObjectCmd{
+		SubCmd: GObjectCount,
+	}
+	if mode != "" {
+		cmd.Mode = &mode
+	}
+	return cmd
+}
+
+// NewGObjectListCmd returns a new instance which can be used to issue a
+// gobject list JSON-RPC command.  The signal and type are omitted when they
+// are empty.
+func NewGObjectListCmd(signal GObjectSignal, listType GObjectListType) *GObjectCmd {
+	cmd := &GObjectCmd{
+		SubCmd: GObjectList,
+	}
+	if signal == "" {
+		return cmd
+	}
+	cmd.Signal = &signal
+	if listType == "" {
+		return cmd
+	}
+	cmd.Type = &listType
+	return cmd
+}
+
+// NewGObjectGetCmd returns a new instance which can be used to issue a gobject
+// get JSON-RPC command.
+func NewGObjectGetCmd(governanceHash string) *GObjectCmd {
+	return &GObjectCmd{
+		SubCmd:         GObjectGet,
+		GovernanceHash: &governanceHash,
+	}
+}
+
+// NewGObjectGetCurrentVotesCmd returns a new instance which can be used to
+// issue a gobject getcurrentvotes JSON-RPC command.  The votes are limited to
+// the masternode with the passed collateral outpoint unless txID is empty.
+func NewGObjectGetCurrentVotesCmd(governanceHash, txID string, vout int) *GObjectCmd {
+	cmd := &GObjectCmd{
+		SubCmd:         GObjectGetCurrentVotes,
+		GovernanceHash: &governanceHash,
+	}
+	if txID == "" {
+		return cmd
+	}
+	cmd.TxID = &txID
+	cmd.Vout = &vout
+	return cmd
+}
+
+// NewGObjectPrepareCmd returns a new instance which can be used to issue a
+// gobject prepare JSON-RPC command.  The collateral transaction spends the
+// passed output unless outputHash is empty.
+func NewGObjectPrepareCmd(parentHash string, revision int, time int64, dataHex, outputHash string, outputIndex int) *GObjectCmd {
+	cmd := &GObjectCmd{
+		SubCmd:     GObjectPrepare,
+		ParentHash: &parentHash,
+		Revision:   &revision,
+		Time:       &time,
+		DataHex:    &dataHex,
+	}
+	if outputHash == "" {
+		return cmd
+	}
+	cmd.OutputHash = &outputHash
+	cmd.OutputIndex = &outputIndex
+	return cmd
+}
+
+// NewGObjectSubmitCmd returns a new instance which can be used to issue a
+// gobject submit JSON-RPC command.  The fee transaction id is omitted when it
+// is empty, which is only valid for triggers submitted by masternodes.
+func NewGObjectSubmitCmd(parentHash string, revision int, time int64, dataHex, feeTxID string) *GObjectCmd {
+	cmd := &GObjectCmd{
+		SubCmd:     GObjectSubmit,
+		ParentHash: &parentHash,
+		Revision:   &revision,
+		Time:       &time,
+		DataHex:    &dataHex,
+	}
+	if feeTxID == "" {
+		return cmd
+	}
+	cmd.FeeTxID = &feeTxID
+	return cmd
+}
+
+// NewGObjectVoteManyCmd returns a new instance which can be used to issue a
+// gobject vote-many JSON-RPC command.
+func NewGObjectVoteManyCmd(governanceHash string, signal GObjectSignal, outcome GObjectOutcome) *GObjectCmd {
+	return &GObjectCmd{
+		SubCmd:         GObjectVoteMany,
+		GovernanceHash: &governanceHash,
+		Signal:         &signal,
+		Outcome:        &outcome,
+	}
+}
+
+// NewGObjectVoteAliasCmd returns a new instance which can be used to issue a
+// gobject vote-alias JSON-RPC command.
+func NewGObjectVoteAliasCmd(governanceHash string, signal GObjectSignal, outcome GObjectOutcome, proTxHash string) *GObjectCmd {
+	return &GObjectCmd{
+		SubCmd:         GObjectVoteAlias,
+		GovernanceHash: &governanceHash,
+		Signal:         &signal,
+		Outcome:        &outcome,
+		ProTxHash:      &proTxHash,
+	}
+}
+
+// NewGObjectCheckCmd returns a new instance which can be used to issue a
+// gobject check JSON-RPC command.
+func NewGObjectCheckCmd(dataHex string) *GObjectCmd {
+	return &GObjectCmd{
+		SubCmd:  GObjectCheck,
+		DataHex: &dataHex,
+	}
+}
+
+// GetGovernanceInfoCmd defines the getgovernanceinfo JSON-RPC command.
+type GetGovernanceInfoCmd struct{}
+
+// NewGetGovernanceInfoCmd returns a new instance which can be used to issue a
+// getgovernanceinfo JSON-RPC command.
+func NewGetGovernanceInfoCmd() *GetGovernanceInfoCmd {
+	return &GetGovernanceInfoCmd{}
+}
+
+// GetSuperblockBudgetCmd defines the getsuperblockbudget JSON-RPC command.
+type GetSuperblockBudgetCmd struct {
+	Index int
+}
+
+// NewGetSuperblockBudgetCmd returns a new instance which can be used to issue a
+// getsuperblockbudget JSON-RPC command.
+func NewGetSuperblockBudgetCmd(index int) *GetSuperblockBudgetCmd {
+	return &GetSuperblockBudgetCmd{
+		Index: index,
+	}
+}
+
+// UnmarshalArgs maps a list of arguments to gobject struct.
+func (g *GObjectCmd) UnmarshalArgs(args []interface{}) error {
+	if len(args) == 0 {
+		return errWrongSizeOfArgs
+	}
+	subCmd, ok := args[0].(string)
+	if !ok {
+		return errWrongTypeOfArg
+	}
+	g.SubCmd = GObjectSubCmd(subCmd)
+	unmarshaler, ok := gObjectCmdUnmarshalers[g.SubCmd]
+	if !ok {
+		return errGObjectUnmarshalerNotFound
+	}
+	return unmarshaler(g, args[1:])
+}
+
+type unmarshalGObjectCmdFunc func(*GObjectCmd, []interface{}) error
+
+var gObjectCmdUnmarshalers = map[GObjectSubCmd]unmarshalGObjectCmdFunc{
+	GObjectCount:           gObjectCountUnmarshaler,
+	GObjectList:            gObjectListUnmarshaler,
+	GObjectGet:             gObjectGetUnmarshaler,
+	GObjectGetCurrentVotes: gObjectGetCurrentVotesUnmarshaler,
+	GObjectPrepare:         gObjectPrepareUnmarshaler,
+	GObjectSubmit:          gObjectSubmitUnmarshaler,
+	GObjectVoteMany:        gObjectVoteUnmarshaler(3),
+	GObjectVoteAlias:       gObjectVoteUnmarshaler(4),
+	GObjectCheck:           gObjectCheckUnmarshaler,
+}
+
+// unmarshalStringArg returns the value of a string argument, which may also be
+// passed as one of the string types of this package.
+func unmarshalStringArg(val interface{}) (string, error) {
+	switch tv := val.(type) {
+	case string:
+		return tv, nil
+	case GObjectSignal:
+		return string(tv), nil
+	case GObjectOutcome:
+		return string(tv), nil
+	case GObjectListType:
+		return string(tv), nil
+	case GObjectCountMode:
+		return string(tv), nil
+	}
+	return "", errWrongTypeOfArg
+}
+
+// unmarshalStringArgs assigns the string arguments to the passed targets in
+// order.  Missing trailing arguments leave their targets untouched.
+func unmarshalStringArgs(args []interface{}, targets ...**string) error {
+	for i, arg := range args {
+		s, err := unmarshalStringArg(arg)
+		if err != nil {
+			return err
+		}
+		*targets[i] = &s
+	}
+	return nil
+}
+
+func gObjectCountUnmarshaler(g *GObjectCmd, args []interface{}) error {
+	if len(args) > 1 {
+		return errWrongSizeOfArgs
+	}
+	var mode *string
+	if err := unmarshalStringArgs(args, &mode); err != nil {
+		return err
+	}
+	if mode != nil {
+		g.Mode = (*GObjectCountMode)(mode)
+	}
+	return nil
+}
+
+func gObjectListUnmarshaler(g *GObjectCmd, args []interface{}) error {
+	if len(args) > 2 {
+		return errWrongSizeOfArgs
+	}
+	var signal, listType *string
+	if err := unmarshalStringArgs(args, &signal, &listType); err != nil {
+		return err
+	}
+	g.Signal = (*GObjectSignal)(signal)
+	g.Type = (*GObjectListType)(listType)
+	return nil
+}
+
+func gObjectGetUnmarshaler(g *GObjectCmd, args []interface{}) error {
+	if len(args) != 1 {
+		return errWrongSizeOfArgs
+	}
+	return unmarshalStringArgs(args, &g.GovernanceHash)
+}
+
+func gObjectGetCurrentVotesUnmarshaler(g *GObjectCmd, args []interface{}) error {
+	if len(args) != 1 && len(args) != 3 {
+		return errWrongSizeOfArgs
+	}
+	if err := unmarshalStringArgs(args[:1], &g.GovernanceHash); err != nil {
+		return err
+	}
+	if len(args) == 1 {
+		return nil
+	}
+	if err := unmarshalStringArgs(args[1:2], &g.TxID); err != nil {
+		return err
+	}
+	vout, err := unmarshalIntArg(args[2])
+	if err != nil {
+		return err
+	}
+	g.Vout = &vout
+	return nil
+}
+
+// unmarshalGObjectData assigns the parent hash, revision, time and data
+// arguments shared by the prepare and submit sub commands.
+func unmarshalGObjectData(g *GObjectCmd, args []interface{}) error {
+	if err := unmarshalStringArgs(args[:1], &g.ParentHash); err != nil {
+		return err
+	}
+	revision, err := unmarshalIntArg(args[1])
+	if err != nil {
+		return err
+	}
+	g.Revision = &revision
+	var time int64
+	switch tv := args[2].(type) {
+	case float64:
+		time = int64(tv)
+	case int64:
+		time = tv
+	case int:
+		time = int64(tv)
+	default:
+		return errWrongTypeOfArg
+	}
+	g.Time = &time
+	return unmarshalStringArgs(args[3:4], &g.DataHex)
+}
+
+func gObjectPrepareUnmarshaler(g *GObjectCmd, args []interface{}) error {
+	if len(args) != 4 && len(args) != 6 {
+		return errWrongSizeOfArgs
+	}
+	if err := unmarshalGObjectData(g, args); err != nil {
+		return err
+	}
+	if len(args) == 6 {
+		if err := unmarshalStringArgs(args[4:5], &g.OutputHash); err != nil {
+			return err
+		}
+		outputIndex, err := unmarshalIntArg(args[5])
+		if err != nil {
+			return err
+		}
+		g.OutputIndex = &outputIndex
+	}
+	return nil
+}
+
+func gObjectSubmitUnmarshaler(g *GObjectCmd, args []interface{}) error {
+	if len(args) != 4 && len(args) != 5 {
+		return errWrongSizeOfArgs
+	}
+	if err := unmarshalGObjectData(g, args); err != nil {
+		return err
+	}
+	return unmarshalStringArgs(args[4:], &g.FeeTxID)
+}
+
+// gObjectVoteUnmarshaler returns the unmarshaler of the vote sub commands,
+// which take the passed number of arguments.
+func gObjectVoteUnmarshaler(n int) unmarshalGObjectCmdFunc {
+	return func(g *GObjectCmd, args []interface{}) error {
+		if len(args) != n {
+			return errWrongSizeOfArgs
+		}
+		var signal, outcome *string
+		err := unmarshalStringArgs(args, &g.GovernanceHash, &signal,
+			&outcome, &g.ProTxHash)
+		if err != nil {
+			return err
+		}
+		g.Signal = (*GObjectSignal)(signal)
+		g.Outcome = (*GObjectOutcome)(outcome)
+		return nil
+	}
+}
+
+func gObjectCheckUnmarshaler(g *GObjectCmd, args []interface{}) error {
+	if len(args) != 1 {
+		return errWrongSizeOfArgs
+	}
+	return unmarshalStringArgs(args, &g.DataHex)
+}
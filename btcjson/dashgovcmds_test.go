@@ -0,0 +1,333 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package btcjson_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/btcjson"
+)
+
+func pInt64(i int64) *int64 { return &i }
+
+func pGObjectSignal(s btcjson.GObjectSignal) *btcjson.GObjectSignal       { return &s }
+func pGObjectOutcome(o btcjson.GObjectOutcome) *btcjson.GObjectOutcome    { return &o }
+func pGObjectListType(t btcjson.GObjectListType) *btcjson.GObjectListType { return &t }
+
+func pGObjectCountMode(m btcjson.GObjectCountMode) *btcjson.GObjectCountMode {
+	return &m
+}
+
+// TestDashGovernanceCmds tests all of the dash governance commands marshal and
+// unmarshal into valid results include handling of optional fields being
+// omitted in the marshalled command.
+func TestDashGovernanceCmds(t *testing.T) {
+	t.Parallel()
+
+	const (
+		objectHash = "4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39"
+		txID       = "d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357"
+		proTxHash  = "04d06d16b3eca2f104ef9749d0c1c17d183eb1b4fe3a16808fd70464f03bcd63"
+		dataHex    = "7b226e616d65223a2274657374227d"
+	)
+
+	testID := 1
+	tests := []struct {
+		name         string
+		newCmd       func() (interface{}, error)
+		staticCmd    func() interface{}
+		marshalled   string
+		unmarshalled interface{}
+	}{
+		{
+			name: "gobject count",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gobject", "count")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGObjectCountCmd("")
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"gobject","params":["count"],"id":1}`,
+			unmarshalled: &btcjson.GObjectCmd{SubCmd: btcjson.GObjectCount},
+		},
+		{
+			name: "gobject count all",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gobject", "count", "all")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGObjectCountCmd(btcjson.GObjectCountModeAll)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gobject","params":["count","all"],"id":1}`,
+			unmarshalled: &btcjson.GObjectCmd{
+				SubCmd: btcjson.GObjectCount,
+				Mode:   pGObjectCountMode(btcjson.GObjectCountModeAll),
+			},
+		},
+		{
+			name: "gobject list",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gobject", "list")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGObjectListCmd("", "")
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"gobject","params":["list"],"id":1}`,
+			unmarshalled: &btcjson.GObjectCmd{SubCmd: btcjson.GObjectList},
+		},
+		{
+			name: "gobject list funding proposals",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gobject", "list",
+					btcjson.GObjectSignalFunding,
+					btcjson.GObjectListTypeProposals)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGObjectListCmd(btcjson.GObjectSignalFunding,
+					btcjson.GObjectListTypeProposals)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gobject","params":["list","funding","proposals"],"id":1}`,
+			unmarshalled: &btcjson.GObjectCmd{
+				SubCmd: btcjson.GObjectList,
+				Signal: pGObjectSignal(btcjson.GObjectSignalFunding),
+				Type:   pGObjectListType(btcjson.GObjectListTypeProposals),
+			},
+		},
+		{
+			name: "gobject get",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gobject", "get", objectHash)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGObjectGetCmd(objectHash)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gobject","params":["get","` + objectHash + `"],"id":1}`,
+			unmarshalled: &btcjson.GObjectCmd{
+				SubCmd:         btcjson.GObjectGet,
+				GovernanceHash: pString(objectHash),
+			},
+		},
+		{
+			name: "gobject getcurrentvotes",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gobject", "getcurrentvotes",
+					objectHash, txID, 1)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGObjectGetCurrentVotesCmd(objectHash,
+					txID, 1)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gobject","params":["getcurrentvotes","` + objectHash + `","` + txID + `",1],"id":1}`,
+			unmarshalled: &btcjson.GObjectCmd{
+				SubCmd:         btcjson.GObjectGetCurrentVotes,
+				GovernanceHash: pString(objectHash),
+				TxID:           pString(txID),
+				Vout:           pInt(1),
+			},
+		},
+		{
+			name: "gobject prepare",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gobject", "prepare", "0", 1,
+					int64(1633024800), dataHex, txID, 2)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGObjectPrepareCmd("0", 1, 1633024800,
+					dataHex, txID, 2)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gobject","params":["prepare","0",1,1633024800,"` + dataHex + `","` + txID + `",2],"id":1}`,
+			unmarshalled: &btcjson.GObjectCmd{
+				SubCmd:      btcjson.GObjectPrepare,
+				ParentHash:  pString("0"),
+				Revision:    pInt(1),
+				Time:        pInt64(1633024800),
+				DataHex:     pString(dataHex),
+				OutputHash:  pString(txID),
+				OutputIndex: pInt(2),
+			},
+		},
+		{
+			name: "gobject submit",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gobject", "submit", "0", 1,
+					int64(1633024800), dataHex, txID)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGObjectSubmitCmd("0", 1, 1633024800,
+					dataHex, txID)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gobject","params":["submit","0",1,1633024800,"` + dataHex + `","` + txID + `"],"id":1}`,
+			unmarshalled: &btcjson.GObjectCmd{
+				SubCmd:     btcjson.GObjectSubmit,
+				ParentHash: pString("0"),
+				Revision:   pInt(1),
+				Time:       pInt64(1633024800),
+				DataHex:    pString(dataHex),
+				FeeTxID:    pString(txID),
+			},
+		},
+		{
+			name: "gobject vote-many",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gobject", "vote-many", objectHash,
+					btcjson.GObjectSignalFunding,
+					btcjson.GObjectOutcomeYes)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGObjectVoteManyCmd(objectHash,
+					btcjson.GObjectSignalFunding,
+					btcjson.GObjectOutcomeYes)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gobject","params":["vote-many","` + objectHash + `","funding","yes"],"id":1}`,
+			unmarshalled: &btcjson.GObjectCmd{
+				SubCmd:         btcjson.GObjectVoteMany,
+				GovernanceHash: pString(objectHash),
+				Signal:         pGObjectSignal(btcjson.GObjectSignalFunding),
+				Outcome:        pGObjectOutcome(btcjson.GObjectOutcomeYes),
+			},
+		},
+		{
+			name: "gobject vote-alias",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gobject", "vote-alias", objectHash,
+					"delete", "no", proTxHash)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGObjectVoteAliasCmd(objectHash,
+					btcjson.GObjectSignalDelete,
+					btcjson.GObjectOutcomeNo, proTxHash)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gobject","params":["vote-alias","` + objectHash + `","delete","no","` + proTxHash + `"],"id":1}`,
+			unmarshalled: &btcjson.GObjectCmd{
+				SubCmd:         btcjson.GObjectVoteAlias,
+				GovernanceHash: pString(objectHash),
+				Signal:         pGObjectSignal(btcjson.GObjectSignalDelete),
+				Outcome:        pGObjectOutcome(btcjson.GObjectOutcomeNo),
+				ProTxHash:      pString(proTxHash),
+			},
+		},
+		{
+			name: "gobject check",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gobject", "check", dataHex)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGObjectCheckCmd(dataHex)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"gobject","params":["check","` + dataHex + `"],"id":1}`,
+			unmarshalled: &btcjson.GObjectCmd{
+				SubCmd:  btcjson.GObjectCheck,
+				DataHex: pString(dataHex),
+			},
+		},
+		{
+			name: "getgovernanceinfo",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getgovernanceinfo")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetGovernanceInfoCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getgovernanceinfo","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetGovernanceInfoCmd{},
+		},
+		{
+			name: "getsuperblockbudget",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getsuperblockbudget", 1296000)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetSuperblockBudgetCmd(1296000)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getsuperblockbudget","params":[1296000],"id":1}`,
+			unmarshalled: &btcjson.GetSuperblockBudgetCmd{Index: 1296000},
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		// Marshal the command as created by the new static command
+		// creation function.
+		marshalled, err := btcjson.MarshalCmd(btcjson.RpcVersion1, testID, test.staticCmd())
+		if err != nil {
+			t.Errorf("MarshalCmd #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+
+		if !bytes.Equal(marshalled, []byte(test.marshalled)) {
+			t.Errorf("Test #%d (%s) unexpected marshalled data - "+
+				"got %s, want %s", i, test.name, marshalled,
+				test.marshalled)
+			continue
+		}
+
+		// Ensure the command is created without error via the generic
+		// new command creation function.
+		cmd, err := test.newCmd()
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected NewCmd error: %v ",
+				i, test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(cmd, test.unmarshalled) {
+			t.Errorf("Test #%d (%s) unexpected NewCmd command "+
+				"- got %s, want %s", i, test.name,
+				fmt.Sprintf("(%T) %+[1]v", cmd),
+				fmt.Sprintf("(%T) %+[1]v\n", test.unmarshalled))
+			continue
+		}
+
+		var request btcjson.Request
+		if err := json.Unmarshal(marshalled, &request); err != nil {
+			t.Errorf("Test #%d (%s) unexpected error while "+
+				"unmarshalling JSON-RPC request: %v", i,
+				test.name, err)
+			continue
+		}
+
+		cmd, err = btcjson.UnmarshalCmd(&request)
+		if err != nil {
+			t.Errorf("UnmarshalCmd #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(cmd, test.unmarshalled) {
+			t.Errorf("Test #%d (%s) unexpected unmarshalled command "+
+				"- got %s, want %s", i, test.name,
+				fmt.Sprintf("(%T) %+[1]v", cmd),
+				fmt.Sprintf("(%T) %+[1]v\n", test.unmarshalled))
+			continue
+		}
+	}
+}
+
+// TestDashGovernanceCmdErrors ensures invalid gobject arguments are rejected.
+func TestDashGovernanceCmdErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []interface{}
+	}{
+		{"unknown sub command", []interface{}{"deserialize", "00"}},
+		{"get without hash", []interface{}{"get"}},
+		{"getcurrentvotes without vout", []interface{}{"getcurrentvotes", "00", "00"}},
+		{"prepare with wrong revision", []interface{}{"prepare", "0", "1", 1, "00"}},
+		{"vote-alias without proTxHash", []interface{}{"vote-alias", "00", "funding", "yes"}},
+		{"count with wrong mode", []interface{}{"count", 1}},
+	}
+
+	for _, test := range tests {
+		if _, err := btcjson.NewCmd("gobject", test.args...); err == nil {
+			t.Errorf("%s: NewCmd unexpectedly succeeded", test.name)
+		}
+	}
+}
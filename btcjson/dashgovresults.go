@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package btcjson
+
+// GObjectType is the type of a governance object.
+type GObjectType int
+
+// Governance object types
+const (
+	GObjectTypeUnknown  GObjectType = 0
+	GObjectTypeProposal GObjectType = 1
+	GObjectTypeTrigger  GObjectType = 2
+)
+
+// GObjectCountResult models the data from the gobject count command in json
+// mode.
+type GObjectCountResult struct {
+	ObjectsTotal int `json:"objects_total"`
+	Proposals    int `json:"proposals"`
+	Triggers     int `json:"triggers"`
+	Other        int `json:"other"`
+	Erased       int `json:"erased"`
+	Votes        int `json:"votes"`
+}
+
+// GObjectListResult models an object returned by the gobject list command,
+// which returns a map of these keyed by object hash.
+type GObjectListResult struct {
+	DataHex            string      `json:"DataHex"`
+	DataString         string      `json:"DataString"`
+	Hash               string      `json:"Hash"`
+	CollateralHash     string      `json:"CollateralHash"`
+	ObjectType         GObjectType `json:"ObjectType"`
+	CreationTime       int64       `json:"CreationTime"`
+	SigningMasternode  string      `json:"SigningMasternode,omitempty"`
+	AbsoluteYesCount   int         `json:"AbsoluteYesCount"`
+	YesCount           int         `json:"YesCount"`
+	NoCount            int         `json:"NoCount"`
+	AbstainCount       int         `json:"AbstainCount"`
+	BlockchainValidity bool        `json:"fBlockchainValidity"`
+	IsValidReason      string      `json:"IsValidReason"`
+	CachedValid        bool        `json:"fCachedValid"`
+	CachedFunding      bool        `json:"fCachedFunding"`
+	CachedDelete       bool        `json:"fCachedDelete"`
+	CachedEndorsed     bool        `json:"fCachedEndorsed"`
+}
+
+// GObjectVoteCounts is the tally of the votes of one signal of a governance
+// object.
+type GObjectVoteCounts struct {
+	AbsoluteYesCount int `json:"AbsoluteYesCount"`
+	YesCount         int `json:"YesCount"`
+	NoCount          int `json:"NoCount"`
+	AbstainCount     int `json:"AbstainCount"`
+}
+
+// GObjectGetResult models the data from the gobject get command.
+type GObjectGetResult struct {
+	DataHex           string            `json:"DataHex"`
+	DataString        string            `json:"DataString"`
+	Hash              string            `json:"Hash"`
+	CollateralHash    string            `json:"CollateralHash"`
+	ObjectType        GObjectType       `json:"ObjectType"`
+	CreationTime      int64             `json:"CreationTime"`
+	SigningMasternode string            `json:"SigningMasternode,omitempty"`
+	FundingResult     GObjectVoteCounts `json:"FundingResult"`
+	ValidResult       GObjectVoteCounts `json:"ValidResult"`
+	DeleteResult      GObjectVoteCounts `json:"DeleteResult"`
+	EndorsedResult    GObjectVoteCounts `json:"EndorsedResult"`
+	LocalValidity     bool              `json:"fLocalValidity"`
+	IsValidReason     string            `json:"IsValidReason"`
+	CachedValid       bool              `json:"fCachedValid"`
+	CachedFunding     bool              `json:"fCachedFunding"`
+	CachedDelete      bool              `json:"fCachedDelete"`
+	CachedEndorsed    bool              `json:"fCachedEndorsed"`
+}
+
+// GObjectVoteDetail is the outcome of the vote of one masternode.
+type GObjectVoteDetail struct {
+	Result       string `json:"result"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// GObjectVoteResult models the data from the gobject vote-many and vote-alias
+// commands.  The details are keyed by the proTxHash of the voting masternodes.
+type GObjectVoteResult struct {
+	Overall string                       `json:"overall"`
+	Detail  map[string]GObjectVoteDetail `json:"detail"`
+}
+
+// GObjectCheckResult models the data from the gobject check command.
+type GObjectCheckResult struct {
+	ObjectStatus string `json:"Object status"`
+}
+
+// GetGovernanceInfoResult models the data from the getgovernanceinfo command.
+type GetGovernanceInfoResult struct {
+	GovernanceMinQuorum      int     `json:"governanceminquorum"`
+	ProposalFee              float64 `json:"proposalfee"`
+	SuperblockCycle          int     `json:"superblockcycle"`
+	SuperblockMaturityWindow int     `json:"superblockmaturitywindow"`
+	LastSuperblock           int     `json:"lastsuperblock"`
+	NextSuperblock           int     `json:"nextsuperblock"`
+	FundingThreshold         int     `json:"fundingthreshold"`
+	GovernanceBudget         float64 `json:"governancebudget"`
+}
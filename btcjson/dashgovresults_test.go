@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package btcjson_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/btcjson"
+)
+
+// TestDashGovernanceResults ensures the governance results are unmarshalled
+// from the output of Dash Core.
+func TestDashGovernanceResults(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		json   string
+		result interface{}
+		want   interface{}
+	}{
+		{
+			name:   "gobject count",
+			json:   `{"objects_total":42,"proposals":40,"triggers":2,"other":0,"erased":3,"votes":1234}`,
+			result: &btcjson.GObjectCountResult{},
+			want: &btcjson.GObjectCountResult{
+				ObjectsTotal: 42,
+				Proposals:    40,
+				Triggers:     2,
+				Erased:       3,
+				Votes:        1234,
+			},
+		},
+		{
+			name:   "gobject list",
+			json:   `{"4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39":{"DataHex":"7b7d","DataString":"{}","Hash":"4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39","CollateralHash":"d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357","ObjectType":1,"CreationTime":1633024800,"AbsoluteYesCount":512,"YesCount":600,"NoCount":88,"AbstainCount":3,"fBlockchainValidity":true,"IsValidReason":"","fCachedValid":true,"fCachedFunding":true,"fCachedDelete":false,"fCachedEndorsed":false}}`,
+			result: &map[string]btcjson.GObjectListResult{},
+			want: &map[string]btcjson.GObjectListResult{
+				"4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39": {
+					DataHex:            "7b7d",
+					DataString:         "{}",
+					Hash:               "4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39",
+					CollateralHash:     "d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357",
+					ObjectType:         btcjson.GObjectTypeProposal,
+					CreationTime:       1633024800,
+					AbsoluteYesCount:   512,
+					YesCount:           600,
+					NoCount:            88,
+					AbstainCount:       3,
+					BlockchainValidity: true,
+					CachedValid:        true,
+					CachedFunding:      true,
+				},
+			},
+		},
+		{
+			name:   "gobject get",
+			json:   `{"DataHex":"7b7d","DataString":"{}","Hash":"4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39","CollateralHash":"0000000000000000000000000000000000000000000000000000000000000000","ObjectType":2,"CreationTime":1633024800,"SigningMasternode":"04d06d16b3eca2f104ef9749d0c1c17d183eb1b4fe3a16808fd70464f03bcd63","FundingResult":{"AbsoluteYesCount":10,"YesCount":12,"NoCount":2,"AbstainCount":0},"ValidResult":{"AbsoluteYesCount":0,"YesCount":0,"NoCount":0,"AbstainCount":0},"DeleteResult":{"AbsoluteYesCount":0,"YesCount":0,"NoCount":0,"AbstainCount":0},"EndorsedResult":{"AbsoluteYesCount":0,"YesCount":0,"NoCount":0,"AbstainCount":0},"fLocalValidity":true,"IsValidReason":"","fCachedValid":true,"fCachedFunding":false,"fCachedDelete":false,"fCachedEndorsed":false}`,
+			result: &btcjson.GObjectGetResult{},
+			want: &btcjson.GObjectGetResult{
+				DataHex:           "7b7d",
+				DataString:        "{}",
+				Hash:              "4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39",
+				CollateralHash:    "0000000000000000000000000000000000000000000000000000000000000000",
+				ObjectType:        btcjson.GObjectTypeTrigger,
+				CreationTime:      1633024800,
+				SigningMasternode: "04d06d16b3eca2f104ef9749d0c1c17d183eb1b4fe3a16808fd70464f03bcd63",
+				FundingResult: btcjson.GObjectVoteCounts{
+					AbsoluteYesCount: 10,
+					YesCount:         12,
+					NoCount:          2,
+				},
+				LocalValidity: true,
+				CachedValid:   true,
+			},
+		},
+		{
+			name:   "gobject vote-many",
+			json:   `{"overall":"Voted successfully 1 time(s) and failed 1 time(s).","detail":{"04d06d16b3eca2f104ef9749d0c1c17d183eb1b4fe3a16808fd70464f03bcd63":{"result":"success"},"ec21749595a34d868cc366c0feefbd1cfaeb659c6acbc1e2e96fd1e714affa56":{"result":"failed","errorMessage":"Failure to sign."}}}`,
+			result: &btcjson.GObjectVoteResult{},
+			want: &btcjson.GObjectVoteResult{
+				Overall: "Voted successfully 1 time(s) and failed 1 time(s).",
+				Detail: map[string]btcjson.GObjectVoteDetail{
+					"04d06d16b3eca2f104ef9749d0c1c17d183eb1b4fe3a16808fd70464f03bcd63": {
+						Result: "success",
+					},
+					"ec21749595a34d868cc366c0feefbd1cfaeb659c6acbc1e2e96fd1e714affa56": {
+						Result:       "failed",
+						ErrorMessage: "Failure to sign.",
+					},
+				},
+			},
+		},
+		{
+			name:   "gobject check",
+			json:   `{"Object status":"OK"}`,
+			result: &btcjson.GObjectCheckResult{},
+			want:   &btcjson.GObjectCheckResult{ObjectStatus: "OK"},
+		},
+		{
+			name:   "getgovernanceinfo",
+			json:   `{"governanceminquorum":10,"proposalfee":1.00000000,"superblockcycle":16616,"superblockmaturitywindow":1662,"lastsuperblock":1961424,"nextsuperblock":1978040,"fundingthreshold":342,"governancebudget":6009.08165520}`,
+			result: &btcjson.GetGovernanceInfoResult{},
+			want: &btcjson.GetGovernanceInfoResult{
+				GovernanceMinQuorum:      10,
+				ProposalFee:              1,
+				SuperblockCycle:          16616,
+				SuperblockMaturityWindow: 1662,
+				LastSuperblock:           1961424,
+				NextSuperblock:           1978040,
+				FundingThreshold:         342,
+				GovernanceBudget:         6009.0816552,
+			},
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		if err := json.Unmarshal([]byte(test.json), test.result); err != nil {
+			t.Errorf("Test #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(test.result, test.want) {
+			t.Errorf("Test #%d (%s) unexpected result - got %+v, "+
+				"want %+v", i, test.name, test.result, test.want)
+		}
+	}
+}
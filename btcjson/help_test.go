@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
 )
 
 // TestHelpReflectInternals ensures the various help functions which deal with
@@ -126,6 +127,13 @@ func TestHelpReflectInternals(t *testing.T) {
 			examples:    []string{`"json-example-string"`},
 			help:        "\"json-example-string\" (json-type-string) fdk",
 		},
+		{
+			name:        "chainhash.Hash",
+			reflectType: reflect.TypeOf(chainhash.Hash{}),
+			key:         "json-type-string",
+			examples:    []string{`"json-example-string"`},
+			help:        "\"json-example-string\" (json-type-string) fdk",
+		},
 		{
 			name:        "bool",
 			reflectType: reflect.TypeOf(true),
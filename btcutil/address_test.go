@@ -44,6 +44,24 @@ func applyCustomParams(params chaincfg.Params, customParams CustomParamStruct) c
 
 var customParams = applyCustomParams(chaincfg.MainNetParams, CustomParams)
 
+// Dash does not support segwit addresses, so the segwit address tests use the
+// Bitcoin human-readable parts on networks derived from the Dash ones.
+var segwitMainNetParams = applyCustomParams(chaincfg.MainNetParams,
+	CustomParamStruct{
+		Net:              0xd9b4bef9, // bitcoin mainnet
+		PubKeyHashAddrID: chaincfg.MainNetParams.PubKeyHashAddrID,
+		ScriptHashAddrID: chaincfg.MainNetParams.ScriptHashAddrID,
+		Bech32HRPSegwit:  "bc",
+	})
+
+var segwitTestNet3Params = applyCustomParams(chaincfg.TestNet3Params,
+	CustomParamStruct{
+		Net:              0x0709110b, // bitcoin testnet3
+		PubKeyHashAddrID: chaincfg.TestNet3Params.PubKeyHashAddrID,
+		ScriptHashAddrID: chaincfg.TestNet3Params.ScriptHashAddrID,
+		Bech32HRPSegwit:  "tb",
+	})
+
 func TestAddresses(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -412,14 +430,14 @@ func TestAddresses(t *testing.T) {
 				[20]byte{
 					0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4, 0x54, 0x94,
 					0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23, 0xf1, 0x43, 0x3b, 0xd6},
-				chaincfg.MainNetParams.Bech32HRPSegwit),
+				segwitMainNetParams.Bech32HRPSegwit),
 			f: func() (btcutil.Address, error) {
 				pkHash := []byte{
 					0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4, 0x54, 0x94,
 					0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23, 0xf1, 0x43, 0x3b, 0xd6}
-				return btcutil.NewAddressWitnessPubKeyHash(pkHash, &chaincfg.MainNetParams)
+				return btcutil.NewAddressWitnessPubKeyHash(pkHash, &segwitMainNetParams)
 			},
-			net: &chaincfg.MainNetParams,
+			net: &segwitMainNetParams,
 		},
 		{
 			name:    "segwit mainnet p2wsh v0",
@@ -433,16 +451,16 @@ func TestAddresses(t *testing.T) {
 					0x04, 0xbd, 0x19, 0x20, 0x33, 0x56, 0xda, 0x13,
 					0x6c, 0x98, 0x56, 0x78, 0xcd, 0x4d, 0x27, 0xa1,
 					0xb8, 0xc6, 0x32, 0x96, 0x04, 0x90, 0x32, 0x62},
-				chaincfg.MainNetParams.Bech32HRPSegwit),
+				segwitMainNetParams.Bech32HRPSegwit),
 			f: func() (btcutil.Address, error) {
 				scriptHash := []byte{
 					0x18, 0x63, 0x14, 0x3c, 0x14, 0xc5, 0x16, 0x68,
 					0x04, 0xbd, 0x19, 0x20, 0x33, 0x56, 0xda, 0x13,
 					0x6c, 0x98, 0x56, 0x78, 0xcd, 0x4d, 0x27, 0xa1,
 					0xb8, 0xc6, 0x32, 0x96, 0x04, 0x90, 0x32, 0x62}
-				return btcutil.NewAddressWitnessScriptHash(scriptHash, &chaincfg.MainNetParams)
+				return btcutil.NewAddressWitnessScriptHash(scriptHash, &segwitMainNetParams)
 			},
-			net: &chaincfg.MainNetParams,
+			net: &segwitMainNetParams,
 		},
 		{
 			name:    "segwit testnet p2wpkh v0",
@@ -454,14 +472,14 @@ func TestAddresses(t *testing.T) {
 				[20]byte{
 					0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4, 0x54, 0x94,
 					0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23, 0xf1, 0x43, 0x3b, 0xd6},
-				chaincfg.TestNet3Params.Bech32HRPSegwit),
+				segwitTestNet3Params.Bech32HRPSegwit),
 			f: func() (btcutil.Address, error) {
 				pkHash := []byte{
 					0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4, 0x54, 0x94,
 					0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23, 0xf1, 0x43, 0x3b, 0xd6}
-				return btcutil.NewAddressWitnessPubKeyHash(pkHash, &chaincfg.TestNet3Params)
+				return btcutil.NewAddressWitnessPubKeyHash(pkHash, &segwitTestNet3Params)
 			},
-			net: &chaincfg.TestNet3Params,
+			net: &segwitTestNet3Params,
 		},
 		{
 			name:    "segwit testnet p2wsh v0",
@@ -475,16 +493,16 @@ func TestAddresses(t *testing.T) {
 					0x04, 0xbd, 0x19, 0x20, 0x33, 0x56, 0xda, 0x13,
 					0x6c, 0x98, 0x56, 0x78, 0xcd, 0x4d, 0x27, 0xa1,
 					0xb8, 0xc6, 0x32, 0x96, 0x04, 0x90, 0x32, 0x62},
-				chaincfg.TestNet3Params.Bech32HRPSegwit),
+				segwitTestNet3Params.Bech32HRPSegwit),
 			f: func() (btcutil.Address, error) {
 				scriptHash := []byte{
 					0x18, 0x63, 0x14, 0x3c, 0x14, 0xc5, 0x16, 0x68,
 					0x04, 0xbd, 0x19, 0x20, 0x33, 0x56, 0xda, 0x13,
 					0x6c, 0x98, 0x56, 0x78, 0xcd, 0x4d, 0x27, 0xa1,
 					0xb8, 0xc6, 0x32, 0x96, 0x04, 0x90, 0x32, 0x62}
-				return btcutil.NewAddressWitnessScriptHash(scriptHash, &chaincfg.TestNet3Params)
+				return btcutil.NewAddressWitnessScriptHash(scriptHash, &segwitTestNet3Params)
 			},
-			net: &chaincfg.TestNet3Params,
+			net: &segwitTestNet3Params,
 		},
 		{
 			name:    "segwit testnet p2wsh witness v0",
@@ -498,16 +516,16 @@ func TestAddresses(t *testing.T) {
 					0x21, 0xb2, 0xa1, 0x87, 0x90, 0x5e, 0x52, 0x66,
 					0x36, 0x2b, 0x99, 0xd5, 0xe9, 0x1c, 0x6c, 0xe2,
 					0x4d, 0x16, 0x5d, 0xab, 0x93, 0xe8, 0x64, 0x33},
-				chaincfg.TestNet3Params.Bech32HRPSegwit),
+				segwitTestNet3Params.Bech32HRPSegwit),
 			f: func() (btcutil.Address, error) {
 				scriptHash := []byte{
 					0x00, 0x00, 0x00, 0xc4, 0xa5, 0xca, 0xd4, 0x62,
 					0x21, 0xb2, 0xa1, 0x87, 0x90, 0x5e, 0x52, 0x66,
 					0x36, 0x2b, 0x99, 0xd5, 0xe9, 0x1c, 0x6c, 0xe2,
 					0x4d, 0x16, 0x5d, 0xab, 0x93, 0xe8, 0x64, 0x33}
-				return btcutil.NewAddressWitnessScriptHash(scriptHash, &chaincfg.TestNet3Params)
+				return btcutil.NewAddressWitnessScriptHash(scriptHash, &segwitTestNet3Params)
 			},
-			net: &chaincfg.TestNet3Params,
+			net: &segwitTestNet3Params,
 		},
 		{
 			name:    "segwit litecoin mainnet p2wpkh v0",
@@ -542,7 +560,7 @@ func TestAddresses(t *testing.T) {
 					0x50, 0x60, 0x0a, 0x5d, 0x36, 0x04, 0x5b, 0xa9,
 					0x7c, 0x26, 0x70, 0xda, 0xa9, 0x1e, 0x9f, 0x3a,
 					0x48, 0xc4, 0x3c, 0x6e, 0x73, 0x97, 0x54, 0xe6,
-				}, chaincfg.MainNetParams.Bech32HRPSegwit,
+				}, segwitMainNetParams.Bech32HRPSegwit,
 			),
 			f: func() (btcutil.Address, error) {
 				scriptHash := []byte{
@@ -552,10 +570,10 @@ func TestAddresses(t *testing.T) {
 					0x48, 0xc4, 0x3c, 0x6e, 0x73, 0x97, 0x54, 0xe6,
 				}
 				return btcutil.NewAddressTaproot(
-					scriptHash, &chaincfg.MainNetParams,
+					scriptHash, &segwitMainNetParams,
 				)
 			},
-			net: &chaincfg.MainNetParams,
+			net: &segwitMainNetParams,
 		},
 
 		// Invalid bech32m tests. Source:
@@ -564,85 +582,85 @@ func TestAddresses(t *testing.T) {
 			name:  "segwit v1 invalid human-readable part",
 			addr:  "tc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq5zuyut",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit v1 mainnet bech32 instead of bech32m",
 			addr:  "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit v1 testnet bech32 instead of bech32m",
 			addr:  "tb1z0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqglt7rf",
 			valid: false,
-			net:   &chaincfg.TestNet3Params,
+			net:   &segwitTestNet3Params,
 		},
 		{
 			name:  "segwit v1 mainnet bech32 instead of bech32m upper case",
 			addr:  "BC1S0XLXVLHEMJA6C4DQV22UAPCTQUPFHLXM9H8Z3K2E72Q4K9HCZ7VQ54WELL",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit v0 mainnet bech32m instead of bech32",
 			addr:  "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit v1 testnet bech32 instead of bech32m second test",
 			addr:  "tb1q0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq24jc47",
 			valid: false,
-			net:   &chaincfg.TestNet3Params,
+			net:   &segwitTestNet3Params,
 		},
 		{
 			name:  "segwit v1 mainnet bech32m invalid character in checksum",
 			addr:  "bc1p38j9r5y49hruaue7wxjce0updqjuyyx0kh56v8s25huc6995vvpql3jow4",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit mainnet witness v17",
 			addr:  "BC130XLXVLHEMJA6C4DQV22UAPCTQUPFHLXM9H8Z3K2E72Q4K9HCZ7VQ7ZWS8R",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit v1 mainnet bech32m invalid program length (1 byte)",
 			addr:  "bc1pw5dgrnzv",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit v1 mainnet bech32m invalid program length (41 bytes)",
 			addr:  "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7v8n0nx0muaewav253zgeav",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit v1 testnet bech32m mixed case",
 			addr:  "tb1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq47Zagq",
 			valid: false,
-			net:   &chaincfg.TestNet3Params,
+			net:   &segwitTestNet3Params,
 		},
 		{
 			name:  "segwit v1 mainnet bech32m zero padding of more than 4 bits",
 			addr:  "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7v07qwwzcrf",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit v1 mainnet bech32m non-zero padding in 8-to-5-conversion",
 			addr:  "tb1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vpggkg4j",
 			valid: false,
-			net:   &chaincfg.TestNet3Params,
+			net:   &segwitTestNet3Params,
 		},
 		{
 			name:  "segwit v1 mainnet bech32m empty data section",
 			addr:  "bc1gmk9yu",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 
 		// Unsupported witness versions (version 0 and 1 only supported at this point)
@@ -650,73 +668,77 @@ func TestAddresses(t *testing.T) {
 			name:  "segwit mainnet witness v16",
 			addr:  "BC1SW50QA3JX3S",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit mainnet witness v2",
 			addr:  "bc1zw508d6qejxtdg4y5r3zarvaryvg6kdaj",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		// Invalid segwit addresses
 		{
 			name:  "segwit invalid hrp",
 			addr:  "tc1qw508d6qejxtdg4y5r3zarvary0c5xw7kg3g4ty",
 			valid: false,
-			net:   &chaincfg.TestNet3Params,
+			net:   &segwitTestNet3Params,
 		},
 		{
 			name:  "segwit invalid checksum",
 			addr:  "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit invalid witness version",
 			addr:  "BC13W508D6QEJXTDG4Y5R3ZARVARY0C5XW7KN40WF2",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit invalid program length",
 			addr:  "bc1rw5uspcuh",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit invalid program length",
 			addr:  "bc10w508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7kw5rljs90",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit invalid program length for witness version 0 (per BIP141)",
 			addr:  "BC1QR508D6QEJXTDG4Y5R3ZARVARYV98GJ9P",
 			valid: false,
-			net:   &chaincfg.MainNetParams,
+			net:   &segwitMainNetParams,
 		},
 		{
 			name:  "segwit mixed case",
 			addr:  "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sL5k7",
 			valid: false,
-			net:   &chaincfg.TestNet3Params,
+			net:   &segwitTestNet3Params,
 		},
 		{
 			name:  "segwit zero padding of more than 4 bits",
 			addr:  "tb1pw508d6qejxtdg4y5r3zarqfsj6c3",
 			valid: false,
-			net:   &chaincfg.TestNet3Params,
+			net:   &segwitTestNet3Params,
 		},
 		{
 			name:  "segwit non-zero padding in 8-to-5 conversion",
 			addr:  "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3pjxtptv",
 			valid: false,
-			net:   &chaincfg.TestNet3Params,
+			net:   &segwitTestNet3Params,
 		},
 	}
 
-	if err := chaincfg.Register(&customParams); err != nil {
-		panic(err)
+	for _, params := range []*chaincfg.Params{&customParams,
+		&segwitMainNetParams, &segwitTestNet3Params} {
+
+		if err := chaincfg.Register(params); err != nil {
+			panic(err)
+		}
 	}
 
 	for _, test := range tests {
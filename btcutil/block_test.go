@@ -35,8 +35,8 @@ func TestBlock(t *testing.T) {
 			gotHeight, wantHeight)
 	}
 
-	// Hash for block 100,000.
-	wantHashStr := "3ba27aa200b1cecaad478d2b00432346c3f1f3986da1afd33e506"
+	// X11 hash for block 100,000.
+	wantHashStr := "86b4763f771e1b63751af737c97f3a62f427201650b439783109404d96c5a550"
 	wantHash, err := chainhash.NewHashFromStr(wantHashStr)
 	if err != nil {
 		t.Errorf("NewHashFromStr: %v", err)
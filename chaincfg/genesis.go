@@ -5,6 +5,7 @@
 package chaincfg
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/dashpay/dashd-go/chaincfg/chainhash"
@@ -12,7 +13,7 @@ import (
 )
 
 // genesisCoinbaseTx is the coinbase transaction for the genesis blocks for
-// the main network, regression test network, and test network (version 3).
+// the main network, regression test network, and test network.
 var genesisCoinbaseTx = wire.MsgTx{
 	Version: 1,
 	TxIn: []*wire.TxIn{
@@ -22,16 +23,19 @@ var genesisCoinbaseTx = wire.MsgTx{
 				Index: 0xffffffff,
 			},
 			SignatureScript: []byte{
-				0x04, 0xff, 0xff, 0x00, 0x1d, 0x01, 0x04, 0x45, /* |.......E| */
-				0x54, 0x68, 0x65, 0x20, 0x54, 0x69, 0x6d, 0x65, /* |The Time| */
-				0x73, 0x20, 0x30, 0x33, 0x2f, 0x4a, 0x61, 0x6e, /* |s 03/Jan| */
-				0x2f, 0x32, 0x30, 0x30, 0x39, 0x20, 0x43, 0x68, /* |/2009 Ch| */
-				0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x6f, 0x72, /* |ancellor| */
-				0x20, 0x6f, 0x6e, 0x20, 0x62, 0x72, 0x69, 0x6e, /* | on brin| */
-				0x6b, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x65, 0x63, /* |k of sec|*/
-				0x6f, 0x6e, 0x64, 0x20, 0x62, 0x61, 0x69, 0x6c, /* |ond bail| */
-				0x6f, 0x75, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, /* |out for |*/
-				0x62, 0x61, 0x6e, 0x6b, 0x73, /* |banks| */
+				0x04, 0xff, 0xff, 0x00, 0x1d, 0x01, 0x04, 0x4c, /* |.......L| */
+				0x59, 0x57, 0x69, 0x72, 0x65, 0x64, 0x20, 0x30, /* |YWired 0| */
+				0x39, 0x2f, 0x4a, 0x61, 0x6e, 0x2f, 0x32, 0x30, /* |9/Jan/20| */
+				0x31, 0x34, 0x20, 0x54, 0x68, 0x65, 0x20, 0x47, /* |14 The G| */
+				0x72, 0x61, 0x6e, 0x64, 0x20, 0x45, 0x78, 0x70, /* |rand Exp| */
+				0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x20, /* |eriment | */
+				0x47, 0x6f, 0x65, 0x73, 0x20, 0x4c, 0x69, 0x76, /* |Goes Liv| */
+				0x65, 0x3a, 0x20, 0x4f, 0x76, 0x65, 0x72, 0x73, /* |e: Overs| */
+				0x74, 0x6f, 0x63, 0x6b, 0x2e, 0x63, 0x6f, 0x6d, /* |tock.com| */
+				0x20, 0x49, 0x73, 0x20, 0x4e, 0x6f, 0x77, 0x20, /* | Is Now | */
+				0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6e, /* |Acceptin| */
+				0x67, 0x20, 0x42, 0x69, 0x74, 0x63, 0x6f, 0x69, /* |g Bitcoi| */
+				0x6e, 0x73, /* |ns| */
 			},
 			Sequence: 0xffffffff,
 		},
@@ -40,15 +44,15 @@ var genesisCoinbaseTx = wire.MsgTx{
 		{
 			Value: 0x12a05f200,
 			PkScript: []byte{
-				0x41, 0x04, 0x67, 0x8a, 0xfd, 0xb0, 0xfe, 0x55, /* |A.g....U| */
-				0x48, 0x27, 0x19, 0x67, 0xf1, 0xa6, 0x71, 0x30, /* |H'.g..q0| */
-				0xb7, 0x10, 0x5c, 0xd6, 0xa8, 0x28, 0xe0, 0x39, /* |..\..(.9| */
-				0x09, 0xa6, 0x79, 0x62, 0xe0, 0xea, 0x1f, 0x61, /* |..yb...a| */
-				0xde, 0xb6, 0x49, 0xf6, 0xbc, 0x3f, 0x4c, 0xef, /* |..I..?L.| */
-				0x38, 0xc4, 0xf3, 0x55, 0x04, 0xe5, 0x1e, 0xc1, /* |8..U....| */
-				0x12, 0xde, 0x5c, 0x38, 0x4d, 0xf7, 0xba, 0x0b, /* |..\8M...| */
-				0x8d, 0x57, 0x8a, 0x4c, 0x70, 0x2b, 0x6b, 0xf1, /* |.W.Lp+k.| */
-				0x1d, 0x5f, 0xac, /* |._.| */
+				0x41, 0x04, 0x01, 0x84, 0x71, 0x0f, 0xa6, 0x89, /* |A...q...| */
+				0xad, 0x50, 0x23, 0x69, 0x0c, 0x80, 0xf3, 0xa4, /* |.P#i....| */
+				0x9c, 0x8f, 0x13, 0xf8, 0xd4, 0x5b, 0x8c, 0x85, /* |.....[..| */
+				0x7f, 0xbc, 0xbc, 0x8b, 0xc4, 0xa8, 0xe4, 0xd3, /* |........| */
+				0xeb, 0x4b, 0x10, 0xf4, 0xd4, 0x60, 0x4f, 0xa0, /* |.K...`O.| */
+				0x8d, 0xce, 0x60, 0x1a, 0xaf, 0x0f, 0x47, 0x02, /* |..`...G.| */
+				0x16, 0xfe, 0x1b, 0x51, 0x85, 0x0b, 0x4a, 0xcf, /* |...Q..J.| */
+				0x21, 0xb1, 0x79, 0xc4, 0x50, 0x70, 0xac, 0x7b, /* |!.y.Pp.{| */
+				0x03, 0xa9, 0xac, /* |...| */
 			},
 		},
 	},
@@ -58,19 +62,19 @@ var genesisCoinbaseTx = wire.MsgTx{
 // genesisHash is the hash of the first block in the block chain for the main
 // network (genesis block).
 var genesisHash = chainhash.Hash([chainhash.HashSize]byte{ // Make go vet happy.
-	0x6f, 0xe2, 0x8c, 0x0a, 0xb6, 0xf1, 0xb3, 0x72,
-	0xc1, 0xa6, 0xa2, 0x46, 0xae, 0x63, 0xf7, 0x4f,
-	0x93, 0x1e, 0x83, 0x65, 0xe1, 0x5a, 0x08, 0x9c,
-	0x68, 0xd6, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xb6, 0x7a, 0x40, 0xf3, 0xcd, 0x58, 0x04, 0x43,
+	0x7a, 0x10, 0x8f, 0x10, 0x55, 0x33, 0x73, 0x9c,
+	0x37, 0xe6, 0x22, 0x9b, 0xc1, 0xad, 0xca, 0xb3,
+	0x85, 0x14, 0x0b, 0x59, 0xfd, 0x0f, 0x00, 0x00,
 })
 
 // genesisMerkleRoot is the hash of the first transaction in the genesis block
 // for the main network.
 var genesisMerkleRoot = chainhash.Hash([chainhash.HashSize]byte{ // Make go vet happy.
-	0x3b, 0xa3, 0xed, 0xfd, 0x7a, 0x7b, 0x12, 0xb2,
-	0x7a, 0xc7, 0x2c, 0x3e, 0x67, 0x76, 0x8f, 0x61,
-	0x7f, 0xc8, 0x1b, 0xc3, 0x88, 0x8a, 0x51, 0x32,
-	0x3a, 0x9f, 0xb8, 0xaa, 0x4b, 0x1e, 0x5e, 0x4a,
+	0xc7, 0x62, 0xa6, 0x56, 0x7f, 0x3c, 0xc0, 0x92,
+	0xf0, 0x68, 0x4b, 0xb6, 0x2b, 0x7e, 0x00, 0xa8,
+	0x48, 0x90, 0xb9, 0x90, 0xf0, 0x7c, 0xc7, 0x1a,
+	0x6b, 0xb5, 0x8d, 0x64, 0xb9, 0x8e, 0x02, 0xe0,
 })
 
 // genesisBlock defines the genesis block of the block chain which serves as the
@@ -79,10 +83,10 @@ var genesisBlock = wire.MsgBlock{
 	Header: wire.BlockHeader{
 		Version:    1,
 		PrevBlock:  chainhash.Hash{},         // 0000000000000000000000000000000000000000000000000000000000000000
-		MerkleRoot: genesisMerkleRoot,        // 4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b
-		Timestamp:  time.Unix(0x495fab29, 0), // 2009-01-03 18:15:05 +0000 UTC
-		Bits:       0x1d00ffff,               // 486604799 [00000000ffff0000000000000000000000000000000000000000000000000000]
-		Nonce:      0x7c2bac1d,               // 2083236893
+		MerkleRoot: genesisMerkleRoot,        // e0028eb9648db56b1ac77cf090b99048a8007e2bb64b68f092c03c7f56a662c7
+		Timestamp:  time.Unix(1390095618, 0), // 2014-01-19 01:40:18 +0000 UTC
+		Bits:       0x1e0ffff0,               // 504365040 [00000ffff0000000000000000000000000000000000000000000000000000000]
+		Nonce:      28917698,
 	},
 	Transactions: []*wire.MsgTx{&genesisCoinbaseTx},
 }
@@ -90,10 +94,10 @@ var genesisBlock = wire.MsgBlock{
 // regTestGenesisHash is the hash of the first block in the block chain for the
 // regression test network (genesis block).
 var regTestGenesisHash = chainhash.Hash([chainhash.HashSize]byte{ // Make go vet happy.
-	0x06, 0x22, 0x6e, 0x46, 0x11, 0x1a, 0x0b, 0x59,
-	0xca, 0xaf, 0x12, 0x60, 0x43, 0xeb, 0x5b, 0xbf,
-	0x28, 0xc3, 0x4f, 0x3a, 0x5e, 0x33, 0x2a, 0x1f,
-	0xc7, 0xb2, 0xb7, 0x3c, 0xf1, 0x88, 0x91, 0x0f,
+	0x2e, 0x3d, 0xf2, 0x3e, 0xec, 0x5c, 0xd6, 0xa8,
+	0x6e, 0xdd, 0x50, 0x95, 0x39, 0x02, 0x8e, 0x2c,
+	0x3a, 0x3d, 0xc0, 0x53, 0x15, 0xeb, 0x28, 0xf2,
+	0xba, 0xa4, 0x32, 0x18, 0xca, 0x08, 0x00, 0x00,
 })
 
 // regTestGenesisMerkleRoot is the hash of the first transaction in the genesis
@@ -107,49 +111,157 @@ var regTestGenesisBlock = wire.MsgBlock{
 	Header: wire.BlockHeader{
 		Version:    1,
 		PrevBlock:  chainhash.Hash{},         // 0000000000000000000000000000000000000000000000000000000000000000
-		MerkleRoot: regTestGenesisMerkleRoot, // 4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b
-		Timestamp:  time.Unix(1296688602, 0), // 2011-02-02 23:16:42 +0000 UTC
+		MerkleRoot: regTestGenesisMerkleRoot, // e0028eb9648db56b1ac77cf090b99048a8007e2bb64b68f092c03c7f56a662c7
+		Timestamp:  time.Unix(1417713337, 0), // 2014-12-04 17:15:37 +0000 UTC
 		Bits:       0x207fffff,               // 545259519 [7fffff0000000000000000000000000000000000000000000000000000000000]
-		Nonce:      2,
+		Nonce:      1096447,
 	},
 	Transactions: []*wire.MsgTx{&genesisCoinbaseTx},
 }
 
 // testNet3GenesisHash is the hash of the first block in the block chain for the
-// test network (version 3).
+// test network.
 var testNet3GenesisHash = chainhash.Hash([chainhash.HashSize]byte{ // Make go vet happy.
-	0x43, 0x49, 0x7f, 0xd7, 0xf8, 0x26, 0x95, 0x71,
-	0x08, 0xf4, 0xa3, 0x0f, 0xd9, 0xce, 0xc3, 0xae,
-	0xba, 0x79, 0x97, 0x20, 0x84, 0xe9, 0x0e, 0xad,
-	0x01, 0xea, 0x33, 0x09, 0x00, 0x00, 0x00, 0x00,
+	0x2c, 0xbc, 0xf8, 0x3b, 0x62, 0x91, 0x3d, 0x56,
+	0xf6, 0x05, 0xc0, 0xe5, 0x81, 0xa4, 0x88, 0x72,
+	0x83, 0x94, 0x28, 0xc9, 0x2e, 0x5e, 0xb7, 0x6c,
+	0xd7, 0xad, 0x94, 0xbc, 0xaf, 0x0b, 0x00, 0x00,
 })
 
 // testNet3GenesisMerkleRoot is the hash of the first transaction in the genesis
-// block for the test network (version 3).  It is the same as the merkle root
-// for the main network.
+// block for the test network.  It is the same as the merkle root for the main
+// network.
 var testNet3GenesisMerkleRoot = genesisMerkleRoot
 
 // testNet3GenesisBlock defines the genesis block of the block chain which
-// serves as the public transaction ledger for the test network (version 3).
+// serves as the public transaction ledger for the test network.
 var testNet3GenesisBlock = wire.MsgBlock{
 	Header: wire.BlockHeader{
 		Version:    1,
 		PrevBlock:  chainhash.Hash{},          // 0000000000000000000000000000000000000000000000000000000000000000
-		MerkleRoot: testNet3GenesisMerkleRoot, // 4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b
-		Timestamp:  time.Unix(1296688602, 0),  // 2011-02-02 23:16:42 +0000 UTC
-		Bits:       0x1d00ffff,                // 486604799 [00000000ffff0000000000000000000000000000000000000000000000000000]
-		Nonce:      0x18aea41a,                // 414098458
+		MerkleRoot: testNet3GenesisMerkleRoot, // e0028eb9648db56b1ac77cf090b99048a8007e2bb64b68f092c03c7f56a662c7
+		Timestamp:  time.Unix(1390666206, 0),  // 2014-01-25 16:10:06 +0000 UTC
+		Bits:       0x1e0ffff0,                // 504365040 [00000ffff0000000000000000000000000000000000000000000000000000000]
+		Nonce:      3861367235,
 	},
 	Transactions: []*wire.MsgTx{&genesisCoinbaseTx},
 }
 
+// devNetGenesisBlock defines the genesis block of the block chain for all
+// development networks.  It is the same as the genesis block for the
+// regression test network.  Every devnet builds its own devnet genesis block on
+// top of it, see NewDevnetParams.
+var devNetGenesisBlock = regTestGenesisBlock
+
+// devNetGenesisHash is the hash of the genesis block for all development
+// networks.
+var devNetGenesisHash = regTestGenesisHash
+
+// newDevnetGenesisBlock creates the devnet genesis block for the devnet with
+// the given name.  It is the second block of the chain and commits to the name
+// of the devnet in the signature script of its coinbase, so devnets sharing the
+// same genesis block are told apart.  The block is mined on top of the given
+// genesis block with the same difficulty, one second after it.
+func newDevnetGenesisBlock(genesis *wire.MsgBlock, name string) *wire.MsgBlock {
+	// The signature script is OP_1 followed by a push of the devnet name.
+	sigScript := []byte{0x51}
+	switch {
+	case len(name) < 0x4c:
+		sigScript = append(sigScript, byte(len(name)))
+	case len(name) <= 0xff:
+		sigScript = append(sigScript, 0x4c, byte(len(name)))
+	default:
+		sigScript = append(sigScript, 0x4d, byte(len(name)),
+			byte(len(name)>>8))
+	}
+	sigScript = append(sigScript, name...)
+
+	coinbaseTx := wire.NewMsgTx(1)
+	coinbaseTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		SignatureScript:  sigScript,
+		Sequence:         wire.MaxTxInSequenceNum,
+	})
+	coinbaseTx.AddTxOut(&wire.TxOut{
+		Value:    0x12a05f200,  // 50 DASH
+		PkScript: []byte{0x6a}, // OP_RETURN
+	})
+
+	block := wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:    4,
+			PrevBlock:  genesis.BlockHash(),
+			MerkleRoot: coinbaseTx.TxHash(),
+			Timestamp:  genesis.Header.Timestamp.Add(time.Second),
+			Bits:       genesis.Header.Bits,
+		},
+		Transactions: []*wire.MsgTx{coinbaseTx},
+	}
+
+	// Solve the block.  The devnet difficulty is the minimum difficulty of
+	// the regression test network, so only a few nonces are tried.
+	target := compactToBig(block.Header.Bits)
+	for {
+		hash := block.Header.BlockHash()
+		if hashToBig(&hash).Cmp(target) <= 0 {
+			return &block
+		}
+		block.Header.Nonce++
+	}
+}
+
+// hashToBig converts a chainhash.Hash into a big.Int that can be used to
+// perform math comparisons.  It is a copy of the blockchain.HashToBig function
+// to avoid a circular dependency.
+func hashToBig(hash *chainhash.Hash) *big.Int {
+	// A Hash is in little-endian, but the big package wants the bytes in
+	// big-endian, so reverse them.
+	buf := *hash
+	blen := len(buf)
+	for i := 0; i < blen/2; i++ {
+		buf[i], buf[blen-1-i] = buf[blen-1-i], buf[i]
+	}
+
+	return new(big.Int).SetBytes(buf[:])
+}
+
+// compactToBig is a copy of the blockchain.CompactToBig function. We copy it
+// here so we don't run into a circular dependency.
+func compactToBig(compact uint32) *big.Int {
+	// Extract the mantissa, sign bit, and exponent.
+	mantissa := compact & 0x007fffff
+	isNegative := compact&0x00800000 != 0
+	exponent := uint(compact >> 24)
+
+	// Since the base for the exponent is 256, the exponent can be treated
+	// as the number of bytes to represent the full 256-bit number.  So,
+	// treat the exponent as the number of bytes and shift the mantissa
+	// right or left accordingly.  This is equivalent to:
+	// N = mantissa * 256^(exponent-3)
+	var bn *big.Int
+	if exponent <= 3 {
+		mantissa >>= 8 * (3 - exponent)
+		bn = big.NewInt(int64(mantissa))
+	} else {
+		bn = big.NewInt(int64(mantissa))
+		bn.Lsh(bn, 8*(exponent-3))
+	}
+
+	// Make it negative if the sign bit is set.
+	if isNegative {
+		bn = bn.Neg(bn)
+	}
+
+	return bn
+}
+
 // simNetGenesisHash is the hash of the first block in the block chain for the
 // simulation test network.
 var simNetGenesisHash = chainhash.Hash([chainhash.HashSize]byte{ // Make go vet happy.
-	0xf6, 0x7a, 0xd7, 0x69, 0x5d, 0x9b, 0x66, 0x2a,
-	0x72, 0xff, 0x3d, 0x8e, 0xdb, 0xbb, 0x2d, 0xe0,
-	0xbf, 0xa6, 0x7b, 0x13, 0x97, 0x4b, 0xb9, 0x91,
-	0x0d, 0x11, 0x6d, 0x5c, 0xbd, 0x86, 0x3e, 0x68,
+	0x71, 0x44, 0xdf, 0xc1, 0x00, 0x9a, 0x7d, 0xb4,
+	0xa0, 0x9d, 0x93, 0xe6, 0x67, 0x3d, 0xde, 0x08,
+	0xec, 0xb5, 0x77, 0x20, 0xf4, 0x43, 0x85, 0xaf,
+	0xc1, 0x6f, 0xf0, 0x7c, 0x92, 0xf0, 0x21, 0xc6,
 })
 
 // simNetGenesisMerkleRoot is the hash of the first transaction in the genesis
@@ -163,7 +275,7 @@ var simNetGenesisBlock = wire.MsgBlock{
 	Header: wire.BlockHeader{
 		Version:    1,
 		PrevBlock:  chainhash.Hash{},         // 0000000000000000000000000000000000000000000000000000000000000000
-		MerkleRoot: simNetGenesisMerkleRoot,  // 4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b
+		MerkleRoot: simNetGenesisMerkleRoot,  // e0028eb9648db56b1ac77cf090b99048a8007e2bb64b68f092c03c7f56a662c7
 		Timestamp:  time.Unix(1401292357, 0), // 2014-05-28 15:52:37 +0000 UTC
 		Bits:       0x207fffff,               // 545259519 [7fffff0000000000000000000000000000000000000000000000000000000000]
 		Nonce:      2,
@@ -174,10 +286,10 @@ var simNetGenesisBlock = wire.MsgBlock{
 // sigNetGenesisHash is the hash of the first block in the block chain for the
 // signet test network.
 var sigNetGenesisHash = chainhash.Hash{
-	0xf6, 0x1e, 0xee, 0x3b, 0x63, 0xa3, 0x80, 0xa4,
-	0x77, 0xa0, 0x63, 0xaf, 0x32, 0xb2, 0xbb, 0xc9,
-	0x7c, 0x9f, 0xf9, 0xf0, 0x1f, 0x2c, 0x42, 0x25,
-	0xe9, 0x73, 0x98, 0x81, 0x08, 0x00, 0x00, 0x00,
+	0x7b, 0x16, 0x70, 0xee, 0x9a, 0x02, 0x08, 0xd5,
+	0x8d, 0x59, 0x1f, 0x5b, 0x4a, 0x97, 0x63, 0xaa,
+	0x74, 0xaf, 0x5e, 0x8c, 0xba, 0x81, 0xa6, 0x2f,
+	0x79, 0xa8, 0x73, 0x5d, 0x0c, 0x93, 0x5f, 0x4e,
 }
 
 // sigNetGenesisMerkleRoot is the hash of the first transaction in the genesis
@@ -191,7 +303,7 @@ var sigNetGenesisBlock = wire.MsgBlock{
 	Header: wire.BlockHeader{
 		Version:    1,
 		PrevBlock:  chainhash.Hash{},         // 0000000000000000000000000000000000000000000000000000000000000000
-		MerkleRoot: sigNetGenesisMerkleRoot,  // 4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b
+		MerkleRoot: sigNetGenesisMerkleRoot,  // e0028eb9648db56b1ac77cf090b99048a8007e2bb64b68f092c03c7f56a662c7
 		Timestamp:  time.Unix(1598918400, 0), // 2020-09-01 00:00:00 +0000 UTC
 		Bits:       0x1e0377ae,               // 503543726 [00000377ae000000000000000000000000000000000000000000000000000000]
 		Nonce:      52613770,
@@ -0,0 +1,341 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import "fmt"
+
+// LLMQType is the type of quorum
+type LLMQType int
+
+// Enum of LLMQTypes
+// See https://github.com/dashpay/dips/blob/master/dip-0006.md#current-llmq-types and
+// https://github.com/dashpay/dash/blob/master/src/llmq/params.h
+const (
+	LLMQType_50_60            LLMQType = 1   // 50 members, 30 (60%) threshold, one per hour
+	LLMQType_400_60           LLMQType = 2   // 400 members, 240 (60%) threshold, one every 12 hours
+	LLMQType_400_85           LLMQType = 3   // 400 members, 340 (85%) threshold, one every 24 hours
+	LLMQType_100_67           LLMQType = 4   // 100 members, 67 (67%) threshold, one per hour
+	LLMQType_60_75            LLMQType = 5   // 60 members, 45 (75%) threshold, one every 12 hours
+	LLMQType_25_67            LLMQType = 6   // 25 members, 17 (67%) threshold, one per hour
+	LLMQType_TEST             LLMQType = 100 // 3 members, 2 (66%) threshold, one per hour
+	LLMQType_DEVNET           LLMQType = 101 // 12 members, 6 (50%) threshold, one per hour
+	LLMQType_TEST_V17         LLMQType = 102 // 3 members, 2 (66%) threshold, one per hour
+	LLMQType_TEST_DIP0024     LLMQType = 103 // 4 members, 2 (66%) threshold, one per hour
+	LLMQType_TEST_INSTANTSEND LLMQType = 104 // 3 members, 2 (66%) threshold, one per hour
+	LLMQType_DEVNET_DIP0024   LLMQType = 105 // 8 members, 4 (50%) threshold, one per hour
+	LLMQType_TEST_PLATFORM    LLMQType = 106 // 3 members, 2 (66%) threshold, one per hour
+	LLMQType_DEVNET_PLATFORM  LLMQType = 107 // 12 members, 8 (67%) threshold, one per hour
+	LLMQType_SINGLE_NODE      LLMQType = 111 // 1 memeber, 1 threshold, one per hour.
+
+	// LLMQType_5_60 is replaced with LLMQType_TEST to adhere to DIP-0006 naming
+	LLMQType_5_60 LLMQType = LLMQType_TEST
+)
+
+var llmqTypes = map[string]LLMQType{
+	"llmq_50_60":            LLMQType_50_60,
+	"llmq_400_60":           LLMQType_400_60,
+	"llmq_400_85":           LLMQType_400_85,
+	"llmq_100_67":           LLMQType_100_67,
+	"llmq_60_75":            LLMQType_60_75,
+	"llmq_25_67":            LLMQType_25_67,
+	"llmq_test":             LLMQType_TEST,
+	"llmq_devnet":           LLMQType_DEVNET,
+	"llmq_test_v17":         LLMQType_TEST_V17,
+	"llmq_test_dip0024":     LLMQType_TEST_DIP0024,
+	"llmq_test_instantsend": LLMQType_TEST_INSTANTSEND,
+	"llmq_devnet_dip0024":   LLMQType_DEVNET_DIP0024,
+	"llmq_test_platform":    LLMQType_TEST_PLATFORM,
+	"llmq_devnet_platform":  LLMQType_DEVNET_PLATFORM,
+	"llmq_single_node":      LLMQType_SINGLE_NODE,
+}
+
+// LLMQParams defines the parameters of an LLMQ type.
+// See https://github.com/dashpay/dash/blob/master/src/llmq/params.h
+type LLMQParams struct {
+	// Type is the LLMQ type the parameters belong to.
+	Type LLMQType
+
+	// UseRotation specifies whether the quorums of the type are rotated
+	// as defined by DIP-0024.
+	UseRotation bool
+
+	// Size is the number of members of a quorum, which is also the number
+	// of masternodes taking part in its DKG.  MinSize is the minimum number
+	// of valid members for the DKG to succeed and Threshold the number of
+	// signature shares needed to recover a quorum signature.
+	Size      int
+	MinSize   int
+	Threshold int
+
+	// DKGInterval is the number of blocks between the starts of two DKGs.
+	// Rotating quorums start DKGs for all their quorum indexes, one per
+	// block, at every interval.
+	DKGInterval int
+
+	// DKGPhaseBlocks is the number of blocks of each of the phases of a
+	// DKG: initialization, contribution, complaining, justification,
+	// commitment and finalization.
+	DKGPhaseBlocks int
+
+	// DKGMiningWindowStart and DKGMiningWindowEnd are the offsets from the
+	// start of a DKG of the first and last block which may include its
+	// final commitment.
+	DKGMiningWindowStart int
+	DKGMiningWindowEnd   int
+
+	// SigningActiveQuorumCount is the number of most recent quorums of the
+	// type which sign requests.  It is a power of two for rotating
+	// quorums, whose quorum indexes are taken from the request ID.
+	SigningActiveQuorumCount int
+
+	// KeepOldConnections is the number of most recent quorums of the type
+	// whose members keep their connections to each other.
+	KeepOldConnections int
+
+	// RecoveryMembers is the number of quorum members which recover the
+	// quorum signature from the signature shares they receive.
+	RecoveryMembers int
+}
+
+// llmqParams is the table of the parameters of every defined LLMQ type.
+var llmqParams = map[LLMQType]LLMQParams{
+	LLMQType_50_60: {
+		Type:                     LLMQType_50_60,
+		Size:                     50,
+		MinSize:                  40,
+		Threshold:                30,
+		DKGInterval:              24, // one DKG per hour
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     10, // DKGPhaseBlocks * 5 = after finalization
+		DKGMiningWindowEnd:       18,
+		SigningActiveQuorumCount: 24, // a full day worth of LLMQs
+		KeepOldConnections:       25,
+		RecoveryMembers:          25,
+	},
+	LLMQType_400_60: {
+		Type:                     LLMQType_400_60,
+		Size:                     400,
+		MinSize:                  300,
+		Threshold:                240,
+		DKGInterval:              24 * 12, // one DKG every 12 hours
+		DKGPhaseBlocks:           4,
+		DKGMiningWindowStart:     20, // DKGPhaseBlocks * 5 = after finalization
+		DKGMiningWindowEnd:       28,
+		SigningActiveQuorumCount: 4, // two days worth of LLMQs
+		KeepOldConnections:       5,
+		RecoveryMembers:          100,
+	},
+	LLMQType_400_85: {
+		Type:                     LLMQType_400_85,
+		Size:                     400,
+		MinSize:                  350,
+		Threshold:                340,
+		DKGInterval:              24 * 24, // one DKG every 24 hours
+		DKGPhaseBlocks:           4,
+		DKGMiningWindowStart:     20, // DKGPhaseBlocks * 5 = after finalization
+		DKGMiningWindowEnd:       48, // give it a larger mining window to make sure it is mined
+		SigningActiveQuorumCount: 4,  // four days worth of LLMQs
+		KeepOldConnections:       5,
+		RecoveryMembers:          100,
+	},
+	LLMQType_100_67: {
+		Type:                     LLMQType_100_67,
+		Size:                     100,
+		MinSize:                  80,
+		Threshold:                67,
+		DKGInterval:              24, // one DKG per hour
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     10, // DKGPhaseBlocks * 5 = after finalization
+		DKGMiningWindowEnd:       18,
+		SigningActiveQuorumCount: 24, // a full day worth of LLMQs
+		KeepOldConnections:       25,
+		RecoveryMembers:          50,
+	},
+	LLMQType_60_75: {
+		Type:                     LLMQType_60_75,
+		UseRotation:              true,
+		Size:                     60,
+		MinSize:                  50,
+		Threshold:                45,
+		DKGInterval:              24 * 12, // DKGs for all quorum indexes every 12 hours
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     42, // SigningActiveQuorumCount + DKGPhaseBlocks * 5
+		DKGMiningWindowEnd:       50,
+		SigningActiveQuorumCount: 32,
+		KeepOldConnections:       64,
+		RecoveryMembers:          25,
+	},
+	LLMQType_25_67: {
+		Type:                     LLMQType_25_67,
+		Size:                     25,
+		MinSize:                  22,
+		Threshold:                17,
+		DKGInterval:              24, // one DKG per hour
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     10, // DKGPhaseBlocks * 5 = after finalization
+		DKGMiningWindowEnd:       18,
+		SigningActiveQuorumCount: 24, // a full day worth of LLMQs
+		KeepOldConnections:       25,
+		RecoveryMembers:          12,
+	},
+	LLMQType_TEST: {
+		Type:                     LLMQType_TEST,
+		Size:                     3,
+		MinSize:                  2,
+		Threshold:                2,
+		DKGInterval:              24, // one DKG per hour
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     10, // DKGPhaseBlocks * 5 = after finalization
+		DKGMiningWindowEnd:       18,
+		SigningActiveQuorumCount: 2,
+		KeepOldConnections:       3,
+		RecoveryMembers:          3,
+	},
+	LLMQType_DEVNET: {
+		Type:                     LLMQType_DEVNET,
+		Size:                     12,
+		MinSize:                  7,
+		Threshold:                6,
+		DKGInterval:              24, // one DKG per hour
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     10, // DKGPhaseBlocks * 5 = after finalization
+		DKGMiningWindowEnd:       18,
+		SigningActiveQuorumCount: 4,
+		KeepOldConnections:       5,
+		RecoveryMembers:          6,
+	},
+	LLMQType_TEST_V17: {
+		Type:                     LLMQType_TEST_V17,
+		Size:                     3,
+		MinSize:                  2,
+		Threshold:                2,
+		DKGInterval:              24, // one DKG per hour
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     10, // DKGPhaseBlocks * 5 = after finalization
+		DKGMiningWindowEnd:       18,
+		SigningActiveQuorumCount: 2,
+		KeepOldConnections:       3,
+		RecoveryMembers:          3,
+	},
+	LLMQType_TEST_DIP0024: {
+		Type:                     LLMQType_TEST_DIP0024,
+		UseRotation:              true,
+		Size:                     4,
+		MinSize:                  4,
+		Threshold:                2,
+		DKGInterval:              24, // DKGs for all quorum indexes every hour
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     12, // SigningActiveQuorumCount + DKGPhaseBlocks * 5
+		DKGMiningWindowEnd:       20,
+		SigningActiveQuorumCount: 2,
+		KeepOldConnections:       4,
+		RecoveryMembers:          3,
+	},
+	LLMQType_TEST_INSTANTSEND: {
+		Type:                     LLMQType_TEST_INSTANTSEND,
+		Size:                     3,
+		MinSize:                  2,
+		Threshold:                2,
+		DKGInterval:              24, // one DKG per hour
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     10, // DKGPhaseBlocks * 5 = after finalization
+		DKGMiningWindowEnd:       18,
+		SigningActiveQuorumCount: 2,
+		KeepOldConnections:       3,
+		RecoveryMembers:          3,
+	},
+	LLMQType_DEVNET_DIP0024: {
+		Type:                     LLMQType_DEVNET_DIP0024,
+		UseRotation:              true,
+		Size:                     8,
+		MinSize:                  6,
+		Threshold:                4,
+		DKGInterval:              48, // DKGs for all quorum indexes every two hours
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     12, // SigningActiveQuorumCount + DKGPhaseBlocks * 5
+		DKGMiningWindowEnd:       20,
+		SigningActiveQuorumCount: 2,
+		KeepOldConnections:       4,
+		RecoveryMembers:          4,
+	},
+	LLMQType_TEST_PLATFORM: {
+		Type:                     LLMQType_TEST_PLATFORM,
+		Size:                     3,
+		MinSize:                  2,
+		Threshold:                2,
+		DKGInterval:              24, // one DKG per hour
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     10, // DKGPhaseBlocks * 5 = after finalization
+		DKGMiningWindowEnd:       18,
+		SigningActiveQuorumCount: 2,
+		KeepOldConnections:       4,
+		RecoveryMembers:          3,
+	},
+	LLMQType_DEVNET_PLATFORM: {
+		Type:                     LLMQType_DEVNET_PLATFORM,
+		Size:                     12,
+		MinSize:                  9,
+		Threshold:                8,
+		DKGInterval:              24, // one DKG per hour
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     10, // DKGPhaseBlocks * 5 = after finalization
+		DKGMiningWindowEnd:       18,
+		SigningActiveQuorumCount: 4,
+		KeepOldConnections:       5,
+		RecoveryMembers:          6,
+	},
+	LLMQType_SINGLE_NODE: {
+		Type:                     LLMQType_SINGLE_NODE,
+		Size:                     1,
+		MinSize:                  1,
+		Threshold:                1,
+		DKGInterval:              24, // one DKG per hour
+		DKGPhaseBlocks:           2,
+		DKGMiningWindowStart:     10, // DKGPhaseBlocks * 5 = after finalization
+		DKGMiningWindowEnd:       18,
+		SigningActiveQuorumCount: 2,
+		KeepOldConnections:       3,
+		RecoveryMembers:          1,
+	},
+}
+
+// GetLLMQType returns LLMQ type for the given name.
+// Returns 0 when the name is not supported.
+func GetLLMQType(name string) LLMQType {
+	return llmqTypes[name]
+}
+
+// Name returns name of the LLMQType.
+// Returns empty string when the type is invalid.
+// See https://github.com/dashpay/dash/blob/master/src/llmq/params.h
+func (t LLMQType) Name() string {
+	for name, item := range llmqTypes {
+		if t == item {
+			return name
+		}
+	}
+	return ""
+}
+
+// Params returns the parameters of the LLMQType.
+// Returns false when the type is invalid.
+func (t LLMQType) Params() (*LLMQParams, bool) {
+	params, ok := llmqParams[t]
+	if !ok {
+		return nil, false
+	}
+	return &params, true
+}
+
+// Validate checks if provided LLMQ type is valid, eg. if it's one of LLMQ types
+// defined in accordance with DIP-0006.
+// See https://github.com/dashpay/dips/blob/master/dip-0006/llmq-types.md
+func (t LLMQType) Validate() error {
+	if (t >= LLMQType_50_60 && t <= LLMQType_25_67) || (t >= LLMQType_TEST && t <= LLMQType_DEVNET_PLATFORM) || t == LLMQType_SINGLE_NODE {
+		return nil
+	}
+
+	return fmt.Errorf("unsupported quorum type %d", t)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import "testing"
+
+// TestLLMQParams ensures every defined LLMQ type has consistent parameters.
+func TestLLMQParams(t *testing.T) {
+	for name, llmqType := range llmqTypes {
+		params, ok := llmqType.Params()
+		if !ok {
+			t.Errorf("%s: no parameters", name)
+			continue
+		}
+		if params.Type != llmqType {
+			t.Errorf("%s: type got %d, want %d", name, params.Type,
+				llmqType)
+		}
+		if params.Threshold < 1 || params.Threshold > params.MinSize ||
+			params.MinSize > params.Size {
+
+			t.Errorf("%s: want 1 <= threshold %d <= min size %d <= "+
+				"size %d", name, params.Threshold, params.MinSize,
+				params.Size)
+		}
+		if params.RecoveryMembers < 1 ||
+			params.RecoveryMembers > params.Size {
+
+			t.Errorf("%s: recovery members %d out of range", name,
+				params.RecoveryMembers)
+		}
+
+		// The commitment can only be mined after the five phases of
+		// the DKG and before the next DKG starts.
+		if params.DKGMiningWindowStart < 5*params.DKGPhaseBlocks ||
+			params.DKGMiningWindowEnd < params.DKGMiningWindowStart ||
+			params.DKGMiningWindowEnd >= params.DKGInterval {
+
+			t.Errorf("%s: mining window [%d, %d] out of range", name,
+				params.DKGMiningWindowStart,
+				params.DKGMiningWindowEnd)
+		}
+
+		count := params.SigningActiveQuorumCount
+		if params.UseRotation && (count < 1 || count&(count-1) != 0) {
+			t.Errorf("%s: signing active quorum count %d of rotating "+
+				"quorums is not a power of two", name, count)
+		}
+		if params.KeepOldConnections < count {
+			t.Errorf("%s: keep old connections %d below signing "+
+				"active quorum count %d", name,
+				params.KeepOldConnections, count)
+		}
+	}
+
+	if _, ok := LLMQType(0).Params(); ok {
+		t.Errorf("invalid LLMQ type 0 has parameters")
+	}
+}
+
+// TestNetworkLLMQTypes ensures the LLMQ types of every network are defined and
+// that only the DIP-24 InstantSend type uses rotation.
+func TestNetworkLLMQTypes(t *testing.T) {
+	devnet := NewDevnetParams("")
+	nets := []*Params{&MainNetParams, &TestNet3Params, &RegressionNetParams,
+		&SimNetParams, &devnet}
+
+	for _, net := range nets {
+		tests := []struct {
+			name     string
+			llmqType LLMQType
+			rotation bool
+		}{
+			{"ChainLocks", net.LLMQTypeChainLocks, false},
+			{"InstantSend", net.LLMQTypeInstantSend, false},
+			{"DIP0024 InstantSend", net.LLMQTypeDIP0024InstantSend, true},
+			{"Platform", net.LLMQTypePlatform, false},
+		}
+		for _, test := range tests {
+			params, ok := test.llmqType.Params()
+			if !ok {
+				t.Errorf("%s: %s type %d is not defined", net.Name,
+					test.name, test.llmqType)
+				continue
+			}
+			if params.UseRotation != test.rotation {
+				t.Errorf("%s: %s rotation got %v, want %v",
+					net.Name, test.name, params.UseRotation,
+					test.rotation)
+			}
+		}
+	}
+}
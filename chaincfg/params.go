@@ -23,18 +23,17 @@ var (
 	// the overhead of creating it multiple times.
 	bigOne = big.NewInt(1)
 
-	// mainPowLimit is the highest proof of work value a Bitcoin block can
-	// have for the main network.  It is the value 2^224 - 1.
-	mainPowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 224), bigOne)
+	// mainPowLimit is the highest proof of work value a Dash block can
+	// have for the main network.  It is the value 2^236 - 1.
+	mainPowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 236), bigOne)
 
 	// regressionPowLimit is the highest proof of work value a Bitcoin block
 	// can have for the regression test network.  It is the value 2^255 - 1.
 	regressionPowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 255), bigOne)
 
-	// testNet3PowLimit is the highest proof of work value a Bitcoin block
-	// can have for the test network (version 3).  It is the value
-	// 2^224 - 1.
-	testNet3PowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 224), bigOne)
+	// testNet3PowLimit is the highest proof of work value a Dash block
+	// can have for the test network.  It is the value 2^236 - 1.
+	testNet3PowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 236), bigOne)
 
 	// simNetPowLimit is the highest proof of work value a Bitcoin block
 	// can have for the simulation test network.  It is the value 2^255 - 1.
@@ -44,6 +43,11 @@ var (
 	// have for the signet test network. It is the value 0x0377ae << 216.
 	sigNetPowLimit = new(big.Int).Lsh(new(big.Int).SetInt64(0x0377ae), 216)
 
+	// neverStartTime is the start time of deployments which are never
+	// voted on.  Block timestamps are 32-bit unsigned integers, so the
+	// median time past can never reach it.
+	neverStartTime = time.Unix(1<<32, 0)
+
 	// DefaultSignetChallenge is the byte representation of the signet
 	// challenge for the default (public, Taproot enabled) signet network.
 	// This is the binary equivalent of the bitcoin script
@@ -77,6 +81,17 @@ type Checkpoint struct {
 	Hash   *chainhash.Hash
 }
 
+// AssumeUtxo identifies a block whose utxo set may be loaded from a snapshot
+// instead of being built by connecting every block before it.  The snapshot is
+// only accepted when it is for the pinned block and its utxo set commits to the
+// pinned hash.  The blocks before it are validated in the background afterwards.
+type AssumeUtxo struct {
+	Height       int32
+	BlockHash    *chainhash.Hash
+	UtxoSetHash  *chainhash.Hash
+	ChainTxCount uint64
+}
+
 // DNSSeed identifies a DNS seed.
 type DNSSeed struct {
 	// Host defines the hostname of the seed.
@@ -170,6 +185,14 @@ type Params struct {
 	// GenesisHash is the starting block hash.
 	GenesisHash *chainhash.Hash
 
+	// DevnetGenesisBlock defines the second block of a devnet, which
+	// commits to the name of the devnet so that devnets sharing the same
+	// genesis block are told apart.  It is nil for other networks.
+	DevnetGenesisBlock *wire.MsgBlock
+
+	// DevnetGenesisHash is the hash of DevnetGenesisBlock.
+	DevnetGenesisHash *chainhash.Hash
+
 	// PowLimit defines the highest allowed proof of work value for a block
 	// as a uint256.
 	PowLimit *big.Int
@@ -184,14 +207,77 @@ type Params struct {
 	BIP0065Height int32
 	BIP0066Height int32
 
+	// These fields define the block heights at which the specified Dash
+	// Improvement Proposals and hard forks became active.
+	//
+	// DIP0001Height activates 2MB blocks and the lower fee rates.
+	// DIP0003Height activates the special transactions and the
+	// deterministic masternode list, which is only enforced from
+	// DIP0003EnforcementHeight.  DIP0008Height activates ChainLocks,
+	// DIP0020Height the re-enabled opcodes, DIP0024Height the rotating
+	// InstantSend quorums and V19Height the basic BLS scheme.
+	DIP0001Height            int32
+	DIP0003Height            int32
+	DIP0003EnforcementHeight int32
+	DIP0008Height            int32
+	DIP0020Height            int32
+	DIP0024Height            int32
+	V19Height                int32
+
 	// CoinbaseMaturity is the number of blocks required before newly mined
 	// coins (coinbase transactions) can be spent.
 	CoinbaseMaturity uint16
 
 	// SubsidyReductionInterval is the interval of blocks before the subsidy
-	// is reduced.
+	// is reduced.  Dash reduces the subsidy by 1/14 every interval.
 	SubsidyReductionInterval int32
 
+	// BudgetPaymentsStartHeight is the block height after which a tenth
+	// of the subsidy of every block is set aside for the superblocks which
+	// pay the budget of the governance system.
+	BudgetPaymentsStartHeight int32
+
+	// SuperblockStartHeight is the height of the first superblock and
+	// SuperblockCycle is the number of blocks between superblocks.
+	SuperblockStartHeight int32
+	SuperblockCycle       int32
+
+	// MasternodePaymentsStartHeight is the block height from which blocks
+	// pay masternodes.  The masternode share started at 20% of the block
+	// reward and was increased at MasternodePaymentsIncreaseHeight and
+	// every MasternodePaymentsIncreasePeriod blocks after it until it
+	// reached 50%.
+	MasternodePaymentsStartHeight    int32
+	MasternodePaymentsIncreaseHeight int32
+	MasternodePaymentsIncreasePeriod int32
+
+	// MasternodeMinimumConfirmations is the number of blocks a masternode
+	// registration needs before the masternode is confirmed and can be
+	// selected for quorums.
+	MasternodeMinimumConfirmations int32
+
+	// BRRHeight is the block height at which the block reward reallocation
+	// activated, which moves the masternode share from 50% to 60% over the
+	// superblock cycles following it.
+	BRRHeight int32
+
+	// V20Height is the block height at which the v20 hard fork activated,
+	// which fixes the base subsidy instead of deriving it from the
+	// difficulty.
+	V20Height int32
+
+	// MNRRHeight is the block height at which the masternode reward
+	// reallocation activated, which raises the treasury share to 20% and
+	// the masternode share to 60% of the subsidy, of which 37.5% is paid to
+	// the Platform credit pool.
+	MNRRHeight int32
+
+	// HighSubsidyBlocks is the number of blocks after the genesis block
+	// whose subsidy is multiplied by HighSubsidyFactor.  This is only used
+	// by devnets to fund the initial masternodes.
+	HighSubsidyBlocks int32
+	HighSubsidyFactor int64
+
 	// TargetTimespan is the desired amount of time that should elapse
 	// before the block difficulty requirement is examined to determine how
 	// it should be changed in order to maintain the desired block
@@ -219,19 +305,57 @@ type Params struct {
 	// NOTE: This only applies if ReduceMinDifficulty is true.
 	MinDiffReductionTime time.Duration
 
+	// PowNoRetargeting defines whether the difficulty is never retargeted.
+	// This is only useful for regression testing.
+	PowNoRetargeting bool
+
+	// PowKGWHeight is the block height from which the difficulty is
+	// retargeted every block with Kimoto Gravity Well instead of every
+	// TargetTimespan with the Bitcoin rules.
+	PowKGWHeight int32
+
+	// PowDGWHeight is the block height from which the difficulty is
+	// retargeted every block with Dark Gravity Wave v3 over the last 24
+	// blocks, spaced TargetTimePerBlock apart.  It must not be lower than
+	// PowKGWHeight.
+	PowDGWHeight int32
+
+	// MinimumDifficultyBlocks is the number of blocks after the genesis
+	// block which are mined at the minimum difficulty.  This is only used
+	// by devnets.
+	MinimumDifficultyBlocks int32
+
 	// GenerateSupported specifies whether or not CPU mining is allowed.
 	GenerateSupported bool
 
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints []Checkpoint
 
+	// AssumeUtxos are the blocks whose utxo set snapshots may be loaded
+	// ordered from oldest to newest.
+	AssumeUtxos []AssumeUtxo
+
+	// These fields define the LLMQ types which sign ChainLocks (DIP0008),
+	// InstantSend locks before and after DIP0024 and Platform blocks.  The
+	// parameters of each type are returned by LLMQType.Params.
+	LLMQTypeChainLocks         LLMQType
+	LLMQTypeInstantSend        LLMQType
+	LLMQTypeDIP0024InstantSend LLMQType
+	LLMQTypePlatform           LLMQType
+
+	// SporkAddresses are the P2PKH addresses of the keys which may sign
+	// sporks.  MinSporkKeys is the number of those keys which must sign
+	// the same value for a spork to take it.
+	SporkAddresses []string
+	MinSporkKeys   int
+
 	// These fields are related to voting on consensus rule changes as
 	// defined by BIP0009.
 	//
 	// RuleChangeActivationThreshold is the number of blocks in a threshold
 	// state retarget window for which a positive vote for a rule change
-	// must be cast in order to lock in a rule change. It should typically
-	// be 95% for the main network and 75% for test networks.
+	// must be cast in order to lock in a rule change. Dash uses 80% for
+	// the main network, testnet and devnets.
 	//
 	// MinerConfirmationWindow is the number of blocks in each threshold
 	// state retarget window.
@@ -265,68 +389,94 @@ type Params struct {
 	HDCoinType uint32
 }
 
-// MainNetParams defines the network parameters for the main Bitcoin network.
+// MainNetParams defines the network parameters for the main Dash network.
 var MainNetParams = Params{
 	Name:        "main",
 	Net:         wire.MainNet,
 	DefaultPort: "9999",
 	DNSSeeds: []DNSSeed{
 		{"dnsseed.dash.org", true},
-		{"dnsseed.dashdot.io", true},
 	},
 
 	// Chain parameters
-	GenesisBlock:             &genesisBlock,
-	GenesisHash:              &genesisHash,
-	PowLimit:                 mainPowLimit,
-	PowLimitBits:             0x1d00ffff,
-	BIP0034Height:            227931, // 000000000000024b89b42a942fe0d9fea3bb44ab7bd1b19115dd6a759c0808b8
-	BIP0065Height:            388381, // 000000000000000004c2b624ed5d7756c508d90fd0da2c7c679febfa6c4735f0
-	BIP0066Height:            363725, // 00000000000000000379eaa19dce8c9b722d46ae6a57c2f1a988119488b50931
-	CoinbaseMaturity:         100,
-	SubsidyReductionInterval: 210000,
-	TargetTimespan:           time.Hour * 24 * 14, // 14 days
-	TargetTimePerBlock:       time.Minute * 10,    // 10 minutes
-	RetargetAdjustmentFactor: 4,                   // 25% less, 400% more
-	ReduceMinDifficulty:      false,
-	MinDiffReductionTime:     0,
-	GenerateSupported:        false,
+	GenesisBlock:                     &genesisBlock,
+	GenesisHash:                      &genesisHash,
+	PowLimit:                         mainPowLimit,
+	PowLimitBits:                     0x1e0fffff,
+	BIP0034Height:                    951,    // 000001f35e70f7c5705f64c6c5cc3dea9449e74d5b5c7cf74dad1bcca14a8012
+	BIP0065Height:                    619382, // 00000000000076d8fcea02ec0963de4abfd01e771fec0863f960c2c64fe6f357
+	BIP0066Height:                    245817, // 00000000000b1fa2dfa312863570e13fae9ca7b5566cb27e55422620b469aefa
+	DIP0001Height:                    782208,
+	DIP0003Height:                    1028160,
+	DIP0003EnforcementHeight:         1047200,
+	DIP0008Height:                    1088640,
+	DIP0020Height:                    1516032,
+	DIP0024Height:                    1737792,
+	V19Height:                        1899072,
+	CoinbaseMaturity:                 100,
+	SubsidyReductionInterval:         210240,
+	BudgetPaymentsStartHeight:        328008,
+	SuperblockStartHeight:            614820,
+	SuperblockCycle:                  16616,
+	MasternodePaymentsStartHeight:    100000,
+	MasternodePaymentsIncreaseHeight: 158000,
+	MasternodePaymentsIncreasePeriod: 576 * 30,
+	MasternodeMinimumConfirmations:   15,
+	BRRHeight:                        1374912,
+	V20Height:                        1987776,
+	MNRRHeight:                       2128896,
+	TargetTimespan:                   time.Hour * 24,    // 1 day
+	TargetTimePerBlock:               time.Second * 150, // 2.5 minutes
+	RetargetAdjustmentFactor:         4,                 // 25% less, 400% more
+	ReduceMinDifficulty:              false,
+	MinDiffReductionTime:             0,
+	PowKGWHeight:                     15200,
+	PowDGWHeight:                     34140,
+	GenerateSupported:                false,
 
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints: []Checkpoint{
-		{11111, newHashFromStr("0000000069e244f73d78e8fd29ba2fd2ed618bd6fa2ee92559f542fdb26e7c1d")},
-		{33333, newHashFromStr("000000002dd5588a74784eaa7ab0507a18ad16a236e7b1ce69f00d7ddfb5d0a6")},
-		{74000, newHashFromStr("0000000000573993a3c9e41ce34471c079dcf5f52a0e824a81e7f953b8661a20")},
-		{105000, newHashFromStr("00000000000291ce28027faea320c8d2b054b2e0fe44a773f3eefb151d6bdc97")},
-		{134444, newHashFromStr("00000000000005b12ffd4cd315cd34ffd4a594f430ac814c91184a0d42d2b0fe")},
-		{168000, newHashFromStr("000000000000099e61ea72015e79632f216fe6cb33d7899acb35b75c8303b763")},
-		{193000, newHashFromStr("000000000000059f452a5f7340de6682a977387c17010ff6e6c3bd83ca8b1317")},
-		{210000, newHashFromStr("000000000000048b95347e83192f69cf0366076336c639f9b7228e9ba171342e")},
-		{216116, newHashFromStr("00000000000001b4f4b433e81ee46494af945cf96014816a4e2370f11b23df4e")},
-		{225430, newHashFromStr("00000000000001c108384350f74090433e7fcf79a606b8e797f065b130575932")},
-		{250000, newHashFromStr("000000000000003887df1f29024b06fc2200b55f8af8f35453d7be294df2d214")},
-		{267300, newHashFromStr("000000000000000a83fbd660e918f218bf37edd92b748ad940483c7c116179ac")},
-		{279000, newHashFromStr("0000000000000001ae8c72a0b0c301f67e3afca10e819efa9041e458e9bd7e40")},
-		{300255, newHashFromStr("0000000000000000162804527c6e9b9f0563a280525f9d08c12041def0a0f3b2")},
-		{319400, newHashFromStr("000000000000000021c6052e9becade189495d1c539aa37c58917305fd15f13b")},
-		{343185, newHashFromStr("0000000000000000072b8bf361d01a6ba7d445dd024203fafc78768ed4368554")},
-		{352940, newHashFromStr("000000000000000010755df42dba556bb72be6a32f3ce0b6941ce4430152c9ff")},
-		{382320, newHashFromStr("00000000000000000a8dc6ed5b133d0eb2fd6af56203e4159789b092defd8ab2")},
-		{400000, newHashFromStr("000000000000000004ec466ce4732fe6f1ed1cddc2ed4b328fff5224276e3f6f")},
-		{430000, newHashFromStr("000000000000000001868b2bb3a285f3cc6b33ea234eb70facf4dcdf22186b87")},
-		{460000, newHashFromStr("000000000000000000ef751bbce8e744ad303c47ece06c8d863e4d417efc258c")},
-		{490000, newHashFromStr("000000000000000000de069137b17b8d5a3dfbd5b145b2dcfb203f15d0c4de90")},
-		{520000, newHashFromStr("0000000000000000000d26984c0229c9f6962dc74db0a6d525f2f1640396f69c")},
-		{550000, newHashFromStr("000000000000000000223b7a2298fb1c6c75fb0efc28a4c56853ff4112ec6bc9")},
-		{560000, newHashFromStr("0000000000000000002c7b276daf6efb2b6aa68e2ce3be67ef925b3264ae7122")},
+		{1500, newHashFromStr("000000aaf0300f59f49bc3e970bad15c11f961fe2347accffff19d96ec9778e3")},
+		{4991, newHashFromStr("000000003b01809551952460744d5dbb8fcbd6cbae3c220267bf7fa43f837367")},
+		{9918, newHashFromStr("00000000213e229f332c0ffbe34defdaa9e74de87f2d8d1f01af8d121c3c170b")},
+		{16912, newHashFromStr("00000000075c0d10371d55a60634da70f197548dbbfa4123e12abfcbc5738af9")},
+		{23912, newHashFromStr("0000000000335eac6703f3b1732ec8b2f89c3ba3a7889e5767b090556bb9a276")},
+		{35457, newHashFromStr("0000000000b0ae211be59b048df14820475ad0dd53b9ff83b010f71a77342d9f")},
+		{45479, newHashFromStr("000000000063d411655d590590e16960f15ceea4257122ac430c6fbe39fbf02d")},
+		{55895, newHashFromStr("0000000000ae4c53a43639a4ca027282f69da9c67ba951768a20415b6439a2d7")},
+		{68899, newHashFromStr("0000000000194ab4d3d9eeb1f2f792f21bb39ff767cb547fe977640f969d77b7")},
+		{74619, newHashFromStr("000000000011d28f38f05d01650a502cc3f4d0e793fbc26e2a2ca71f07dc3842")},
+		{75095, newHashFromStr("0000000000193d12f6ad352a9996ee58ef8bdc4946818a5fec5ce99c11b87f0d")},
+		{88805, newHashFromStr("00000000001392f1652e9bf45cd8bc79dc60fe935277cd11538565b4a94fa85f")},
+		{107996, newHashFromStr("00000000000a23840ac16115407488267aa3da2b9bc843e301185b7d17e4dc40")},
+		{137993, newHashFromStr("00000000000cf69ce152b1bffdeddc59188d7a80879210d6e5c9503011929c3c")},
+		{167996, newHashFromStr("000000000009486020a80f7f2cc065342b0c2fb59af5e090cd813dba68ab0fed")},
+		{207992, newHashFromStr("00000000000d85c22be098f74576ef00b7aa00c05777e966aff68a270f1e01a5")},
+		{312645, newHashFromStr("0000000000059dcb71ad35a9e40526c44e7aae6c99169a9e7017b7d84b1c2daf")},
+		{407452, newHashFromStr("000000000003c6a87e73623b9d70af7cd908ae22fee466063e4ffc20be1d2dbc")},
+		{523412, newHashFromStr("000000000000e54f036576a10597e0e42cc22a5159ce572f999c33975e121d4d")},
+		{523930, newHashFromStr("0000000000000bccdb11c2b1cfb0ecab452abf267d89b7f46eaf2d54ce6e652c")},
 	},
 
+	// Blocks whose utxo set snapshots may be loaded.
+	AssumeUtxos: nil,
+
+	// LLMQ types used by ChainLocks, InstantSend and Platform.
+	LLMQTypeChainLocks:         LLMQType_400_60,
+	LLMQTypeInstantSend:        LLMQType_50_60,
+	LLMQTypeDIP0024InstantSend: LLMQType_60_75,
+	LLMQTypePlatform:           LLMQType_100_67,
+
+	// Addresses of the spork keys.
+	SporkAddresses: []string{"Xgtyuk76vhuFW2iT7UAiHgNdWXCf3J34wh"},
+	MinSporkKeys:   1,
+
 	// Consensus rule change deployments.
 	//
-	// The miner confirmation window is defined as:
-	//   target proof of work timespan / target proof of work spacing
-	RuleChangeActivationThreshold: 1916, // 95% of MinerConfirmationWindow
-	MinerConfirmationWindow:       2016, //
+	// Like the hard forks of Dash Core, deployments are voted on in windows
+	// of 4032 blocks, about a week, and lock in at 80% of a window.
+	RuleChangeActivationThreshold: 3226, // 80% of MinerConfirmationWindow
+	MinerConfirmationWindow:       4032,
 	Deployments: [DefinedDeployments]ConsensusDeployment{
 		DeploymentTestDummy: {
 			BitNumber: 28,
@@ -339,7 +489,7 @@ var MainNetParams = Params{
 		},
 		DeploymentTestDummyMinActivation: {
 			BitNumber:                 22,
-			CustomActivationThreshold: 1815,    // Only needs 90% hash rate.
+			CustomActivationThreshold: 3629,    // Only needs 90% hash rate.
 			MinActivationHeight:       10_0000, // Can only activate after height 10k.
 			DeploymentStarter: NewMedianTimeDeploymentStarter(
 				time.Time{}, // Always available for vote
@@ -351,19 +501,19 @@ var MainNetParams = Params{
 		DeploymentCSV: {
 			BitNumber: 0,
 			DeploymentStarter: NewMedianTimeDeploymentStarter(
-				time.Unix(1462060800, 0), // May 1st, 2016
+				time.Unix(1486252800, 0), // February 5th, 2017
 			),
 			DeploymentEnder: NewMedianTimeDeploymentEnder(
-				time.Unix(1493596800, 0), // May 1st, 2017
+				time.Unix(1517788800, 0), // February 5th, 2018
 			),
 		},
 		DeploymentSegwit: {
 			BitNumber: 1,
 			DeploymentStarter: NewMedianTimeDeploymentStarter(
-				time.Unix(1479168000, 0), // November 15, 2016 UTC
+				neverStartTime, // Dash does not support segwit
 			),
 			DeploymentEnder: NewMedianTimeDeploymentEnder(
-				time.Unix(1510704000, 0), // November 15, 2017 UTC.
+				time.Time{}, // Never expires.
 			),
 		},
 	},
@@ -372,8 +522,8 @@ var MainNetParams = Params{
 	RelayNonStdTxs: false,
 
 	// Human-readable part for Bech32 encoded segwit addresses, as defined in
-	// BIP 173.
-	Bech32HRPSegwit: "bc", // always bc for main net
+	// BIP 173.  Dash does not support segwit addresses.
+	Bech32HRPSegwit: "",
 
 	// Address encoding magics
 	PubKeyHashAddrID:        0x4C, // starts with X
@@ -401,29 +551,71 @@ var RegressionNetParams = Params{
 	DNSSeeds:    []DNSSeed{},
 
 	// Chain parameters
-	GenesisBlock:             &regTestGenesisBlock,
-	GenesisHash:              &regTestGenesisHash,
-	PowLimit:                 regressionPowLimit,
-	PowLimitBits:             0x207fffff,
-	CoinbaseMaturity:         100,
-	BIP0034Height:            100000000, // Not active - Permit ver 1 blocks
-	BIP0065Height:            1351,      // Used by regression tests
-	BIP0066Height:            1251,      // Used by regression tests
-	SubsidyReductionInterval: 150,
-	TargetTimespan:           time.Hour * 24 * 14, // 14 days
-	TargetTimePerBlock:       time.Minute * 10,    // 10 minutes
-	RetargetAdjustmentFactor: 4,                   // 25% less, 400% more
-	ReduceMinDifficulty:      true,
-	MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
-	GenerateSupported:        true,
+	GenesisBlock:                     &regTestGenesisBlock,
+	GenesisHash:                      &regTestGenesisHash,
+	PowLimit:                         regressionPowLimit,
+	PowLimitBits:                     0x207fffff,
+	CoinbaseMaturity:                 100,
+	BIP0034Height:                    500,  // Used by regression tests
+	BIP0065Height:                    1351, // Used by regression tests
+	BIP0066Height:                    1251, // Used by regression tests
+	DIP0001Height:                    2000,
+	DIP0003Height:                    432,
+	DIP0003EnforcementHeight:         500,
+	DIP0008Height:                    432,
+	DIP0020Height:                    300,
+	DIP0024Height:                    900,
+	V19Height:                        900,
+	SubsidyReductionInterval:         150,
+	BudgetPaymentsStartHeight:        1000,
+	SuperblockStartHeight:            1500,
+	SuperblockCycle:                  10,
+	MasternodePaymentsStartHeight:    240,
+	MasternodePaymentsIncreaseHeight: 350,
+	MasternodePaymentsIncreasePeriod: 10,
+	MasternodeMinimumConfirmations:   1,
+	BRRHeight:                        2500,              // Used by regression tests
+	V20Height:                        900,               // Used by regression tests
+	MNRRHeight:                       900,               // Used by regression tests
+	TargetTimespan:                   time.Hour * 24,    // 1 day
+	TargetTimePerBlock:               time.Second * 150, // 2.5 minutes
+	RetargetAdjustmentFactor:         4,                 // 25% less, 400% more
+	ReduceMinDifficulty:              true,
+	MinDiffReductionTime:             time.Minute * 5, // TargetTimePerBlock * 2
+	PowNoRetargeting:                 true,
+	PowKGWHeight:                     15200,
+	PowDGWHeight:                     34140,
+	GenerateSupported:                true,
 
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints: nil,
 
+	// Blocks whose utxo set snapshots may be loaded.  The snapshot is of
+	// the chain of coinbases paying to OP_TRUE which is created by the
+	// regression tests of the utxo set snapshots.
+	AssumeUtxos: []AssumeUtxo{
+		{
+			Height:       110,
+			BlockHash:    newHashFromStr("1e940d08030e3e96d7c61c7946b2eabcbf9ca0e4d20e3a77e79717c8f695d5d6"),
+			UtxoSetHash:  newHashFromStr("30f00b37ea212341ede449d22e12506de561a5f67a20bfeb9d44fdeb35217c6e"),
+			ChainTxCount: 111,
+		},
+	},
+
+	// LLMQ types used by ChainLocks, InstantSend and Platform.
+	LLMQTypeChainLocks:         LLMQType_TEST,
+	LLMQTypeInstantSend:        LLMQType_TEST_INSTANTSEND,
+	LLMQTypeDIP0024InstantSend: LLMQType_TEST_DIP0024,
+	LLMQTypePlatform:           LLMQType_TEST_PLATFORM,
+
+	// Addresses of the spork keys.
+	SporkAddresses: []string{"yj949n1UH6fDhw6HtVE5VMj2iSTaSWBMcW"},
+	MinSporkKeys:   1,
+
 	// Consensus rule change deployments.
 	//
-	// The miner confirmation window is defined as:
-	//   target proof of work timespan / target proof of work spacing
+	// These are the window and threshold of Dash Core on regtest, which are
+	// kept short so deployments can be tested quickly.
 	RuleChangeActivationThreshold: 108, // 75%  of MinerConfirmationWindow
 	MinerConfirmationWindow:       144,
 	Deployments: [DefinedDeployments]ConsensusDeployment{
@@ -459,7 +651,7 @@ var RegressionNetParams = Params{
 		DeploymentSegwit: {
 			BitNumber: 1,
 			DeploymentStarter: NewMedianTimeDeploymentStarter(
-				time.Time{}, // Always available for vote
+				neverStartTime, // Dash does not support segwit
 			),
 			DeploymentEnder: NewMedianTimeDeploymentEnder(
 				time.Time{}, // Never expires.
@@ -471,8 +663,8 @@ var RegressionNetParams = Params{
 	RelayNonStdTxs: true,
 
 	// Human-readable part for Bech32 encoded segwit addresses, as defined in
-	// BIP 173.
-	Bech32HRPSegwit: "bcrt", // always bcrt for reg test net
+	// BIP 173.  Dash does not support segwit addresses.
+	Bech32HRPSegwit: "",
 
 	// Address encoding magics
 	PubKeyHashAddrID: 0x8C, // starts with y
@@ -500,21 +692,40 @@ var TestNet3Params = Params{
 	},
 
 	// Chain parameters
-	GenesisBlock:             &testNet3GenesisBlock,
-	GenesisHash:              &testNet3GenesisHash,
-	PowLimit:                 testNet3PowLimit,
-	PowLimitBits:             0x1d00ffff,
-	BIP0034Height:            21111,  // 0000000023b3a96d3484e5abb3755c413e7d41500f8e2a5c3f0dd01299cd8ef8
-	BIP0065Height:            581885, // 00000000007f6655f22f98e72ed80d8b06dc761d5da09df0fa1dc4be4f861eb6
-	BIP0066Height:            330776, // 000000002104c8c45e99a8853285a3b592602a3ccde2b832481da85e9e4ba182
-	CoinbaseMaturity:         100,
-	SubsidyReductionInterval: 210000,
-	TargetTimespan:           time.Hour * 24 * 14, // 14 days
-	TargetTimePerBlock:       time.Minute * 10,    // 10 minutes
-	RetargetAdjustmentFactor: 4,                   // 25% less, 400% more
-	ReduceMinDifficulty:      true,
-	MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
-	GenerateSupported:        false,
+	GenesisBlock:                     &testNet3GenesisBlock,
+	GenesisHash:                      &testNet3GenesisHash,
+	PowLimit:                         testNet3PowLimit,
+	PowLimitBits:                     0x1e0fffff,
+	BIP0034Height:                    76,   // 0000082f5939c2154dbcba35f784530d12e9d72472fcfaf29674ea312cdf4c83
+	BIP0065Height:                    2431, // 0000039cf01242c7f921dcb4806a5994bc003b48c1973ae0c89b67809c2bb2ab
+	BIP0066Height:                    2075, // 0000002acdd29a14583540cb72e1c5cc83783560e38fa7081495d474fe1671f7
+	DIP0001Height:                    5500,
+	DIP0003Height:                    7000,
+	DIP0003EnforcementHeight:         7300,
+	DIP0008Height:                    78800,
+	DIP0020Height:                    414100,
+	DIP0024Height:                    769700,
+	V19Height:                        850100,
+	CoinbaseMaturity:                 100,
+	SubsidyReductionInterval:         210240,
+	BudgetPaymentsStartHeight:        4100,
+	SuperblockStartHeight:            4200,
+	SuperblockCycle:                  24,
+	MasternodePaymentsStartHeight:    4010,
+	MasternodePaymentsIncreaseHeight: 4030,
+	MasternodePaymentsIncreasePeriod: 10,
+	MasternodeMinimumConfirmations:   1,
+	BRRHeight:                        387500,
+	V20Height:                        905100,
+	MNRRHeight:                       1066900,
+	TargetTimespan:                   time.Hour * 24,    // 1 day
+	TargetTimePerBlock:               time.Second * 150, // 2.5 minutes
+	RetargetAdjustmentFactor:         4,                 // 25% less, 400% more
+	ReduceMinDifficulty:              true,
+	MinDiffReductionTime:             time.Minute * 5, // TargetTimePerBlock * 2
+	PowKGWHeight:                     4002,
+	PowDGWHeight:                     4002,
+	GenerateSupported:                false,
 
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints: []Checkpoint{
@@ -527,12 +738,25 @@ var TestNet3Params = Params{
 		{470000, newHashFromStr("0000009303aeadf8cf3812f5c869691dbd4cb118ad20e9bf553be434bafe6a52")},
 	},
 
+	// Blocks whose utxo set snapshots may be loaded.
+	AssumeUtxos: nil,
+
+	// LLMQ types used by ChainLocks, InstantSend and Platform.
+	LLMQTypeChainLocks:         LLMQType_50_60,
+	LLMQTypeInstantSend:        LLMQType_50_60,
+	LLMQTypeDIP0024InstantSend: LLMQType_60_75,
+	LLMQTypePlatform:           LLMQType_25_67,
+
+	// Addresses of the spork keys.
+	SporkAddresses: []string{"yjPtiKh2uwk3bDutTEA2q9mCtXyiZRWn55"},
+	MinSporkKeys:   1,
+
 	// Consensus rule change deployments.
 	//
-	// The miner confirmation window is defined as:
-	//   target proof of work timespan / target proof of work spacing
-	RuleChangeActivationThreshold: 1512, // 75% of MinerConfirmationWindow
-	MinerConfirmationWindow:       2016,
+	// Like the hard forks of Dash Core on testnet, deployments are voted on
+	// in windows of 100 blocks and lock in at 80% of a window.
+	RuleChangeActivationThreshold: 80, // 80% of MinerConfirmationWindow
+	MinerConfirmationWindow:       100,
 	Deployments: [DefinedDeployments]ConsensusDeployment{
 		DeploymentTestDummy: {
 			BitNumber: 28,
@@ -557,19 +781,19 @@ var TestNet3Params = Params{
 		DeploymentCSV: {
 			BitNumber: 0,
 			DeploymentStarter: NewMedianTimeDeploymentStarter(
-				time.Unix(1456790400, 0), // March 1st, 2016
+				time.Unix(1506556800, 0), // September 28th, 2017
 			),
 			DeploymentEnder: NewMedianTimeDeploymentEnder(
-				time.Unix(1493596800, 0), // May 1st, 2017
+				time.Unix(1538092800, 0), // September 28th, 2018
 			),
 		},
 		DeploymentSegwit: {
 			BitNumber: 1,
 			DeploymentStarter: NewMedianTimeDeploymentStarter(
-				time.Unix(1462060800, 0), // May 1, 2016 UTC
+				neverStartTime, // Dash does not support segwit
 			),
 			DeploymentEnder: NewMedianTimeDeploymentEnder(
-				time.Unix(1493596800, 0), // May 1, 2017 UTC.
+				time.Time{}, // Never expires.
 			),
 		},
 	},
@@ -578,8 +802,8 @@ var TestNet3Params = Params{
 	RelayNonStdTxs: true,
 
 	// Human-readable part for Bech32 encoded segwit addresses, as defined in
-	// BIP 173.
-	Bech32HRPSegwit: "tb", // always tb for test net
+	// BIP 173.  Dash does not support segwit addresses.
+	Bech32HRPSegwit: "",
 
 	// Address encoding magics
 	PubKeyHashAddrID:        0x8C, // starts with m or n
@@ -597,6 +821,156 @@ var TestNet3Params = Params{
 	HDCoinType: 1,
 }
 
+// NewDevnetParams returns the network parameters for the Dash development
+// network with the given name.  All devnets share the same magic, ports and
+// genesis block and are told apart by their devnet genesis block, which is the
+// second block of the chain and commits to the name of the devnet.  Devnets are
+// not registered by default.
+func NewDevnetParams(name string) Params {
+	devnetName := "devnet"
+	if name != "" {
+		devnetName += "-" + name
+	}
+	devnetGenesisBlock := newDevnetGenesisBlock(&devNetGenesisBlock,
+		devnetName)
+	devnetGenesisHash := devnetGenesisBlock.BlockHash()
+
+	return Params{
+		Name:        devnetName,
+		Net:         wire.DevNet,
+		DefaultPort: "19799",
+		DNSSeeds:    []DNSSeed{},
+
+		// Chain parameters
+		GenesisBlock:                     &devNetGenesisBlock,
+		GenesisHash:                      &devNetGenesisHash,
+		DevnetGenesisBlock:               devnetGenesisBlock,
+		DevnetGenesisHash:                &devnetGenesisHash,
+		PowLimit:                         regressionPowLimit,
+		PowLimitBits:                     0x207fffff,
+		BIP0034Height:                    1, // BIP34 activated immediately on devnet
+		BIP0065Height:                    1, // BIP65 activated immediately on devnet
+		BIP0066Height:                    1, // BIP66 activated immediately on devnet
+		DIP0001Height:                    2, // DIP0001 activated immediately on devnet
+		DIP0003Height:                    2, // DIP0003 activated immediately on devnet
+		DIP0003EnforcementHeight:         2, // DIP0003 enforced immediately on devnet
+		DIP0008Height:                    2, // DIP0008 activated immediately on devnet
+		DIP0020Height:                    300,
+		DIP0024Height:                    300,
+		V19Height:                        300,
+		CoinbaseMaturity:                 100,
+		SubsidyReductionInterval:         210240,
+		BudgetPaymentsStartHeight:        4100,
+		SuperblockStartHeight:            4200,
+		SuperblockCycle:                  24,
+		MasternodePaymentsStartHeight:    4010,
+		MasternodePaymentsIncreaseHeight: 4030,
+		MasternodePaymentsIncreasePeriod: 10,
+		MasternodeMinimumConfirmations:   1,
+		BRRHeight:                        300,
+		V20Height:                        300,
+		MNRRHeight:                       300,
+		HighSubsidyBlocks:                500,
+		HighSubsidyFactor:                10,
+		TargetTimespan:                   time.Hour * 24,    // 1 day
+		TargetTimePerBlock:               time.Second * 150, // 2.5 minutes
+		RetargetAdjustmentFactor:         4,                 // 25% less, 400% more
+		ReduceMinDifficulty:              true,
+		MinDiffReductionTime:             time.Minute * 5, // TargetTimePerBlock * 2
+		PowKGWHeight:                     4001,            // nPowKGWHeight >= nPowDGWHeight means "no KGW"
+		PowDGWHeight:                     4001,
+		MinimumDifficultyBlocks:          4032,
+		GenerateSupported:                true,
+
+		// Checkpoints ordered from oldest to newest.  The devnet genesis
+		// block is checkpointed so nodes of different devnets never
+		// follow each other's chains.
+		Checkpoints: []Checkpoint{
+			{1, &devnetGenesisHash},
+		},
+
+		// Blocks whose utxo set snapshots may be loaded.
+		AssumeUtxos: nil,
+
+		// LLMQ types used by ChainLocks, InstantSend and Platform.
+		LLMQTypeChainLocks:         LLMQType_DEVNET,
+		LLMQTypeInstantSend:        LLMQType_DEVNET,
+		LLMQTypeDIP0024InstantSend: LLMQType_DEVNET_DIP0024,
+		LLMQTypePlatform:           LLMQType_DEVNET_PLATFORM,
+
+		// Addresses of the spork keys.
+		SporkAddresses: []string{"yjPtiKh2uwk3bDutTEA2q9mCtXyiZRWn55"},
+		MinSporkKeys:   1,
+
+		// Consensus rule change deployments.
+		//
+		// Like the hard forks of Dash Core on devnets, deployments are voted
+		// on in windows of 100 blocks and lock in at 80% of a window.
+		RuleChangeActivationThreshold: 80, // 80% of MinerConfirmationWindow
+		MinerConfirmationWindow:       100,
+		Deployments: [DefinedDeployments]ConsensusDeployment{
+			DeploymentTestDummy: {
+				BitNumber: 28,
+				DeploymentStarter: NewMedianTimeDeploymentStarter(
+					time.Time{}, // Always available for vote
+				),
+				DeploymentEnder: NewMedianTimeDeploymentEnder(
+					time.Time{}, // Never expires
+				),
+			},
+			DeploymentTestDummyMinActivation: {
+				BitNumber:                 22,
+				CustomActivationThreshold: 1008, // Only needs 50% hash rate.
+				MinActivationHeight:       4032, // Can only activate after height 4032.
+				DeploymentStarter: NewMedianTimeDeploymentStarter(
+					time.Time{}, // Always available for vote
+				),
+				DeploymentEnder: NewMedianTimeDeploymentEnder(
+					time.Time{}, // Never expires
+				),
+			},
+			DeploymentCSV: {
+				BitNumber: 0,
+				DeploymentStarter: NewMedianTimeDeploymentStarter(
+					time.Time{}, // Always available for vote
+				),
+				DeploymentEnder: NewMedianTimeDeploymentEnder(
+					time.Time{}, // Never expires
+				),
+			},
+			DeploymentSegwit: {
+				BitNumber: 1,
+				DeploymentStarter: NewMedianTimeDeploymentStarter(
+					neverStartTime, // Dash does not support segwit
+				),
+				DeploymentEnder: NewMedianTimeDeploymentEnder(
+					time.Time{}, // Never expires.
+				),
+			},
+		},
+
+		// Mempool parameters
+		RelayNonStdTxs: true,
+
+		// Human-readable part for Bech32 encoded segwit addresses, as
+		// defined in BIP 173.  Dash does not support segwit addresses.
+		Bech32HRPSegwit: "",
+
+		// Address encoding magics
+		PubKeyHashAddrID: 0x8C, // starts with y
+		ScriptHashAddrID: 0x13, // starts with 8 or 9
+		PrivateKeyID:     0xEF, // starts with 9 (uncompressed) or c (compressed)
+
+		// BIP32 hierarchical deterministic extended key magics
+		HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94}, // starts with tprv
+		HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf}, // starts with tpub
+
+		// BIP44 coin type used in the hierarchical deterministic path for
+		// address generation.
+		HDCoinType: 1,
+	}
+}
+
 // SimNetParams defines the network parameters for the simulation test Bitcoin
 // network.  This network is similar to the normal test network except it is
 // intended for private use within a group of individuals doing simulation
@@ -611,25 +985,48 @@ var SimNetParams = Params{
 	DNSSeeds:    []DNSSeed{}, // NOTE: There must NOT be any seeds.
 
 	// Chain parameters
-	GenesisBlock:             &simNetGenesisBlock,
-	GenesisHash:              &simNetGenesisHash,
-	PowLimit:                 simNetPowLimit,
-	PowLimitBits:             0x207fffff,
-	BIP0034Height:            0, // Always active on simnet
-	BIP0065Height:            0, // Always active on simnet
-	BIP0066Height:            0, // Always active on simnet
-	CoinbaseMaturity:         100,
-	SubsidyReductionInterval: 210000,
-	TargetTimespan:           time.Hour * 24 * 14, // 14 days
-	TargetTimePerBlock:       time.Minute * 10,    // 10 minutes
-	RetargetAdjustmentFactor: 4,                   // 25% less, 400% more
-	ReduceMinDifficulty:      true,
-	MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
-	GenerateSupported:        true,
+	GenesisBlock:                     &simNetGenesisBlock,
+	GenesisHash:                      &simNetGenesisHash,
+	PowLimit:                         simNetPowLimit,
+	PowLimitBits:                     0x207fffff,
+	BIP0034Height:                    0,         // Always active on simnet
+	BIP0065Height:                    0,         // Always active on simnet
+	BIP0066Height:                    0,         // Always active on simnet
+	DIP0003Height:                    100000000, // Not active on simnet
+	DIP0003EnforcementHeight:         100000000, // Not active on simnet
+	CoinbaseMaturity:                 100,
+	SubsidyReductionInterval:         210000,
+	BudgetPaymentsStartHeight:        1000,
+	SuperblockStartHeight:            1500,
+	SuperblockCycle:                  10,
+	MasternodePaymentsStartHeight:    240,
+	MasternodePaymentsIncreaseHeight: 350,
+	MasternodePaymentsIncreasePeriod: 10,
+	MasternodeMinimumConfirmations:   1,
+	BRRHeight:                        2500,
+	V20Height:                        900,
+	MNRRHeight:                       900,
+	TargetTimespan:                   time.Hour * 24 * 14, // 14 days
+	TargetTimePerBlock:               time.Minute * 10,    // 10 minutes
+	RetargetAdjustmentFactor:         4,                   // 25% less, 400% more
+	ReduceMinDifficulty:              true,
+	MinDiffReductionTime:             time.Minute * 20, // TargetTimePerBlock * 2
+	PowKGWHeight:                     0,                // Always active
+	PowDGWHeight:                     0,                // Always active
+	GenerateSupported:                true,
 
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints: nil,
 
+	// Blocks whose utxo set snapshots may be loaded.
+	AssumeUtxos: nil,
+
+	// LLMQ types used by ChainLocks, InstantSend and Platform.
+	LLMQTypeChainLocks:         LLMQType_TEST,
+	LLMQTypeInstantSend:        LLMQType_TEST_INSTANTSEND,
+	LLMQTypeDIP0024InstantSend: LLMQType_TEST_DIP0024,
+	LLMQTypePlatform:           LLMQType_TEST_PLATFORM,
+
 	// Consensus rule change deployments.
 	//
 	// The miner confirmation window is defined as:
@@ -729,25 +1126,42 @@ func CustomSignetParams(challenge []byte, dnsSeeds []DNSSeed) Params {
 		DNSSeeds:    dnsSeeds,
 
 		// Chain parameters
-		GenesisBlock:             &sigNetGenesisBlock,
-		GenesisHash:              &sigNetGenesisHash,
-		PowLimit:                 sigNetPowLimit,
-		PowLimitBits:             0x1e0377ae,
-		BIP0034Height:            1,
-		BIP0065Height:            1,
-		BIP0066Height:            1,
-		CoinbaseMaturity:         100,
-		SubsidyReductionInterval: 210000,
-		TargetTimespan:           time.Hour * 24 * 14, // 14 days
-		TargetTimePerBlock:       time.Minute * 10,    // 10 minutes
-		RetargetAdjustmentFactor: 4,                   // 25% less, 400% more
-		ReduceMinDifficulty:      false,
-		MinDiffReductionTime:     time.Minute * 20, // TargetTimePerBlock * 2
-		GenerateSupported:        false,
+		GenesisBlock:                     &sigNetGenesisBlock,
+		GenesisHash:                      &sigNetGenesisHash,
+		PowLimit:                         sigNetPowLimit,
+		PowLimitBits:                     0x1e0377ae,
+		BIP0034Height:                    1,
+		BIP0065Height:                    1,
+		BIP0066Height:                    1,
+		DIP0003Height:                    100000000, // Not active on signet
+		DIP0003EnforcementHeight:         100000000, // Not active on signet
+		CoinbaseMaturity:                 100,
+		SubsidyReductionInterval:         210000,
+		BudgetPaymentsStartHeight:        1000,
+		SuperblockStartHeight:            1500,
+		SuperblockCycle:                  10,
+		MasternodePaymentsStartHeight:    240,
+		MasternodePaymentsIncreaseHeight: 350,
+		MasternodePaymentsIncreasePeriod: 10,
+		MasternodeMinimumConfirmations:   1,
+		BRRHeight:                        2500,
+		V20Height:                        900,
+		MNRRHeight:                       900,
+		TargetTimespan:                   time.Hour * 24 * 14, // 14 days
+		TargetTimePerBlock:               time.Minute * 10,    // 10 minutes
+		RetargetAdjustmentFactor:         4,                   // 25% less, 400% more
+		ReduceMinDifficulty:              false,
+		MinDiffReductionTime:             time.Minute * 20, // TargetTimePerBlock * 2
+		PowKGWHeight:                     0,                // Always active
+		PowDGWHeight:                     0,                // Always active
+		GenerateSupported:                false,
 
 		// Checkpoints ordered from oldest to newest.
 		Checkpoints: nil,
 
+		// Blocks whose utxo set snapshots may be loaded.
+		AssumeUtxos: nil,
+
 		// Consensus rule change deployments.
 		//
 		// The miner confirmation window is defined as:
@@ -871,8 +1285,11 @@ func Register(params *Params) error {
 	}
 
 	// A valid Bech32 encoded segwit address always has as prefix the
-	// human-readable part for the given net followed by '1'.
-	bech32SegwitPrefixes[params.Bech32HRPSegwit+"1"] = struct{}{}
+	// human-readable part for the given net followed by '1'.  Networks
+	// without segwit addresses have no human-readable part.
+	if params.Bech32HRPSegwit != "" {
+		bech32SegwitPrefixes[params.Bech32HRPSegwit+"1"] = struct{}{}
+	}
 	return nil
 }
 
@@ -104,32 +104,64 @@ func TestSigNetPowLimit(t *testing.T) {
 	}
 }
 
-// compactToBig is a copy of the blockchain.CompactToBig function. We copy it
-// here so we don't run into a circular dependency just because of a test.
-func compactToBig(compact uint32) *big.Int {
-	// Extract the mantissa, sign bit, and exponent.
-	mantissa := compact & 0x007fffff
-	isNegative := compact&0x00800000 != 0
-	exponent := uint(compact >> 24)
-
-	// Since the base for the exponent is 256, the exponent can be treated
-	// as the number of bytes to represent the full 256-bit number.  So,
-	// treat the exponent as the number of bytes and shift the mantissa
-	// right or left accordingly.  This is equivalent to:
-	// N = mantissa * 256^(exponent-3)
-	var bn *big.Int
-	if exponent <= 3 {
-		mantissa >>= 8 * (3 - exponent)
-		bn = big.NewInt(int64(mantissa))
-	} else {
-		bn = big.NewInt(int64(mantissa))
-		bn.Lsh(bn, 8*(exponent-3))
+// TestNewDevnetParams ensures devnets are named after the given name, that
+// their devnet genesis block builds on the shared genesis block and commits to
+// the name, and that it is checkpointed.
+func TestNewDevnetParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		wantName string
+	}{
+		{"", "devnet"},
+		{"alpha", "devnet-alpha"},
+		{"beta", "devnet-beta"},
 	}
 
-	// Make it negative if the sign bit is set.
-	if isNegative {
-		bn = bn.Neg(bn)
-	}
+	seen := make(map[string]struct{})
+	for _, test := range tests {
+		params := NewDevnetParams(test.name)
+		if params.Name != test.wantName {
+			t.Errorf("%q: name got %q, want %q", test.name,
+				params.Name, test.wantName)
+			continue
+		}
+
+		block := params.DevnetGenesisBlock
+		if block.Header.PrevBlock != *params.GenesisHash {
+			t.Errorf("%q: devnet genesis block does not build on "+
+				"the genesis block", test.name)
+		}
+		if block.BlockHash() != *params.DevnetGenesisHash {
+			t.Errorf("%q: devnet genesis hash mismatch", test.name)
+		}
+		hash := block.Header.BlockHash()
+		if hashToBig(&hash).Cmp(params.PowLimit) > 0 {
+			t.Errorf("%q: devnet genesis block is not solved",
+				test.name)
+		}
+		sigScript := block.Transactions[0].TxIn[0].SignatureScript
+		if !bytes.HasSuffix(sigScript, []byte(test.wantName)) {
+			t.Errorf("%q: coinbase does not commit to the devnet "+
+				"name: %x", test.name, sigScript)
+		}
+
+		if len(params.Checkpoints) != 1 ||
+			params.Checkpoints[0].Height != 1 ||
+			*params.Checkpoints[0].Hash != *params.DevnetGenesisHash {
+			t.Errorf("%q: devnet genesis block is not checkpointed",
+				test.name)
+		}
 
-	return bn
+		// Devnets must be deterministic and distinct.
+		again := NewDevnetParams(test.name)
+		if *again.DevnetGenesisHash != *params.DevnetGenesisHash {
+			t.Errorf("%q: devnet genesis block is not deterministic",
+				test.name)
+		}
+		if _, ok := seen[params.DevnetGenesisHash.String()]; ok {
+			t.Errorf("%q: devnet genesis hash is not unique",
+				test.name)
+		}
+		seen[params.DevnetGenesisHash.String()] = struct{}{}
+	}
 }
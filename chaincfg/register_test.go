@@ -128,23 +128,23 @@ func TestRegister(t *testing.T) {
 			},
 			segwitPrefixes: []prefixTest{
 				{
-					prefix: MainNetParams.Bech32HRPSegwit + "1",
-					valid:  true,
+					prefix: "bc1",
+					valid:  false,
 				},
 				{
-					prefix: TestNet3Params.Bech32HRPSegwit + "1",
-					valid:  true,
+					prefix: "tb1",
+					valid:  false,
 				},
 				{
-					prefix: RegressionNetParams.Bech32HRPSegwit + "1",
-					valid:  true,
+					prefix: "bcrt1",
+					valid:  false,
 				},
 				{
 					prefix: SimNetParams.Bech32HRPSegwit + "1",
 					valid:  true,
 				},
 				{
-					prefix: strings.ToUpper(MainNetParams.Bech32HRPSegwit + "1"),
+					prefix: strings.ToUpper(SimNetParams.Bech32HRPSegwit + "1"),
 					valid:  true,
 				},
 				{
@@ -160,7 +160,7 @@ func TestRegister(t *testing.T) {
 					valid:  false,
 				},
 				{
-					prefix: MainNetParams.Bech32HRPSegwit,
+					prefix: SimNetParams.Bech32HRPSegwit,
 					valid:  false,
 				},
 			},
@@ -262,23 +262,23 @@ func TestRegister(t *testing.T) {
 			},
 			segwitPrefixes: []prefixTest{
 				{
-					prefix: MainNetParams.Bech32HRPSegwit + "1",
-					valid:  true,
+					prefix: "bc1",
+					valid:  false,
 				},
 				{
-					prefix: TestNet3Params.Bech32HRPSegwit + "1",
-					valid:  true,
+					prefix: "tb1",
+					valid:  false,
 				},
 				{
-					prefix: RegressionNetParams.Bech32HRPSegwit + "1",
-					valid:  true,
+					prefix: "bcrt1",
+					valid:  false,
 				},
 				{
 					prefix: SimNetParams.Bech32HRPSegwit + "1",
 					valid:  true,
 				},
 				{
-					prefix: strings.ToUpper(MainNetParams.Bech32HRPSegwit + "1"),
+					prefix: strings.ToUpper(SimNetParams.Bech32HRPSegwit + "1"),
 					valid:  true,
 				},
 				{
@@ -294,7 +294,7 @@ func TestRegister(t *testing.T) {
 					valid:  false,
 				},
 				{
-					prefix: MainNetParams.Bech32HRPSegwit,
+					prefix: SimNetParams.Bech32HRPSegwit,
 					valid:  false,
 				},
 			},
@@ -389,23 +389,23 @@ func TestRegister(t *testing.T) {
 			},
 			segwitPrefixes: []prefixTest{
 				{
-					prefix: MainNetParams.Bech32HRPSegwit + "1",
-					valid:  true,
+					prefix: "bc1",
+					valid:  false,
 				},
 				{
-					prefix: TestNet3Params.Bech32HRPSegwit + "1",
-					valid:  true,
+					prefix: "tb1",
+					valid:  false,
 				},
 				{
-					prefix: RegressionNetParams.Bech32HRPSegwit + "1",
-					valid:  true,
+					prefix: "bcrt1",
+					valid:  false,
 				},
 				{
 					prefix: SimNetParams.Bech32HRPSegwit + "1",
 					valid:  true,
 				},
 				{
-					prefix: strings.ToUpper(MainNetParams.Bech32HRPSegwit + "1"),
+					prefix: strings.ToUpper(SimNetParams.Bech32HRPSegwit + "1"),
 					valid:  true,
 				},
 				{
@@ -421,7 +421,7 @@ func TestRegister(t *testing.T) {
 					valid:  false,
 				},
 				{
-					prefix: MainNetParams.Bech32HRPSegwit,
+					prefix: SimNetParams.Bech32HRPSegwit,
 					valid:  false,
 				},
 			},
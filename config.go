@@ -66,6 +66,7 @@ const (
 	sampleConfigFilename         = "sample-btcd.conf"
 	defaultTxIndex               = false
 	defaultAddrIndex             = false
+	pruneMinSize                 = 1536
 )
 
 var (
@@ -97,6 +98,7 @@ func minUint32(a, b uint32) uint32 {
 type config struct {
 	AddCheckpoints       []string      `long:"addcheckpoint" description:"Add a custom checkpoint.  Format: '<height>:<hash>'"`
 	AddPeers             []string      `short:"a" long:"addpeer" description:"Add a peer to connect with at startup"`
+	AddressIndex         bool          `long:"addressindex" description:"Maintain an index of the balance changes and unspent outputs of every address which makes the getaddressbalance, getaddressdeltas, getaddresstxids and getaddressutxos RPCs available"`
 	AddrIndex            bool          `long:"addrindex" description:"Maintain a full address-based transaction index which makes the searchrawtransactions RPC available"`
 	AgentBlacklist       []string      `long:"agentblacklist" description:"A comma separated list of user-agent substrings which will cause btcd to reject any peers whose user-agent contains any of the blacklisted substrings."`
 	AgentWhitelist       []string      `long:"agentwhitelist" description:"A comma separated list of user-agent substrings which will cause btcd to require all peers' user-agents to contain one of the whitelisted substrings. The blacklist is applied before the blacklist, and an empty whitelist will allow all agents that do not fail the blacklist."`
@@ -114,16 +116,24 @@ type config struct {
 	DataDir              string        `short:"b" long:"datadir" description:"Directory to store data"`
 	DbType               string        `long:"dbtype" description:"Database backend to use for the Block Chain"`
 	DebugLevel           string        `short:"d" long:"debuglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
+	DevNet               string        `long:"devnet" description:"Use the development network with the given name"`
 	DropAddrIndex        bool          `long:"dropaddrindex" description:"Deletes the address-based transaction index from the database on start up and then exits."`
+	DropAddressIndex     bool          `long:"dropaddressindex" description:"Deletes the address balance index from the database on start up and then exits."`
 	DropCfIndex          bool          `long:"dropcfindex" description:"Deletes the index used for committed filtering (CF) support from the database on start up and then exits."`
+	DropSpecialTxIndex   bool          `long:"dropspecialtxindex" description:"Deletes the special transaction index from the database on start up and then exits."`
+	DropSpentIndex       bool          `long:"dropspentindex" description:"Deletes the spent output index from the database on start up and then exits."`
+	DropTimestampIndex   bool          `long:"droptimestampindex" description:"Deletes the block timestamp index from the database on start up and then exits."`
 	DropTxIndex          bool          `long:"droptxindex" description:"Deletes the hash-based transaction index from the database on start up and then exits."`
+	EnforceMNPayments    bool          `long:"enforcemnpayments" description:"Reject blocks which don't pay the masternode selected by the masternode list -- Requires --mnlist"`
 	ExternalIPs          []string      `long:"externalip" description:"Add an ip to the list of local addresses we claim to listen on to peers"`
 	Generate             bool          `long:"generate" description:"Generate (mine) bitcoins using the CPU"`
 	FreeTxRelayLimit     float64       `long:"limitfreerelay" description:"Limit relay of transactions with no transaction fee to the given amount in thousands of bytes per minute"`
 	Listeners            []string      `long:"listen" description:"Add an interface/port to listen for connections (default all interfaces port: 8333, testnet: 18333)"`
+	LoadSnapshot         string        `long:"loadsnapshot" description:"Start a new block chain database from the utxo set snapshot in the given file written by the dumptxoutset RPC -- The snapshot must be for a block pinned by the network parameters, which is only the case for regtest so far, and the blocks below it are validated in the background"`
 	LogDir               string        `long:"logdir" description:"Directory to log output."`
 	MaxOrphanTxs         int           `long:"maxorphantx" description:"Max number of orphan transactions to keep in memory"`
 	MaxPeers             int           `long:"maxpeers" description:"Max number of inbound and outbound peers"`
+	MNList               bool          `long:"mnlist" description:"Maintain the deterministic masternode list from connected blocks which makes the protx list and info RPCs available and is needed to verify ChainLocks and InstantSend locks"`
 	MiningAddrs          []string      `long:"miningaddr" description:"Add the specified payment address to the list of addresses to use for generated blocks -- At least one address is required if the generate option is set"`
 	MinRelayTxFee        float64       `long:"minrelaytxfee" description:"The minimum transaction fee in BTC/kB to be considered a non-zero fee."`
 	DisableBanning       bool          `long:"nobanning" description:"Disable banning of misbehaving peers"`
@@ -145,6 +155,7 @@ type config struct {
 	Proxy                string        `long:"proxy" description:"Connect via SOCKS5 proxy (eg. 127.0.0.1:9050)"`
 	ProxyPass            string        `long:"proxypass" default-mask:"-" description:"Password for proxy server"`
 	ProxyUser            string        `long:"proxyuser" description:"Username for proxy server"`
+	Prune                uint64        `long:"prune" description:"Prune already validated blocks from the database -- Must specify a target size in MiB (minimum value of 1536, default value of 0 will disable pruning)"`
 	RegressionTest       bool          `long:"regtest" description:"Use the regression test network"`
 	RejectNonStd         bool          `long:"rejectnonstd" description:"Reject non-standard transactions regardless of the default settings for the active network."`
 	RejectReplacement    bool          `long:"rejectreplacement" description:"Reject transactions that attempt to replace existing transactions within the mempool through the Replace-By-Fee (RBF) signaling policy."`
@@ -165,7 +176,10 @@ type config struct {
 	SigNet               bool          `long:"signet" description:"Use the signet test network"`
 	SigNetChallenge      string        `long:"signetchallenge" description:"Connect to a custom signet network defined by this challenge instead of using the global default signet test network -- Can be specified multiple times"`
 	SigNetSeedNode       []string      `long:"signetseednode" description:"Specify a seed node for the signet network instead of using the global default signet network seed nodes"`
+	SpecialTxIndex       bool          `long:"specialtxindex" description:"Maintain an index of the special transactions by type and proTxHash which makes them available via the getspecialtxes RPC"`
+	SpentIndex           bool          `long:"spentindex" description:"Maintain an index of the inputs spending every output which makes the getspentinfo RPC available"`
 	TestNet3             bool          `long:"testnet" description:"Use the test network"`
+	TimestampIndex       bool          `long:"timestampindex" description:"Maintain an index of the blocks by timestamp which makes the getblockhashes RPC available"`
 	TorIsolation         bool          `long:"torisolation" description:"Enable Tor stream isolation by randomizing user credentials for each connection."`
 	TrickleInterval      time.Duration `long:"trickleinterval" description:"Minimum time between attempts to send new inventory to a connected peer"`
 	TxIndex              bool          `long:"txindex" description:"Maintain a full hash-based transaction index which makes all transactions available via the getrawtransaction RPC"`
@@ -556,6 +570,14 @@ func loadConfig() (*config, []string, error) {
 		activeNetParams = &simNetParams
 		cfg.DisableDNSSeed = true
 	}
+	if cfg.DevNet != "" {
+		numNets++
+		chainParams := chaincfg.NewDevnetParams(cfg.DevNet)
+		activeNetParams = &params{
+			Params:  &chainParams,
+			rpcPort: devNetRPCPort,
+		}
+	}
 	if cfg.SigNet {
 		numNets++
 		activeNetParams = &sigNetParams
@@ -596,7 +618,7 @@ func loadConfig() (*config, []string, error) {
 		activeNetParams.Params = &chainParams
 	}
 	if numNets > 1 {
-		str := "%s: The testnet, regtest, segnet, signet and simnet " +
+		str := "%s: The testnet, regtest, devnet, signet and simnet " +
 			"params can't be used together -- choose one of the " +
 			"five"
 		err := fmt.Errorf(str, funcName)
@@ -649,6 +671,10 @@ func loadConfig() (*config, []string, error) {
 	cfg.LogDir = cleanAndExpandPath(cfg.LogDir)
 	cfg.LogDir = filepath.Join(cfg.LogDir, netName(activeNetParams))
 
+	if cfg.LoadSnapshot != "" {
+		cfg.LoadSnapshot = cleanAndExpandPath(cfg.LoadSnapshot)
+	}
+
 	// Special show command to list supported subsystems and exit.
 	if cfg.DebugLevel == "show" {
 		fmt.Println("Supported subsystems", supportedSubsystems())
@@ -892,6 +918,51 @@ func loadConfig() (*config, []string, error) {
 		}
 	}
 
+	// --prune must be large enough to retain the most recent block file
+	// and does not mix with the indexes and the masternode list which
+	// require all blocks.
+	if cfg.Prune != 0 && cfg.Prune < pruneMinSize {
+		err := fmt.Errorf("%s: the minimum value for --prune is %d MiB",
+			funcName, pruneMinSize)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+	pruneConflicts := []struct {
+		option  string
+		enabled bool
+	}{
+		{"--txindex", cfg.TxIndex},
+		{"--addrindex", cfg.AddrIndex},
+		{"--addressindex", cfg.AddressIndex},
+		{"--spentindex", cfg.SpentIndex},
+		{"--timestampindex", cfg.TimestampIndex},
+		{"--specialtxindex", cfg.SpecialTxIndex},
+		{"--mnlist", cfg.MNList},
+	}
+	for _, conflict := range pruneConflicts {
+		if cfg.Prune == 0 || !conflict.enabled {
+			continue
+		}
+		err := fmt.Errorf("%s: the --prune and %s options may "+
+			"not be activated at the same time", funcName,
+			conflict.option)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// --loadsnapshot requires a snapshot block pinned by the parameters of
+	// the network.
+	if cfg.LoadSnapshot != "" && len(activeNetParams.AssumeUtxos) == 0 {
+		err := fmt.Errorf("%s: --loadsnapshot can't be used since no "+
+			"snapshot is pinned for the %s network", funcName,
+			activeNetParams.Name)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// --txindex and --droptxindex do not mix.
 	if cfg.TxIndex && cfg.DropTxIndex {
 		err := fmt.Errorf("%s: the --txindex and --droptxindex "+
@@ -912,6 +983,46 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// --addressindex and --dropaddressindex do not mix.
+	if cfg.AddressIndex && cfg.DropAddressIndex {
+		err := fmt.Errorf("%s: the --addressindex and --dropaddressindex "+
+			"options may not be activated at the same time",
+			funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// --specialtxindex and --dropspecialtxindex do not mix.
+	if cfg.SpecialTxIndex && cfg.DropSpecialTxIndex {
+		err := fmt.Errorf("%s: the --specialtxindex and "+
+			"--dropspecialtxindex options may not be activated at "+
+			"the same time", funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// --spentindex and --dropspentindex do not mix.
+	if cfg.SpentIndex && cfg.DropSpentIndex {
+		err := fmt.Errorf("%s: the --spentindex and --dropspentindex "+
+			"options may not be activated at the same time",
+			funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// --timestampindex and --droptimestampindex do not mix.
+	if cfg.TimestampIndex && cfg.DropTimestampIndex {
+		err := fmt.Errorf("%s: the --timestampindex and "+
+			"--droptimestampindex options may not be activated at "+
+			"the same time", funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// --addrindex and --droptxindex do not mix.
 	if cfg.AddrIndex && cfg.DropTxIndex {
 		err := fmt.Errorf("%s: the --addrindex and --droptxindex "+
@@ -1022,6 +1133,16 @@ func loadConfig() (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// Enforcing the masternode payments requires the masternode list.
+	if cfg.EnforceMNPayments && !cfg.MNList {
+		str := "%s: the --enforcemnpayments option requires the " +
+			"--mnlist option"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// Tor stream isolation requires either proxy or onion proxy to be set.
 	if cfg.TorIsolation && cfg.Proxy == "" && cfg.OnionProxy == "" {
 		str := "%s: Tor stream isolation requires either proxy or " +
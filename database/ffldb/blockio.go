@@ -15,6 +15,8 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/dashpay/dashd-go/chaincfg/chainhash"
@@ -307,6 +309,32 @@ func (s *blockStore) deleteFile(fileNum uint32) error {
 	return nil
 }
 
+// pruneFile closes the block file for the passed flat file number when it is
+// open and then removes it.  It is the responsibility of the caller to remove
+// the locations of the blocks it houses from the block index.
+//
+// This function MUST NOT be called for the current write file.
+func (s *blockStore) pruneFile(fileNum uint32) error {
+	s.obfMutex.Lock()
+	if blockFile, ok := s.openBlockFiles[fileNum]; ok {
+		s.lruMutex.Lock()
+		s.openBlocksLRU.Remove(s.fileNumToLRUElem[fileNum])
+		delete(s.fileNumToLRUElem, fileNum)
+		s.lruMutex.Unlock()
+
+		// Close the file under the write lock for the file in case any
+		// readers are currently reading from it.
+		blockFile.Lock()
+		_ = blockFile.file.Close()
+		blockFile.Unlock()
+
+		delete(s.openBlockFiles, fileNum)
+	}
+	s.obfMutex.Unlock()
+
+	return s.deleteFileFunc(fileNum)
+}
+
 // blockFile attempts to return an existing file handle for the passed flat file
 // number if it is already open as well as marking it as most recently used.  It
 // will also open the file when it's not already open subject to the rules
@@ -713,14 +741,34 @@ func (s *blockStore) handleRollback(oldBlockFileNum, oldBlockOffset uint32) {
 }
 
 // scanBlockFiles searches the database directory for all flat block files to
-// find the end of the most recent file.  This position is considered the
-// current write cursor which is also stored in the metadata.  Thus, it is used
-// to detect unexpected shutdowns in the middle of writes so the block files
-// can be reconciled.
-func scanBlockFiles(dbPath string) (int, uint32) {
+// find the first file and the end of the most recent file.  The first file is
+// only after file zero when the oldest block files have been pruned.  The end
+// of the most recent file is considered the current write cursor which is also
+// stored in the metadata.  Thus, it is used to detect unexpected shutdowns in
+// the middle of writes so the block files can be reconciled.
+func scanBlockFiles(dbPath string) (int, int, uint32) {
+	// Find the first block file, which is the one with the lowest number
+	// in the directory.
+	firstFile := -1
+	files, _ := filepath.Glob(filepath.Join(dbPath, "*.fdb"))
+	for _, file := range files {
+		fileNum, err := strconv.ParseUint(strings.TrimSuffix(
+			filepath.Base(file), ".fdb"), 10, 32)
+		if err != nil {
+			continue
+		}
+		if firstFile == -1 || int(fileNum) < firstFile {
+			firstFile = int(fileNum)
+		}
+	}
+	if firstFile == -1 {
+		log.Tracef("Scan found no block files")
+		return -1, -1, 0
+	}
+
 	lastFile := -1
 	fileLen := uint32(0)
-	for i := 0; ; i++ {
+	for i := firstFile; ; i++ {
 		filePath := blockFilePath(dbPath, uint32(i))
 		st, err := os.Stat(filePath)
 		if err != nil {
@@ -731,9 +779,9 @@ func scanBlockFiles(dbPath string) (int, uint32) {
 		fileLen = uint32(st.Size())
 	}
 
-	log.Tracef("Scan found latest block file #%d with length %d", lastFile,
-		fileLen)
-	return lastFile, fileLen
+	log.Tracef("Scan found block files #%d to #%d with length %d",
+		firstFile, lastFile, fileLen)
+	return firstFile, lastFile, fileLen
 }
 
 // newBlockStore returns a new block store with the current block file number
@@ -742,7 +790,7 @@ func newBlockStore(basePath string, network wire.BitcoinNet) *blockStore {
 	// Look for the end of the latest block to file to determine what the
 	// write cursor position is from the viewpoing of the block files on
 	// disk.
-	fileNum, fileOff := scanBlockFiles(basePath)
+	_, fileNum, fileOff := scanBlockFiles(basePath)
 	if fileNum == -1 {
 		fileNum = 0
 		fileOff = 0
@@ -970,6 +970,10 @@ type transaction struct {
 	pendingKeys   *treap.Mutable
 	pendingRemove *treap.Mutable
 
+	// Block files that need to be deleted once the transaction, which
+	// removes the blocks they house from the block index, is committed.
+	pendingPrunedFiles []uint32
+
 	// Active iterators that need to be notified when the pending keys have
 	// been updated so the cursors can properly handle updates to the
 	// transaction state.
@@ -1583,6 +1587,117 @@ func (tx *transaction) FetchBlockRegions(regions []database.BlockRegion) ([][]by
 	return blockRegions, nil
 }
 
+// PruneBlocks deletes the oldest flat block files until the total size of the
+// block files is at or below the provided target size in bytes and removes the
+// blocks they house from the block index.  The block file which houses the
+// block identified by the provided hash and all later block files are never
+// deleted, and neither is the current write file.  The target size must not be
+// below the maximum size of a single block file.
+//
+// Returns the following errors as required by the interface contract:
+//   - ErrBlockNotFound if the block to retain does not exist
+//   - ErrTxNotWritable if attempted against a read-only transaction
+//   - ErrTxClosed if the transaction has already been closed
+//
+// This function is part of the database.Tx interface implementation.
+func (tx *transaction) PruneBlocks(targetSize uint64, keepHash *chainhash.Hash) ([]chainhash.Hash, error) {
+	// Ensure transaction state is valid.
+	if err := tx.checkClosed(); err != nil {
+		return nil, err
+	}
+
+	// Ensure the transaction is writable.
+	if !tx.writable {
+		str := "prune blocks requires a writable database transaction"
+		return nil, makeDbErr(database.ErrTxNotWritable, str, nil)
+	}
+
+	maxFileSize := uint64(tx.db.store.maxBlockFileSize)
+	if targetSize < maxFileSize {
+		str := fmt.Sprintf("prune target size %d is below the maximum "+
+			"block file size %d", targetSize, maxFileSize)
+		return nil, makeDbErr(database.ErrDriverSpecific, str, nil)
+	}
+
+	// Never delete the current write file or any file after the one
+	// housing the block to retain.
+	wc := tx.db.store.writeCursor
+	wc.RLock()
+	keepFileNum := wc.curFileNum
+	wc.RUnlock()
+	if keepHash != nil {
+		blockRow, err := tx.fetchBlockRow(keepHash)
+		if err != nil {
+			return nil, err
+		}
+		location := deserializeBlockLoc(blockRow)
+		if location.blockFileNum < keepFileNum {
+			keepFileNum = location.blockFileNum
+		}
+	}
+
+	// All files but the last one are treated as full since blocks are
+	// only written to the next file once they no longer fit.
+	firstFile, lastFile, lastFileLen := scanBlockFiles(tx.db.store.basePath)
+	if firstFile == -1 || firstFile == lastFile {
+		return nil, nil
+	}
+	totalSize := uint64(lastFile-firstFile)*maxFileSize + uint64(lastFileLen)
+
+	// The files are only deleted once the transaction is committed so
+	// that the block index never refers to a deleted file.
+	prunedFiles := make(map[uint32]struct{})
+	for fileNum := uint32(firstFile); fileNum < keepFileNum &&
+		totalSize > targetSize; fileNum++ {
+
+		prunedFiles[fileNum] = struct{}{}
+		tx.pendingPrunedFiles = append(tx.pendingPrunedFiles, fileNum)
+		totalSize -= maxFileSize
+	}
+	if len(prunedFiles) == 0 {
+		return nil, nil
+	}
+
+	// Remove the blocks housed by the deleted files from the block index.
+	var prunedHashes []chainhash.Hash
+	cursor := tx.blockIdxBucket.Cursor()
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		location := deserializeBlockLoc(cursor.Value())
+		if _, ok := prunedFiles[location.blockFileNum]; ok {
+			var hash chainhash.Hash
+			copy(hash[:], cursor.Key())
+			prunedHashes = append(prunedHashes, hash)
+		}
+	}
+	for i := range prunedHashes {
+		if err := tx.blockIdxBucket.Delete(prunedHashes[i][:]); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Debugf("Pruning %d block files housing %d blocks", len(prunedFiles),
+		len(prunedHashes))
+	return prunedHashes, nil
+}
+
+// BeenPruned returns whether or not blocks have ever been deleted from the flat
+// block files by PruneBlocks, which is the case when the first block file is
+// missing.
+//
+// Returns the following errors as required by the interface contract:
+//   - ErrTxClosed if the transaction has already been closed
+//
+// This function is part of the database.Tx interface implementation.
+func (tx *transaction) BeenPruned() (bool, error) {
+	// Ensure transaction state is valid.
+	if err := tx.checkClosed(); err != nil {
+		return false, err
+	}
+
+	firstFile, _, _ := scanBlockFiles(tx.db.store.basePath)
+	return firstFile > 0, nil
+}
+
 // close marks the transaction closed then releases any pending data, the
 // underlying snapshot, the transaction read lock, and the write lock when the
 // transaction is writable.
@@ -1597,6 +1712,9 @@ func (tx *transaction) close() {
 	tx.pendingKeys = nil
 	tx.pendingRemove = nil
 
+	// Clear pending block files that would have been deleted on commit.
+	tx.pendingPrunedFiles = nil
+
 	// Release the snapshot.
 	if tx.snapshot != nil {
 		tx.snapshot.Release()
@@ -1666,7 +1784,38 @@ func (tx *transaction) writePendingAndCommit() error {
 
 	// Atomically update the database cache.  The cache automatically
 	// handles flushing to the underlying persistent storage database.
-	return tx.db.cache.commitTx(tx)
+	if err := tx.db.cache.commitTx(tx); err != nil {
+		return err
+	}
+
+	// Delete the block files pruned by the transaction now that the
+	// removal of the blocks they house from the block index is committed.
+	return tx.deletePrunedFiles()
+}
+
+// deletePrunedFiles deletes the block files pruned by the transaction.  The
+// database cache is flushed first so that the removal of the blocks they house
+// from the block index is persisted before any of the files are deleted.
+// Failing to delete a file only leaves it behind until the next prune since
+// none of the blocks it houses are referenced anymore.
+//
+// This function MUST be called with the database write lock held.
+func (tx *transaction) deletePrunedFiles() error {
+	if len(tx.pendingPrunedFiles) == 0 {
+		return nil
+	}
+	if err := tx.db.cache.flush(); err != nil {
+		return err
+	}
+
+	for _, fileNum := range tx.pendingPrunedFiles {
+		if err := tx.db.store.pruneFile(fileNum); err != nil {
+			log.Warnf("Unable to delete pruned block file %d: %v",
+				fileNum, err)
+		}
+	}
+	log.Debugf("Deleted %d pruned block files", len(tx.pendingPrunedFiles))
+	return nil
 }
 
 // Commit commits all changes that have been made to the root metadata bucket
@@ -10,6 +10,7 @@ package ffldb
 import (
 	"compress/bzip2"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"hash/crc32"
 	"io"
@@ -21,6 +22,7 @@ import (
 	ldberrors "github.com/btcsuite/goleveldb/leveldb/errors"
 	"github.com/dashpay/dashd-go/btcutil"
 	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
 	"github.com/dashpay/dashd-go/database"
 	"github.com/dashpay/dashd-go/wire"
 )
@@ -704,3 +706,187 @@ func TestFailureScenarios(t *testing.T) {
 	// Test various corruption scenarios.
 	testCorruption(tc)
 }
+
+// TestPruneBlocks ensures pruning deletes the oldest block files down to the
+// target size while retaining the requested blocks, and that the database can
+// be reopened and written to afterwards.
+func TestPruneBlocks(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	idb, err := database.Create(dbType, dbPath, blockDataNet)
+	if err != nil {
+		t.Fatalf("Failed to create test database (%s) %v", dbType, err)
+	}
+
+	// Change the maximum file size to a small value to force multiple flat
+	// files with the test data set.
+	const maxFileSize = 1024 // 1KiB
+	idb.(*db).store.maxBlockFileSize = maxFileSize
+
+	blocks, err := loadBlocks(t, blockDataFile, blockDataNet)
+	if err != nil {
+		t.Fatalf("loadBlocks: Unexpected error: %v", err)
+	}
+	for _, block := range blocks[:200] {
+		err := idb.Update(func(tx database.Tx) error {
+			return tx.StoreBlock(block)
+		})
+		if err != nil {
+			idb.Close()
+			t.Fatalf("StoreBlock: Unexpected error: %v", err)
+		}
+	}
+
+	var beenPruned bool
+	_ = idb.View(func(tx database.Tx) error {
+		beenPruned, err = tx.BeenPruned()
+		return err
+	})
+	if err != nil || beenPruned {
+		idb.Close()
+		t.Fatalf("BeenPruned before pruning: got %v, %v", beenPruned, err)
+	}
+
+	// Pruning below the size of a single file must fail, and pruning with
+	// a read-only transaction is not allowed.
+	err = idb.Update(func(tx database.Tx) error {
+		_, err := tx.PruneBlocks(maxFileSize-1, nil)
+		return err
+	})
+	if !checkDbError(t, "PruneBlocks small target", err,
+		database.ErrDriverSpecific) {
+
+		idb.Close()
+		return
+	}
+	err = idb.View(func(tx database.Tx) error {
+		_, err := tx.PruneBlocks(maxFileSize, nil)
+		return err
+	})
+	if !checkDbError(t, "PruneBlocks read-only", err,
+		database.ErrTxNotWritable) {
+
+		idb.Close()
+		return
+	}
+
+	// Pruning in a transaction which is rolled back must neither delete
+	// any block files nor remove any blocks from the block index.
+	errRollback := errors.New("rollback")
+	err = idb.Update(func(tx database.Tx) error {
+		if _, err := tx.PruneBlocks(maxFileSize, nil); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if err != errRollback {
+		idb.Close()
+		t.Fatalf("PruneBlocks rollback: got %v, want %v", err,
+			errRollback)
+	}
+	if firstFile, _, _ := scanBlockFiles(dbPath); firstFile != 0 {
+		idb.Close()
+		t.Fatalf("PruneBlocks rollback: first block file is %d, want 0",
+			firstFile)
+	}
+	err = idb.View(func(tx database.Tx) error {
+		_, err := tx.FetchBlock(blocks[0].Hash())
+		return err
+	})
+	if err != nil {
+		idb.Close()
+		t.Fatalf("FetchBlock after rollback: Unexpected error: %v", err)
+	}
+
+	// Prune with a target which allows three files while retaining the
+	// block at index 50, which is housed well before the last three files,
+	// so only the files before it are deleted.
+	keepHash := blocks[50].Hash()
+	var pruned []chainhash.Hash
+	err = idb.Update(func(tx database.Tx) error {
+		var err error
+		pruned, err = tx.PruneBlocks(3*maxFileSize, keepHash)
+		return err
+	})
+	if err != nil {
+		idb.Close()
+		t.Fatalf("PruneBlocks: Unexpected error: %v", err)
+	}
+	if len(pruned) == 0 || len(pruned) > 50 {
+		idb.Close()
+		t.Fatalf("PruneBlocks: pruned %d blocks, want between 1 and 50",
+			len(pruned))
+	}
+	err = idb.View(func(tx database.Tx) error {
+		for i, block := range blocks[:200] {
+			has, err := tx.HasBlock(block.Hash())
+			if err != nil {
+				return err
+			}
+			if has != (i >= len(pruned)) {
+				return fmt.Errorf("HasBlock #%d: got %v", i, has)
+			}
+		}
+		_, err := tx.FetchBlock(blocks[0].Hash())
+		if !checkDbError(t, "FetchBlock pruned", err,
+			database.ErrBlockNotFound) {
+
+			return errSubTestFail
+		}
+		beenPruned, err = tx.BeenPruned()
+		if err != nil || !beenPruned {
+			return fmt.Errorf("BeenPruned: got %v, %v", beenPruned,
+				err)
+		}
+		return nil
+	})
+	if err != nil {
+		idb.Close()
+		t.Fatalf("%v", err)
+	}
+
+	// Prune down to the target and ensure the total size of the remaining
+	// block files is within it.
+	err = idb.Update(func(tx database.Tx) error {
+		_, err := tx.PruneBlocks(3*maxFileSize, nil)
+		return err
+	})
+	if err != nil {
+		idb.Close()
+		t.Fatalf("PruneBlocks: Unexpected error: %v", err)
+	}
+	firstFile, lastFile, _ := scanBlockFiles(dbPath)
+	if lastFile-firstFile+1 > 3 {
+		idb.Close()
+		t.Fatalf("PruneBlocks: %d block files remain, want at most 3",
+			lastFile-firstFile+1)
+	}
+
+	// Reopen the database and ensure new blocks are appended after the
+	// remaining files.
+	idb.Close()
+	idb, err = database.Open(dbType, dbPath, blockDataNet)
+	if err != nil {
+		t.Fatalf("Failed to reopen test database: %v", err)
+	}
+	defer idb.Close()
+	idb.(*db).store.maxBlockFileSize = maxFileSize
+	err = idb.Update(func(tx database.Tx) error {
+		return tx.StoreBlock(blocks[200])
+	})
+	if err != nil {
+		t.Fatalf("StoreBlock after reopen: Unexpected error: %v", err)
+	}
+	err = idb.View(func(tx database.Tx) error {
+		_, err := tx.FetchBlock(blocks[200].Hash())
+		return err
+	})
+	if err != nil {
+		t.Fatalf("FetchBlock after reopen: Unexpected error: %v", err)
+	}
+	if newFirstFile, _, _ := scanBlockFiles(dbPath); newFirstFile != firstFile {
+		t.Fatalf("first block file after reopen: got %d, want %d",
+			newFirstFile, firstFile)
+	}
+}
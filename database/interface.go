@@ -389,6 +389,28 @@ type Tx interface {
 	// implementations.
 	FetchBlockRegions(regions []BlockRegion) ([][]byte, error)
 
+	// PruneBlocks deletes the oldest blocks from the block storage until
+	// its total size is at or below the provided target size in bytes.
+	// The blocks stored along with the block identified by the provided
+	// hash and all blocks stored after it are never deleted, and neither
+	// is the most recently stored block when the hash is nil.  It returns
+	// the hashes of the deleted blocks.
+	//
+	// The interface contract guarantees at least the following errors will
+	// be returned (other implementation-specific errors are possible):
+	//   - ErrBlockNotFound if the block to retain does not exist
+	//   - ErrTxNotWritable if attempted against a read-only transaction
+	//   - ErrTxClosed if the transaction has already been closed
+	PruneBlocks(targetSize uint64, keepHash *chainhash.Hash) ([]chainhash.Hash, error)
+
+	// BeenPruned returns whether or not blocks have ever been deleted from
+	// the block storage by PruneBlocks.
+	//
+	// The interface contract guarantees at least the following errors will
+	// be returned (other implementation-specific errors are possible):
+	//   - ErrTxClosed if the transaction has already been closed
+	BeenPruned() (bool, error)
+
 	// ******************************************************************
 	// Methods related to both atomic metadata storage and block storage.
 	// ******************************************************************
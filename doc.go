@@ -24,6 +24,11 @@ Application Options:
       --addcheckpoint=        Add a custom checkpoint.  Format:
                               '<height>:<hash>'
   -a, --addpeer=              Add a peer to connect with at startup
+      --addressindex          Maintain an index of the balance changes and
+                              unspent outputs of every address which makes the
+                              getaddressbalance, getaddressdeltas,
+                              getaddresstxids and getaddressutxos RPCs
+                              available
       --addrindex             Maintain a full address-based transaction index
                               which makes the searchrawtransactions RPC
                               available
@@ -57,13 +62,25 @@ Application Options:
                               set the log level for individual subsystems --
                               Use show to list available subsystems (default:
                               info)
+      --devnet=               Use the development network with the given name
       --dropaddrindex         Deletes the address-based transaction index from
                               the database on start up and then exits.
+      --dropaddressindex      Deletes the address balance index from the
+                              database on start up and then exits.
       --dropcfindex           Deletes the index used for committed filtering
                               (CF) support from the database on start up and
                               then exits.
+      --dropspecialtxindex    Deletes the special transaction index from the
+                              database on start up and then exits.
+      --dropspentindex        Deletes the spent output index from the database
+                              on start up and then exits.
+      --droptimestampindex    Deletes the block timestamp index from the
+                              database on start up and then exits.
       --droptxindex           Deletes the hash-based transaction index from the
                               database on start up and then exits.
+      --enforcemnpayments     Reject blocks which don't pay the masternode
+                              selected by the masternode list -- Requires
+                              --mnlist
       --externalip=           Add an ip to the list of local addresses we claim
                               to listen on to peers
       --generate              Generate (mine) bitcoins using the CPU
@@ -73,6 +90,12 @@ Application Options:
       --listen=               Add an interface/port to listen for connections
                               (default all interfaces port: 8333, testnet:
                               18333, signet: 38333)
+      --loadsnapshot=         Start a new block chain database from the utxo
+                              set snapshot in the given file written by the
+                              dumptxoutset RPC -- The snapshot must be for a
+                              block pinned by the network parameters, which is
+                              only the case for regtest so far, and the blocks
+                              below it are validated in the background
       --logdir=               Directory to log output
       --maxorphantx=          Max number of orphan transactions to keep in
                               memory (default: 100)
@@ -82,6 +105,10 @@ Application Options:
                               addresses to use for generated blocks -- At least
                               one address is required if the generate option is
                               set
+      --mnlist                Maintain the deterministic masternode list from
+                              connected blocks which makes the protx list and
+                              info RPCs available and is needed to verify
+                              ChainLocks and InstantSend locks
       --minrelaytxfee=        The minimum transaction fee in BTC/kB to be
                               considered a non-zero fee. (default: 1e-05)
       --nobanning             Disable banning of misbehaving peers
@@ -113,6 +140,10 @@ Application Options:
       --proxy=                Connect via SOCKS5 proxy (eg. 127.0.0.1:9050)
       --proxypass=            Password for proxy server
       --proxyuser=            Username for proxy server
+      --prune=                Prune already validated blocks from the database
+                              -- Must specify a target size in MiB (minimum
+                              value of 1536, default value of 0 will disable
+                              pruning)
       --regtest               Use the regression test network
       --rejectnonstd          Reject non-standard transactions regardless of
                               the default settings for the active network.
@@ -138,7 +169,14 @@ Application Options:
       --sigcachemaxsize=      The maximum number of entries in the signature
                               verification cache (default: 100000)
       --simnet                Use the simulation test network
+      --specialtxindex        Maintain an index of the special transactions by
+                              type and proTxHash which makes them available via
+                              the getspecialtxes RPC
+      --spentindex            Maintain an index of the inputs spending every
+                              output which makes the getspentinfo RPC available
       --testnet               Use the test network
+      --timestampindex        Maintain an index of the blocks by timestamp
+                              which makes the getblockhashes RPC available
       --torisolation          Enable Tor stream isolation by randomizing user
                               credentials for each connection.
       --trickleinterval=      Minimum time between attempts to send new
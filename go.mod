@@ -12,6 +12,7 @@ require (
 	github.com/decred/dcrd/lru v1.1.3
 	github.com/jessevdk/go-flags v1.6.1
 	github.com/jrick/logrotate v1.1.2
+	github.com/kilic/bls12-381 v0.1.0
 	golang.org/x/crypto v0.31.0
 )
 
@@ -44,7 +44,7 @@ func solveBlock(header *wire.BlockHeader, targetDifficulty *big.Int) bool {
 				return
 			default:
 				hdr.Nonce = i
-				hash := hdr.BlockHash()
+				hash := hdr.PowHash()
 				if blockchain.HashToBig(&hash).Cmp(targetDifficulty) <= 0 {
 					select {
 					case results <- sbResult{true, i}:
@@ -97,7 +97,7 @@ func standardCoinbaseScript(nextBlockHeight int32, extraNonce uint64) ([]byte, e
 // createCoinbaseTx returns a coinbase transaction paying an appropriate
 // subsidy based on the passed block height to the provided address.
 func createCoinbaseTx(coinbaseScript []byte, nextBlockHeight int32,
-	addr btcutil.Address, mineTo []wire.TxOut,
+	prevBits uint32, addr btcutil.Address, mineTo []wire.TxOut,
 	net *chaincfg.Params) (*btcutil.Tx, error) {
 
 	// Create the script to pay to the provided payment address.
@@ -116,8 +116,10 @@ func createCoinbaseTx(coinbaseScript []byte, nextBlockHeight int32,
 		Sequence:        wire.MaxTxInSequenceNum,
 	})
 	if len(mineTo) == 0 {
+		subsidy := blockchain.CalcBlockSubsidy(nextBlockHeight, prevBits,
+			net)
 		tx.AddTxOut(&wire.TxOut{
-			Value:    blockchain.CalcBlockSubsidy(nextBlockHeight, net),
+			Value:    subsidy,
 			PkScript: pkScript,
 		})
 	} else {
@@ -141,6 +143,7 @@ func CreateBlock(prevBlock *btcutil.Block, inclusionTxs []*btcutil.Tx,
 		prevHash      *chainhash.Hash
 		blockHeight   int32
 		prevBlockTime time.Time
+		prevBits      uint32
 	)
 
 	// If the previous block isn't specified, then we'll construct a block
@@ -149,10 +152,12 @@ func CreateBlock(prevBlock *btcutil.Block, inclusionTxs []*btcutil.Tx,
 		prevHash = net.GenesisHash
 		blockHeight = 1
 		prevBlockTime = net.GenesisBlock.Header.Timestamp.Add(time.Minute)
+		prevBits = net.GenesisBlock.Header.Bits
 	} else {
 		prevHash = prevBlock.Hash()
 		blockHeight = prevBlock.Height() + 1
 		prevBlockTime = prevBlock.MsgBlock().Header.Timestamp
+		prevBits = prevBlock.MsgBlock().Header.Bits
 	}
 
 	// If a target block time was specified, then use that as the header's
@@ -172,7 +177,7 @@ func CreateBlock(prevBlock *btcutil.Block, inclusionTxs []*btcutil.Tx,
 		return nil, err
 	}
 	coinbaseTx, err := createCoinbaseTx(coinbaseScript, blockHeight,
-		miningAddr, mineTo, net)
+		prevBits, miningAddr, mineTo, net)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,175 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package llmq
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dashpay/dashd-go/blscrypto"
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+var (
+	// ErrInvalidCommitment describes an error where a final quorum
+	// commitment does not conform to the parameters of its LLMQ type or
+	// its signatures do not verify.
+	ErrInvalidCommitment = errors.New("invalid final quorum commitment")
+
+	// ErrLegacyCommitment describes an error where the signatures of a
+	// final quorum commitment can't be verified because they were created
+	// with the legacy BLS scheme, whose hash to the curve is not
+	// implemented.
+	ErrLegacyCommitment = errors.New("signatures of legacy final quorum " +
+		"commitments are not supported")
+)
+
+// CommitmentScheme returns the BLS scheme the keys and signatures of the
+// passed final commitment are serialized in, which depends on its version.
+func CommitmentScheme(qc *wire.QuorumCommitment) blscrypto.Scheme {
+	switch qc.Version {
+	case wire.QuorumCommitmentVersionLegacy,
+		wire.QuorumCommitmentVersionLegacyIndexed:
+		return blscrypto.SchemeLegacy
+	}
+	return blscrypto.SchemeBasic
+}
+
+// CheckCommitment performs the checks of a final commitment which don't need
+// the members of the quorum: the LLMQ type must be known, the version must
+// match whether the type rotates, the bit sets must have one flag per member
+// and enough members must be valid and have signed.  Null commitments only
+// need bit sets of the right size.
+func CheckCommitment(qc *wire.QuorumCommitment) error {
+	params, ok := btcjson.LLMQType(qc.LLMQType).Params()
+	if !ok {
+		return fmt.Errorf("%w: unknown LLMQ type %d", ErrInvalidCommitment,
+			qc.LLMQType)
+	}
+
+	if len(qc.Signers) != params.Size ||
+		len(qc.ValidMembers) != params.Size {
+
+		return fmt.Errorf("%w: %d signers and %d valid members flagged "+
+			"for quorum size %d", ErrInvalidCommitment,
+			len(qc.Signers), len(qc.ValidMembers), params.Size)
+	}
+	if qc.IsNull() {
+		return nil
+	}
+
+	if qc.Version < wire.QuorumCommitmentVersionLegacy ||
+		qc.Version > wire.QuorumCommitmentVersionBasicIndexed {
+
+		return fmt.Errorf("%w: unknown version %d", ErrInvalidCommitment,
+			qc.Version)
+	}
+	if qc.IsIndexed() != params.UseRotation {
+		return fmt.Errorf("%w: version %d for LLMQ type %d with "+
+			"rotation %v", ErrInvalidCommitment, qc.Version,
+			qc.LLMQType, params.UseRotation)
+	}
+
+	if n := qc.CountValidMembers(); n < params.MinSize {
+		return fmt.Errorf("%w: %d valid members, min %d",
+			ErrInvalidCommitment, n, params.MinSize)
+	}
+	if n := qc.CountSigners(); n < params.MinSize {
+		return fmt.Errorf("%w: %d signers, min %d", ErrInvalidCommitment,
+			n, params.MinSize)
+	}
+
+	scheme := CommitmentScheme(qc)
+	_, err := blscrypto.PublicKeyFromBytes(qc.QuorumPublicKey[:], scheme)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCommitment, err)
+	}
+	if qc.QuorumVvecHash == (chainhash.Hash{}) {
+		return fmt.Errorf("%w: null verification vector hash",
+			ErrInvalidCommitment)
+	}
+
+	return nil
+}
+
+// VerifyCommitment checks the final commitment with CheckCommitment and
+// verifies its signatures.  The members are the operator keys of the members
+// of the quorum in the order of the bit sets, as determined by the
+// deterministic quorum selection at the quorum hash.  A quorum can have fewer
+// members than its size when there are not enough masternodes, in which case
+// the flags of the missing members must be unset.
+//
+// The members signature must be the secure aggregation of the signatures of
+// the commitment hash by the signers, and the quorum signature must be the
+// signature of the commitment hash by the quorum public key.
+func VerifyCommitment(qc *wire.QuorumCommitment,
+	members []*blscrypto.PublicKey) error {
+
+	if err := CheckCommitment(qc); err != nil {
+		return err
+	}
+	if qc.IsNull() {
+		return nil
+	}
+
+	if len(members) > len(qc.Signers) {
+		return fmt.Errorf("%w: %d members for quorum size %d",
+			ErrInvalidCommitment, len(members), len(qc.Signers))
+	}
+	for i := len(members); i < len(qc.Signers); i++ {
+		if qc.Signers[i] || qc.ValidMembers[i] {
+			return fmt.Errorf("%w: flag set for missing member %d",
+				ErrInvalidCommitment, i)
+		}
+	}
+
+	scheme := CommitmentScheme(qc)
+	if scheme == blscrypto.SchemeLegacy {
+		return fmt.Errorf("%w: version %d", ErrLegacyCommitment,
+			qc.Version)
+	}
+
+	signers := make([]*blscrypto.PublicKey, 0, len(members))
+	for i, signed := range qc.Signers[:len(members)] {
+		if !signed {
+			continue
+		}
+		if members[i] == nil {
+			return fmt.Errorf("%w: no operator key for signer %d",
+				ErrInvalidCommitment, i)
+		}
+		signers = append(signers, members[i])
+	}
+
+	quorumPubKey, err := blscrypto.PublicKeyFromBytes(
+		qc.QuorumPublicKey[:], scheme)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCommitment, err)
+	}
+	quorumSig, err := blscrypto.SignatureFromBytes(qc.QuorumSig[:], scheme)
+	if err != nil {
+		return fmt.Errorf("%w: quorum signature: %v",
+			ErrInvalidCommitment, err)
+	}
+	membersSig, err := blscrypto.SignatureFromBytes(qc.MembersSig[:], scheme)
+	if err != nil {
+		return fmt.Errorf("%w: members signature: %v",
+			ErrInvalidCommitment, err)
+	}
+
+	hash := qc.CommitmentHash()
+	if !membersSig.VerifySecureAggregated(signers, hash[:], scheme) {
+		return fmt.Errorf("%w: members signature does not verify",
+			ErrInvalidCommitment)
+	}
+	if !quorumSig.Verify(quorumPubKey, hash[:]) {
+		return fmt.Errorf("%w: quorum signature does not verify",
+			ErrInvalidCommitment)
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package llmq
+
+import (
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/dashpay/dashd-go/blscrypto"
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// memberKeys are the operator keys of the members of a quorum.
+type memberKeys = []*blscrypto.PublicKey
+
+// testCommitment returns a final commitment of a test quorum with three
+// members of which the first two signed, signed by the returned member keys.
+func testCommitment(t *testing.T) (*wire.QuorumCommitment, []*blscrypto.PublicKey) {
+	t.Helper()
+
+	quorumKey, err := blscrypto.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: unexpected error: %v", err)
+	}
+	qc := &wire.QuorumCommitment{
+		Version:        wire.QuorumCommitmentVersionBasic,
+		LLMQType:       uint8(btcjson.LLMQType_TEST),
+		QuorumHash:     chainhash.Hash{0x01},
+		Signers:        []bool{true, true, false},
+		ValidMembers:   []bool{true, true, true},
+		QuorumVvecHash: chainhash.Hash{0x02},
+	}
+	copy(qc.QuorumPublicKey[:],
+		quorumKey.PublicKey().Serialize(blscrypto.SchemeBasic))
+
+	hash := qc.CommitmentHash()
+	members := make([]*blscrypto.PublicKey, 3)
+	var sigs []*blscrypto.Signature
+	var signers []*blscrypto.PublicKey
+	for i := range members {
+		sk, err := blscrypto.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatalf("GenerateKey: unexpected error: %v", err)
+		}
+		members[i] = sk.PublicKey()
+		if !qc.Signers[i] {
+			continue
+		}
+		sig, err := sk.Sign(hash[:])
+		if err != nil {
+			t.Fatalf("Sign: unexpected error: %v", err)
+		}
+		sigs = append(sigs, sig)
+		signers = append(signers, members[i])
+	}
+
+	membersSig, err := blscrypto.AggregateSignaturesSecure(sigs, signers,
+		blscrypto.SchemeBasic)
+	if err != nil {
+		t.Fatalf("AggregateSignaturesSecure: unexpected error: %v", err)
+	}
+	copy(qc.MembersSig[:], membersSig.Serialize(blscrypto.SchemeBasic))
+	quorumSig, err := quorumKey.Sign(hash[:])
+	if err != nil {
+		t.Fatalf("Sign: unexpected error: %v", err)
+	}
+	copy(qc.QuorumSig[:], quorumSig.Serialize(blscrypto.SchemeBasic))
+
+	return qc, members
+}
+
+// TestVerifyCommitment ensures valid final commitments verify and that
+// commitments violating the parameters of their LLMQ type or carrying bad
+// signatures are rejected.
+func TestVerifyCommitment(t *testing.T) {
+	qc, members := testCommitment(t)
+	if err := VerifyCommitment(qc, members); err != nil {
+		t.Fatalf("VerifyCommitment: unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(qc *wire.QuorumCommitment, members memberKeys) memberKeys
+		wantErr error
+	}{{
+		name: "unknown LLMQ type",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			qc.LLMQType = 0
+			return members
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "bit set size mismatch",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			qc.Signers = append(qc.Signers, false)
+			return members
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "unknown version",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			qc.Version = 5
+			return members
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "indexed version for non-rotating type",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			qc.Version = wire.QuorumCommitmentVersionBasicIndexed
+			return members
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "too few signers",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			qc.Signers[1] = false
+			return members
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "too few valid members",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			qc.ValidMembers = []bool{true, false, false}
+			return members
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "null verification vector hash",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			qc.QuorumVvecHash = chainhash.Hash{}
+			return members
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "flag set for missing member",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			return members[:2]
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "different signers",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			qc.Signers = []bool{true, false, true}
+			return members
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "wrong member key",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			return []*blscrypto.PublicKey{members[0], members[2],
+				members[1]}
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "different quorum hash",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			qc.QuorumHash[0] ^= 0xff
+			return members
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "quorum signature of another key",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			qc.QuorumSig = qc.MembersSig
+			return members
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "legacy version",
+		modify: func(qc *wire.QuorumCommitment, members memberKeys) memberKeys {
+			qc.Version = wire.QuorumCommitmentVersionLegacy
+			copy(qc.QuorumPublicKey[:], members[0].Serialize(
+				blscrypto.SchemeLegacy))
+			return members
+		},
+		wantErr: ErrLegacyCommitment,
+	}}
+
+	for _, test := range tests {
+		qc, members := testCommitment(t)
+		err := VerifyCommitment(qc, test.modify(qc, members))
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("%s: got %v, want %v", test.name, err,
+				test.wantErr)
+		}
+	}
+}
+
+// TestCheckCommitment ensures null commitments only need bit sets of the
+// quorum size and that rotating quorums need an indexed version.
+func TestCheckCommitment(t *testing.T) {
+	tests := []struct {
+		name    string
+		qc      wire.QuorumCommitment
+		wantErr error
+	}{{
+		name: "null commitment",
+		qc: wire.QuorumCommitment{
+			Version:      wire.QuorumCommitmentVersionBasic,
+			LLMQType:     uint8(btcjson.LLMQType_TEST),
+			Signers:      make([]bool, 3),
+			ValidMembers: make([]bool, 3),
+		},
+	}, {
+		name: "null commitment of wrong size",
+		qc: wire.QuorumCommitment{
+			Version:      wire.QuorumCommitmentVersionBasic,
+			LLMQType:     uint8(btcjson.LLMQType_TEST),
+			Signers:      make([]bool, 4),
+			ValidMembers: make([]bool, 4),
+		},
+		wantErr: ErrInvalidCommitment,
+	}, {
+		name: "null rotating commitment",
+		qc: wire.QuorumCommitment{
+			Version:      wire.QuorumCommitmentVersionBasicIndexed,
+			LLMQType:     uint8(btcjson.LLMQType_TEST_DIP0024),
+			QuorumIndex:  1,
+			Signers:      make([]bool, 4),
+			ValidMembers: make([]bool, 4),
+		},
+	}, {
+		name: "non-indexed version for rotating type",
+		qc: wire.QuorumCommitment{
+			Version:         wire.QuorumCommitmentVersionBasic,
+			LLMQType:        uint8(btcjson.LLMQType_TEST_DIP0024),
+			Signers:         []bool{true, true, true, true},
+			ValidMembers:    []bool{true, true, true, true},
+			QuorumVvecHash:  chainhash.Hash{0x01},
+			QuorumPublicKey: [48]byte{0xc0},
+		},
+		wantErr: ErrInvalidCommitment,
+	}}
+
+	for _, test := range tests {
+		err := CheckCommitment(&test.qc)
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("%s: got %v, want %v", test.name, err,
+				test.wantErr)
+		}
+	}
+
+	// Null commitments have nothing to verify.
+	null := tests[0].qc
+	if err := VerifyCommitment(&null, nil); err != nil {
+		t.Errorf("VerifyCommitment: null commitment: unexpected error: %v",
+			err)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package llmq implements the local rules of long living masternode quorums
+(LLMQs) as specified by DIP-7 and DIP-24.
+
+These are the rules a client needs in order to check a recovered quorum
+signature, such as the signature of a ChainLock or of an InstantSend lock,
+against a set of quorums it already verified, without asking a node through
+the quorum selectquorum and quorum verify RPCs.
+
+# Sign Hash
+
+A quorum never signs a message hash directly.  It signs the hash of the LLMQ
+type, the quorum hash, the request ID and the message hash, which is returned
+by SignHash.  This binds every signature to the quorum which created it and to
+the signing session it belongs to.
+
+# Quorum Selection
+
+Every request ID is signed by exactly one of the active quorums of a type.  The
+active quorums are the signingActiveQuorumCount most recent quorums of the
+type as of SignHeightOffset blocks below the chain tip, so nodes which are a
+few blocks apart still agree on them.
+
+For non-rotating quorums, SelectQuorum picks the quorum with the lowest hash of
+the LLMQ type, the quorum hash and the request ID.  For rotating quorums
+(DIP-24), SelectRotatedQuorum picks the quorum whose quorum index is taken from
+the most significant bits of the request ID.
+
+VerifyRecoveredSig combines the selection with the verification of the
+signature against the public key of the selected quorum.
+
+A Verifier looks up the active quorums through its VerifierConfig and verifies
+ChainLocks and deterministic InstantSend locks with them, so it can be used as
+a blockchain.ChainLockVerifier and to verify the locks of the mempool.  Locks
+signed by quorums whose final commitments use the legacy BLS scheme are
+rejected with ErrLegacyCommitment.
+
+# Final Commitments
+
+A quorum is only trusted once its final commitment, a wire.QuorumCommitment,
+checks out.  CheckCommitment ensures the bit sets match the size of the LLMQ
+type, enough members are valid and signed, and the version matches whether the
+type rotates.  VerifyCommitment additionally verifies, given the operator keys
+of the members, that the members signature is the secure aggregation of the
+signatures of the commitment hash by the signers and that the quorum public key
+signed the same hash.  Commitments using the legacy BLS scheme can be checked
+but their signatures can't be verified, see ErrLegacyCommitment.
+*/
+package llmq
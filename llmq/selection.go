@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package llmq
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/dashpay/dashd-go/blscrypto"
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+// SignHeightOffset is the number of blocks below the chain tip at which the
+// active quorums of a signing session are determined.
+const SignHeightOffset = 8
+
+var (
+	// ErrNoQuorums describes an error where a quorum is selected from an
+	// empty set of active quorums.
+	ErrNoQuorums = errors.New("no active quorums")
+
+	// ErrQuorumNotFound describes an error where the active quorums of a
+	// rotating type don't include the quorum index selected for a request.
+	ErrQuorumNotFound = errors.New("selected quorum not found")
+)
+
+// Quorum describes an active quorum as needed to select it for a request and
+// to verify its signatures.
+type Quorum struct {
+	// Hash is the hash of the block at which the quorum was formed.
+	Hash chainhash.Hash
+
+	// Index is the quorum index of rotating quorums, which is zero for
+	// non-rotating quorums.
+	Index int16
+
+	// PublicKey is the public key of the quorum.
+	PublicKey *blscrypto.PublicKey
+
+	// Scheme is the BLS scheme the signatures of the quorum are
+	// serialized in.
+	Scheme blscrypto.Scheme
+}
+
+// selectionHash returns the hash which orders the non-rotating quorums for a
+// request ID.
+func selectionHash(llmqType btcjson.LLMQType, quorumHash,
+	requestID *chainhash.Hash) chainhash.Hash {
+
+	var buf [1 + 2*chainhash.HashSize]byte
+	buf[0] = uint8(llmqType)
+	copy(buf[1:], quorumHash[:])
+	copy(buf[1+chainhash.HashSize:], requestID[:])
+	return chainhash.DoubleHashH(buf[:])
+}
+
+// SelectQuorum returns the quorum responsible for the passed request ID among
+// the active quorums of a non-rotating LLMQ type.  It is the quorum with the
+// lowest selection hash, where hashes are compared by their raw bytes.
+func SelectQuorum(llmqType btcjson.LLMQType, requestID *chainhash.Hash,
+	quorums []*Quorum) (*Quorum, error) {
+
+	if len(quorums) == 0 {
+		return nil, ErrNoQuorums
+	}
+
+	var selected *Quorum
+	var lowest chainhash.Hash
+	for _, quorum := range quorums {
+		h := selectionHash(llmqType, &quorum.Hash, requestID)
+		if selected == nil || bytes.Compare(h[:], lowest[:]) < 0 {
+			selected = quorum
+			lowest = h
+		}
+	}
+	return selected, nil
+}
+
+// SelectRotatedQuorum returns the quorum responsible for the passed request ID
+// among the active quorums of a rotating LLMQ type.  The quorums must be all
+// signingActiveQuorumCount active quorums of the type, which is a power of two,
+// and the one whose quorum index is encoded in the most significant bits of
+// the request ID is selected.
+func SelectRotatedQuorum(requestID *chainhash.Hash,
+	quorums []*Quorum) (*Quorum, error) {
+
+	count := len(quorums)
+	if count == 0 {
+		return nil, ErrNoQuorums
+	}
+	if count > 64 || count&(count-1) != 0 {
+		return nil, fmt.Errorf("number of active rotating quorums %d is "+
+			"not a power of two up to 64", count)
+	}
+
+	// The index is the n bits below the most significant bit of the last
+	// 64 bits of the request ID, where n is log2 of the number of active
+	// quorums.  This matches Dash Core, which shifts by one bit more than
+	// needed.
+	n := uint(bits.TrailingZeros(uint(count)))
+	b := binary.LittleEndian.Uint64(requestID[24:])
+	index := (b >> (64 - n - 1)) & (1<<n - 1)
+
+	for _, quorum := range quorums {
+		if uint64(quorum.Index) == index {
+			return quorum, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no quorum with index %d", ErrQuorumNotFound,
+		index)
+}
+
+// selectQuorum selects the quorum responsible for the request ID among the
+// passed active quorums of the LLMQ type with the rules of rotating or
+// non-rotating quorums.
+func selectQuorum(llmqType btcjson.LLMQType, rotating bool,
+	requestID *chainhash.Hash, quorums []*Quorum) (*Quorum, error) {
+
+	if rotating {
+		return SelectRotatedQuorum(requestID, quorums)
+	}
+	return SelectQuorum(llmqType, requestID, quorums)
+}
+
+// VerifyRecoveredSig selects the quorum responsible for the request ID among
+// the passed active quorums of the LLMQ type and verifies the recovered
+// signature of the message hash against it.  The rotating flag selects the
+// DIP-24 rules.  It returns the selected quorum when the signature is valid.
+func VerifyRecoveredSig(llmqType btcjson.LLMQType, rotating bool,
+	quorums []*Quorum, requestID, msgHash *chainhash.Hash,
+	sig *blscrypto.Signature) (*Quorum, error) {
+
+	quorum, err := selectQuorum(llmqType, rotating, requestID, quorums)
+	if err != nil {
+		return nil, err
+	}
+
+	if quorum.PublicKey == nil {
+		return nil, fmt.Errorf("selected quorum %v has no public key",
+			quorum.Hash)
+	}
+	if !VerifySignature(sig, quorum.PublicKey, llmqType, &quorum.Hash,
+		requestID, msgHash) {
+
+		return nil, fmt.Errorf("%w: request %v by quorum %v",
+			blscrypto.ErrInvalidSignature, requestID, quorum.Hash)
+	}
+	return quorum, nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package llmq
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/dashpay/dashd-go/blscrypto"
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+// TestSignHash ensures the sign hash commits to the LLMQ type as a single byte
+// followed by the quorum hash, request ID and message hash.
+func TestSignHash(t *testing.T) {
+	quorumHash := chainhash.Hash{0x01}
+	requestID := chainhash.Hash{0x02}
+	msgHash := chainhash.Hash{0x03}
+
+	data := []byte{byte(btcjson.LLMQType_400_60)}
+	data = append(data, quorumHash[:]...)
+	data = append(data, requestID[:]...)
+	data = append(data, msgHash[:]...)
+	want := chainhash.DoubleHashH(data)
+
+	got := SignHash(btcjson.LLMQType_400_60, &quorumHash, &requestID,
+		&msgHash)
+	if got != want {
+		t.Fatalf("SignHash: got %v, want %v", got, want)
+	}
+}
+
+// TestSelectQuorum ensures the non-rotating quorum with the lowest selection
+// hash is selected regardless of the order of the active quorums.
+func TestSelectQuorum(t *testing.T) {
+	llmqType := btcjson.LLMQType_50_60
+	quorums := make([]*Quorum, 24)
+	for i := range quorums {
+		quorums[i] = &Quorum{Hash: chainhash.Hash{byte(i), 0xaa}}
+	}
+
+	for i := 0; i < 16; i++ {
+		requestID := chainhash.DoubleHashH([]byte{byte(i)})
+		selected, err := SelectQuorum(llmqType, &requestID, quorums)
+		if err != nil {
+			t.Fatalf("SelectQuorum: unexpected error: %v", err)
+		}
+
+		want := selectionHash(llmqType, &selected.Hash, &requestID)
+		for _, quorum := range quorums {
+			h := selectionHash(llmqType, &quorum.Hash, &requestID)
+			if bytes.Compare(h[:], want[:]) < 0 {
+				t.Fatalf("SelectQuorum: quorum %v has a lower hash "+
+					"than selected quorum %v", quorum.Hash,
+					selected.Hash)
+			}
+		}
+
+		// The order of the quorums doesn't matter.
+		reversed := make([]*Quorum, len(quorums))
+		for j, quorum := range quorums {
+			reversed[len(quorums)-1-j] = quorum
+		}
+		again, err := SelectQuorum(llmqType, &requestID, reversed)
+		if err != nil || again != selected {
+			t.Fatalf("SelectQuorum: selection depends on order")
+		}
+	}
+
+	if _, err := SelectQuorum(llmqType, &chainhash.Hash{}, nil); err != ErrNoQuorums {
+		t.Fatalf("SelectQuorum: got %v for no quorums, want %v", err,
+			ErrNoQuorums)
+	}
+}
+
+// TestSelectRotatedQuorum ensures the rotating quorum index is taken from the
+// bits below the most significant bit of the request ID.
+func TestSelectRotatedQuorum(t *testing.T) {
+	quorums := make([]*Quorum, 4)
+	for i := range quorums {
+		quorums[i] = &Quorum{Hash: chainhash.Hash{byte(i)}, Index: int16(i)}
+	}
+
+	tests := []struct {
+		name     string
+		lastByte byte
+		index    int16
+	}{
+		{"zero", 0x00, 0},
+		{"msb ignored", 0x80, 0},
+		{"index 1", 0x20, 1},
+		{"index 2", 0x40, 2},
+		{"index 3", 0xe0, 3},
+		{"low bits ignored", 0x1f, 0},
+	}
+	for _, test := range tests {
+		var requestID chainhash.Hash
+		requestID[chainhash.HashSize-1] = test.lastByte
+		selected, err := SelectRotatedQuorum(&requestID, quorums)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if selected.Index != test.index {
+			t.Errorf("%s: selected index %d, want %d", test.name,
+				selected.Index, test.index)
+		}
+	}
+
+	var requestID chainhash.Hash
+	requestID[chainhash.HashSize-1] = 0x60
+	_, err := SelectRotatedQuorum(&requestID, quorums[:2])
+	if err != nil {
+		t.Fatalf("SelectRotatedQuorum: unexpected error for two "+
+			"quorums: %v", err)
+	}
+	_, err = SelectRotatedQuorum(&requestID, quorums[:3])
+	if err == nil {
+		t.Fatalf("SelectRotatedQuorum: unexpected success for three " +
+			"quorums")
+	}
+	missing := []*Quorum{quorums[0], quorums[1], quorums[2], quorums[2]}
+	_, err = SelectRotatedQuorum(&requestID, missing)
+	if !errors.Is(err, ErrQuorumNotFound) {
+		t.Fatalf("SelectRotatedQuorum: got %v for missing index, want %v",
+			err, ErrQuorumNotFound)
+	}
+}
+
+// TestVerifyRecoveredSig ensures recovered signatures only verify when they
+// were created by the selected quorum over the sign hash.
+func TestVerifyRecoveredSig(t *testing.T) {
+	llmqType := btcjson.LLMQType_TEST
+	sks := make([]*blscrypto.SecretKey, 3)
+	quorums := make([]*Quorum, len(sks))
+	for i := range sks {
+		var err error
+		sks[i], err = blscrypto.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatalf("GenerateKey: unexpected error: %v", err)
+		}
+		quorums[i] = &Quorum{
+			Hash:      chainhash.Hash{byte(i)},
+			PublicKey: sks[i].PublicKey(),
+		}
+	}
+
+	requestID := chainhash.Hash{0x01}
+	msgHash := chainhash.Hash{0x02}
+	selected, err := SelectQuorum(llmqType, &requestID, quorums)
+	if err != nil {
+		t.Fatalf("SelectQuorum: unexpected error: %v", err)
+	}
+
+	for i, quorum := range quorums {
+		signHash := SignHash(llmqType, &quorum.Hash, &requestID, &msgHash)
+		sig, err := sks[i].Sign(signHash[:])
+		if err != nil {
+			t.Fatalf("Sign: unexpected error: %v", err)
+		}
+		got, err := VerifyRecoveredSig(llmqType, false, quorums,
+			&requestID, &msgHash, sig)
+		if quorum == selected {
+			if err != nil || got != selected {
+				t.Fatalf("VerifyRecoveredSig: unexpected error "+
+					"for selected quorum: %v", err)
+			}
+			continue
+		}
+		if !errors.Is(err, blscrypto.ErrInvalidSignature) {
+			t.Fatalf("VerifyRecoveredSig: got %v for quorum which "+
+				"is not selected, want %v", err,
+				blscrypto.ErrInvalidSignature)
+		}
+	}
+}
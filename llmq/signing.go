@@ -0,0 +1,40 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package llmq
+
+import (
+	"bytes"
+
+	"github.com/dashpay/dashd-go/blscrypto"
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+// SignHash returns the hash which is signed by a quorum of the passed type and
+// hash for the passed request ID and message hash.  It is the double SHA-256
+// hash of the LLMQ type serialized as a single byte followed by the three
+// hashes.
+func SignHash(llmqType btcjson.LLMQType, quorumHash, requestID,
+	msgHash *chainhash.Hash) chainhash.Hash {
+
+	var buf bytes.Buffer
+	buf.Grow(1 + 3*chainhash.HashSize)
+	buf.WriteByte(uint8(llmqType))
+	buf.Write(quorumHash[:])
+	buf.Write(requestID[:])
+	buf.Write(msgHash[:])
+	return chainhash.DoubleHashH(buf.Bytes())
+}
+
+// VerifySignature returns whether the passed signature is the signature of the
+// message hash for the request ID by the quorum with the passed type, hash and
+// public key.
+func VerifySignature(sig *blscrypto.Signature, quorumPubKey *blscrypto.PublicKey,
+	llmqType btcjson.LLMQType, quorumHash, requestID,
+	msgHash *chainhash.Hash) bool {
+
+	signHash := SignHash(llmqType, quorumHash, requestID, msgHash)
+	return sig.Verify(quorumPubKey, signHash[:])
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package llmq
+
+import (
+	"fmt"
+
+	"github.com/dashpay/dashd-go/blscrypto"
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// VerifierConfig is the configuration of a Verifier.
+type VerifierConfig struct {
+	// ChainParams are the parameters of the network, which define the
+	// LLMQ types signing ChainLocks and InstantSend locks.
+	ChainParams *chaincfg.Params
+
+	// ActiveQuorums defines the function to use to look up the active
+	// quorums of an LLMQ type at the block of the main chain at the passed
+	// height.
+	ActiveQuorums func(llmqType btcjson.LLMQType, height int32) ([]*Quorum, error)
+
+	// BestHeight defines the function to use to access the height of the
+	// current best chain.
+	BestHeight func() int32
+
+	// BlockHeightByHash defines the function to use to look up the height
+	// of the block of the main chain with the passed hash.
+	BlockHeightByHash func(hash *chainhash.Hash) (int32, error)
+}
+
+// Verifier verifies the recovered signatures of ChainLocks and InstantSend
+// locks against the quorums which were active when they were signed.
+type Verifier struct {
+	cfg VerifierConfig
+}
+
+// NewVerifier returns a verifier using the passed configuration.
+func NewVerifier(cfg *VerifierConfig) *Verifier {
+	return &Verifier{cfg: *cfg}
+}
+
+// verify verifies the recovered signature of the message hash for the request
+// ID by the quorum of the passed type which is responsible for the request
+// among the quorums active at the passed height.
+func (v *Verifier) verify(llmqType btcjson.LLMQType, height int32,
+	requestID, msgHash *chainhash.Hash, sig []byte) error {
+
+	params, ok := llmqType.Params()
+	if !ok {
+		return fmt.Errorf("unknown LLMQ type %d", llmqType)
+	}
+	if height < 0 {
+		return fmt.Errorf("%w: sign height %d", ErrNoQuorums, height)
+	}
+	quorums, err := v.cfg.ActiveQuorums(llmqType, height)
+	if err != nil {
+		return err
+	}
+
+	quorum, err := selectQuorum(llmqType, params.UseRotation, requestID,
+		quorums)
+	if err != nil {
+		return err
+	}
+	if quorum.PublicKey == nil {
+		return fmt.Errorf("selected quorum %v has no public key",
+			quorum.Hash)
+	}
+	if quorum.Scheme == blscrypto.SchemeLegacy {
+		return fmt.Errorf("%w: quorum %v", ErrLegacyCommitment,
+			quorum.Hash)
+	}
+	parsed, err := blscrypto.SignatureFromBytes(sig, quorum.Scheme)
+	if err != nil {
+		return err
+	}
+	if !VerifySignature(parsed, quorum.PublicKey, llmqType, &quorum.Hash,
+		requestID, msgHash) {
+
+		return fmt.Errorf("%w: request %v by quorum %v",
+			blscrypto.ErrInvalidSignature, requestID, quorum.Hash)
+	}
+	return nil
+}
+
+// VerifyChainLock verifies the signature of the passed ChainLock.  It must be
+// the recovered signature of the block hash by the ChainLock quorum selected
+// among those active SignHeightOffset blocks below the locked block.
+//
+// This is part of the blockchain.ChainLockVerifier interface.
+func (v *Verifier) VerifyChainLock(clsig *wire.MsgCLSig) error {
+	llmqType := v.cfg.ChainParams.LLMQTypeChainLocks
+	requestID := clsig.RequestID()
+	return v.verify(llmqType, clsig.Height-SignHeightOffset, &requestID,
+		&clsig.BlockHash, clsig.Signature[:])
+}
+
+// VerifyInstantLock verifies the signature of the passed deterministic
+// InstantSend lock.  It must be the recovered signature of the txid by the
+// rotating InstantSend quorum selected among those active at the end of the
+// cycle of the lock, or at the chain tip while the cycle is not over.  Like
+// Dash Core, the quorums active one cycle earlier are tried as well, since a
+// new set of quorums may have been mined in between.
+func (v *Verifier) VerifyInstantLock(islock *wire.MsgISDLock) error {
+	llmqType := v.cfg.ChainParams.LLMQTypeDIP0024InstantSend
+	params, ok := llmqType.Params()
+	if !ok {
+		return fmt.Errorf("unknown LLMQ type %d", llmqType)
+	}
+	cycleHeight, err := v.cfg.BlockHeightByHash(&islock.CycleHash)
+	if err != nil {
+		return fmt.Errorf("cycle block %v of InstantSend lock: %v",
+			islock.CycleHash, err)
+	}
+	interval := int32(params.DKGInterval)
+	if cycleHeight%interval != 0 {
+		return fmt.Errorf("block %v at height %d of InstantSend lock does "+
+			"not start a cycle", islock.CycleHash, cycleHeight)
+	}
+
+	signHeight := v.cfg.BestHeight()
+	if cycleHeight+interval < signHeight {
+		signHeight = cycleHeight + interval - 1
+	}
+	requestID := islock.RequestID()
+	err = v.verify(llmqType, signHeight, &requestID, &islock.TxID,
+		islock.Signature[:])
+	if err == nil {
+		return nil
+	}
+	if v.verify(llmqType, signHeight-interval, &requestID, &islock.TxID,
+		islock.Signature[:]) == nil {
+
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package llmq
+
+import (
+	"crypto/rand"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/blscrypto"
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// testVerifierQuorums returns active quorums with generated keys, together
+// with their secret keys by quorum hash.  The passed byte tells the hashes of
+// different sets of quorums apart.
+func testVerifierQuorums(t *testing.T, count int, set byte) ([]*Quorum,
+	map[chainhash.Hash]*blscrypto.SecretKey) {
+
+	quorums := make([]*Quorum, 0, count)
+	keys := make(map[chainhash.Hash]*blscrypto.SecretKey, count)
+	for i := 0; i < count; i++ {
+		sk, err := blscrypto.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatalf("GenerateKey: unexpected error: %v", err)
+		}
+		quorum := &Quorum{
+			Hash:      chainhash.Hash{byte(i + 1), set},
+			Index:     int16(i),
+			PublicKey: sk.PublicKey(),
+			Scheme:    blscrypto.SchemeBasic,
+		}
+		quorums = append(quorums, quorum)
+		keys[quorum.Hash] = sk
+	}
+	return quorums, keys
+}
+
+// TestVerifyChainLock ensures ChainLocks are verified against the quorum
+// selected among those active SignHeightOffset blocks below the locked block.
+func TestVerifyChainLock(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+	llmqType := params.LLMQTypeChainLocks
+	quorums, keys := testVerifierQuorums(t, 2, 0)
+
+	var gotType btcjson.LLMQType
+	var gotHeight int32
+	v := NewVerifier(&VerifierConfig{
+		ChainParams: params,
+		ActiveQuorums: func(llmqType btcjson.LLMQType,
+			height int32) ([]*Quorum, error) {
+
+			gotType, gotHeight = llmqType, height
+			return quorums, nil
+		},
+	})
+
+	clsig := &wire.MsgCLSig{Height: 100, BlockHash: chainhash.Hash{0xcc}}
+	requestID := clsig.RequestID()
+	quorum, err := SelectQuorum(llmqType, &requestID, quorums)
+	if err != nil {
+		t.Fatalf("SelectQuorum: unexpected error: %v", err)
+	}
+	signHash := SignHash(llmqType, &quorum.Hash, &requestID,
+		&clsig.BlockHash)
+	sig, err := keys[quorum.Hash].Sign(signHash[:])
+	if err != nil {
+		t.Fatalf("Sign: unexpected error: %v", err)
+	}
+	copy(clsig.Signature[:], sig.Serialize(blscrypto.SchemeBasic))
+
+	if err := v.VerifyChainLock(clsig); err != nil {
+		t.Fatalf("VerifyChainLock: unexpected error: %v", err)
+	}
+	if gotType != llmqType || gotHeight != clsig.Height-SignHeightOffset {
+		t.Fatalf("ActiveQuorums: got type %d at height %d, want type %d "+
+			"at height %d", gotType, gotHeight, llmqType,
+			clsig.Height-SignHeightOffset)
+	}
+
+	// The signature does not cover another block.
+	clsig.BlockHash = chainhash.Hash{0xdd}
+	err = v.VerifyChainLock(clsig)
+	if !errors.Is(err, blscrypto.ErrInvalidSignature) {
+		t.Fatalf("VerifyChainLock of other block: got %v, want %v", err,
+			blscrypto.ErrInvalidSignature)
+	}
+
+	// Signatures of quorums with legacy commitments can't be verified.
+	quorum.Scheme = blscrypto.SchemeLegacy
+	err = v.VerifyChainLock(clsig)
+	if !errors.Is(err, ErrLegacyCommitment) {
+		t.Fatalf("VerifyChainLock by legacy quorum: got %v, want %v",
+			err, ErrLegacyCommitment)
+	}
+}
+
+// TestVerifyInstantLock ensures InstantSend locks are verified against the
+// rotating quorum selected among those active at the end of the cycle of the
+// lock, or at the chain tip during the cycle, and among those active one cycle
+// earlier.
+func TestVerifyInstantLock(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+	llmqType := params.LLMQTypeDIP0024InstantSend
+	llmqParams, _ := llmqType.Params()
+	interval := int32(llmqParams.DKGInterval)
+	current, currentKeys := testVerifierQuorums(t, 2, 0)
+	previous, previousKeys := testVerifierQuorums(t, 2, 1)
+
+	cycleHash := chainhash.Hash{0xcc}
+	cycleHeight := 2 * interval
+	var bestHeight int32
+	var heights []int32
+	v := NewVerifier(&VerifierConfig{
+		ChainParams: params,
+		ActiveQuorums: func(llmqType btcjson.LLMQType,
+			height int32) ([]*Quorum, error) {
+
+			heights = append(heights, height)
+			if height >= cycleHeight {
+				return current, nil
+			}
+			return previous, nil
+		},
+		BestHeight: func() int32 {
+			return bestHeight
+		},
+		BlockHeightByHash: func(hash *chainhash.Hash) (int32, error) {
+			if *hash == cycleHash {
+				return cycleHeight, nil
+			}
+			return cycleHeight + 1, nil
+		},
+	})
+
+	// signedLock returns a lock of the cycle signed by the quorum selected
+	// among the passed quorums.
+	signedLock := func(quorums []*Quorum,
+		keys map[chainhash.Hash]*blscrypto.SecretKey) *wire.MsgISDLock {
+
+		islock := &wire.MsgISDLock{
+			Version:   1,
+			Inputs:    []wire.OutPoint{{Hash: chainhash.Hash{0x01}}},
+			TxID:      chainhash.Hash{0x02},
+			CycleHash: cycleHash,
+		}
+		requestID := islock.RequestID()
+		quorum, err := SelectRotatedQuorum(&requestID, quorums)
+		if err != nil {
+			t.Fatalf("SelectRotatedQuorum: unexpected error: %v", err)
+		}
+		signHash := SignHash(llmqType, &quorum.Hash, &requestID,
+			&islock.TxID)
+		sig, err := keys[quorum.Hash].Sign(signHash[:])
+		if err != nil {
+			t.Fatalf("Sign: unexpected error: %v", err)
+		}
+		copy(islock.Signature[:], sig.Serialize(blscrypto.SchemeBasic))
+		return islock
+	}
+
+	tests := []struct {
+		name        string
+		bestHeight  int32
+		signed      []*Quorum
+		signedKeys  map[chainhash.Hash]*blscrypto.SecretKey
+		wantHeights []int32
+	}{{
+		name:        "during the cycle",
+		bestHeight:  cycleHeight + interval,
+		signed:      current,
+		signedKeys:  currentKeys,
+		wantHeights: []int32{cycleHeight + interval},
+	}, {
+		name:        "after the cycle",
+		bestHeight:  cycleHeight + interval + 1,
+		signed:      current,
+		signedKeys:  currentKeys,
+		wantHeights: []int32{cycleHeight + interval - 1},
+	}, {
+		name:        "previous quorums",
+		bestHeight:  cycleHeight + 5,
+		signed:      previous,
+		signedKeys:  previousKeys,
+		wantHeights: []int32{cycleHeight + 5, cycleHeight + 5 - interval},
+	}}
+	for _, test := range tests {
+		bestHeight = test.bestHeight
+		heights = nil
+		err := v.VerifyInstantLock(signedLock(test.signed, test.signedKeys))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if !reflect.DeepEqual(heights, test.wantHeights) {
+			t.Fatalf("%s: active quorums at heights %v, want %v",
+				test.name, heights, test.wantHeights)
+		}
+	}
+
+	// A lock signed with other keys is rejected.
+	_, otherKeys := testVerifierQuorums(t, 2, 0)
+	err := v.VerifyInstantLock(signedLock(current, otherKeys))
+	if !errors.Is(err, blscrypto.ErrInvalidSignature) {
+		t.Fatalf("other quorum: got %v, want %v", err,
+			blscrypto.ErrInvalidSignature)
+	}
+
+	// The cycle hash must be the first block of a cycle.
+	islock := signedLock(current, currentKeys)
+	islock.CycleHash = chainhash.Hash{0xdd}
+	if err := v.VerifyInstantLock(islock); err == nil {
+		t.Fatal("lock of block inside cycle: expected error")
+	}
+}
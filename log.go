@@ -15,11 +15,13 @@ import (
 	"github.com/dashpay/dashd-go/blockchain/indexers"
 	"github.com/dashpay/dashd-go/connmgr"
 	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/masternodelist"
 	"github.com/dashpay/dashd-go/mempool"
 	"github.com/dashpay/dashd-go/mining"
 	"github.com/dashpay/dashd-go/mining/cpuminer"
 	"github.com/dashpay/dashd-go/netsync"
 	"github.com/dashpay/dashd-go/peer"
+	"github.com/dashpay/dashd-go/spork"
 	"github.com/dashpay/dashd-go/txscript"
 
 	"github.com/btcsuite/btclog"
@@ -63,9 +65,11 @@ var (
 	discLog = backendLog.Logger("DISC")
 	indxLog = backendLog.Logger("INDX")
 	minrLog = backendLog.Logger("MINR")
+	mnlsLog = backendLog.Logger("MNLS")
 	peerLog = backendLog.Logger("PEER")
 	rpcsLog = backendLog.Logger("RPCS")
 	scrpLog = backendLog.Logger("SCRP")
+	sprkLog = backendLog.Logger("SPRK")
 	srvrLog = backendLog.Logger("SRVR")
 	syncLog = backendLog.Logger("SYNC")
 	txmpLog = backendLog.Logger("TXMP")
@@ -78,9 +82,11 @@ func init() {
 	database.UseLogger(bcdbLog)
 	blockchain.UseLogger(chanLog)
 	indexers.UseLogger(indxLog)
+	masternodelist.UseLogger(mnlsLog)
 	mining.UseLogger(minrLog)
 	cpuminer.UseLogger(minrLog)
 	peer.UseLogger(peerLog)
+	spork.UseLogger(sprkLog)
 	txscript.UseLogger(scrpLog)
 	netsync.UseLogger(syncLog)
 	mempool.UseLogger(txmpLog)
@@ -97,9 +103,11 @@ var subsystemLoggers = map[string]btclog.Logger{
 	"DISC": discLog,
 	"INDX": indxLog,
 	"MINR": minrLog,
+	"MNLS": mnlsLog,
 	"PEER": peerLog,
 	"RPCS": rpcsLog,
 	"SCRP": scrpLog,
+	"SPRK": sprkLog,
 	"SRVR": srvrLog,
 	"SYNC": syncLog,
 	"TXMP": txmpLog,
@@ -0,0 +1,647 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+const (
+	// maxEvoConsecutivePayments is the number of blocks in a row an evo
+	// masternode is paid between the v19 hard fork and the masternode
+	// reward reallocation.
+	maxEvoConsecutivePayments = 4
+
+	// minMaxPoSePenalty is the lower bound of the PoSe penalty at which a
+	// masternode is banned.
+	minMaxPoSePenalty = 100
+
+	// dkgFailurePenaltyPercent is the share of the maximum PoSe penalty a
+	// quorum member is punished with when a final commitment marks it as
+	// invalid.
+	dkgFailurePenaltyPercent = 66
+)
+
+var (
+	// ErrInvalidProTx is returned when a block contains a provider
+	// transaction which can't be applied to the masternode list.
+	ErrInvalidProTx = errors.New("invalid provider transaction")
+
+	// ErrDuplicateProperty is returned when a provider transaction sets an
+	// address or key which is already used by another masternode.
+	ErrDuplicateProperty = errors.New("duplicate masternode property")
+)
+
+// QuorumMembersFunc returns the registration transaction hashes of the members
+// of the quorum of the passed type formed at the block with the passed hash,
+// in the order of the valid members bitset of its final commitment.  A nil
+// slice skips the PoSe punishment of the quorum.
+type QuorumMembersFunc func(llmqType btcjson.LLMQType,
+	quorumHash *chainhash.Hash) ([]chainhash.Hash, error)
+
+// DeterministicMNList is the full deterministic masternode list as of a given
+// block.  It is immutable and safe for concurrent access.
+type DeterministicMNList struct {
+	blockHash chainhash.Hash
+	height    int32
+	mns       map[chainhash.Hash]*Masternode
+}
+
+// NewDeterministicMNList returns an empty deterministic masternode list at the
+// block with the passed hash and height, which is the list of every block
+// before DIP-3 activated.
+func NewDeterministicMNList(blockHash *chainhash.Hash,
+	height int32) *DeterministicMNList {
+
+	return &DeterministicMNList{
+		blockHash: *blockHash,
+		height:    height,
+		mns:       make(map[chainhash.Hash]*Masternode),
+	}
+}
+
+// BlockHash returns the hash of the block the list corresponds to.
+func (l *DeterministicMNList) BlockHash() chainhash.Hash {
+	return l.blockHash
+}
+
+// Height returns the height of the block the list corresponds to.
+func (l *DeterministicMNList) Height() int32 {
+	return l.height
+}
+
+// Len returns the number of masternodes in the list, including banned ones.
+func (l *DeterministicMNList) Len() int {
+	return len(l.mns)
+}
+
+// ValidCount returns the number of masternodes in the list which are not PoSe
+// banned.
+func (l *DeterministicMNList) ValidCount() int {
+	var count int
+	for _, mn := range l.mns {
+		if !mn.IsBanned() {
+			count++
+		}
+	}
+	return count
+}
+
+// Get returns the masternode identified by the passed registration transaction
+// hash, or nil when it is not part of the list.  The returned masternode must
+// not be modified.
+func (l *DeterministicMNList) Get(proTxHash *chainhash.Hash) *Masternode {
+	return l.mns[*proTxHash]
+}
+
+// GetByCollateral returns the masternode whose collateral is the passed
+// outpoint, or nil when there is none.  The returned masternode must not be
+// modified.
+func (l *DeterministicMNList) GetByCollateral(outpoint *wire.OutPoint) *Masternode {
+	for _, mn := range l.mns {
+		if mn.CollateralOutpoint == *outpoint {
+			return mn
+		}
+	}
+	return nil
+}
+
+// Masternodes returns the masternodes of the list ordered by the raw bytes of
+// their registration transaction hashes.  The returned masternodes must not be
+// modified.
+func (l *DeterministicMNList) Masternodes() []*Masternode {
+	mns := make([]*Masternode, 0, len(l.mns))
+	for _, mn := range l.mns {
+		mns = append(mns, mn)
+	}
+	sort.Slice(mns, func(i, j int) bool {
+		return bytes.Compare(mns[i].ProTxHash[:], mns[j].ProTxHash[:]) < 0
+	})
+	return mns
+}
+
+// SimplifiedMNList returns the simplified masternode list of the list, whose
+// merkle root is committed to by the coinbase of the block.
+func (l *DeterministicMNList) SimplifiedMNList() *SimplifiedMNList {
+	entries := make(map[chainhash.Hash]*wire.MNListEntry, len(l.mns))
+	for hash, mn := range l.mns {
+		entries[hash] = mn.Entry()
+	}
+	return &SimplifiedMNList{
+		blockHash: l.blockHash,
+		entries:   entries,
+	}
+}
+
+// payHeight returns the height the payment order of the masternode is based
+// on: the height it was last paid, revived or registered at.
+func (mn *Masternode) payHeight() int32 {
+	height := mn.LastPaidHeight
+	if mn.PoSeRevivedHeight != -1 && mn.PoSeRevivedHeight > height {
+		height = mn.PoSeRevivedHeight
+	} else if height == 0 {
+		height = mn.RegisteredHeight
+	}
+	return height
+}
+
+// Payee returns the masternode to be paid by the block following the block of
+// the list, or nil when no masternode is valid.
+//
+// It is the valid masternode which was paid longest ago, except that an evo
+// masternode keeps being paid for four blocks in a row between the v19 hard
+// fork and the masternode reward reallocation.
+func (l *DeterministicMNList) Payee(params *chaincfg.Params) *Masternode {
+	nextHeight := l.height + 1
+	if nextHeight >= params.V19Height && nextHeight < params.MNRRHeight {
+		for _, mn := range l.mns {
+			if !mn.IsBanned() && mn.Type == evo.MnTypeEvo &&
+				mn.LastPaidHeight == l.height &&
+				mn.ConsecutivePayments < maxEvoConsecutivePayments {
+
+				return mn
+			}
+		}
+	}
+
+	var payee *Masternode
+	for _, mn := range l.mns {
+		if mn.IsBanned() {
+			continue
+		}
+		if payee == nil {
+			payee = mn
+			continue
+		}
+		height, payeeHeight := mn.payHeight(), payee.payHeight()
+		if height < payeeHeight || (height == payeeHeight &&
+			bytes.Compare(mn.ProTxHash[:], payee.ProTxHash[:]) < 0) {
+
+			payee = mn
+		}
+	}
+	return payee
+}
+
+// quorumScore is the score of a masternode for a quorum.
+type quorumScore struct {
+	score [chainhash.HashSize]byte
+	mn    *Masternode
+}
+
+// less returns whether the score is lower than the passed one, comparing the
+// scores as little-endian 256-bit numbers and ties by collateral outpoint.
+func (s *quorumScore) less(other *quorumScore) bool {
+	for i := chainhash.HashSize - 1; i >= 0; i-- {
+		if s.score[i] != other.score[i] {
+			return s.score[i] < other.score[i]
+		}
+	}
+
+	a, b := &s.mn.CollateralOutpoint, &other.mn.CollateralOutpoint
+	if c := bytes.Compare(a.Hash[:], b.Hash[:]); c != 0 {
+		return c < 0
+	}
+	return a.Index < b.Index
+}
+
+// CalculateQuorum returns the members of a quorum of the passed size selected
+// from the list with the passed modifier.  Only valid masternodes with a
+// confirmed registration are eligible, and only evo masternodes when evoOnly
+// is set.  The members are ordered by descending score, which is the single
+// SHA256 of the confirmed hash with the registration transaction hash and the
+// modifier.
+func (l *DeterministicMNList) CalculateQuorum(size int, modifier *chainhash.Hash,
+	evoOnly bool) []*Masternode {
+
+	scores := make([]*quorumScore, 0, len(l.mns))
+	for _, mn := range l.mns {
+		if mn.IsBanned() || mn.ConfirmedHash == (chainhash.Hash{}) ||
+			(evoOnly && mn.Type != evo.MnTypeEvo) {
+
+			continue
+		}
+
+		var buf [chainhash.HashSize * 2]byte
+		h := mn.confirmedHashWithProTxHash()
+		copy(buf[:chainhash.HashSize], h[:])
+		copy(buf[chainhash.HashSize:], modifier[:])
+		scores = append(scores, &quorumScore{
+			score: sha256.Sum256(buf[:]),
+			mn:    mn,
+		})
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		return scores[j].less(scores[i])
+	})
+
+	if len(scores) > size {
+		scores = scores[:size]
+	}
+	members := make([]*Masternode, 0, len(scores))
+	for _, s := range scores {
+		members = append(members, s.mn)
+	}
+	return members
+}
+
+// isEmptyService returns whether the passed address is unset.
+func isEmptyService(ip net.IP, port uint16) bool {
+	return port == 0 && (len(ip) == 0 || ip.IsUnspecified())
+}
+
+// listBuilder applies the changes of a block to a copy of a list.
+type listBuilder struct {
+	*DeterministicMNList
+}
+
+// findProperty returns the masternode other than the excluded one which uses
+// the passed property, or nil when there is none.
+func (b *listBuilder) findProperty(exclude *chainhash.Hash,
+	match func(mn *Masternode) bool) *Masternode {
+
+	for hash, mn := range b.mns {
+		if hash != *exclude && match(mn) {
+			return mn
+		}
+	}
+	return nil
+}
+
+// checkUnique ensures the service address, operator key and platform node ID
+// of the passed masternode and, when checkOwner is set, its owner key are not
+// used by any other masternode of the list.
+func (b *listBuilder) checkUnique(mn *Masternode, checkOwner bool) error {
+	if !isEmptyService(mn.IPAddress, mn.Port) {
+		dup := b.findProperty(&mn.ProTxHash, func(other *Masternode) bool {
+			return other.Port == mn.Port &&
+				other.IPAddress.Equal(mn.IPAddress)
+		})
+		if dup != nil {
+			return fmt.Errorf("%w: address %s of %v is used by %v",
+				ErrDuplicateProperty, mn.Service(), mn.ProTxHash,
+				dup.ProTxHash)
+		}
+	}
+	if checkOwner {
+		dup := b.findProperty(&mn.ProTxHash, func(other *Masternode) bool {
+			return other.KeyIDOwner == mn.KeyIDOwner
+		})
+		if dup != nil {
+			return fmt.Errorf("%w: owner key of %v is used by %v",
+				ErrDuplicateProperty, mn.ProTxHash, dup.ProTxHash)
+		}
+	}
+	if mn.PubKeyOperator != (evo.BLSPublicKey{}) {
+		dup := b.findProperty(&mn.ProTxHash, func(other *Masternode) bool {
+			return other.PubKeyOperator == mn.PubKeyOperator
+		})
+		if dup != nil {
+			return fmt.Errorf("%w: operator key of %v is used by %v",
+				ErrDuplicateProperty, mn.ProTxHash, dup.ProTxHash)
+		}
+	}
+	if mn.Type == evo.MnTypeEvo && mn.PlatformNodeID != (evo.KeyID{}) {
+		dup := b.findProperty(&mn.ProTxHash, func(other *Masternode) bool {
+			return other.Type == evo.MnTypeEvo &&
+				other.PlatformNodeID == mn.PlatformNodeID
+		})
+		if dup != nil {
+			return fmt.Errorf("%w: platform node ID of %v is used by "+
+				"%v", ErrDuplicateProperty, mn.ProTxHash,
+				dup.ProTxHash)
+		}
+	}
+	return nil
+}
+
+// mustGet returns the masternode a provider transaction refers to.
+func (b *listBuilder) mustGet(txHash, proTxHash *chainhash.Hash) (*Masternode, error) {
+	mn := b.mns[*proTxHash]
+	if mn == nil {
+		return nil, fmt.Errorf("%w: %v refers to unknown masternode %v",
+			ErrInvalidProTx, txHash, proTxHash)
+	}
+	return mn, nil
+}
+
+// applyProRegTx adds the masternode registered by the passed transaction.
+func (b *listBuilder) applyProRegTx(txHash *chainhash.Hash, p *evo.ProRegTx) error {
+	mn := &Masternode{
+		ProTxHash:          *txHash,
+		CollateralOutpoint: p.CollateralOutpoint,
+		OperatorReward:     p.OperatorReward,
+		Type:               p.Type,
+		MasternodeState: MasternodeState{
+			Version:           p.Version,
+			RegisteredHeight:  b.height,
+			PoSeRevivedHeight: -1,
+			PoSeBanHeight:     -1,
+			KeyIDOwner:        p.KeyIDOwner,
+			PubKeyOperator:    p.PubKeyOperator,
+			KeyIDVoting:       p.KeyIDVoting,
+			IPAddress:         append(net.IP(nil), p.IPAddress...),
+			Port:              p.Port,
+			ScriptPayout:      append([]byte(nil), p.ScriptPayout...),
+		},
+	}
+	if mn.Type == evo.MnTypeEvo {
+		mn.PlatformNodeID = p.PlatformNodeID
+		mn.PlatformP2PPort = p.PlatformP2PPort
+		mn.PlatformHTTPPort = p.PlatformHTTPPort
+	}
+
+	// The collateral is an output of the registration itself when the
+	// outpoint has no hash.  A registration referring to the collateral of
+	// an existing masternode replaces it.
+	if mn.CollateralOutpoint.Hash == (chainhash.Hash{}) {
+		mn.CollateralOutpoint.Hash = *txHash
+	}
+	if replaced := b.GetByCollateral(&mn.CollateralOutpoint); replaced != nil {
+		delete(b.mns, replaced.ProTxHash)
+	}
+	if _, ok := b.mns[*txHash]; ok {
+		return fmt.Errorf("%w: masternode %v is already registered",
+			ErrInvalidProTx, txHash)
+	}
+	if err := b.checkUnique(mn, true); err != nil {
+		return err
+	}
+
+	// A masternode without an address starts out banned until a
+	// ProUpServTx sets one.
+	if isEmptyService(mn.IPAddress, mn.Port) {
+		mn.banIfNotBanned(b.height)
+	}
+
+	b.mns[*txHash] = mn
+	return nil
+}
+
+// applyProUpServTx updates the service of the masternode referred to by the
+// passed transaction.
+func (b *listBuilder) applyProUpServTx(txHash *chainhash.Hash, p *evo.ProUpServTx) error {
+	mn, err := b.mustGet(txHash, &p.ProTxHash)
+	if err != nil {
+		return err
+	}
+	if p.Type != mn.Type {
+		return fmt.Errorf("%w: %v has type %v, masternode %v has type %v",
+			ErrInvalidProTx, txHash, p.Type, mn.ProTxHash, mn.Type)
+	}
+
+	mn = mn.update(func(s *MasternodeState) {
+		s.IPAddress = append(net.IP(nil), p.IPAddress...)
+		s.Port = p.Port
+		s.ScriptOperatorPayout = append([]byte(nil),
+			p.ScriptOperatorPayout...)
+		if mn.Type == evo.MnTypeEvo {
+			s.PlatformNodeID = p.PlatformNodeID
+			s.PlatformP2PPort = p.PlatformP2PPort
+			s.PlatformHTTPPort = p.PlatformHTTPPort
+		}
+
+		// The masternode is only revived when all of its keys are set.
+		if s.PoSeBanHeight != -1 &&
+			s.PubKeyOperator != (evo.BLSPublicKey{}) &&
+			s.KeyIDVoting != (evo.KeyID{}) &&
+			s.KeyIDOwner != (evo.KeyID{}) {
+
+			s.revive(b.height)
+		}
+	})
+	if err := b.checkUnique(mn, false); err != nil {
+		return err
+	}
+
+	b.mns[mn.ProTxHash] = mn
+	return nil
+}
+
+// applyProUpRegTx updates the registrar fields of the masternode referred to
+// by the passed transaction.
+func (b *listBuilder) applyProUpRegTx(txHash *chainhash.Hash, p *evo.ProUpRegTx) error {
+	mn, err := b.mustGet(txHash, &p.ProTxHash)
+	if err != nil {
+		return err
+	}
+
+	mn = mn.update(func(s *MasternodeState) {
+		// A new operator has to announce its service again, so the
+		// masternode is banned until then.
+		if s.PubKeyOperator != p.PubKeyOperator {
+			s.resetOperator()
+			s.banIfNotBanned(b.height)
+			s.Version = p.Version
+			s.PubKeyOperator = p.PubKeyOperator
+		}
+		s.KeyIDVoting = p.KeyIDVoting
+		s.ScriptPayout = append([]byte(nil), p.ScriptPayout...)
+	})
+	if err := b.checkUnique(mn, false); err != nil {
+		return err
+	}
+
+	b.mns[mn.ProTxHash] = mn
+	return nil
+}
+
+// applyProUpRevTx revokes the operator of the masternode referred to by the
+// passed transaction.
+func (b *listBuilder) applyProUpRevTx(txHash *chainhash.Hash, p *evo.ProUpRevTx) error {
+	mn, err := b.mustGet(txHash, &p.ProTxHash)
+	if err != nil {
+		return err
+	}
+
+	b.mns[mn.ProTxHash] = mn.update(func(s *MasternodeState) {
+		s.resetOperator()
+		s.banIfNotBanned(b.height)
+		s.RevocationReason = p.Reason
+	})
+	return nil
+}
+
+// applyQcTx punishes the members of a quorum which the passed final
+// commitment marks as invalid.
+func (b *listBuilder) applyQcTx(p *evo.QcTx, quorumMembers QuorumMembersFunc) error {
+	qc := &p.Commitment
+	if qc.IsNull() {
+		return nil
+	}
+
+	members, err := quorumMembers(btcjson.LLMQType(qc.LLMQType),
+		&qc.QuorumHash)
+	if err != nil {
+		return err
+	}
+	if len(members) > len(qc.ValidMembers) {
+		return fmt.Errorf("%w: commitment for quorum %v has %d members, "+
+			"want %d", ErrInvalidProTx, qc.QuorumHash,
+			len(qc.ValidMembers), len(members))
+	}
+
+	maxPenalty := int32(len(b.mns))
+	if maxPenalty < minMaxPoSePenalty {
+		maxPenalty = minMaxPoSePenalty
+	}
+	penalty := maxPenalty * dkgFailurePenaltyPercent / 100
+	for i := range members {
+		mn := b.mns[members[i]]
+		if mn == nil || qc.ValidMembers[i] {
+			continue
+		}
+		b.mns[mn.ProTxHash] = mn.update(func(s *MasternodeState) {
+			s.PoSePenalty += penalty
+			if s.PoSePenalty > maxPenalty {
+				s.PoSePenalty = maxPenalty
+			}
+			if s.PoSePenalty >= maxPenalty {
+				s.banIfNotBanned(b.height)
+			}
+		})
+	}
+	return nil
+}
+
+// applyTx applies the payload of a special transaction to the list.
+func (b *listBuilder) applyTx(tx *btcutil.Tx, quorumMembers QuorumMembersFunc) error {
+	msgTx := tx.MsgTx()
+	if !msgTx.IsSpecial() {
+		return nil
+	}
+	switch msgTx.Type {
+	case wire.TxTypeProRegTx, wire.TxTypeProUpServTx, wire.TxTypeProUpRegTx,
+		wire.TxTypeProUpRevTx, wire.TxTypeQuorumCommitment:
+	default:
+		return nil
+	}
+
+	payload, err := evo.DecodeTxPayload(msgTx)
+	if err != nil {
+		return fmt.Errorf("%w: %v: %v", ErrInvalidProTx, tx.Hash(), err)
+	}
+	switch p := payload.(type) {
+	case *evo.ProRegTx:
+		return b.applyProRegTx(tx.Hash(), p)
+	case *evo.ProUpServTx:
+		return b.applyProUpServTx(tx.Hash(), p)
+	case *evo.ProUpRegTx:
+		return b.applyProUpRegTx(tx.Hash(), p)
+	case *evo.ProUpRevTx:
+		return b.applyProUpRevTx(tx.Hash(), p)
+	case *evo.QcTx:
+		return b.applyQcTx(p, quorumMembers)
+	}
+	return nil
+}
+
+// ApplyBlock returns the list which results from applying the passed block,
+// whose parent must be the block of the receiver, to the receiver.  The
+// receiver is not modified.
+//
+// The changes are applied in the same order as Dash Core: registrations which
+// reached the minimum number of confirmations are confirmed, the PoSe
+// penalties of valid masternodes decrease by one, the provider transactions
+// and final commitments of the block are applied together with the spends of
+// collaterals, and finally the payee of the block is marked as paid.  The
+// quorumMembers function is used to look up the members of quorums whose final
+// commitments are mined in the block.
+func (l *DeterministicMNList) ApplyBlock(block *btcutil.Block,
+	params *chaincfg.Params,
+	quorumMembers QuorumMembersFunc) (*DeterministicMNList, error) {
+
+	header := &block.MsgBlock().Header
+	if header.PrevBlock != l.blockHash {
+		return nil, fmt.Errorf("%w: list at %v, block %v builds on %v",
+			ErrBaseBlockMismatch, l.blockHash, block.Hash(),
+			header.PrevBlock)
+	}
+
+	b := &listBuilder{&DeterministicMNList{
+		blockHash: *block.Hash(),
+		height:    l.height + 1,
+		mns:       make(map[chainhash.Hash]*Masternode, len(l.mns)),
+	}}
+	for hash, mn := range l.mns {
+		b.mns[hash] = mn
+	}
+	payee := l.Payee(params)
+
+	for hash, mn := range b.mns {
+		// The confirmed hash is the hash of the block at which the
+		// registration reached the minimum number of confirmations,
+		// which is set one block later.
+		if mn.ConfirmedHash == (chainhash.Hash{}) &&
+			l.height-mn.RegisteredHeight >=
+				params.MasternodeMinimumConfirmations {
+
+			mn = mn.update(func(s *MasternodeState) {
+				s.ConfirmedHash = l.blockHash
+			})
+		}
+		if !mn.IsBanned() && mn.PoSePenalty > 0 {
+			mn = mn.update(func(s *MasternodeState) {
+				s.PoSePenalty--
+			})
+		}
+		b.mns[hash] = mn
+	}
+
+	for _, tx := range block.Transactions()[1:] {
+		if err := b.applyTx(tx, quorumMembers); err != nil {
+			return nil, err
+		}
+
+		for _, txIn := range tx.MsgTx().TxIn {
+			mn := b.GetByCollateral(&txIn.PreviousOutPoint)
+			if mn != nil {
+				delete(b.mns, mn.ProTxHash)
+			}
+		}
+	}
+
+	// The payee is paid by the block even when it was removed from the
+	// list by it.  Evo masternodes are paid in a row until the masternode
+	// reward reallocation, so the consecutive payments of all other evo
+	// masternodes are reset.
+	isMNRR := b.height >= params.MNRRHeight
+	var payeeHash chainhash.Hash
+	if payee != nil {
+		payeeHash = payee.ProTxHash
+		if mn := b.mns[payeeHash]; mn != nil {
+			b.mns[payeeHash] = mn.update(func(s *MasternodeState) {
+				s.LastPaidHeight = b.height
+				if mn.Type == evo.MnTypeEvo && !isMNRR {
+					s.ConsecutivePayments++
+				}
+			})
+		}
+	}
+	for hash, mn := range b.mns {
+		if mn.Type != evo.MnTypeEvo || mn.ConsecutivePayments == 0 ||
+			(payee != nil && hash == payeeHash && !isMNRR) {
+
+			continue
+		}
+		b.mns[hash] = mn.update(func(s *MasternodeState) {
+			s.ConsecutivePayments = 0
+		})
+	}
+
+	return b.DeterministicMNList, nil
+}
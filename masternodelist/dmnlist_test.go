@@ -0,0 +1,427 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"net"
+	"testing"
+
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+// testParams returns network parameters which confirm registrations after one
+// block and have the evo masternode payment rules active.
+func testParams() *chaincfg.Params {
+	params := chaincfg.RegressionNetParams
+	params.MasternodeMinimumConfirmations = 1
+	params.V19Height = 1
+	params.V20Height = 1
+	params.MNRRHeight = 1000
+	return &params
+}
+
+// testChain builds blocks on top of a masternode list.
+type testChain struct {
+	t      *testing.T
+	params *chaincfg.Params
+	list   *DeterministicMNList
+
+	// members is returned as the members of every quorum.
+	members []chainhash.Hash
+}
+
+// newTestChain returns a test chain starting with an empty list at the
+// genesis block.
+func newTestChain(t *testing.T) *testChain {
+	params := testParams()
+	return &testChain{
+		t:      t,
+		params: params,
+		list:   NewDeterministicMNList(params.GenesisHash, 0),
+	}
+}
+
+// block returns a block on top of the current list with the passed
+// transactions after a coinbase.
+func (c *testChain) block(txs ...*wire.MsgTx) *btcutil.Block {
+	coinbase := wire.NewMsgTx(wire.TxVersion)
+	coinbase.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		SignatureScript:  []byte{byte(c.list.height + 1), 0},
+	})
+	coinbase.AddTxOut(wire.NewTxOut(0, []byte{0x51}))
+
+	msgBlock := wire.NewMsgBlock(&wire.BlockHeader{
+		PrevBlock: c.list.blockHash,
+	})
+	msgBlock.AddTransaction(coinbase)
+	for _, tx := range txs {
+		msgBlock.AddTransaction(tx)
+	}
+	return btcutil.NewBlock(msgBlock)
+}
+
+// connect applies a block with the passed transactions to the current list.
+func (c *testChain) connect(txs ...*wire.MsgTx) error {
+	l, err := c.list.ApplyBlock(c.block(txs...), c.params,
+		func(btcjson.LLMQType, *chainhash.Hash) ([]chainhash.Hash, error) {
+			return c.members, nil
+		})
+	if err != nil {
+		return err
+	}
+	c.list = l
+	return nil
+}
+
+// mustConnect is connect which fails the test on error.
+func (c *testChain) mustConnect(txs ...*wire.MsgTx) {
+	c.t.Helper()
+	if err := c.connect(txs...); err != nil {
+		c.t.Fatalf("ApplyBlock at height %d: unexpected error: %v",
+			c.list.height+1, err)
+	}
+}
+
+// specialTx returns a special transaction with the passed payload spending an
+// outpoint identified by the passed byte.
+func specialTx(t *testing.T, txType wire.TxType, payload evo.Payload,
+	spend wire.OutPoint) *wire.MsgTx {
+
+	t.Helper()
+
+	tx := wire.NewMsgTx(wire.SpecialTxVersion)
+	tx.Type = txType
+	tx.AddTxIn(&wire.TxIn{PreviousOutPoint: spend})
+	if err := evo.SetTxPayload(tx, payload); err != nil {
+		t.Fatalf("SetTxPayload: unexpected error: %v", err)
+	}
+	return tx
+}
+
+// proRegTx returns a registration of a masternode with keys derived from the
+// passed ID and an internal collateral.
+func proRegTx(t *testing.T, id byte, ip string, port uint16) *wire.MsgTx {
+	payload := &evo.ProRegTx{
+		Version:            evo.ProTxVersionBasicBLS,
+		CollateralOutpoint: wire.OutPoint{Index: 1},
+		IPAddress:          net.ParseIP(ip),
+		Port:               port,
+		KeyIDOwner:         evo.KeyID{id, 1},
+		PubKeyOperator:     evo.BLSPublicKey{id, 2},
+		KeyIDVoting:        evo.KeyID{id, 3},
+		OperatorReward:     500,
+		ScriptPayout:       []byte{0x51, id},
+	}
+	return specialTx(t, wire.TxTypeProRegTx, payload,
+		wire.OutPoint{Hash: chainhash.Hash{id, 0xff}})
+}
+
+// TestApplyBlock ensures provider transactions and collateral spends update the
+// list like Dash Core.
+func TestApplyBlock(t *testing.T) {
+	c := newTestChain(t)
+
+	// Register one masternode with an address and one without, which
+	// starts out banned.
+	regA := proRegTx(t, 1, "10.0.0.1", 9999)
+	regB := proRegTx(t, 2, "", 0)
+	c.mustConnect(regA, regB)
+	hashA, hashB := regA.TxHash(), regB.TxHash()
+	a, b := c.list.Get(&hashA), c.list.Get(&hashB)
+	if a == nil || b == nil {
+		t.Fatalf("registered masternodes missing from the list")
+	}
+	if a.IsBanned() || a.RegisteredHeight != 1 ||
+		a.CollateralOutpoint != (wire.OutPoint{Hash: hashA, Index: 1}) {
+
+		t.Fatalf("registered masternode: unexpected state %+v", a)
+	}
+	if b.PoSeBanHeight != 1 {
+		t.Fatalf("masternode without address: ban height got %d, "+
+			"want 1", b.PoSeBanHeight)
+	}
+	if c.list.ValidCount() != 1 {
+		t.Fatalf("ValidCount: got %d, want 1", c.list.ValidCount())
+	}
+
+	// The registration is confirmed one block after it reached the
+	// minimum number of confirmations, with the hash of the block at
+	// which it did.
+	c.mustConnect()
+	if a := c.list.Get(&hashA); a.ConfirmedHash != (chainhash.Hash{}) {
+		t.Fatalf("masternode confirmed too early")
+	}
+	confirmedHash := c.list.blockHash
+	c.mustConnect()
+	if a := c.list.Get(&hashA); a.ConfirmedHash != confirmedHash {
+		t.Fatalf("confirmed hash: got %v, want %v", a.ConfirmedHash,
+			confirmedHash)
+	}
+
+	// Using the address of another masternode is rejected.
+	upServ := func(ip string) *wire.MsgTx {
+		return specialTx(t, wire.TxTypeProUpServTx, &evo.ProUpServTx{
+			Version:   evo.ProTxVersionBasicBLS,
+			ProTxHash: hashB,
+			IPAddress: net.ParseIP(ip),
+			Port:      9999,
+		}, wire.OutPoint{Hash: chainhash.Hash{2, 0xfe}})
+	}
+	err := c.connect(upServ("10.0.0.1"))
+	if !errors.Is(err, ErrDuplicateProperty) {
+		t.Fatalf("duplicate address: got %v, want %v", err,
+			ErrDuplicateProperty)
+	}
+
+	// Setting an address revives the banned masternode.
+	c.mustConnect(upServ("10.0.0.2"))
+	b = c.list.Get(&hashB)
+	if b.IsBanned() || b.PoSeRevivedHeight != 4 ||
+		b.Service() != "10.0.0.2:9999" {
+
+		t.Fatalf("revived masternode: unexpected state %+v", b)
+	}
+
+	// A new operator key resets the operator fields and bans the
+	// masternode until the new operator sets the address.
+	c.mustConnect(specialTx(t, wire.TxTypeProUpRegTx, &evo.ProUpRegTx{
+		Version:        evo.ProTxVersionLegacyBLS,
+		ProTxHash:      hashA,
+		PubKeyOperator: evo.BLSPublicKey{9},
+		KeyIDVoting:    evo.KeyID{9},
+		ScriptPayout:   []byte{0x52},
+	}, wire.OutPoint{Hash: chainhash.Hash{1, 0xfe}}))
+	a = c.list.Get(&hashA)
+	if a.PoSeBanHeight != 5 || a.Port != 0 ||
+		a.Version != evo.ProTxVersionLegacyBLS ||
+		a.PubKeyOperator != (evo.BLSPublicKey{9}) ||
+		a.KeyIDVoting != (evo.KeyID{9}) {
+
+		t.Fatalf("updated registrar: unexpected state %+v", a)
+	}
+
+	// Revoking the operator bans the masternode.
+	c.mustConnect(specialTx(t, wire.TxTypeProUpRevTx, &evo.ProUpRevTx{
+		Version:   evo.ProTxVersionBasicBLS,
+		ProTxHash: hashB,
+		Reason:    evo.RevocationReasonCompromisedKeys,
+	}, wire.OutPoint{Hash: chainhash.Hash{2, 0xfd}}))
+	b = c.list.Get(&hashB)
+	if b.PoSeBanHeight != 6 ||
+		b.RevocationReason != evo.RevocationReasonCompromisedKeys ||
+		b.PubKeyOperator != (evo.BLSPublicKey{}) {
+
+		t.Fatalf("revoked masternode: unexpected state %+v", b)
+	}
+
+	// Updating an unknown masternode is rejected.
+	err = c.connect(specialTx(t, wire.TxTypeProUpRevTx, &evo.ProUpRevTx{
+		Version:   evo.ProTxVersionBasicBLS,
+		ProTxHash: chainhash.Hash{0xaa},
+	}, wire.OutPoint{Hash: chainhash.Hash{3, 0xfd}}))
+	if !errors.Is(err, ErrInvalidProTx) {
+		t.Fatalf("unknown masternode: got %v, want %v", err,
+			ErrInvalidProTx)
+	}
+
+	// Spending the collateral removes the masternode.
+	spend := wire.NewMsgTx(wire.TxVersion)
+	spend.AddTxIn(&wire.TxIn{PreviousOutPoint: a.CollateralOutpoint})
+	c.mustConnect(spend)
+	if c.list.Get(&hashA) != nil || c.list.Len() != 1 {
+		t.Fatalf("masternode with spent collateral is still listed")
+	}
+}
+
+// TestApplyBlockPoSe ensures quorum members which failed the DKG are punished,
+// penalties decay by one point per block and masternodes are banned at the
+// maximum penalty.
+func TestApplyBlockPoSe(t *testing.T) {
+	c := newTestChain(t)
+	regA := proRegTx(t, 1, "10.0.0.1", 9999)
+	regB := proRegTx(t, 2, "10.0.0.2", 9999)
+	c.mustConnect(regA, regB)
+	hashA, hashB := regA.TxHash(), regB.TxHash()
+	c.members = []chainhash.Hash{hashA, hashB}
+
+	qcTx := func(id byte) *wire.MsgTx {
+		return specialTx(t, wire.TxTypeQuorumCommitment, &evo.QcTx{
+			Version: evo.QcTxVersion,
+			Commitment: wire.QuorumCommitment{
+				Version:         wire.QuorumCommitmentVersionBasic,
+				LLMQType:        uint8(btcjson.LLMQType_TEST),
+				QuorumHash:      chainhash.Hash{id},
+				Signers:         []bool{true, false},
+				ValidMembers:    []bool{true, false},
+				QuorumPublicKey: [48]byte{id},
+			},
+		}, wire.OutPoint{Hash: chainhash.Hash{id, 0xfc}})
+	}
+
+	// With fewer than 100 masternodes the maximum penalty is 100, so a
+	// failed DKG costs 66 points.
+	c.mustConnect(qcTx(1))
+	if a := c.list.Get(&hashA); a.PoSePenalty != 0 {
+		t.Fatalf("valid member punished: penalty %d", a.PoSePenalty)
+	}
+	if b := c.list.Get(&hashB); b.PoSePenalty != 66 || b.IsBanned() {
+		t.Fatalf("invalid member: penalty got %d, want 66",
+			b.PoSePenalty)
+	}
+	c.mustConnect()
+	if b := c.list.Get(&hashB); b.PoSePenalty != 65 {
+		t.Fatalf("penalty decay: got %d, want 65", b.PoSePenalty)
+	}
+
+	c.mustConnect(qcTx(2))
+	b := c.list.Get(&hashB)
+	if b.PoSePenalty != 100 || b.PoSeBanHeight != c.list.height {
+		t.Fatalf("second failure: got penalty %d ban height %d, want "+
+			"100 and %d", b.PoSePenalty, b.PoSeBanHeight,
+			c.list.height)
+	}
+
+	// Banned masternodes don't decay.
+	c.mustConnect()
+	if b := c.list.Get(&hashB); b.PoSePenalty != 100 {
+		t.Fatalf("banned masternode decayed to %d", b.PoSePenalty)
+	}
+}
+
+// testMN returns a masternode identified by the passed byte.
+func testMN(id byte) *Masternode {
+	return &Masternode{
+		ProTxHash:          chainhash.Hash{id},
+		CollateralOutpoint: wire.OutPoint{Hash: chainhash.Hash{id, 1}},
+		MasternodeState: MasternodeState{
+			Version:           evo.ProTxVersionBasicBLS,
+			PoSeRevivedHeight: -1,
+			PoSeBanHeight:     -1,
+			ConfirmedHash:     chainhash.Hash{id, 2},
+			IPAddress:         make(net.IP, net.IPv6len),
+		},
+	}
+}
+
+// testList returns a list at the passed height with the passed masternodes.
+func testList(height int32, mns ...*Masternode) *DeterministicMNList {
+	l := NewDeterministicMNList(&chainhash.Hash{0xbb}, height)
+	for _, mn := range mns {
+		l.mns[mn.ProTxHash] = mn
+	}
+	return l
+}
+
+// TestPayee ensures the payee is the valid masternode paid longest ago and that
+// evo masternodes are paid four blocks in a row before the masternode reward
+// reallocation.
+func TestPayee(t *testing.T) {
+	params := testParams()
+
+	paid := testMN(1)
+	paid.RegisteredHeight, paid.LastPaidHeight = 1, 90
+	revived := testMN(2)
+	revived.RegisteredHeight, revived.LastPaidHeight = 1, 10
+	revived.PoSeRevivedHeight = 95
+	unpaid := testMN(3)
+	unpaid.RegisteredHeight = 50
+	banned := testMN(4)
+	banned.PoSeBanHeight = 20
+	tie := testMN(0)
+	tie.RegisteredHeight = 50
+
+	l := testList(100, paid, revived, unpaid, banned)
+	if payee := l.Payee(params); payee != unpaid {
+		t.Fatalf("Payee: got %v, want %v", payee.ProTxHash,
+			unpaid.ProTxHash)
+	}
+	l = testList(100, paid, revived, unpaid, banned, tie)
+	if payee := l.Payee(params); payee != tie {
+		t.Fatalf("Payee with tie: got %v, want %v", payee.ProTxHash,
+			tie.ProTxHash)
+	}
+
+	evoMN := testMN(5)
+	evoMN.Type = evo.MnTypeEvo
+	evoMN.LastPaidHeight, evoMN.ConsecutivePayments = 100, 3
+	l = testList(100, paid, unpaid, evoMN)
+	if payee := l.Payee(params); payee != evoMN {
+		t.Fatalf("Payee: evo masternode not paid again")
+	}
+	evoMN.ConsecutivePayments = maxEvoConsecutivePayments
+	if payee := l.Payee(params); payee != unpaid {
+		t.Fatalf("Payee: evo masternode paid more than %d times",
+			maxEvoConsecutivePayments)
+	}
+
+	if payee := testList(100, banned).Payee(params); payee != nil {
+		t.Fatalf("Payee: got %v without valid masternodes",
+			payee.ProTxHash)
+	}
+}
+
+// TestCalculateQuorum ensures quorum members are the eligible masternodes with
+// the highest scores.
+func TestCalculateQuorum(t *testing.T) {
+	var mns []*Masternode
+	for i := byte(1); i <= 20; i++ {
+		mns = append(mns, testMN(i))
+	}
+	mns[0].ConfirmedHash = chainhash.Hash{}
+	mns[1].PoSeBanHeight = 5
+	mns[2].Type = evo.MnTypeEvo
+	mns[3].Type = evo.MnTypeEvo
+	l := testList(100, mns...)
+	modifier := chainhash.Hash{0x42}
+
+	score := func(mn *Masternode) *big.Int {
+		h := mn.confirmedHashWithProTxHash()
+		s := sha256.Sum256(append(h[:], modifier[:]...))
+		for i := 0; i < len(s)/2; i++ {
+			s[i], s[len(s)-1-i] = s[len(s)-1-i], s[i]
+		}
+		return new(big.Int).SetBytes(s[:])
+	}
+
+	members := l.CalculateQuorum(10, &modifier, false)
+	if len(members) != 10 {
+		t.Fatalf("CalculateQuorum: got %d members, want 10",
+			len(members))
+	}
+	selected := make(map[*Masternode]bool)
+	for i, mn := range members {
+		selected[mn] = true
+		if mn == mns[0] || mn == mns[1] {
+			t.Fatalf("CalculateQuorum: ineligible masternode %v "+
+				"selected", mn.ProTxHash)
+		}
+		if i > 0 && score(members[i-1]).Cmp(score(mn)) < 0 {
+			t.Fatalf("CalculateQuorum: members not in descending " +
+				"score order")
+		}
+	}
+	lowest := score(members[len(members)-1])
+	for _, mn := range mns[2:] {
+		if !selected[mn] && score(mn).Cmp(lowest) > 0 {
+			t.Fatalf("CalculateQuorum: %v with a higher score was "+
+				"not selected", mn.ProTxHash)
+		}
+	}
+
+	members = l.CalculateQuorum(10, &modifier, true)
+	if len(members) != 2 {
+		t.Fatalf("CalculateQuorum: got %d evo members, want 2",
+			len(members))
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package masternodelist maintains a verified Dash simplified masternode list
+(SML) and the full deterministic masternode list built from blocks.
+
+The SML is the subset of the deterministic masternode list which light clients
+need in order to talk to masternodes and to verify their signatures.  Nodes
+relay it as a series of diffs, each of which describes the masternodes that
+were removed, added or changed between a base block and a target block.  Since
+DIP-4, the coinbase transaction of every block commits to the merkle root of
+the full list at that block, so a client which applies the diffs itself can
+verify the resulting list instead of trusting the node which served it.
+
+# Applying Diffs
+
+A SimplifiedMNList starts out empty and is advanced one diff at a time with
+ApplyDiff, which returns a new list and leaves the receiver untouched.  Every
+diff must build on the block of the list it is applied to.  When the diff
+carries the coinbase transaction of its target block, the merkle root of the
+resulting list is checked against the MerkleRootMNList field of the coinbase
+payload and the diff is rejected on mismatch.
+
+Diffs are obtained either from peers as mnlistdiff messages
+(wire.MsgMNListDiff), which are converted with NewDiffFromMsg, or from the
+protx diff RPC through rpcclient.Client.ProTxDiff, which is converted with
+NewDiffFromRPC.
+
+# Merkle Root
+
+The merkle root is computed the same way as by Dash Core: the entries are
+sorted by the raw bytes of their registration transaction hash, each entry is
+hashed with wire.MNListEntry.Hash and the hashes are combined into a merkle
+tree like the transactions of a block.  The merkle root of an empty list is
+the zero hash.
+
+Quorum information carried by diffs is not handled by this package.
+
+# Deterministic Masternode List
+
+A full node does not need to trust diffs at all, since it can build the
+deterministic masternode list (DML) from the blocks themselves.  A
+DeterministicMNList holds every registered masternode with its complete state,
+and ApplyBlock advances it by one block the same way Dash Core does:
+registrations which reached the minimum number of confirmations are confirmed,
+PoSe penalties decay by one point per block, ProRegTx, ProUpServTx, ProUpRegTx
+and ProUpRevTx transactions register and update masternodes, final quorum
+commitments punish members which failed the DKG, spending a collateral removes
+its masternode, and the payee of the block is marked as paid.
+
+The Manager keeps the list of the best chain up to date by applying the blocks
+of blockchain.Notifications.  It only accesses the chain through the functions
+of its Config, which the caller provides.  It stores a diff per block and a full list every
+576 blocks in the database, so the list of any connected block can be loaded
+again with ListForBlock.  The simplified list derived from each list is checked
+against the coinbase commitment of its block.  A mismatch means the list is
+wrong, so the Manager stops following the chain and Tip returns the error.
+
+The Manager also implements blockchain.MasternodePayeeSource.  Once it is set
+with SetMasternodePayeeSource, the chain rejects blocks whose coinbase does not
+pay the payee of the list of their parent block.  btcd only does so with the
+--enforcemnpayments option.
+
+The Manager also stores the final commitments mined in every block, so
+ActiveQuorums returns the quorums of a type which were active at a block.  They
+back the llmq.Verifier which verifies ChainLocks and InstantSend locks.  The commitments are
+trusted as mined, their signatures are not verified.
+
+The members of rotating (DIP-24) quorums are calculated from the quarters of
+the last four cycles.  The quorum snapshots of the cycles which the quarters
+are derived from are kept in memory, so they are calculated again from the
+first cycle after DIP-24 activated when btcd is restarted.
+*/
+package masternodelist
@@ -0,0 +1,217 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+var (
+	// ErrBaseBlockMismatch is returned when a diff does not build on the
+	// block of the list it is applied to.
+	ErrBaseBlockMismatch = errors.New("diff base block does not match the " +
+		"block of the list")
+
+	// ErrUnknownMasternode is returned when a diff deletes a masternode
+	// which is not part of the list.
+	ErrUnknownMasternode = errors.New("deleted masternode is not in the list")
+
+	// ErrMerkleRootMismatch is returned when the merkle root of a list does
+	// not match the one committed to by the coinbase transaction.
+	ErrMerkleRootMismatch = errors.New("masternode list merkle root does " +
+		"not match the coinbase commitment")
+)
+
+// Diff describes the changes to the simplified masternode list between two
+// blocks.
+type Diff struct {
+	// BaseBlockHash is the hash of the block the diff builds on.
+	BaseBlockHash chainhash.Hash
+
+	// BlockHash is the hash of the block the diff leads to.
+	BlockHash chainhash.Hash
+
+	// CbTx is the coinbase transaction of the block identified by
+	// BlockHash.  The list resulting from the diff is verified against its
+	// payload when it is not nil.
+	CbTx *wire.MsgTx
+
+	// DeletedMNs holds the registration transaction hashes of the
+	// masternodes which were removed from the list.
+	DeletedMNs []chainhash.Hash
+
+	// MNList holds the masternodes which were added or changed.
+	MNList []*wire.MNListEntry
+}
+
+// NewDiffFromMsg returns the diff carried by the passed mnlistdiff message.
+// The entries of the message are shared with the returned diff.
+func NewDiffFromMsg(msg *wire.MsgMNListDiff) *Diff {
+	return &Diff{
+		BaseBlockHash: msg.BaseBlockHash,
+		BlockHash:     msg.BlockHash,
+		CbTx:          msg.CbTx,
+		DeletedMNs:    msg.DeletedMNs,
+		MNList:        msg.MNList,
+	}
+}
+
+// SimplifiedMNList is the simplified masternode list as of a given block.  It
+// is immutable and safe for concurrent access.
+type SimplifiedMNList struct {
+	blockHash chainhash.Hash
+	entries   map[chainhash.Hash]*wire.MNListEntry
+}
+
+// New returns an empty simplified masternode list which is not associated
+// with any block.
+func New() *SimplifiedMNList {
+	return &SimplifiedMNList{
+		entries: make(map[chainhash.Hash]*wire.MNListEntry),
+	}
+}
+
+// BlockHash returns the hash of the block the list corresponds to.  It is the
+// zero hash for a list returned by New.
+func (l *SimplifiedMNList) BlockHash() chainhash.Hash {
+	return l.blockHash
+}
+
+// Len returns the number of masternodes in the list.
+func (l *SimplifiedMNList) Len() int {
+	return len(l.entries)
+}
+
+// Get returns the masternode identified by the passed registration transaction
+// hash, or nil when it is not part of the list.  The returned entry must not
+// be modified.
+func (l *SimplifiedMNList) Get(proRegTxHash *chainhash.Hash) *wire.MNListEntry {
+	return l.entries[*proRegTxHash]
+}
+
+// Entries returns the masternodes of the list in merkle tree order, which is
+// the order of the raw bytes of their registration transaction hashes.  The
+// returned entries must not be modified.
+func (l *SimplifiedMNList) Entries() []*wire.MNListEntry {
+	entries := make([]*wire.MNListEntry, 0, len(l.entries))
+	for _, entry := range l.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].ProRegTxHash[:],
+			entries[j].ProRegTxHash[:]) < 0
+	})
+
+	return entries
+}
+
+// MerkleRoot returns the merkle root of the list as committed to by the
+// MerkleRootMNList field of the coinbase payload.
+func (l *SimplifiedMNList) MerkleRoot() chainhash.Hash {
+	entries := l.Entries()
+	if len(entries) == 0 {
+		return chainhash.Hash{}
+	}
+
+	hashes := make([]chainhash.Hash, 0, len(entries))
+	for _, entry := range entries {
+		hashes = append(hashes, entry.Hash())
+	}
+
+	// Combine the hashes level by level, duplicating the last hash of a
+	// level with an odd number of hashes.
+	var branches [chainhash.HashSize * 2]byte
+	for len(hashes) > 1 {
+		if len(hashes)%2 != 0 {
+			hashes = append(hashes, hashes[len(hashes)-1])
+		}
+		for i := 0; i < len(hashes); i += 2 {
+			copy(branches[:chainhash.HashSize], hashes[i][:])
+			copy(branches[chainhash.HashSize:], hashes[i+1][:])
+			hashes[i/2] = chainhash.DoubleHashH(branches[:])
+		}
+		hashes = hashes[:len(hashes)/2]
+	}
+
+	return hashes[0]
+}
+
+// VerifyCbTx ensures the merkle root of the list matches the one committed to
+// by the payload of the passed coinbase transaction.
+func (l *SimplifiedMNList) VerifyCbTx(cbTx *wire.MsgTx) error {
+	if cbTx.Type != wire.TxTypeCoinbase {
+		return fmt.Errorf("transaction %v is not a coinbase special "+
+			"transaction", cbTx.TxHash())
+	}
+	payload, err := evo.DecodeTxPayload(cbTx)
+	if err != nil {
+		return err
+	}
+
+	want := payload.(*evo.CbTx).MerkleRootMNList
+	if got := l.MerkleRoot(); got != want {
+		return fmt.Errorf("%w: calculated %v, coinbase %v",
+			ErrMerkleRootMismatch, got, want)
+	}
+
+	return nil
+}
+
+// ApplyDiff returns the list which results from applying the passed diff to
+// the receiver.  The receiver is not modified.
+//
+// The base block of the diff must match the block of the list, except for an
+// empty list returned by New, which accepts a diff from any base block.  That
+// is only correct when the diff is a full list, such as one built on the
+// genesis block, so callers must start from such a diff.  When the diff
+// carries a coinbase transaction, the resulting list is verified against it
+// with VerifyCbTx.
+func (l *SimplifiedMNList) ApplyDiff(diff *Diff) (*SimplifiedMNList, error) {
+	if l.blockHash != (chainhash.Hash{}) &&
+		diff.BaseBlockHash != l.blockHash {
+
+		return nil, fmt.Errorf("%w: list at %v, diff based on %v",
+			ErrBaseBlockMismatch, l.blockHash, diff.BaseBlockHash)
+	}
+
+	entries := make(map[chainhash.Hash]*wire.MNListEntry,
+		len(l.entries)+len(diff.MNList))
+	for hash, entry := range l.entries {
+		entries[hash] = entry
+	}
+	for i := range diff.DeletedMNs {
+		hash := diff.DeletedMNs[i]
+		if _, ok := entries[hash]; !ok {
+			return nil, fmt.Errorf("%w: %v", ErrUnknownMasternode,
+				hash)
+		}
+		delete(entries, hash)
+	}
+	for _, entry := range diff.MNList {
+		entryCopy := *entry
+		entryCopy.IP = append(net.IP(nil), entry.IP...)
+		entries[entry.ProRegTxHash] = &entryCopy
+	}
+
+	list := &SimplifiedMNList{
+		blockHash: diff.BlockHash,
+		entries:   entries,
+	}
+	if diff.CbTx != nil {
+		if err := list.VerifyCbTx(diff.CbTx); err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+// testEntry returns a simplified masternode list entry identified by the
+// passed first byte of its registration transaction hash.
+func testEntry(id byte) *wire.MNListEntry {
+	return &wire.MNListEntry{
+		Version:      wire.MNListEntryVersionBasicBLS,
+		ProRegTxHash: chainhash.Hash{id},
+		IP:           net.ParseIP("10.0.0.1"),
+		Port:         9999,
+		IsValid:      true,
+	}
+}
+
+// testCbTx returns a coinbase special transaction which commits to the passed
+// masternode list merkle root.
+func testCbTx(t *testing.T, merkleRoot chainhash.Hash) *wire.MsgTx {
+	t.Helper()
+
+	tx := wire.NewMsgTx(wire.SpecialTxVersion)
+	tx.Type = wire.TxTypeCoinbase
+	payload := &evo.CbTx{
+		Version:          evo.CbTxVersionMerkleRootMNList,
+		Height:           1,
+		MerkleRootMNList: merkleRoot,
+	}
+	if err := evo.SetTxPayload(tx, payload); err != nil {
+		t.Fatalf("SetTxPayload: unexpected error: %v", err)
+	}
+
+	return tx
+}
+
+// hashPair returns the merkle tree node which combines the passed hashes.
+func hashPair(left, right chainhash.Hash) chainhash.Hash {
+	return chainhash.DoubleHashH(append(left[:], right[:]...))
+}
+
+// TestMerkleRoot ensures the merkle root of the list is calculated over the
+// entries sorted by registration transaction hash.
+func TestMerkleRoot(t *testing.T) {
+	list := New()
+	if root := list.MerkleRoot(); root != (chainhash.Hash{}) {
+		t.Fatalf("MerkleRoot: empty list got %v, want zero hash", root)
+	}
+
+	e1, e2, e3 := testEntry(0x01), testEntry(0x02), testEntry(0x03)
+	list, err := list.ApplyDiff(&Diff{
+		BlockHash: chainhash.Hash{0xaa},
+		MNList:    []*wire.MNListEntry{e3, e1, e2},
+	})
+	if err != nil {
+		t.Fatalf("ApplyDiff: unexpected error: %v", err)
+	}
+
+	entries := list.Entries()
+	if !reflect.DeepEqual(entries, []*wire.MNListEntry{e1, e2, e3}) {
+		t.Fatalf("Entries: wrong order")
+	}
+
+	// The odd number of entries duplicates the last leaf.
+	h1, h2, h3 := e1.Hash(), e2.Hash(), e3.Hash()
+	want := hashPair(hashPair(h1, h2), hashPair(h3, h3))
+	if root := list.MerkleRoot(); root != want {
+		t.Errorf("MerkleRoot: got %v, want %v", root, want)
+	}
+
+	// A single entry is its own merkle root.
+	single, err := New().ApplyDiff(&Diff{MNList: []*wire.MNListEntry{e1}})
+	if err != nil {
+		t.Fatalf("ApplyDiff: unexpected error: %v", err)
+	}
+	if root := single.MerkleRoot(); root != h1 {
+		t.Errorf("MerkleRoot: single entry got %v, want %v", root, h1)
+	}
+}
+
+// TestApplyDiff tests applying successive diffs which delete, add and update
+// masternodes.
+func TestApplyDiff(t *testing.T) {
+	e1, e2, e3 := testEntry(0x01), testEntry(0x02), testEntry(0x03)
+	base, err := New().ApplyDiff(&Diff{
+		BlockHash: chainhash.Hash{0xaa},
+		MNList:    []*wire.MNListEntry{e1, e2},
+	})
+	if err != nil {
+		t.Fatalf("ApplyDiff: unexpected error: %v", err)
+	}
+
+	// Remove the first masternode, ban the second and add a third one.
+	banned := *e2
+	banned.IsValid = false
+	expected, err := New().ApplyDiff(&Diff{
+		MNList: []*wire.MNListEntry{&banned, e3},
+	})
+	if err != nil {
+		t.Fatalf("ApplyDiff: unexpected error: %v", err)
+	}
+	diff := &Diff{
+		BaseBlockHash: chainhash.Hash{0xaa},
+		BlockHash:     chainhash.Hash{0xbb},
+		CbTx:          testCbTx(t, expected.MerkleRoot()),
+		DeletedMNs:    []chainhash.Hash{e1.ProRegTxHash},
+		MNList:        []*wire.MNListEntry{&banned, e3},
+	}
+	list, err := base.ApplyDiff(diff)
+	if err != nil {
+		t.Fatalf("ApplyDiff: unexpected error: %v", err)
+	}
+
+	if list.BlockHash() != diff.BlockHash {
+		t.Errorf("BlockHash: got %v, want %v", list.BlockHash(),
+			diff.BlockHash)
+	}
+	if list.Len() != 2 {
+		t.Errorf("Len: got %d, want 2", list.Len())
+	}
+	if list.Get(&e1.ProRegTxHash) != nil {
+		t.Errorf("Get: deleted masternode is still in the list")
+	}
+	if entry := list.Get(&e2.ProRegTxHash); entry == nil || entry.IsValid {
+		t.Errorf("Get: updated masternode was not updated")
+	}
+
+	// The base list must be left untouched.
+	if base.Len() != 2 || base.Get(&e1.ProRegTxHash) == nil ||
+		!base.Get(&e2.ProRegTxHash).IsValid {
+
+		t.Errorf("ApplyDiff: base list was modified")
+	}
+}
+
+// TestApplyDiffErrors ensures invalid diffs are rejected with the expected
+// errors.
+func TestApplyDiffErrors(t *testing.T) {
+	e1 := testEntry(0x01)
+	base, err := New().ApplyDiff(&Diff{
+		BlockHash: chainhash.Hash{0xaa},
+		MNList:    []*wire.MNListEntry{e1},
+	})
+	if err != nil {
+		t.Fatalf("ApplyDiff: unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		diff *Diff
+		err  error
+	}{{
+		name: "wrong base block",
+		diff: &Diff{
+			BaseBlockHash: chainhash.Hash{0xcc},
+			BlockHash:     chainhash.Hash{0xbb},
+		},
+		err: ErrBaseBlockMismatch,
+	}, {
+		name: "unknown deleted masternode",
+		diff: &Diff{
+			BaseBlockHash: chainhash.Hash{0xaa},
+			BlockHash:     chainhash.Hash{0xbb},
+			DeletedMNs:    []chainhash.Hash{{0x02}},
+		},
+		err: ErrUnknownMasternode,
+	}, {
+		name: "merkle root mismatch",
+		diff: &Diff{
+			BaseBlockHash: chainhash.Hash{0xaa},
+			BlockHash:     chainhash.Hash{0xbb},
+			CbTx:          testCbTx(t, chainhash.Hash{0x01}),
+			MNList:        []*wire.MNListEntry{testEntry(0x02)},
+		},
+		err: ErrMerkleRootMismatch,
+	}}
+
+	for _, test := range tests {
+		_, err := base.ApplyDiff(test.diff)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: got error %v, want %v", test.name, err,
+				test.err)
+		}
+	}
+
+	// A transaction which is not a coinbase special transaction can't be
+	// used for verification.
+	if err := base.VerifyCbTx(wire.NewMsgTx(wire.TxVersion)); err == nil {
+		t.Errorf("VerifyCbTx: unexpected success for normal transaction")
+	}
+}
+
+// TestNewDiffFromMsg ensures the diff carried by a mnlistdiff message can be
+// applied to a list.
+func TestNewDiffFromMsg(t *testing.T) {
+	e1 := testEntry(0x01)
+	expected, err := New().ApplyDiff(&Diff{
+		MNList: []*wire.MNListEntry{e1},
+	})
+	if err != nil {
+		t.Fatalf("ApplyDiff: unexpected error: %v", err)
+	}
+
+	msg := wire.NewMsgMNListDiff(&chainhash.Hash{}, &chainhash.Hash{0xaa},
+		testCbTx(t, expected.MerkleRoot()))
+	msg.MNList = append(msg.MNList, e1)
+
+	list, err := New().ApplyDiff(NewDiffFromMsg(msg))
+	if err != nil {
+		t.Fatalf("ApplyDiff: unexpected error: %v", err)
+	}
+	if list.BlockHash() != msg.BlockHash || list.Len() != 1 {
+		t.Errorf("ApplyDiff: got list at %v with %d entries, want %v "+
+			"with 1 entry", list.BlockHash(), list.Len(), msg.BlockHash)
+	}
+}
@@ -0,0 +1,676 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dashpay/dashd-go/blockchain"
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+const (
+	// maxCachedLists is the number of recent lists kept in memory.  It
+	// covers the work blocks of quorums and short reorganizations.
+	maxCachedLists = 32
+
+	// quorumWorkBlockOffset is the number of blocks below the base block
+	// of a quorum at which its members are selected since v20.
+	quorumWorkBlockOffset = 8
+)
+
+var (
+	// ErrInterruptRequested is returned when the catch up with the chain
+	// was cancelled by the interrupt channel.
+	ErrInterruptRequested = errors.New("interrupt requested")
+
+	// ErrListNotFound is returned when the list of a block is requested
+	// which was never connected.
+	ErrListNotFound = errors.New("masternode list not found")
+)
+
+// Config is the configuration of a Manager.
+type Config struct {
+	// DB is the database the lists are stored in.
+	DB database.DB
+
+	// ChainParams are the parameters of the network of the chain.
+	ChainParams *chaincfg.Params
+
+	// Interrupt cancels the catch up with the chain in NewManager when it
+	// is closed.
+	Interrupt <-chan struct{}
+
+	// BestHeight defines the function to use to access the height of the
+	// current best chain.
+	BestHeight func() int32
+
+	// BlockByHeight defines the function to use to fetch the block of the
+	// main chain at the passed height.
+	BlockByHeight func(height int32) (*btcutil.Block, error)
+
+	// BlockHashByHeight defines the function to use to look up the hash of
+	// the block of the main chain at the passed height.
+	BlockHashByHeight func(height int32) (*chainhash.Hash, error)
+
+	// BlockHeightByHash defines the function to use to look up the height
+	// of the block of the main chain with the passed hash.
+	BlockHeightByHash func(hash *chainhash.Hash) (int32, error)
+
+	// MainChainHasBlock defines the function to use to determine whether
+	// the block with the passed hash is in the main chain.
+	MainChainHasBlock func(hash *chainhash.Hash) bool
+
+	// Subscribe defines the function to use to receive the notifications
+	// of the blocks connected to and disconnected from the chain.
+	Subscribe func(callback blockchain.NotificationCallback)
+}
+
+// Manager maintains the deterministic masternode list of the best chain.  It
+// applies every block connected to and disconnected from the chain and stores
+// the resulting lists in the database.
+//
+// When a block can't be applied, including when the resulting list does not
+// match the merkle root committed to by the coinbase of the block, the manager
+// logs the error and stops following the chain, and the error is returned by
+// Tip.
+type Manager struct {
+	cfg Config
+
+	mtx   sync.RWMutex
+	tip   *DeterministicMNList
+	cache map[chainhash.Hash]*DeterministicMNList
+	err   error
+
+	// snapshots holds the quorum snapshots of the DIP-24 cycles whose
+	// members were calculated, keyed by the type and the hash of the base
+	// block of the cycle.  A nil snapshot means there were not enough
+	// masternodes to select the members of the cycle.
+	snapshotsMtx sync.Mutex
+	snapshots    map[snapshotKey]*quorumSnapshot
+}
+
+// snapshotKey identifies the cycle of the rotating quorums of a type.
+type snapshotKey struct {
+	llmqType  btcjson.LLMQType
+	cycleHash chainhash.Hash
+}
+
+// interruptRequested returns true when the provided channel has been closed.
+func interruptRequested(interrupted <-chan struct{}) bool {
+	select {
+	case <-interrupted:
+		return true
+	default:
+	}
+
+	return false
+}
+
+// NewManager returns a manager which loads the list stored in the database,
+// catches it up with the best chain and subscribes to the notifications of the
+// chain to follow it from there.
+func NewManager(cfg *Config) (*Manager, error) {
+	m := &Manager{
+		cfg:       *cfg,
+		cache:     make(map[chainhash.Hash]*DeterministicMNList),
+		snapshots: make(map[snapshotKey]*quorumSnapshot),
+	}
+	if err := m.init(); err != nil {
+		return nil, err
+	}
+
+	cfg.Subscribe(m.handleBlockchainNotification)
+	return m, nil
+}
+
+// init loads the stored list and connects the blocks of the best chain which
+// were not applied yet.
+func (m *Manager) init() error {
+	var tipHash *chainhash.Hash
+	err := m.cfg.DB.Update(func(dbTx database.Tx) error {
+		if err := dbCreateBuckets(dbTx); err != nil {
+			return err
+		}
+		tipHash = dbFetchTip(dbTx)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	bestHeight := m.cfg.BestHeight()
+	if tipHash == nil {
+		// Start with the empty list of the last block before DIP-3
+		// activated, which is the base of all stored diffs.
+		height := m.cfg.ChainParams.DIP0003Height - 1
+		if height > bestHeight {
+			height = bestHeight
+		}
+		if height < 0 {
+			height = 0
+		}
+		hash, err := m.cfg.BlockHashByHeight(height)
+		if err != nil {
+			return err
+		}
+		m.tip = NewDeterministicMNList(hash, height)
+		err = m.cfg.DB.Update(func(dbTx database.Tx) error {
+			return dbPutSnapshot(dbTx, m.tip)
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		// The stored list might belong to a block which was
+		// disconnected while the node was not running, so walk back to
+		// the last block of the best chain.
+		tip, err := m.ListForBlock(tipHash)
+		if err != nil {
+			return err
+		}
+		for !m.cfg.MainChainHasBlock(&tip.blockHash) {
+			var diff *listDiff
+			err := m.cfg.DB.View(func(dbTx database.Tx) error {
+				bucket := dbTx.Metadata().Bucket(listBucketName)
+				serialized := bucket.Bucket(diffsBucketName).
+					Get(tip.blockHash[:])
+				if serialized == nil {
+					return fmt.Errorf("%w: diff of block %v",
+						ErrListNotFound, tip.blockHash)
+				}
+				var err error
+				diff, err = deserializeDiff(serialized)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			if tip, err = m.ListForBlock(&diff.prevHash); err != nil {
+				return err
+			}
+		}
+		m.tip = tip
+	}
+	m.cacheList(m.tip)
+
+	if m.tip.height < bestHeight {
+		log.Infof("Catching up masternode list from height %d to %d",
+			m.tip.height, bestHeight)
+	}
+	for height := m.tip.height + 1; height <= bestHeight; height++ {
+		if interruptRequested(m.cfg.Interrupt) {
+			return ErrInterruptRequested
+		}
+		block, err := m.cfg.BlockByHeight(height)
+		if err != nil {
+			return err
+		}
+		if err := m.connectBlock(block); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Masternode list at height %d with %d masternodes, %d valid",
+		m.tip.height, m.tip.Len(), m.tip.ValidCount())
+	return nil
+}
+
+// Tip returns the list of the best block, or the error which stopped the
+// manager from following the chain.
+func (m *Manager) Tip() (*DeterministicMNList, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.tip, nil
+}
+
+// cacheList adds the passed list to the cache of recent lists, evicting the
+// lowest list when the cache is full.
+func (m *Manager) cacheList(l *DeterministicMNList) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.cache[l.blockHash] = l
+	if len(m.cache) <= maxCachedLists {
+		return
+	}
+	var lowest *DeterministicMNList
+	for _, cached := range m.cache {
+		if lowest == nil || cached.height < lowest.height {
+			lowest = cached
+		}
+	}
+	delete(m.cache, lowest.blockHash)
+}
+
+// ListForBlock returns the list of the block with the passed hash.  The block
+// must be before DIP-3 activated or have been connected to the chain since
+// the manager was first started.
+func (m *Manager) ListForBlock(hash *chainhash.Hash) (*DeterministicMNList, error) {
+	return m.listForBlock(hash, m.preDIP3List)
+}
+
+// listForBlock returns the cached or stored list of the block with the passed
+// hash.  The fallback function returns the list of blocks for which no list
+// is stored, as with dbFetchList.
+func (m *Manager) listForBlock(hash *chainhash.Hash,
+	fallback func(hash *chainhash.Hash) (*DeterministicMNList, error)) (
+	*DeterministicMNList, error) {
+
+	m.mtx.RLock()
+	l := m.cache[*hash]
+	m.mtx.RUnlock()
+	if l != nil {
+		return l, nil
+	}
+
+	err := m.cfg.DB.View(func(dbTx database.Tx) error {
+		var err error
+		l, err = dbFetchList(dbTx, hash, fallback)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.cacheList(l)
+	return l, nil
+}
+
+// MasternodePayee returns the masternode which must be paid by the block
+// following the block with the passed hash.  The bool is false when the list
+// of the block is not known.
+//
+// This is part of the blockchain.MasternodePayeeSource interface.  Since it is
+// invoked with the chain lock held, only lists which are cached or stored in
+// the database are considered.
+func (m *Manager) MasternodePayee(prevHash *chainhash.Hash) (*blockchain.MasternodePayee, bool) {
+	l, err := m.listForBlock(prevHash, func(hash *chainhash.Hash) (
+		*DeterministicMNList, error) {
+
+		return nil, fmt.Errorf("%w: block %v", ErrListNotFound, hash)
+	})
+	if err != nil {
+		if !errors.Is(err, ErrListNotFound) {
+			log.Warnf("Unable to load masternode list of block %v: %v",
+				prevHash, err)
+		}
+		return nil, false
+	}
+
+	mn := l.Payee(m.cfg.ChainParams)
+	if mn == nil {
+		return nil, true
+	}
+	return &blockchain.MasternodePayee{
+		ProTxHash:            mn.ProTxHash,
+		ScriptPayout:         mn.ScriptPayout,
+		ScriptOperatorPayout: mn.ScriptOperatorPayout,
+		OperatorReward:       mn.OperatorReward,
+	}, true
+}
+
+// preDIP3List returns the empty list of a block of the best chain before DIP-3
+// activated.
+func (m *Manager) preDIP3List(hash *chainhash.Hash) (*DeterministicMNList, error) {
+	height, err := m.cfg.BlockHeightByHash(hash)
+	if err != nil || height >= m.cfg.ChainParams.DIP0003Height {
+		return nil, fmt.Errorf("%w: block %v", ErrListNotFound, hash)
+	}
+	return NewDeterministicMNList(hash, height), nil
+}
+
+// connectBlock applies the passed block to the current list and stores the
+// result.
+func (m *Manager) connectBlock(block *btcutil.Block) error {
+	m.mtx.RLock()
+	tip := m.tip
+	m.mtx.RUnlock()
+
+	l, err := tip.ApplyBlock(block, m.cfg.ChainParams, m.quorumMembers)
+	if err != nil {
+		return err
+	}
+
+	// The simplified list of every block is committed to by its coinbase.
+	// A list which doesn't match it is wrong, so it must not be stored or
+	// built upon.
+	coinbase := block.Transactions()[0].MsgTx()
+	if coinbase.Type == wire.TxTypeCoinbase {
+		err := l.SimplifiedMNList().VerifyCbTx(coinbase)
+		if err != nil {
+			return fmt.Errorf("masternode list of block %v "+
+				"(height %d): %w", block.Hash(), l.height, err)
+		}
+	}
+
+	if l.height >= m.cfg.ChainParams.DIP0003Height {
+		commitments, err := blockCommitments(block)
+		if err != nil {
+			return err
+		}
+		err = m.cfg.DB.Update(func(dbTx database.Tx) error {
+			if err := dbPutList(dbTx, tip, l); err != nil {
+				return err
+			}
+			err := dbPutCommitments(dbTx, &l.blockHash, commitments)
+			if err != nil {
+				return err
+			}
+			return dbPutTip(dbTx, &l.blockHash)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	m.cacheList(l)
+	m.mtx.Lock()
+	m.tip = l
+	m.mtx.Unlock()
+	return nil
+}
+
+// disconnectBlock reverts the current list to the list of the parent of the
+// passed block.
+func (m *Manager) disconnectBlock(block *btcutil.Block) error {
+	prevHash := &block.MsgBlock().Header.PrevBlock
+	l, err := m.ListForBlock(prevHash)
+	if err != nil {
+		return err
+	}
+	err = m.cfg.DB.Update(func(dbTx database.Tx) error {
+		return dbPutTip(dbTx, prevHash)
+	})
+	if err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	m.tip = l
+	m.mtx.Unlock()
+	return nil
+}
+
+// handleBlockchainNotification applies connected and disconnected blocks to
+// the list.
+func (m *Manager) handleBlockchainNotification(n *blockchain.Notification) {
+	m.mtx.RLock()
+	stopped := m.err != nil
+	m.mtx.RUnlock()
+	if stopped {
+		return
+	}
+
+	var err error
+	switch n.Type {
+	case blockchain.NTBlockConnected:
+		err = m.connectBlock(n.Data.(*btcutil.Block))
+
+	case blockchain.NTBlockDisconnected:
+		err = m.disconnectBlock(n.Data.(*btcutil.Block))
+
+	default:
+		return
+	}
+	if err != nil {
+		log.Errorf("Unable to update masternode list, no longer "+
+			"following the chain: %v", err)
+		m.mtx.Lock()
+		m.err = fmt.Errorf("masternode list stopped at block %v: %w",
+			m.tip.blockHash, err)
+		m.mtx.Unlock()
+	}
+}
+
+// quorumModifier returns the modifier the members of the quorum of the passed
+// type with the passed base block height are selected with.  The base block of
+// rotating quorums is the base block of their cycle.
+func (m *Manager) quorumModifier(llmqType btcjson.LLMQType, rotation bool,
+	baseHash *chainhash.Hash, baseHeight int32) (*chainhash.Hash, error) {
+
+	workHeight := baseHeight - quorumWorkBlockOffset
+	if workHeight+1 < m.cfg.ChainParams.V20Height {
+		// Rotating quorums use the work block instead of the base
+		// block.
+		if rotation {
+			var err error
+			baseHash, err = m.cfg.BlockHashByHeight(workHeight)
+			if err != nil {
+				return nil, err
+			}
+		}
+		var buf [1 + chainhash.HashSize]byte
+		buf[0] = uint8(llmqType)
+		copy(buf[1:], baseHash[:])
+		h := chainhash.DoubleHashH(buf[:])
+		return &h, nil
+	}
+
+	// Since v20, the modifier is the best ChainLock signature known to the
+	// work block, or the hash of the work block when there is none.
+	workBlock, err := m.cfg.BlockByHeight(workHeight)
+	if err != nil {
+		return nil, err
+	}
+	coinbase := workBlock.Transactions()[0].MsgTx()
+	if coinbase.Type == wire.TxTypeCoinbase {
+		payload, err := evo.DecodeTxPayload(coinbase)
+		if err != nil {
+			return nil, err
+		}
+		cbTx := payload.(*evo.CbTx)
+		if cbTx.Version >= evo.CbTxVersionCLSigAndBalance &&
+			cbTx.BestCLSignature != (evo.BLSSignature{}) {
+
+			var buf [1 + 4 + evo.BLSSignatureSize]byte
+			buf[0] = uint8(llmqType)
+			buf[1] = byte(workHeight)
+			buf[2] = byte(workHeight >> 8)
+			buf[3] = byte(workHeight >> 16)
+			buf[4] = byte(workHeight >> 24)
+			copy(buf[5:], cbTx.BestCLSignature[:])
+			h := chainhash.DoubleHashH(buf[:])
+			return &h, nil
+		}
+	}
+
+	var buf [1 + chainhash.HashSize]byte
+	buf[0] = uint8(llmqType)
+	copy(buf[1:], workBlock.Hash()[:])
+	h := chainhash.DoubleHashH(buf[:])
+	return &h, nil
+}
+
+// quorumMembers returns the members of the quorum of the passed type formed at
+// the block with the passed hash.
+func (m *Manager) quorumMembers(llmqType btcjson.LLMQType,
+	quorumHash *chainhash.Hash) ([]chainhash.Hash, error) {
+
+	params, ok := llmqType.Params()
+	if !ok {
+		return nil, fmt.Errorf("unknown LLMQ type %d", llmqType)
+	}
+
+	baseHeight, err := m.cfg.BlockHeightByHash(quorumHash)
+	if err != nil {
+		return nil, err
+	}
+	if params.UseRotation {
+		quorumIndex := int(baseHeight) % params.DKGInterval
+		cycleHeight := baseHeight - int32(quorumIndex)
+		if cycleHeight >= m.cfg.ChainParams.DIP0024Height {
+			if quorumIndex >= params.SigningActiveQuorumCount {
+				return nil, fmt.Errorf("block %v at height %d "+
+					"is not the base block of a quorum of "+
+					"type %d", quorumHash, baseHeight,
+					llmqType)
+			}
+			members, err := m.rotatingQuorumMembers(params,
+				cycleHeight)
+			if err != nil {
+				return nil, err
+			}
+			hashes := make([]chainhash.Hash, 0,
+				len(members[quorumIndex]))
+			for _, mn := range members[quorumIndex] {
+				hashes = append(hashes, mn.ProTxHash)
+			}
+			return hashes, nil
+		}
+	}
+
+	modifier, err := m.quorumModifier(llmqType, false, quorumHash,
+		baseHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	listHash := quorumHash
+	if baseHeight+1 >= m.cfg.ChainParams.V20Height {
+		listHash, err = m.cfg.BlockHashByHeight(baseHeight -
+			quorumWorkBlockOffset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	l, err := m.ListForBlock(listHash)
+	if err != nil {
+		return nil, err
+	}
+
+	evoOnly := llmqType == m.cfg.ChainParams.LLMQTypePlatform &&
+		baseHeight+1 >= m.cfg.ChainParams.V19Height
+	members := l.CalculateQuorum(params.Size, modifier, evoOnly)
+	hashes := make([]chainhash.Hash, 0, len(members))
+	for _, mn := range members {
+		hashes = append(hashes, mn.ProTxHash)
+	}
+	return hashes, nil
+}
+
+// cycleWorkList returns the list at the work block of the cycle of rotating
+// quorums of the passed type starting at the passed height and the modifier the
+// members of the cycle are selected with.
+func (m *Manager) cycleWorkList(llmqType btcjson.LLMQType, cycleHash *chainhash.Hash,
+	cycleHeight int32) (*DeterministicMNList, *chainhash.Hash, error) {
+
+	modifier, err := m.quorumModifier(llmqType, true, cycleHash,
+		cycleHeight)
+	if err != nil {
+		return nil, nil, err
+	}
+	workHash, err := m.cfg.BlockHashByHeight(cycleHeight -
+		quorumWorkBlockOffset)
+	if err != nil {
+		return nil, nil, err
+	}
+	l, err := m.ListForBlock(workHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l, modifier, nil
+}
+
+// quorumSnapshot returns the quorum snapshot of the cycle of rotating quorums of
+// the passed type starting at the passed height, calculating the members of the
+// cycle when they were not calculated yet.  It returns nil when no members were
+// selected in the cycle, including for cycles before DIP-24 activated.
+func (m *Manager) quorumSnapshot(params *chaincfg.LLMQParams,
+	cycleHeight int32) (*quorumSnapshot, error) {
+
+	if cycleHeight < 1 || cycleHeight < m.cfg.ChainParams.DIP0024Height {
+		return nil, nil
+	}
+	cycleHash, err := m.cfg.BlockHashByHeight(cycleHeight)
+	if err != nil {
+		return nil, err
+	}
+	key := snapshotKey{btcjson.LLMQType(params.Type), *cycleHash}
+	m.snapshotsMtx.Lock()
+	snapshot, ok := m.snapshots[key]
+	m.snapshotsMtx.Unlock()
+	if ok {
+		return snapshot, nil
+	}
+
+	if _, err := m.rotatingQuorumMembers(params, cycleHeight); err != nil {
+		return nil, err
+	}
+	m.snapshotsMtx.Lock()
+	snapshot = m.snapshots[key]
+	m.snapshotsMtx.Unlock()
+	return snapshot, nil
+}
+
+// rotatingQuorumMembers returns the members of the rotating quorums of the
+// passed type of the cycle starting at the passed height, indexed by quorum
+// index, as defined by DIP-24.  The members of a quorum are the new quarters of
+// the last four cycles, which are derived from the quorum snapshots of the
+// previous cycles.  Those are calculated first when needed, which goes back to
+// the first cycle after DIP-24 activated when the node was restarted.
+func (m *Manager) rotatingQuorumMembers(params *chaincfg.LLMQParams,
+	cycleHeight int32) ([][]*Masternode, error) {
+
+	llmqType := btcjson.LLMQType(params.Type)
+	prev := newPreviousQuarters(params.SigningActiveQuorumCount)
+	for i, quarters := range []*[][]*Masternode{&prev.hMinusC,
+		&prev.hMinus2C, &prev.hMinus3C} {
+
+		// The quarters of a cycle are only known when it has a
+		// snapshot, and so are those of the cycles before it.
+		prevHeight := cycleHeight - int32((i+1)*params.DKGInterval)
+		snapshot, err := m.quorumSnapshot(params, prevHeight)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot == nil {
+			break
+		}
+		prevHash, err := m.cfg.BlockHashByHeight(prevHeight)
+		if err != nil {
+			return nil, err
+		}
+		l, modifier, err := m.cycleWorkList(llmqType, prevHash,
+			prevHeight)
+		if err != nil {
+			return nil, err
+		}
+		*quarters = quarterMembersBySnapshot(params, l, modifier,
+			snapshot)
+	}
+
+	cycleHash, err := m.cfg.BlockHashByHeight(cycleHeight)
+	if err != nil {
+		return nil, err
+	}
+	l, modifier, err := m.cycleWorkList(llmqType, cycleHash, cycleHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Masternodes of previous quarters which were removed from the list
+	// are only skipped since v19, except on testnet.
+	skipRemoved := cycleHeight+1 >= m.cfg.ChainParams.V19Height ||
+		m.cfg.ChainParams.Name == chaincfg.TestNet3Params.Name
+	newQuarters, snapshot := buildNewQuarterMembers(params, l, modifier,
+		prev, skipRemoved)
+	m.snapshotsMtx.Lock()
+	m.snapshots[snapshotKey{llmqType, *cycleHash}] = snapshot
+	m.snapshotsMtx.Unlock()
+
+	return rotatingQuorumMembers(prev, newQuarters), nil
+}
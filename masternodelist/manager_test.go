@@ -0,0 +1,217 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/dashpay/dashd-go/blockchain"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	_ "github.com/dashpay/dashd-go/database/ffldb"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+// testBlocks serves the blocks of a test chain through the functions of the
+// manager config.
+type testBlocks struct {
+	blocks   []*btcutil.Block
+	callback blockchain.NotificationCallback
+}
+
+// config returns a manager config backed by the blocks.
+func (b *testBlocks) config(db database.DB, params *chaincfg.Params) *Config {
+	blockByHeight := func(height int32) (*btcutil.Block, error) {
+		if height < 0 || int(height) >= len(b.blocks) {
+			return nil, fmt.Errorf("no block at height %d", height)
+		}
+		return b.blocks[height], nil
+	}
+	blockHeightByHash := func(hash *chainhash.Hash) (int32, error) {
+		for height, block := range b.blocks {
+			if *block.Hash() == *hash {
+				return int32(height), nil
+			}
+		}
+		return 0, fmt.Errorf("block %v not in main chain", hash)
+	}
+	return &Config{
+		DB:          db,
+		ChainParams: params,
+		BestHeight: func() int32 {
+			return int32(len(b.blocks) - 1)
+		},
+		BlockByHeight: blockByHeight,
+		BlockHashByHeight: func(height int32) (*chainhash.Hash, error) {
+			block, err := blockByHeight(height)
+			if err != nil {
+				return nil, err
+			}
+			return block.Hash(), nil
+		},
+		BlockHeightByHash: blockHeightByHash,
+		MainChainHasBlock: func(hash *chainhash.Hash) bool {
+			_, err := blockHeightByHash(hash)
+			return err == nil
+		},
+		Subscribe: func(callback blockchain.NotificationCallback) {
+			b.callback = callback
+		},
+	}
+}
+
+// extend appends the passed number of blocks without transactions to the
+// chain, using the passed byte to tell forks apart.
+func (b *testBlocks) extend(c *testChain, count int, fork byte) {
+	for i := 0; i < count; i++ {
+		block := c.block()
+		coinbase := block.MsgBlock().Transactions[0]
+		coinbase.TxIn[0].SignatureScript[1] = fork
+		block = btcutil.NewBlock(block.MsgBlock())
+		b.blocks = append(b.blocks, block)
+		c.list = NewDeterministicMNList(block.Hash(), c.list.height+1)
+	}
+}
+
+// TestNewManager ensures the manager catches up with the chain it is
+// configured with, follows its notifications and walks back to the main chain
+// from a stored list of a block which was disconnected while it was stopped.
+func TestNewManager(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "ffldb")
+	db, err := database.Create("ffldb", dbPath, wire.MainNet)
+	if err != nil {
+		t.Fatalf("database.Create: unexpected error: %v", err)
+	}
+	defer db.Close()
+
+	c := newTestChain(t)
+	c.params.DIP0003Height = 1
+	chain := &testBlocks{
+		blocks: []*btcutil.Block{btcutil.NewBlock(c.params.GenesisBlock)},
+	}
+	chain.extend(c, 5, 0)
+
+	m, err := NewManager(chain.config(db, c.params))
+	if err != nil {
+		t.Fatalf("NewManager: unexpected error: %v", err)
+	}
+	tip, err := m.Tip()
+	if err != nil || tip.height != 5 || tip.blockHash != *chain.blocks[5].Hash() {
+		t.Fatalf("Tip: got %v, %v, want list at height 5", tip, err)
+	}
+	if chain.callback == nil {
+		t.Fatal("NewManager: not subscribed to the chain")
+	}
+
+	// Connected blocks are applied.
+	chain.extend(c, 1, 0)
+	chain.callback(&blockchain.Notification{
+		Type: blockchain.NTBlockConnected,
+		Data: chain.blocks[6],
+	})
+	if tip, err := m.Tip(); err != nil || tip.height != 6 {
+		t.Fatalf("Tip: got %v, %v, want list at height 6", tip, err)
+	}
+
+	// Replace the last two blocks with a longer fork while the manager is
+	// stopped.
+	chain.blocks = chain.blocks[:5]
+	c.list = NewDeterministicMNList(chain.blocks[4].Hash(), 4)
+	chain.extend(c, 3, 1)
+	m, err = NewManager(chain.config(db, c.params))
+	if err != nil {
+		t.Fatalf("NewManager: unexpected error: %v", err)
+	}
+	tip, err = m.Tip()
+	if err != nil || tip.height != 7 || tip.blockHash != *chain.blocks[7].Hash() {
+		t.Fatalf("Tip: got %v, %v, want list of fork at height 7", tip,
+			err)
+	}
+}
+
+// TestConnectBlockCbTx ensures the manager stops following the chain when the
+// list of a connected block does not match the merkle root committed to by its
+// coinbase.
+func TestConnectBlockCbTx(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "ffldb")
+	db, err := database.Create("ffldb", dbPath, wire.MainNet)
+	if err != nil {
+		t.Fatalf("database.Create: unexpected error: %v", err)
+	}
+	defer db.Close()
+	err = db.Update(func(dbTx database.Tx) error {
+		return dbCreateBuckets(dbTx)
+	})
+	if err != nil {
+		t.Fatalf("dbCreateBuckets: unexpected error: %v", err)
+	}
+
+	c := newTestChain(t)
+	c.params.DIP0003Height = 1
+	m := &Manager{
+		cfg:   Config{DB: db, ChainParams: c.params},
+		tip:   c.list,
+		cache: make(map[chainhash.Hash]*DeterministicMNList),
+	}
+
+	// block returns the next block with a coinbase committing to the
+	// passed merkle root.
+	block := func(merkleRoot chainhash.Hash) *btcutil.Block {
+		block := c.block()
+		coinbase := block.MsgBlock().Transactions[0]
+		coinbase.Version = wire.SpecialTxVersion
+		coinbase.Type = wire.TxTypeCoinbase
+		err := evo.SetTxPayload(coinbase, &evo.CbTx{
+			Version:          evo.CbTxVersionMerkleRootMNList,
+			Height:           c.list.height + 1,
+			MerkleRootMNList: merkleRoot,
+		})
+		if err != nil {
+			t.Fatalf("SetTxPayload: unexpected error: %v", err)
+		}
+		return btcutil.NewBlock(block.MsgBlock())
+	}
+	connect := func(block *btcutil.Block) {
+		m.handleBlockchainNotification(&blockchain.Notification{
+			Type: blockchain.NTBlockConnected,
+			Data: block,
+		})
+	}
+
+	// A block committing to the list is applied.
+	emptyRoot := c.list.SimplifiedMNList().MerkleRoot()
+	good := block(emptyRoot)
+	connect(good)
+	tip, err := m.Tip()
+	if err != nil || tip.blockHash != *good.Hash() {
+		t.Fatalf("Tip: got %v, %v, want list of block %v", tip, err,
+			good.Hash())
+	}
+	c.list = tip
+
+	// A block committing to another list stops the manager.
+	bad := block(chainhash.Hash{0x01})
+	connect(bad)
+	if _, err := m.Tip(); !errors.Is(err, ErrMerkleRootMismatch) {
+		t.Fatalf("Tip: got %v, want %v", err, ErrMerkleRootMismatch)
+	}
+	err = db.View(func(dbTx database.Tx) error {
+		stored := dbFetchTip(dbTx)
+		if stored == nil || *stored != *good.Hash() {
+			t.Errorf("dbFetchTip: got %v, want %v", stored,
+				good.Hash())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("View: unexpected error: %v", err)
+	}
+}
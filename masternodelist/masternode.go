@@ -0,0 +1,342 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+// maxScriptSize is the maximum size of a payout script of a masternode.
+const maxScriptSize = wire.MaxTxExtraPayload
+
+// Masternode is a masternode of the deterministic masternode list.  The fields
+// which are set by the registration and never change are held directly, while
+// the fields which are updated by later provider transactions and blocks are
+// held in the embedded MasternodeState.
+//
+// Masternodes are shared between lists and must not be modified once they are
+// part of a list.
+type Masternode struct {
+	// ProTxHash is the hash of the registration transaction which uniquely
+	// identifies the masternode.
+	ProTxHash chainhash.Hash
+
+	// CollateralOutpoint is the outpoint of the collateral.  Spending it
+	// removes the masternode from the list.
+	CollateralOutpoint wire.OutPoint
+
+	// OperatorReward is the share of the masternode reward paid to the
+	// operator in hundredths of a percent.
+	OperatorReward uint16
+
+	Type evo.MnType
+
+	MasternodeState
+}
+
+// MasternodeState is the part of a masternode which changes over its lifetime.
+type MasternodeState struct {
+	// Version is the version of the last provider transaction which set the
+	// operator key.  It determines the BLS scheme of PubKeyOperator.
+	Version uint16
+
+	RegisteredHeight    int32
+	LastPaidHeight      int32
+	ConsecutivePayments int32
+
+	// PoSePenalty is the proof of service penalty.  The masternode is
+	// banned at PoSeBanHeight once it reaches the number of valid
+	// masternodes.  PoSeBanHeight and PoSeRevivedHeight are -1 when the
+	// masternode was never banned or revived.
+	PoSePenalty       int32
+	PoSeRevivedHeight int32
+	PoSeBanHeight     int32
+
+	RevocationReason evo.RevocationReason
+
+	// ConfirmedHash is the hash of the block at which the registration
+	// reached the minimum number of confirmations, or the zero hash until
+	// then.
+	ConfirmedHash chainhash.Hash
+
+	KeyIDOwner     evo.KeyID
+	PubKeyOperator evo.BLSPublicKey
+	KeyIDVoting    evo.KeyID
+
+	// IPAddress and Port are the service address of the masternode.  They
+	// are the unspecified address when the operator was reset until the
+	// next ProUpServTx.
+	IPAddress net.IP
+	Port      uint16
+
+	ScriptPayout         []byte
+	ScriptOperatorPayout []byte
+
+	// The platform fields are only set for evo masternodes.
+	PlatformNodeID   evo.KeyID
+	PlatformP2PPort  uint16
+	PlatformHTTPPort uint16
+}
+
+// IsBanned returns whether the masternode is PoSe banned.  Banned masternodes
+// are not valid: they are neither paid nor selected for quorums.
+func (mn *Masternode) IsBanned() bool {
+	return mn.PoSeBanHeight != -1
+}
+
+// Service returns the service address of the masternode in host:port form.
+func (mn *Masternode) Service() string {
+	ip := mn.IPAddress
+	if ip == nil {
+		ip = net.IPv6unspecified
+	}
+	return net.JoinHostPort(ip.String(), strconv.Itoa(int(mn.Port)))
+}
+
+// Entry returns the simplified masternode list entry of the masternode, as
+// committed to by the coinbase of every block.
+func (mn *Masternode) Entry() *wire.MNListEntry {
+	entry := &wire.MNListEntry{
+		Version:        1,
+		ProRegTxHash:   mn.ProTxHash,
+		ConfirmedHash:  mn.ConfirmedHash,
+		IP:             append(net.IP(nil), mn.IPAddress...),
+		Port:           mn.Port,
+		PubKeyOperator: mn.PubKeyOperator,
+		KeyIDVoting:    mn.KeyIDVoting,
+		IsValid:        !mn.IsBanned(),
+		Type:           uint16(mn.Type),
+	}
+	if mn.Version >= evo.ProTxVersionBasicBLS {
+		entry.Version = wire.MNListEntryVersionBasicBLS
+	}
+	if mn.Type == evo.MnTypeEvo {
+		entry.PlatformHTTPPort = mn.PlatformHTTPPort
+		entry.PlatformNodeID = mn.PlatformNodeID
+	}
+
+	return entry
+}
+
+// confirmedHashWithProTxHash returns the hash of the registration transaction
+// hash and the confirmed hash, which is the first part of the quorum score.
+func (mn *Masternode) confirmedHashWithProTxHash() chainhash.Hash {
+	var buf [chainhash.HashSize * 2]byte
+	copy(buf[:chainhash.HashSize], mn.ProTxHash[:])
+	copy(buf[chainhash.HashSize:], mn.ConfirmedHash[:])
+	return chainhash.DoubleHashH(buf[:])
+}
+
+// update returns a copy of the masternode with the passed function applied to
+// the state of the copy.
+func (mn *Masternode) update(f func(state *MasternodeState)) *Masternode {
+	mnCopy := *mn
+	f(&mnCopy.MasternodeState)
+	return &mnCopy
+}
+
+// banIfNotBanned bans the masternode at the passed height unless it is already
+// banned.
+func (s *MasternodeState) banIfNotBanned(height int32) {
+	if s.PoSeBanHeight == -1 {
+		s.PoSeBanHeight = height
+	}
+}
+
+// revive lifts the ban of the masternode at the passed height.
+func (s *MasternodeState) revive(height int32) {
+	s.PoSePenalty = 0
+	s.PoSeBanHeight = -1
+	s.PoSeRevivedHeight = height
+}
+
+// resetOperator clears the fields set by the operator, which happens when the
+// operator is revoked or replaced.
+func (s *MasternodeState) resetOperator() {
+	s.Version = evo.ProTxVersionLegacyBLS
+	s.PubKeyOperator = evo.BLSPublicKey{}
+	s.IPAddress = make(net.IP, net.IPv6len)
+	s.Port = 0
+	s.ScriptOperatorPayout = nil
+	s.RevocationReason = evo.RevocationReasonNotSpecified
+	s.PlatformNodeID = evo.KeyID{}
+}
+
+// serialize encodes the masternode to w.
+func (mn *Masternode) serialize(w io.Writer) error {
+	var buf [4]byte
+	putUint16 := func(v uint16) error {
+		binary.LittleEndian.PutUint16(buf[:2], v)
+		_, err := w.Write(buf[:2])
+		return err
+	}
+	putInt32 := func(v int32) error {
+		binary.LittleEndian.PutUint32(buf[:], uint32(v))
+		_, err := w.Write(buf[:])
+		return err
+	}
+
+	if _, err := w.Write(mn.ProTxHash[:]); err != nil {
+		return err
+	}
+	if _, err := w.Write(mn.CollateralOutpoint.Hash[:]); err != nil {
+		return err
+	}
+	if err := putInt32(int32(mn.CollateralOutpoint.Index)); err != nil {
+		return err
+	}
+	for _, v := range []uint16{mn.OperatorReward, uint16(mn.Type),
+		mn.Version} {
+
+		if err := putUint16(v); err != nil {
+			return err
+		}
+	}
+	for _, v := range []int32{mn.RegisteredHeight, mn.LastPaidHeight,
+		mn.ConsecutivePayments, mn.PoSePenalty, mn.PoSeRevivedHeight,
+		mn.PoSeBanHeight} {
+
+		if err := putInt32(v); err != nil {
+			return err
+		}
+	}
+	if err := putUint16(uint16(mn.RevocationReason)); err != nil {
+		return err
+	}
+	for _, b := range [][]byte{mn.ConfirmedHash[:], mn.KeyIDOwner[:],
+		mn.PubKeyOperator[:], mn.KeyIDVoting[:]} {
+
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+
+	var ip [16]byte
+	copy(ip[:], mn.IPAddress.To16())
+	if _, err := w.Write(ip[:]); err != nil {
+		return err
+	}
+	if err := putUint16(mn.Port); err != nil {
+		return err
+	}
+
+	err := wire.WriteVarBytes(w, 0, mn.ScriptPayout)
+	if err != nil {
+		return err
+	}
+	err = wire.WriteVarBytes(w, 0, mn.ScriptOperatorPayout)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(mn.PlatformNodeID[:]); err != nil {
+		return err
+	}
+	if err := putUint16(mn.PlatformP2PPort); err != nil {
+		return err
+	}
+	return putUint16(mn.PlatformHTTPPort)
+}
+
+// deserialize decodes a masternode encoded with serialize from r into the
+// receiver.
+func (mn *Masternode) deserialize(r io.Reader) error {
+	var buf [4]byte
+	readUint16 := func(v *uint16) error {
+		if _, err := io.ReadFull(r, buf[:2]); err != nil {
+			return err
+		}
+		*v = binary.LittleEndian.Uint16(buf[:2])
+		return nil
+	}
+	readInt32 := func(v *int32) error {
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			return err
+		}
+		*v = int32(binary.LittleEndian.Uint32(buf[:]))
+		return nil
+	}
+
+	if _, err := io.ReadFull(r, mn.ProTxHash[:]); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(r, mn.CollateralOutpoint.Hash[:]); err != nil {
+		return err
+	}
+	var index int32
+	if err := readInt32(&index); err != nil {
+		return err
+	}
+	mn.CollateralOutpoint.Index = uint32(index)
+
+	var mnType, reason uint16
+	for _, v := range []*uint16{&mn.OperatorReward, &mnType, &mn.Version} {
+		if err := readUint16(v); err != nil {
+			return err
+		}
+	}
+	mn.Type = evo.MnType(mnType)
+	for _, v := range []*int32{&mn.RegisteredHeight, &mn.LastPaidHeight,
+		&mn.ConsecutivePayments, &mn.PoSePenalty, &mn.PoSeRevivedHeight,
+		&mn.PoSeBanHeight} {
+
+		if err := readInt32(v); err != nil {
+			return err
+		}
+	}
+	if err := readUint16(&reason); err != nil {
+		return err
+	}
+	mn.RevocationReason = evo.RevocationReason(reason)
+	for _, b := range [][]byte{mn.ConfirmedHash[:], mn.KeyIDOwner[:],
+		mn.PubKeyOperator[:], mn.KeyIDVoting[:]} {
+
+		if _, err := io.ReadFull(r, b); err != nil {
+			return err
+		}
+	}
+
+	var ip [16]byte
+	if _, err := io.ReadFull(r, ip[:]); err != nil {
+		return err
+	}
+	mn.IPAddress = net.IP(ip[:])
+	if err := readUint16(&mn.Port); err != nil {
+		return err
+	}
+
+	var err error
+	mn.ScriptPayout, err = wire.ReadVarBytes(r, 0, maxScriptSize,
+		"ScriptPayout")
+	if err != nil {
+		return err
+	}
+	if len(mn.ScriptPayout) == 0 {
+		mn.ScriptPayout = nil
+	}
+	mn.ScriptOperatorPayout, err = wire.ReadVarBytes(r, 0, maxScriptSize,
+		"ScriptOperatorPayout")
+	if err != nil {
+		return err
+	}
+	if len(mn.ScriptOperatorPayout) == 0 {
+		mn.ScriptOperatorPayout = nil
+	}
+
+	if _, err := io.ReadFull(r, mn.PlatformNodeID[:]); err != nil {
+		return err
+	}
+	if err := readUint16(&mn.PlatformP2PPort); err != nil {
+		return err
+	}
+	return readUint16(&mn.PlatformHTTPPort)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"fmt"
+
+	"github.com/dashpay/dashd-go/blscrypto"
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/llmq"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+// minedCommitment is the part of a final commitment mined in a block which is
+// needed to verify the signatures of its quorum.
+type minedCommitment struct {
+	llmqType    btcjson.LLMQType
+	quorumHash  chainhash.Hash
+	quorumIndex int16
+	scheme      blscrypto.Scheme
+	publicKey   [48]byte
+}
+
+// blockCommitments returns the final commitments which are mined in the
+// passed block and are not null, in the order of the block.
+func blockCommitments(block *btcutil.Block) ([]*minedCommitment, error) {
+	var commitments []*minedCommitment
+	for _, tx := range block.Transactions() {
+		msgTx := tx.MsgTx()
+		if msgTx.Type != wire.TxTypeQuorumCommitment {
+			continue
+		}
+		payload, err := evo.DecodeTxPayload(msgTx)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v: %v", ErrInvalidProTx,
+				tx.Hash(), err)
+		}
+		qc := &payload.(*evo.QcTx).Commitment
+		if qc.IsNull() {
+			continue
+		}
+		commitments = append(commitments, &minedCommitment{
+			llmqType:    btcjson.LLMQType(qc.LLMQType),
+			quorumHash:  qc.QuorumHash,
+			quorumIndex: qc.QuorumIndex,
+			scheme:      llmq.CommitmentScheme(qc),
+			publicKey:   qc.QuorumPublicKey,
+		})
+	}
+	return commitments, nil
+}
+
+// ActiveQuorums returns the quorums of the passed type which are active at the
+// block of the main chain at the passed height, most recently mined first.
+// These are the signingActiveQuorumCount quorums of the type whose final
+// commitments were mined last at or below the height, and for rotating types
+// the last one of every quorum index.  The height can't be above the current
+// list.
+//
+// The final commitments are trusted as mined, their signatures are not
+// verified.
+func (m *Manager) ActiveQuorums(llmqType btcjson.LLMQType,
+	height int32) ([]*llmq.Quorum, error) {
+
+	params, ok := llmqType.Params()
+	if !ok {
+		return nil, fmt.Errorf("unknown LLMQ type %d", llmqType)
+	}
+	tip, err := m.Tip()
+	if err != nil {
+		return nil, err
+	}
+	if height > tip.height {
+		return nil, fmt.Errorf("%w: height %d is above the list at "+
+			"height %d", ErrListNotFound, height, tip.height)
+	}
+
+	// New quorums are mined every DKG interval, so the active ones are
+	// found within one interval more than their count.
+	count := params.SigningActiveQuorumCount
+	minHeight := height - int32((count+1)*params.DKGInterval)
+	if minHeight < m.cfg.ChainParams.DIP0003Height {
+		minHeight = m.cfg.ChainParams.DIP0003Height
+	}
+
+	quorums := make([]*llmq.Quorum, 0, count)
+	indexes := make(map[int16]struct{}, count)
+	err = m.cfg.DB.View(func(dbTx database.Tx) error {
+		for h := height; h >= minHeight && len(quorums) < count; h-- {
+			hash, err := m.cfg.BlockHashByHeight(h)
+			if err != nil {
+				return err
+			}
+			commitments, err := dbFetchCommitments(dbTx, hash)
+			if err != nil {
+				return err
+			}
+			for _, c := range commitments {
+				if c.llmqType != llmqType || len(quorums) == count {
+					continue
+				}
+				if params.UseRotation {
+					if _, ok := indexes[c.quorumIndex]; ok {
+						continue
+					}
+					indexes[c.quorumIndex] = struct{}{}
+				}
+				pubKey, err := blscrypto.PublicKeyFromBytes(
+					c.publicKey[:], c.scheme)
+				if err != nil {
+					return fmt.Errorf("public key of quorum "+
+						"%v: %v", c.quorumHash, err)
+				}
+				quorums = append(quorums, &llmq.Quorum{
+					Hash:      c.quorumHash,
+					Index:     c.quorumIndex,
+					PublicKey: pubKey,
+					Scheme:    c.scheme,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return quorums, nil
+}
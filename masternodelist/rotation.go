@@ -0,0 +1,260 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+// quorumSnapshot describes how the new quarters of the rotating quorums of a
+// DIP-24 cycle were selected, which is what the members of the quorums of the
+// following cycles are derived from.  It is the quorum snapshot of Dash Core.
+type quorumSnapshot struct {
+	// activeMembers tells for every masternode of the list at the work
+	// block of the cycle, sorted by their score, whether it was a member
+	// of the previous three cycles.
+	activeMembers []bool
+
+	// skipList holds the indexes of the masternodes which were skipped
+	// while filling the new quarters.  The first index which is not zero
+	// is absolute and the following ones are relative to it.  It is empty
+	// when none were skipped.
+	skipList []int
+}
+
+// previousQuarters are the quarters of the members of the rotating quorums of
+// the three cycles before a cycle, indexed by quorum index.
+type previousQuarters struct {
+	hMinusC  [][]*Masternode
+	hMinus2C [][]*Masternode
+	hMinus3C [][]*Masternode
+}
+
+// newPreviousQuarters returns empty previous quarters for the passed number of
+// quorums.
+func newPreviousQuarters(numQuorums int) *previousQuarters {
+	return &previousQuarters{
+		hMinusC:  make([][]*Masternode, numQuorums),
+		hMinus2C: make([][]*Masternode, numQuorums),
+		hMinus3C: make([][]*Masternode, numQuorums),
+	}
+}
+
+// tryAdd adds the passed masternode to the list unless the list already
+// contains it or another masternode with the same collateral, address or key,
+// like CDeterministicMNList::AddMN of Dash Core, whose callers ignore the
+// failures.  It returns whether the masternode was added.
+func (l *DeterministicMNList) tryAdd(mn *Masternode) bool {
+	if l.mns[mn.ProTxHash] != nil {
+		return false
+	}
+	b := &listBuilder{l}
+	dup := b.findProperty(&mn.ProTxHash, func(other *Masternode) bool {
+		return other.CollateralOutpoint == mn.CollateralOutpoint
+	})
+	if dup != nil || b.checkUnique(mn, true) != nil {
+		return false
+	}
+	l.mns[mn.ProTxHash] = mn
+	return true
+}
+
+// sortedByScore returns all valid masternodes of the list which take part in
+// quorums, ordered by their score for the passed modifier.
+func (l *DeterministicMNList) sortedByScore(modifier *chainhash.Hash) []*Masternode {
+	return l.CalculateQuorum(len(l.mns), modifier, false)
+}
+
+// mnUsageBySnapshot splits the masternodes of the passed list at the work block
+// of a cycle into those which were members of the previous three cycles and the
+// valid others according to the snapshot of the cycle.
+func mnUsageBySnapshot(l *DeterministicMNList, modifier *chainhash.Hash,
+	snapshot *quorumSnapshot) (*DeterministicMNList, *DeterministicMNList) {
+
+	used := NewDeterministicMNList(&l.blockHash, l.height)
+	notUsed := NewDeterministicMNList(&l.blockHash, l.height)
+	for i, mn := range l.sortedByScore(modifier) {
+		if i < len(snapshot.activeMembers) && snapshot.activeMembers[i] {
+			used.tryAdd(mn)
+		} else if !mn.IsBanned() {
+			notUsed.tryAdd(mn)
+		}
+	}
+	return used, notUsed
+}
+
+// combinedByScore returns the masternodes which were not used by the previous
+// cycles followed by those which were, both ordered by their score for the
+// passed modifier.  New quarters are filled from this order.
+func combinedByScore(used, notUsed *DeterministicMNList,
+	modifier *chainhash.Hash) []*Masternode {
+
+	combined := notUsed.sortedByScore(modifier)
+	return append(combined, used.sortedByScore(modifier)...)
+}
+
+// quarterMembersBySnapshot returns the new quarters of the rotating quorums of
+// a cycle, indexed by quorum index, from the list at the work block of the cycle
+// and the snapshot taken when the quarters were selected.
+func quarterMembersBySnapshot(params *chaincfg.LLMQParams,
+	l *DeterministicMNList, modifier *chainhash.Hash,
+	snapshot *quorumSnapshot) [][]*Masternode {
+
+	numQuorums := params.SigningActiveQuorumCount
+	quarterSize := params.Size / 4
+	quarters := make([][]*Masternode, numQuorums)
+
+	used, notUsed := mnUsageBySnapshot(l, modifier, snapshot)
+	combined := combinedByScore(used, notUsed, modifier)
+	if len(combined) == 0 {
+		return quarters
+	}
+
+	// Like Dash Core, the first index which is not zero is the one the
+	// following ones are relative to.
+	skipList := make([]int, 0, len(snapshot.skipList))
+	var firstSkipped int
+	for _, skip := range snapshot.skipList {
+		if firstSkipped == 0 {
+			firstSkipped = skip
+		} else {
+			skip += firstSkipped
+		}
+		skipList = append(skipList, skip)
+	}
+
+	var idx int
+	for i := range quarters {
+		for len(quarters[i]) < quarterSize {
+			if len(skipList) > 0 && idx == skipList[0] {
+				skipList = skipList[1:]
+			} else {
+				quarters[i] = append(quarters[i], combined[idx])
+			}
+			idx++
+			if idx == len(combined) {
+				idx = 0
+			}
+		}
+	}
+	return quarters
+}
+
+// buildNewQuarterMembers selects the new quarters of the rotating quorums of a
+// cycle, indexed by quorum index, from the list at the work block of the cycle
+// and the quarters of the previous three cycles.  Every quorum gets the
+// masternodes with the best score which are not members of its previous
+// quarters, preferring those which are not members of any previous quarter.
+//
+// It also returns the snapshot which allows to derive the new quarters again
+// with quarterMembersBySnapshot.  The snapshot is nil when there are not enough
+// masternodes, in which case all quarters are empty.
+//
+// Masternodes of the previous quarters which were removed from the list since
+// are skipped when skipRemoved is set.
+func buildNewQuarterMembers(params *chaincfg.LLMQParams, l *DeterministicMNList,
+	modifier *chainhash.Hash, prev *previousQuarters,
+	skipRemoved bool) ([][]*Masternode, *quorumSnapshot) {
+
+	numQuorums := params.SigningActiveQuorumCount
+	quarterSize := params.Size / 4
+	quarters := make([][]*Masternode, numQuorums)
+	if l.ValidCount() < quarterSize {
+		return quarters, nil
+	}
+
+	used := NewDeterministicMNList(&l.blockHash, l.height)
+	usedByQuorum := make([]*DeterministicMNList, numQuorums)
+	for i := range usedByQuorum {
+		usedByQuorum[i] = NewDeterministicMNList(&l.blockHash, l.height)
+		for _, quarter := range [][]*Masternode{prev.hMinusC[i],
+			prev.hMinus2C[i], prev.hMinus3C[i]} {
+
+			for _, mn := range quarter {
+				current := l.mns[mn.ProTxHash]
+				if skipRemoved && current == nil {
+					continue
+				}
+				if current != nil && current.IsBanned() {
+					continue
+				}
+				used.tryAdd(mn)
+				usedByQuorum[i].tryAdd(mn)
+			}
+		}
+	}
+
+	notUsed := NewDeterministicMNList(&l.blockHash, l.height)
+	for _, mn := range l.mns {
+		if used.mns[mn.ProTxHash] == nil && !mn.IsBanned() {
+			notUsed.tryAdd(mn)
+		}
+	}
+	combined := combinedByScore(used, notUsed, modifier)
+
+	var skipList []int
+	var firstSkipped, idx int
+	for i := range quarters {
+		usedCount := usedByQuorum[i].Len()
+		updated := false
+		initialIdx := idx
+		for len(quarters[i]) < quarterSize &&
+			usedCount+len(quarters[i]) < len(combined) {
+
+			mn := combined[idx]
+			if usedByQuorum[i].tryAdd(mn) {
+				quarters[i] = append(quarters[i], mn)
+				updated = true
+			} else if firstSkipped == 0 {
+				firstSkipped = idx
+				skipList = append(skipList, idx)
+			} else {
+				skipList = append(skipList, idx-firstSkipped)
+			}
+
+			idx++
+			if idx == len(combined) {
+				idx = 0
+			}
+			if idx == initialIdx {
+				// A full pass over all masternodes without
+				// adding any means there are not enough.
+				if !updated {
+					return make([][]*Masternode, numQuorums), nil
+				}
+				updated = false
+			}
+		}
+	}
+
+	// The snapshot records which masternodes of the list were used by the
+	// previous cycles in the order of their score.
+	sorted := l.sortedByScore(modifier)
+	snapshot := &quorumSnapshot{
+		activeMembers: make([]bool, len(l.mns)),
+		skipList:      skipList,
+	}
+	for i, mn := range sorted {
+		snapshot.activeMembers[i] = used.mns[mn.ProTxHash] != nil
+	}
+	return quarters, snapshot
+}
+
+// rotatingQuorumMembers returns the members of the quorums of a cycle, indexed
+// by quorum index, which consist of the quarters of the previous three cycles
+// followed by the new quarter.
+func rotatingQuorumMembers(prev *previousQuarters,
+	newQuarters [][]*Masternode) [][]*Masternode {
+
+	members := make([][]*Masternode, len(newQuarters))
+	for i := range members {
+		members[i] = append(members[i], prev.hMinus3C[i]...)
+		members[i] = append(members[i], prev.hMinus2C[i]...)
+		members[i] = append(members[i], prev.hMinusC[i]...)
+		members[i] = append(members[i], newQuarters[i]...)
+	}
+	return members
+}
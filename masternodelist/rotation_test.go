@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+// rotationTestList returns a list of the passed number of masternodes whose
+// keys are distinct, as required by the quarter selection.
+func rotationTestList(count int) *DeterministicMNList {
+	l := testList(100)
+	for i := 1; i <= count; i++ {
+		mn := testMN(byte(i))
+		mn.KeyIDOwner = evo.KeyID{byte(i)}
+		l.mns[mn.ProTxHash] = mn
+	}
+	return l
+}
+
+// TestBuildNewQuarterMembers ensures the new quarters of the rotating quorums
+// of consecutive cycles don't repeat the members of their previous quarters and
+// can be derived again from the quorum snapshot of their cycle.
+func TestBuildNewQuarterMembers(t *testing.T) {
+	params := &chaincfg.LLMQParams{
+		UseRotation:              true,
+		Size:                     8,
+		SigningActiveQuorumCount: 4,
+	}
+	quarterSize := params.Size / 4
+
+	tests := []struct {
+		name     string
+		numMNs   int
+		wantSkip bool
+	}{
+		{name: "plenty of masternodes", numMNs: 40},
+		{name: "few masternodes", numMNs: 10, wantSkip: true},
+	}
+	for _, test := range tests {
+		l := rotationTestList(test.numMNs)
+		prev := newPreviousQuarters(params.SigningActiveQuorumCount)
+		var skipped bool
+		for cycle := 0; cycle < 6; cycle++ {
+			modifier := chainhash.Hash{byte(cycle), 0xcc}
+			quarters, snapshot := buildNewQuarterMembers(params, l,
+				&modifier, prev, true)
+			if snapshot == nil {
+				t.Fatalf("%s: cycle %d: no snapshot", test.name,
+					cycle)
+			}
+			skipped = skipped || len(snapshot.skipList) > 0
+
+			for i, quarter := range quarters {
+				if len(quarter) != quarterSize {
+					t.Fatalf("%s: cycle %d: quorum %d has %d "+
+						"new members, want %d", test.name,
+						cycle, i, len(quarter), quarterSize)
+				}
+				previous := make(map[chainhash.Hash]bool)
+				for _, q := range [][]*Masternode{prev.hMinusC[i],
+					prev.hMinus2C[i], prev.hMinus3C[i]} {
+
+					for _, mn := range q {
+						previous[mn.ProTxHash] = true
+					}
+				}
+				for _, mn := range quarter {
+					if previous[mn.ProTxHash] {
+						t.Fatalf("%s: cycle %d: quorum %d "+
+							"repeats member %v", test.name,
+							cycle, i, mn.ProTxHash)
+					}
+				}
+			}
+
+			got := quarterMembersBySnapshot(params, l, &modifier,
+				snapshot)
+			if !reflect.DeepEqual(got, quarters) {
+				t.Fatalf("%s: cycle %d: quarters by snapshot %v, "+
+					"want %v", test.name, cycle, got, quarters)
+			}
+
+			members := rotatingQuorumMembers(prev, quarters)
+			for i := range members {
+				want := len(prev.hMinus3C[i]) + len(prev.hMinus2C[i]) +
+					len(prev.hMinusC[i]) + quarterSize
+				if len(members[i]) != want ||
+					members[i][want-1] != quarters[i][quarterSize-1] {
+
+					t.Fatalf("%s: cycle %d: quorum %d members %v",
+						test.name, cycle, i, members[i])
+				}
+			}
+
+			prev = &previousQuarters{
+				hMinusC:  quarters,
+				hMinus2C: prev.hMinusC,
+				hMinus3C: prev.hMinus2C,
+			}
+		}
+		if skipped != test.wantSkip {
+			t.Fatalf("%s: skipped masternodes %v, want %v", test.name,
+				skipped, test.wantSkip)
+		}
+	}
+
+	// No members are selected without enough valid masternodes.
+	l := rotationTestList(3)
+	for _, mn := range l.mns {
+		if mn.ProTxHash != (chainhash.Hash{1}) {
+			mn.PoSeBanHeight = 50
+		}
+	}
+	prev := newPreviousQuarters(params.SigningActiveQuorumCount)
+	quarters, snapshot := buildNewQuarterMembers(params, l,
+		&chainhash.Hash{}, prev, true)
+	if snapshot != nil {
+		t.Fatalf("not enough masternodes: got snapshot %+v", snapshot)
+	}
+	for i, quarter := range quarters {
+		if len(quarter) != 0 {
+			t.Fatalf("not enough masternodes: quorum %d has members "+
+				"%v", i, quarter)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// NewDiffFromRPC converts the result of a protx diff RPC into a Diff.  The
+// passed network parameters are used to decode the voting addresses of the
+// masternodes.
+func NewDiffFromRPC(result *btcjson.ProTxDiffResult,
+	params *chaincfg.Params) (*Diff, error) {
+
+	var diff Diff
+	err := chainhash.Decode(&diff.BaseBlockHash, result.BaseBlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("baseBlockHash: %w", err)
+	}
+	if err := chainhash.Decode(&diff.BlockHash, result.BlockHash); err != nil {
+		return nil, fmt.Errorf("blockHash: %w", err)
+	}
+
+	if result.CbTx != "" {
+		serializedTx, err := hex.DecodeString(result.CbTx)
+		if err != nil {
+			return nil, fmt.Errorf("cbTx: %w", err)
+		}
+		var cbTx wire.MsgTx
+		err = cbTx.Deserialize(bytes.NewReader(serializedTx))
+		if err != nil {
+			return nil, fmt.Errorf("cbTx: %w", err)
+		}
+		diff.CbTx = &cbTx
+	}
+
+	diff.DeletedMNs = make([]chainhash.Hash, len(result.DeletedMNs))
+	for i, hashStr := range result.DeletedMNs {
+		err := chainhash.Decode(&diff.DeletedMNs[i], hashStr)
+		if err != nil {
+			return nil, fmt.Errorf("deletedMNs: %w", err)
+		}
+	}
+
+	diff.MNList = make([]*wire.MNListEntry, 0, len(result.MnList))
+	for i := range result.MnList {
+		entry, err := newEntryFromRPC(&result.MnList[i], params)
+		if err != nil {
+			return nil, fmt.Errorf("mnList: %s: %w",
+				result.MnList[i].ProRegTxHash, err)
+		}
+		diff.MNList = append(diff.MNList, entry)
+	}
+
+	return &diff, nil
+}
+
+// newEntryFromRPC converts a masternode of a protx diff RPC result into a
+// simplified masternode list entry.
+func newEntryFromRPC(mn *btcjson.ProTxDiffMN,
+	params *chaincfg.Params) (*wire.MNListEntry, error) {
+
+	entry := wire.MNListEntry{
+		Version: uint16(mn.NVersion),
+		IsValid: mn.IsValid,
+		Type:    uint16(mn.NType),
+	}
+
+	// Entries reported without a version predate the basic BLS scheme.
+	if entry.Version == 0 {
+		entry.Version = 1
+	}
+
+	err := chainhash.Decode(&entry.ProRegTxHash, mn.ProRegTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("proRegTxHash: %w", err)
+	}
+	err = chainhash.Decode(&entry.ConfirmedHash, mn.ConfirmedHash)
+	if err != nil {
+		return nil, fmt.Errorf("confirmedHash: %w", err)
+	}
+
+	host, portStr, err := net.SplitHostPort(mn.Service)
+	if err != nil {
+		return nil, fmt.Errorf("service: %w", err)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("service: %w", err)
+	}
+	entry.IP = net.ParseIP(host)
+	if entry.IP == nil {
+		return nil, fmt.Errorf("service: invalid IP address %q", host)
+	}
+	entry.Port = uint16(port)
+
+	err = decodeHexFixed(entry.PubKeyOperator[:], mn.PubKeyOperator)
+	if err != nil {
+		return nil, fmt.Errorf("pubKeyOperator: %w", err)
+	}
+
+	addr, err := btcutil.DecodeAddress(mn.VotingAddress, params)
+	if err != nil {
+		return nil, fmt.Errorf("votingAddress: %w", err)
+	}
+	pkhAddr, ok := addr.(*btcutil.AddressPubKeyHash)
+	if !ok {
+		return nil, fmt.Errorf("votingAddress: %s is not a pay-to-pubkey-"+
+			"hash address", mn.VotingAddress)
+	}
+	entry.KeyIDVoting = *pkhAddr.Hash160()
+
+	if entry.Type == wire.MNTypeEvo {
+		entry.PlatformHTTPPort = uint16(mn.PlatformHTTPPort)
+		err := decodeHexFixed(entry.PlatformNodeID[:], mn.PlatformNodeID)
+		if err != nil {
+			return nil, fmt.Errorf("platformNodeID: %w", err)
+		}
+	}
+
+	return &entry, nil
+}
+
+// decodeHexFixed decodes the passed hex string into dst and ensures it has
+// exactly the size of dst.
+func decodeHexFixed(dst []byte, src string) error {
+	if hex.DecodedLen(len(src)) != len(dst) {
+		return fmt.Errorf("got %d hex characters, want %d", len(src),
+			hex.EncodedLen(len(dst)))
+	}
+	_, err := hex.Decode(dst, []byte(src))
+	return err
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestNewDiffFromRPC ensures a protx diff RPC result is converted into the
+// expected diff.
+func TestNewDiffFromRPC(t *testing.T) {
+	params := &chaincfg.MainNetParams
+	keyIDVoting := bytes.Repeat([]byte{0x04}, 20)
+	votingAddr, err := btcutil.NewAddressPubKeyHash(keyIDVoting, params)
+	if err != nil {
+		t.Fatalf("NewAddressPubKeyHash: unexpected error: %v", err)
+	}
+
+	cbTx := testCbTx(t, chainhash.Hash{0x11})
+	var cbTxBuf bytes.Buffer
+	if err := cbTx.Serialize(&cbTxBuf); err != nil {
+		t.Fatalf("Serialize: unexpected error: %v", err)
+	}
+
+	result := &btcjson.ProTxDiffResult{
+		BaseBlockHash: chainhash.Hash{0xaa}.String(),
+		BlockHash:     chainhash.Hash{0xbb}.String(),
+		CbTx:          hex.EncodeToString(cbTxBuf.Bytes()),
+		DeletedMNs:    []string{chainhash.Hash{0x01}.String()},
+		MnList: []btcjson.ProTxDiffMN{{
+			ProRegTxHash:   chainhash.Hash{0x02}.String(),
+			ConfirmedHash:  chainhash.Hash{0x03}.String(),
+			Service:        "1.2.3.4:9999",
+			PubKeyOperator: strings.Repeat("05", 48),
+			VotingAddress:  votingAddr.EncodeAddress(),
+			IsValid:        true,
+		}, {
+			NVersion:         2,
+			NType:            1,
+			ProRegTxHash:     chainhash.Hash{0x06}.String(),
+			Service:          "[2001:db8::1]:19999",
+			PubKeyOperator:   strings.Repeat("07", 48),
+			VotingAddress:    votingAddr.EncodeAddress(),
+			PlatformHTTPPort: 443,
+			PlatformNodeID:   strings.Repeat("08", 20),
+		}},
+	}
+
+	legacy := &wire.MNListEntry{
+		Version:       1,
+		ProRegTxHash:  chainhash.Hash{0x02},
+		ConfirmedHash: chainhash.Hash{0x03},
+		IP:            net.ParseIP("1.2.3.4"),
+		Port:          9999,
+		IsValid:       true,
+	}
+	copy(legacy.PubKeyOperator[:], bytes.Repeat([]byte{0x05}, 48))
+	copy(legacy.KeyIDVoting[:], keyIDVoting)
+	evoEntry := &wire.MNListEntry{
+		Version:          wire.MNListEntryVersionBasicBLS,
+		ProRegTxHash:     chainhash.Hash{0x06},
+		IP:               net.ParseIP("2001:db8::1"),
+		Port:             19999,
+		Type:             wire.MNTypeEvo,
+		PlatformHTTPPort: 443,
+	}
+	copy(evoEntry.PubKeyOperator[:], bytes.Repeat([]byte{0x07}, 48))
+	copy(evoEntry.KeyIDVoting[:], keyIDVoting)
+	copy(evoEntry.PlatformNodeID[:], bytes.Repeat([]byte{0x08}, 20))
+	want := &Diff{
+		BaseBlockHash: chainhash.Hash{0xaa},
+		BlockHash:     chainhash.Hash{0xbb},
+		CbTx:          cbTx,
+		DeletedMNs:    []chainhash.Hash{{0x01}},
+		MNList:        []*wire.MNListEntry{legacy, evoEntry},
+	}
+
+	diff, err := NewDiffFromRPC(result, params)
+	if err != nil {
+		t.Fatalf("NewDiffFromRPC: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(diff, want) {
+		t.Fatalf("NewDiffFromRPC\n got: %s want: %s", spew.Sdump(diff),
+			spew.Sdump(want))
+	}
+
+	// Malformed fields must be rejected.
+	result.MnList[0].PubKeyOperator = "05"
+	if _, err := NewDiffFromRPC(result, params); err == nil {
+		t.Errorf("NewDiffFromRPC: unexpected success for short " +
+			"operator key")
+	}
+}
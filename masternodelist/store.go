@@ -0,0 +1,429 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/dashpay/dashd-go/blscrypto"
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// snapshotInterval is the number of blocks between two full lists stored in
+// the database.  The lists of the blocks in between are stored as diffs.
+const snapshotInterval = 576
+
+var (
+	// listBucketName is the name of the metadata bucket which holds the
+	// masternode lists.
+	listBucketName = []byte("mnlist")
+
+	// diffsBucketName is the name of the bucket within the list bucket
+	// which maps block hashes to the diff from the list of their parent.
+	diffsBucketName = []byte("diffs")
+
+	// snapshotsBucketName is the name of the bucket within the list bucket
+	// which maps block hashes to full lists.
+	snapshotsBucketName = []byte("snapshots")
+
+	// commitmentsBucketName is the name of the bucket within the list
+	// bucket which maps block hashes to the final commitments mined in
+	// the block.
+	commitmentsBucketName = []byte("commitments")
+
+	// tipKeyName is the key within the list bucket which holds the hash of
+	// the block of the current list.
+	tipKeyName = []byte("tip")
+)
+
+// listDiff is the change of the list between a block and its parent as stored
+// in the database.
+type listDiff struct {
+	prevHash chainhash.Hash
+	height   int32
+	removed  []chainhash.Hash
+	updated  []*Masternode
+}
+
+// diffLists returns the diff which turns the old list into the new one.
+// Masternodes are shared between lists unless they changed, so changed
+// masternodes are found by pointer comparison.
+func diffLists(oldList, newList *DeterministicMNList) *listDiff {
+	diff := &listDiff{
+		prevHash: oldList.blockHash,
+		height:   newList.height,
+	}
+	for hash := range oldList.mns {
+		if _, ok := newList.mns[hash]; !ok {
+			diff.removed = append(diff.removed, hash)
+		}
+	}
+	for hash, mn := range newList.mns {
+		if oldList.mns[hash] != mn {
+			diff.updated = append(diff.updated, mn)
+		}
+	}
+	return diff
+}
+
+// apply returns the list of the block with the passed hash which results from
+// applying the diff to the list of its parent.
+func (d *listDiff) apply(l *DeterministicMNList,
+	blockHash *chainhash.Hash) *DeterministicMNList {
+
+	mns := make(map[chainhash.Hash]*Masternode, len(l.mns))
+	for hash, mn := range l.mns {
+		mns[hash] = mn
+	}
+	for _, hash := range d.removed {
+		delete(mns, hash)
+	}
+	for _, mn := range d.updated {
+		mns[mn.ProTxHash] = mn
+	}
+	return &DeterministicMNList{
+		blockHash: *blockHash,
+		height:    d.height,
+		mns:       mns,
+	}
+}
+
+// serializeMasternodes encodes the count and the masternodes to w.
+func serializeMasternodes(w io.Writer, mns []*Masternode) error {
+	if err := wire.WriteVarInt(w, 0, uint64(len(mns))); err != nil {
+		return err
+	}
+	for _, mn := range mns {
+		if err := mn.serialize(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deserializeMasternodes decodes masternodes encoded with serializeMasternodes
+// from r.
+func deserializeMasternodes(r *bytes.Reader) ([]*Masternode, error) {
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	// Every masternode takes far more than one byte, which bounds the
+	// count by the remaining data.
+	if count > uint64(r.Len()) {
+		return nil, fmt.Errorf("masternode count %d exceeds the remaining "+
+			"%d bytes", count, r.Len())
+	}
+
+	mns := make([]*Masternode, 0, count)
+	for i := uint64(0); i < count; i++ {
+		var mn Masternode
+		if err := mn.deserialize(r); err != nil {
+			return nil, err
+		}
+		mns = append(mns, &mn)
+	}
+	return mns, nil
+}
+
+// serialize encodes the diff.
+func (d *listDiff) serialize() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(d.prevHash[:])
+	var height [4]byte
+	binary.LittleEndian.PutUint32(height[:], uint32(d.height))
+	buf.Write(height[:])
+
+	err := wire.WriteVarInt(&buf, 0, uint64(len(d.removed)))
+	if err != nil {
+		return nil, err
+	}
+	for _, hash := range d.removed {
+		buf.Write(hash[:])
+	}
+	if err := serializeMasternodes(&buf, d.updated); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// deserializeDiff decodes a diff encoded with serialize.
+func deserializeDiff(serialized []byte) (*listDiff, error) {
+	r := bytes.NewReader(serialized)
+	var d listDiff
+	if _, err := io.ReadFull(r, d.prevHash[:]); err != nil {
+		return nil, err
+	}
+	var height [4]byte
+	if _, err := io.ReadFull(r, height[:]); err != nil {
+		return nil, err
+	}
+	d.height = int32(binary.LittleEndian.Uint32(height[:]))
+
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	if count > uint64(r.Len()/chainhash.HashSize) {
+		return nil, fmt.Errorf("removed masternode count %d exceeds the "+
+			"remaining %d bytes", count, r.Len())
+	}
+	d.removed = make([]chainhash.Hash, count)
+	for i := range d.removed {
+		if _, err := io.ReadFull(r, d.removed[i][:]); err != nil {
+			return nil, err
+		}
+	}
+	d.updated, err = deserializeMasternodes(r)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// serializeList encodes the height and the masternodes of the list.
+func serializeList(l *DeterministicMNList) ([]byte, error) {
+	var buf bytes.Buffer
+	var height [4]byte
+	binary.LittleEndian.PutUint32(height[:], uint32(l.height))
+	buf.Write(height[:])
+	if err := serializeMasternodes(&buf, l.Masternodes()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// deserializeList decodes the list of the block with the passed hash encoded
+// with serializeList.
+func deserializeList(blockHash *chainhash.Hash,
+	serialized []byte) (*DeterministicMNList, error) {
+
+	r := bytes.NewReader(serialized)
+	var height [4]byte
+	if _, err := io.ReadFull(r, height[:]); err != nil {
+		return nil, err
+	}
+	mns, err := deserializeMasternodes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	l := NewDeterministicMNList(blockHash,
+		int32(binary.LittleEndian.Uint32(height[:])))
+	for _, mn := range mns {
+		l.mns[mn.ProTxHash] = mn
+	}
+	return l, nil
+}
+
+// dbCreateBuckets creates the buckets which hold the masternode lists when
+// they don't exist yet.
+func dbCreateBuckets(dbTx database.Tx) error {
+	bucket, err := dbTx.Metadata().CreateBucketIfNotExists(listBucketName)
+	if err != nil {
+		return err
+	}
+	if _, err := bucket.CreateBucketIfNotExists(diffsBucketName); err != nil {
+		return err
+	}
+	_, err = bucket.CreateBucketIfNotExists(snapshotsBucketName)
+	if err != nil {
+		return err
+	}
+	_, err = bucket.CreateBucketIfNotExists(commitmentsBucketName)
+	return err
+}
+
+// dbFetchTip returns the hash of the block of the stored current list, or nil
+// when no list was stored yet.
+func dbFetchTip(dbTx database.Tx) *chainhash.Hash {
+	serialized := dbTx.Metadata().Bucket(listBucketName).Get(tipKeyName)
+	if len(serialized) != chainhash.HashSize {
+		return nil
+	}
+	var hash chainhash.Hash
+	copy(hash[:], serialized)
+	return &hash
+}
+
+// dbPutTip stores the hash of the block of the current list.
+func dbPutTip(dbTx database.Tx, hash *chainhash.Hash) error {
+	return dbTx.Metadata().Bucket(listBucketName).Put(tipKeyName, hash[:])
+}
+
+// dbPutList stores the passed list as the diff from the old list and, every
+// snapshotInterval blocks, in full.
+func dbPutList(dbTx database.Tx, oldList, l *DeterministicMNList) error {
+	bucket := dbTx.Metadata().Bucket(listBucketName)
+	serialized, err := diffLists(oldList, l).serialize()
+	if err != nil {
+		return err
+	}
+	err = bucket.Bucket(diffsBucketName).Put(l.blockHash[:], serialized)
+	if err != nil {
+		return err
+	}
+
+	if l.height%snapshotInterval != 0 {
+		return nil
+	}
+	return dbPutSnapshot(dbTx, l)
+}
+
+// dbPutSnapshot stores the passed list in full.
+func dbPutSnapshot(dbTx database.Tx, l *DeterministicMNList) error {
+	serialized, err := serializeList(l)
+	if err != nil {
+		return err
+	}
+	bucket := dbTx.Metadata().Bucket(listBucketName)
+	return bucket.Bucket(snapshotsBucketName).Put(l.blockHash[:], serialized)
+}
+
+// dbFetchList loads the list of the block with the passed hash.  It walks back
+// the stored diffs to the closest full list and applies them from there.  The
+// fallback function is called with the hash of a block for which neither is
+// stored and returns its list, such as the empty list of a block before DIP-3.
+func dbFetchList(dbTx database.Tx, blockHash *chainhash.Hash,
+	fallback func(hash *chainhash.Hash) (*DeterministicMNList, error)) (
+	*DeterministicMNList, error) {
+
+	bucket := dbTx.Metadata().Bucket(listBucketName)
+	diffs := bucket.Bucket(diffsBucketName)
+	snapshots := bucket.Bucket(snapshotsBucketName)
+
+	type pendingDiff struct {
+		hash chainhash.Hash
+		diff *listDiff
+	}
+	var pending []pendingDiff
+	var l *DeterministicMNList
+	hash := *blockHash
+	for l == nil {
+		if serialized := snapshots.Get(hash[:]); serialized != nil {
+			var err error
+			l, err = deserializeList(&hash, serialized)
+			if err != nil {
+				return nil, fmt.Errorf("corrupt masternode list of "+
+					"block %v: %v", hash, err)
+			}
+			break
+		}
+
+		serialized := diffs.Get(hash[:])
+		if serialized == nil {
+			var err error
+			l, err = fallback(&hash)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+		diff, err := deserializeDiff(serialized)
+		if err != nil {
+			return nil, fmt.Errorf("corrupt masternode list diff of "+
+				"block %v: %v", hash, err)
+		}
+		pending = append(pending, pendingDiff{hash, diff})
+		hash = diff.prevHash
+	}
+
+	for i := len(pending) - 1; i >= 0; i-- {
+		l = pending[i].diff.apply(l, &pending[i].hash)
+	}
+	return l, nil
+}
+
+// serializeCommitments encodes the count and the passed commitments.
+func serializeCommitments(commitments []*minedCommitment) ([]byte, error) {
+	var buf bytes.Buffer
+	err := wire.WriteVarInt(&buf, 0, uint64(len(commitments)))
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range commitments {
+		buf.WriteByte(uint8(c.llmqType))
+		buf.Write(c.quorumHash[:])
+		var index [2]byte
+		binary.LittleEndian.PutUint16(index[:], uint16(c.quorumIndex))
+		buf.Write(index[:])
+		buf.WriteByte(uint8(c.scheme))
+		buf.Write(c.publicKey[:])
+	}
+	return buf.Bytes(), nil
+}
+
+// deserializeCommitments decodes commitments encoded with
+// serializeCommitments.
+func deserializeCommitments(serialized []byte) ([]*minedCommitment, error) {
+	r := bytes.NewReader(serialized)
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	const commitmentSize = 1 + chainhash.HashSize + 2 + 1 + 48
+	if count > uint64(r.Len()/commitmentSize) {
+		return nil, fmt.Errorf("commitment count %d exceeds the remaining "+
+			"%d bytes", count, r.Len())
+	}
+
+	commitments := make([]*minedCommitment, 0, count)
+	for i := uint64(0); i < count; i++ {
+		var buf [commitmentSize]byte
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			return nil, err
+		}
+		c := &minedCommitment{
+			llmqType: btcjson.LLMQType(buf[0]),
+			quorumIndex: int16(binary.LittleEndian.Uint16(
+				buf[1+chainhash.HashSize:])),
+			scheme: blscrypto.Scheme(buf[3+chainhash.HashSize]),
+		}
+		copy(c.quorumHash[:], buf[1:])
+		copy(c.publicKey[:], buf[4+chainhash.HashSize:])
+		commitments = append(commitments, c)
+	}
+	return commitments, nil
+}
+
+// dbPutCommitments stores the final commitments mined in the block with the
+// passed hash.  Nothing is stored for blocks without commitments.
+func dbPutCommitments(dbTx database.Tx, blockHash *chainhash.Hash,
+	commitments []*minedCommitment) error {
+
+	if len(commitments) == 0 {
+		return nil
+	}
+	serialized, err := serializeCommitments(commitments)
+	if err != nil {
+		return err
+	}
+	bucket := dbTx.Metadata().Bucket(listBucketName)
+	return bucket.Bucket(commitmentsBucketName).Put(blockHash[:], serialized)
+}
+
+// dbFetchCommitments loads the final commitments mined in the block with the
+// passed hash.
+func dbFetchCommitments(dbTx database.Tx,
+	blockHash *chainhash.Hash) ([]*minedCommitment, error) {
+
+	bucket := dbTx.Metadata().Bucket(listBucketName)
+	serialized := bucket.Bucket(commitmentsBucketName).Get(blockHash[:])
+	if serialized == nil {
+		return nil, nil
+	}
+	commitments, err := deserializeCommitments(serialized)
+	if err != nil {
+		return nil, fmt.Errorf("corrupt final commitments of block %v: %v",
+			blockHash, err)
+	}
+	return commitments, nil
+}
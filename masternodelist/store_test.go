@@ -0,0 +1,175 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package masternodelist
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/blscrypto"
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	_ "github.com/dashpay/dashd-go/database/ffldb"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+// TestMasternodeSerialize ensures masternodes survive a serialization round
+// trip.
+func TestMasternodeSerialize(t *testing.T) {
+	regular := testMN(1)
+	regular.IPAddress = net.ParseIP("10.0.0.1")
+	regular.Port = 9999
+	regular.OperatorReward = 1234
+	regular.LastPaidHeight = 77
+	regular.PoSePenalty = 12
+	regular.RevocationReason = evo.RevocationReasonChangeOfKeys
+	regular.KeyIDOwner = evo.KeyID{1}
+	regular.PubKeyOperator = evo.BLSPublicKey{2}
+	regular.KeyIDVoting = evo.KeyID{3}
+	regular.ScriptPayout = []byte{0x51}
+
+	evoMN := testMN(2)
+	evoMN.Type = evo.MnTypeEvo
+	evoMN.ConsecutivePayments = 3
+	evoMN.ScriptOperatorPayout = []byte{0x52, 0x53}
+	evoMN.PlatformNodeID = evo.KeyID{4}
+	evoMN.PlatformP2PPort = 26656
+	evoMN.PlatformHTTPPort = 443
+
+	for _, mn := range []*Masternode{regular, evoMN} {
+		var buf bytes.Buffer
+		if err := mn.serialize(&buf); err != nil {
+			t.Fatalf("serialize: unexpected error: %v", err)
+		}
+		var got Masternode
+		if err := got.deserialize(bytes.NewReader(buf.Bytes())); err != nil {
+			t.Fatalf("deserialize: unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(&got, mn) {
+			t.Fatalf("round trip: got %+v, want %+v", &got, mn)
+		}
+	}
+}
+
+// TestStore ensures lists are loaded from the stored diffs and snapshots.
+func TestStore(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "ffldb")
+	db, err := database.Create("ffldb", dbPath, wire.MainNet)
+	if err != nil {
+		t.Fatalf("database.Create: unexpected error: %v", err)
+	}
+	defer db.Close()
+
+	// Build a chain of lists with additions, updates and removals.
+	base := testList(snapshotInterval - 2)
+	lists := []*DeterministicMNList{base}
+	for i := byte(1); i <= 4; i++ {
+		prev := lists[len(lists)-1]
+		l := testList(prev.height + 1)
+		l.blockHash = chainhash.Hash{i}
+		for hash, mn := range prev.mns {
+			l.mns[hash] = mn
+		}
+		l.mns[chainhash.Hash{i}] = testMN(i)
+		if i == 3 {
+			delete(l.mns, chainhash.Hash{1})
+			l.mns[chainhash.Hash{2}] = l.mns[chainhash.Hash{2}].update(
+				func(s *MasternodeState) {
+					s.LastPaidHeight = 3
+				})
+		}
+		lists = append(lists, l)
+	}
+
+	err = db.Update(func(dbTx database.Tx) error {
+		if err := dbCreateBuckets(dbTx); err != nil {
+			return err
+		}
+		if err := dbPutSnapshot(dbTx, base); err != nil {
+			return err
+		}
+		for i := 1; i < len(lists); i++ {
+			err := dbPutList(dbTx, lists[i-1], lists[i])
+			if err != nil {
+				return err
+			}
+		}
+		return dbPutTip(dbTx, &lists[len(lists)-1].blockHash)
+	})
+	if err != nil {
+		t.Fatalf("storing lists: unexpected error: %v", err)
+	}
+
+	errNoFallback := errors.New("no fallback")
+	noFallback := func(*chainhash.Hash) (*DeterministicMNList, error) {
+		return nil, errNoFallback
+	}
+	err = db.View(func(dbTx database.Tx) error {
+		tip := dbFetchTip(dbTx)
+		if tip == nil || *tip != lists[len(lists)-1].blockHash {
+			t.Errorf("dbFetchTip: got %v", tip)
+		}
+
+		for _, want := range lists {
+			got, err := dbFetchList(dbTx, &want.blockHash, noFallback)
+			if err != nil {
+				return err
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("list at height %d: got %+v, want %+v",
+					want.height, got, want)
+			}
+		}
+
+		_, err := dbFetchList(dbTx, &chainhash.Hash{0xee}, noFallback)
+		if !errors.Is(err, errNoFallback) {
+			t.Errorf("unknown block: got %v, want %v", err,
+				errNoFallback)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("loading lists: unexpected error: %v", err)
+	}
+}
+
+// TestCommitmentsSerialize ensures mined final commitments survive a
+// serialization round trip.
+func TestCommitmentsSerialize(t *testing.T) {
+	commitments := []*minedCommitment{{
+		llmqType:   btcjson.LLMQType_400_60,
+		quorumHash: chainhash.Hash{1},
+		scheme:     blscrypto.SchemeLegacy,
+		publicKey:  [48]byte{2},
+	}, {
+		llmqType:    btcjson.LLMQType_60_75,
+		quorumHash:  chainhash.Hash{3},
+		quorumIndex: 31,
+		scheme:      blscrypto.SchemeBasic,
+		publicKey:   [48]byte{4},
+	}}
+	serialized, err := serializeCommitments(commitments)
+	if err != nil {
+		t.Fatalf("serializeCommitments: unexpected error: %v", err)
+	}
+	got, err := deserializeCommitments(serialized)
+	if err != nil {
+		t.Fatalf("deserializeCommitments: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, commitments) {
+		t.Fatalf("round trip: got %+v, want %+v", got, commitments)
+	}
+
+	_, err = deserializeCommitments(serialized[:len(serialized)-1])
+	if err == nil {
+		t.Fatal("deserializeCommitments of truncated data: expected error")
+	}
+}
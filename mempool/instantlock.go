@@ -0,0 +1,196 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"fmt"
+
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// ProcessInstantLock verifies the passed InstantSend lock and records it in the
+// memory pool.  From then on, transactions which spend any of the locked inputs
+// other than the locked transaction itself are rejected, and such transactions
+// which are already in the pool are removed along with their redeemers.  The
+// locked transaction does not need to be in the pool yet, since locks are
+// routinely received before the transaction they lock.
+//
+// The returned bool indicates whether the lock was not known before.  Locks are
+// kept until the locked transaction, or a transaction which conflicts with it,
+// is removed by RemoveInstantLocks.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) ProcessInstantLock(islock *wire.MsgISDLock) (bool, error) {
+	if mp.cfg.VerifyInstantLock == nil {
+		str := fmt.Sprintf("unable to verify InstantSend lock for "+
+			"transaction %v: no verifier configured", islock.TxID)
+		return false, txRuleError(wire.RejectInvalid, str)
+	}
+
+	// Skip the costly signature verification for known locks.
+	mp.mtx.RLock()
+	_, exists := mp.instantLocks[islock.TxID]
+	mp.mtx.RUnlock()
+	if exists {
+		return false, nil
+	}
+
+	// The signature is verified without holding the mempool lock since the
+	// verifier needs to query the chain in order to select the quorum.
+	if err := mp.cfg.VerifyInstantLock(islock); err != nil {
+		str := fmt.Sprintf("invalid InstantSend lock signature for "+
+			"transaction %v: %v", islock.TxID, err)
+		return false, txRuleError(wire.RejectInvalid, str)
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	// Another peer may have sent the same lock in the mean time.
+	if _, exists := mp.instantLocks[islock.TxID]; exists {
+		return false, nil
+	}
+
+	// Two valid locks must never lock the same input, but check anyway
+	// rather than silently overwriting an existing lock.
+	for _, op := range islock.Inputs {
+		if txHash, ok := mp.lockedOutpoints[op]; ok {
+			str := fmt.Sprintf("InstantSend lock for transaction %v "+
+				"conflicts with the lock of transaction %v on "+
+				"output %v", islock.TxID, txHash, op)
+			return false, txRuleError(wire.RejectDuplicate, str)
+		}
+	}
+
+	// The inputs of the locked transaction must be the locked inputs.
+	if txDesc, ok := mp.pool[islock.TxID]; ok {
+		if !instantLockMatchesTx(islock, txDesc.Tx.MsgTx()) {
+			str := fmt.Sprintf("InstantSend lock inputs do not match "+
+				"the inputs of transaction %v", islock.TxID)
+			return false, txRuleError(wire.RejectInvalid, str)
+		}
+	}
+
+	mp.instantLocks[islock.TxID] = islock
+	for _, op := range islock.Inputs {
+		mp.lockedOutpoints[op] = islock.TxID
+
+		// Locks trump the first seen rule, so evict any transaction
+		// which conflicts with the lock.
+		conflict, ok := mp.outpoints[op]
+		if ok && *conflict.Hash() != islock.TxID {
+			log.Debugf("Removing transaction %v which conflicts with "+
+				"the InstantSend lock of transaction %v",
+				conflict.Hash(), islock.TxID)
+			mp.removeTransaction(conflict, true)
+		}
+	}
+
+	return true, nil
+}
+
+// instantLockMatchesTx returns whether the passed InstantSend lock locks
+// exactly the inputs of the passed transaction.
+func instantLockMatchesTx(islock *wire.MsgISDLock, msgTx *wire.MsgTx) bool {
+	if len(islock.Inputs) != len(msgTx.TxIn) {
+		return false
+	}
+	spent := make(map[wire.OutPoint]struct{}, len(msgTx.TxIn))
+	for _, txIn := range msgTx.TxIn {
+		spent[txIn.PreviousOutPoint] = struct{}{}
+	}
+	for _, op := range islock.Inputs {
+		if _, ok := spent[op]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// checkInstantLockConflicts returns an error when the passed transaction
+// spends an input locked by the InstantSend lock of another transaction, or
+// when the transaction itself is locked by a lock which doesn't match its
+// inputs.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) checkInstantLockConflicts(tx *btcutil.Tx) error {
+	txHash := tx.Hash()
+	if islock, ok := mp.instantLocks[*txHash]; ok {
+		if !instantLockMatchesTx(islock, tx.MsgTx()) {
+			str := fmt.Sprintf("transaction %v does not spend the "+
+				"inputs of its InstantSend lock", txHash)
+			return txRuleError(wire.RejectInvalid, str)
+		}
+		return nil
+	}
+
+	for _, txIn := range tx.MsgTx().TxIn {
+		lockedTx, ok := mp.lockedOutpoints[txIn.PreviousOutPoint]
+		if ok {
+			str := fmt.Sprintf("output %v is locked by the "+
+				"InstantSend lock of transaction %v",
+				txIn.PreviousOutPoint, lockedTx)
+			return txRuleError(wire.RejectDuplicate, str)
+		}
+	}
+
+	return nil
+}
+
+// removeInstantLock removes the InstantSend lock of the transaction with the
+// passed hash along with its locked inputs.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) removeInstantLock(txHash *chainhash.Hash) {
+	islock, ok := mp.instantLocks[*txHash]
+	if !ok {
+		return
+	}
+	for _, op := range islock.Inputs {
+		delete(mp.lockedOutpoints, op)
+	}
+	delete(mp.instantLocks, *txHash)
+}
+
+// RemoveInstantLocks removes the InstantSend lock of the passed transaction as
+// well as the locks of any transactions which conflict with it.  This is
+// necessary when a block is connected to the main chain because the finality of
+// mined transactions is provided by ChainLocks instead.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) RemoveInstantLocks(tx *btcutil.Tx) {
+	// Protect concurrent access.
+	mp.mtx.Lock()
+	mp.removeInstantLock(tx.Hash())
+	for _, txIn := range tx.MsgTx().TxIn {
+		if txHash, ok := mp.lockedOutpoints[txIn.PreviousOutPoint]; ok {
+			mp.removeInstantLock(&txHash)
+		}
+	}
+	mp.mtx.Unlock()
+}
+
+// InstantLock returns the InstantSend lock of the transaction with the passed
+// hash, or nil when the transaction is not locked.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) InstantLock(txHash *chainhash.Hash) *wire.MsgISDLock {
+	// Protect concurrent access.
+	mp.mtx.RLock()
+	islock := mp.instantLocks[*txHash]
+	mp.mtx.RUnlock()
+
+	return islock
+}
+
+// IsInstantLocked returns whether the transaction with the passed hash is
+// locked by an InstantSend lock.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) IsInstantLocked(txHash *chainhash.Hash) bool {
+	return mp.InstantLock(txHash) != nil
+}
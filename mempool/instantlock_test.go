@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// testInstantLock returns an InstantSend lock for the passed transaction.
+func testInstantLock(tx *btcutil.Tx) *wire.MsgISDLock {
+	var inputs []wire.OutPoint
+	for _, txIn := range tx.MsgTx().TxIn {
+		inputs = append(inputs, txIn.PreviousOutPoint)
+	}
+	return wire.NewMsgISDLock(inputs, tx.Hash(), &chainhash.Hash{},
+		[96]byte{})
+}
+
+// TestProcessInstantLock ensures InstantSend locks are verified, evict
+// conflicting transactions and prevent conflicting transactions from being
+// accepted.
+func TestProcessInstantLock(t *testing.T) {
+	t.Parallel()
+
+	harness, _, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	ctx := &testContext{t, harness}
+	txPool := harness.txPool
+
+	// Create a replaceable transaction in the pool and another one which
+	// spends the same output and is only known to the lock at first.
+	coinbase := ctx.addCoinbaseTx(1)
+	outs := []spendableOutput{txOutToSpendableOut(coinbase, 0)}
+	first := ctx.addSignedTx(outs, 1, 0, true, false)
+	locked, err := harness.CreateSignedTx(outs, 2, 0, false)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	islock := testInstantLock(locked)
+
+	// Locks are rejected when there is no way to verify them.
+	if _, err := txPool.ProcessInstantLock(islock); err == nil {
+		t.Fatalf("ProcessInstantLock: unexpected success without " +
+			"verifier")
+	}
+
+	// Locks with invalid signatures are rejected.
+	var verifyErr error
+	txPool.cfg.VerifyInstantLock = func(*wire.MsgISDLock) error {
+		return verifyErr
+	}
+	verifyErr = errors.New("bad signature")
+	if _, err := txPool.ProcessInstantLock(islock); err == nil {
+		t.Fatalf("ProcessInstantLock: unexpected success for invalid " +
+			"signature")
+	}
+	verifyErr = nil
+
+	// The valid lock evicts the conflicting transaction.
+	isNew, err := txPool.ProcessInstantLock(islock)
+	if err != nil || !isNew {
+		t.Fatalf("ProcessInstantLock: got (%v, %v), want (true, nil)",
+			isNew, err)
+	}
+	testPoolMembership(ctx, first, false, false)
+	if !txPool.IsInstantLocked(locked.Hash()) {
+		t.Fatalf("IsInstantLocked: locked transaction is not locked")
+	}
+
+	// Processing the same lock again is not an error.
+	isNew, err = txPool.ProcessInstantLock(islock)
+	if err != nil || isNew {
+		t.Fatalf("ProcessInstantLock: got (%v, %v) for known lock, "+
+			"want (false, nil)", isNew, err)
+	}
+
+	// A lock of another transaction spending the same output is rejected.
+	if _, err := txPool.ProcessInstantLock(testInstantLock(first)); err == nil {
+		t.Fatalf("ProcessInstantLock: unexpected success for " +
+			"conflicting lock")
+	}
+
+	// The conflicting transaction can't be accepted again while the
+	// locked one is.
+	_, err = txPool.ProcessTransaction(first, false, false, 0)
+	if _, ok := err.(RuleError); !ok {
+		t.Fatalf("ProcessTransaction: got error %v for conflicting "+
+			"transaction, want RuleError", err)
+	}
+	if _, err := txPool.ProcessTransaction(locked, false, false, 0); err != nil {
+		t.Fatalf("ProcessTransaction: unexpected error for locked "+
+			"transaction: %v", err)
+	}
+	testPoolMembership(ctx, locked, false, true)
+
+	// A lock which doesn't lock the inputs of the transaction in the pool
+	// is rejected.
+	coinbase = ctx.addCoinbaseTx(1)
+	outs = []spendableOutput{txOutToSpendableOut(coinbase, 0)}
+	unlocked := ctx.addSignedTx(outs, 1, 0, false, false)
+	mismatch := testInstantLock(unlocked)
+	mismatch.Inputs[0].Index++
+	if _, err := txPool.ProcessInstantLock(mismatch); err == nil {
+		t.Fatalf("ProcessInstantLock: unexpected success for " +
+			"mismatched inputs")
+	}
+
+	// Confirming the locked transaction removes its lock.
+	txPool.RemoveTransaction(locked, false)
+	txPool.RemoveInstantLocks(locked)
+	if txPool.InstantLock(locked.Hash()) != nil {
+		t.Fatalf("InstantLock: lock of confirmed transaction was not " +
+			"removed")
+	}
+	if len(txPool.lockedOutpoints) != 0 {
+		t.Fatalf("RemoveInstantLocks: %d locked outputs left",
+			len(txPool.lockedOutpoints))
+	}
+}
@@ -103,6 +103,11 @@ type Config struct {
 	// FeeEstimatator provides a feeEstimator. If it is not nil, the mempool
 	// records all new transactions it observes into the feeEstimator.
 	FeeEstimator *FeeEstimator
+
+	// VerifyInstantLock defines the function to use to verify the quorum
+	// signature of InstantSend locks passed to ProcessInstantLock.  This
+	// can be nil in which case all InstantSend locks are rejected.
+	VerifyInstantLock func(*wire.MsgISDLock) error
 }
 
 // Policy houses the policy (configuration parameters) which is used to
@@ -182,6 +187,13 @@ type TxPool struct {
 	orphans       map[chainhash.Hash]*orphanTx
 	orphansByPrev map[wire.OutPoint]map[chainhash.Hash]*btcutil.Tx
 	outpoints     map[wire.OutPoint]*btcutil.Tx
+
+	// instantLocks holds the InstantSend locks by the hash of the locked
+	// transaction and lockedOutpoints maps each of their inputs back to
+	// the hash of the locked transaction.
+	instantLocks    map[chainhash.Hash]*wire.MsgISDLock
+	lockedOutpoints map[wire.OutPoint]chainhash.Hash
+
 	pennyTotal    float64 // exponentially decaying total for penny spends.
 	lastPennyUnix int64   // unix time of last ``penny spend''
 
@@ -978,6 +990,15 @@ func (mp *TxPool) maybeAcceptTransaction(tx *btcutil.Tx, isNew, rateLimit, rejec
 		return nil, nil, txRuleError(wire.RejectInvalid, str)
 	}
 
+	// Final quorum commitments are only valid when mined by a block.
+	if msgTx := tx.MsgTx(); msgTx.IsSpecial() &&
+		msgTx.Type == wire.TxTypeQuorumCommitment {
+
+		str := fmt.Sprintf("transaction %v is an individual final "+
+			"quorum commitment", txHash)
+		return nil, nil, txRuleError(wire.RejectInvalid, str)
+	}
+
 	// Get the current height of the main chain.  A standalone transaction
 	// will be mined into the next block at best, so its height is at least
 	// one more than the current height.
@@ -1006,6 +1027,14 @@ func (mp *TxPool) maybeAcceptTransaction(tx *btcutil.Tx, isNew, rateLimit, rejec
 		}
 	}
 
+	// The transaction may not spend any outputs locked by the InstantSend
+	// lock of another transaction, whether or not that transaction is in
+	// the pool, and it must spend exactly the locked outputs when it is
+	// locked itself.
+	if err := mp.checkInstantLockConflicts(tx); err != nil {
+		return nil, nil, err
+	}
+
 	// The transaction may not use any of the same outputs as other
 	// transactions already in the pool as that would ultimately result in a
 	// double spend, unless those transactions signal for RBF. This check is
@@ -1549,11 +1578,13 @@ func (mp *TxPool) LastUpdated() time.Time {
 // transactions until they are mined into a block.
 func New(cfg *Config) *TxPool {
 	return &TxPool{
-		cfg:            *cfg,
-		pool:           make(map[chainhash.Hash]*TxDesc),
-		orphans:        make(map[chainhash.Hash]*orphanTx),
-		orphansByPrev:  make(map[wire.OutPoint]map[chainhash.Hash]*btcutil.Tx),
-		nextExpireScan: time.Now().Add(orphanExpireScanInterval),
-		outpoints:      make(map[wire.OutPoint]*btcutil.Tx),
+		cfg:             *cfg,
+		pool:            make(map[chainhash.Hash]*TxDesc),
+		orphans:         make(map[chainhash.Hash]*orphanTx),
+		orphansByPrev:   make(map[wire.OutPoint]map[chainhash.Hash]*btcutil.Tx),
+		nextExpireScan:  time.Now().Add(orphanExpireScanInterval),
+		outpoints:       make(map[wire.OutPoint]*btcutil.Tx),
+		instantLocks:    make(map[chainhash.Hash]*wire.MsgISDLock),
+		lockedOutpoints: make(map[wire.OutPoint]chainhash.Hash),
 	}
 }
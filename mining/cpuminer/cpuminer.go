@@ -269,16 +269,14 @@ func (m *CPUMiner) solveBlock(msgBlock *wire.MsgBlock, blockHeight int32,
 				// Non-blocking select to fall through
 			}
 
-			// Update the nonce and hash the block header.  Each
-			// hash is actually a double sha256 (two hashes), so
-			// increment the number of hashes completed for each
-			// attempt accordingly.
+			// Update the nonce and compute the X11 proof of work
+			// hash of the block header.
 			header.Nonce = i
-			hash := header.BlockHash()
-			hashesCompleted += 2
+			hash := header.PowHash()
+			hashesCompleted++
 
-			// The block is solved when the new block hash is less
-			// than the target difficulty.  Yay!
+			// The block is solved when the new proof of work hash
+			// is less than the target difficulty.  Yay!
 			if blockchain.HashToBig(&hash).Cmp(targetDifficulty) <= 0 {
 				m.updateHashes <- hashesCompleted
 				return true
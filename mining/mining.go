@@ -16,6 +16,7 @@ import (
 	"github.com/dashpay/dashd-go/chaincfg/chainhash"
 	"github.com/dashpay/dashd-go/txscript"
 	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
 )
 
 const (
@@ -248,9 +249,14 @@ func standardCoinbaseScript(nextBlockHeight int32, extraNonce uint64) ([]byte, e
 // based on the passed block height to the provided address.  When the address
 // is nil, the coinbase transaction will instead be redeemable by anyone.
 //
+// Once DIP0003 is active, the coinbase transaction is a coinbase special
+// transaction whose payload commits to the block height.  The merkle roots of
+// the payload commit to an empty masternode list and no quorums, so templates
+// are only valid for chains without registered masternodes.
+//
 // See the comment for NewBlockTemplate for more information about why the nil
 // address handling is useful.
-func createCoinbaseTx(params *chaincfg.Params, coinbaseScript []byte, nextBlockHeight int32, addr btcutil.Address) (*btcutil.Tx, error) {
+func createCoinbaseTx(params *chaincfg.Params, coinbaseScript []byte, nextBlockHeight int32, prevBits uint32, addr btcutil.Address) (*btcutil.Tx, error) {
 	// Create the script to pay to the provided payment address if one was
 	// specified.  Otherwise create a script that allows the coinbase to be
 	// redeemable by anyone.
@@ -280,9 +286,26 @@ func createCoinbaseTx(params *chaincfg.Params, coinbaseScript []byte, nextBlockH
 		Sequence:        wire.MaxTxInSequenceNum,
 	})
 	tx.AddTxOut(&wire.TxOut{
-		Value:    blockchain.CalcBlockSubsidy(nextBlockHeight, params),
+		Value:    blockchain.CalcBlockSubsidy(nextBlockHeight, prevBits, params),
 		PkScript: pkScript,
 	})
+
+	if nextBlockHeight >= params.DIP0003Height {
+		payload := evo.CbTx{
+			Version: evo.CbTxVersionMerkleRootMNList,
+			Height:  nextBlockHeight,
+		}
+		if nextBlockHeight >= params.DIP0008Height {
+			payload.Version = evo.CbTxVersionMerkleRootQuorums
+		}
+		var buf bytes.Buffer
+		if err := payload.Serialize(&buf); err != nil {
+			return nil, err
+		}
+		tx.Version = wire.SpecialTxVersion
+		tx.Type = wire.TxTypeCoinbase
+		tx.ExtraPayload = buf.Bytes()
+	}
 	return btcutil.NewTx(tx), nil
 }
 
@@ -459,7 +482,7 @@ func (g *BlkTmplGenerator) NewBlockTemplate(payToAddress btcutil.Address) (*Bloc
 		return nil, err
 	}
 	coinbaseTx, err := createCoinbaseTx(g.chainParams, coinbaseScript,
-		nextBlockHeight, payToAddress)
+		nextBlockHeight, best.Bits, payToAddress)
 	if err != nil {
 		return nil, err
 	}
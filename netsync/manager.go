@@ -6,6 +6,7 @@ package netsync
 
 import (
 	"container/list"
+	"errors"
 	"math/rand"
 	"net"
 	"sync"
@@ -47,6 +48,15 @@ const (
 	// stallSampleInterval the interval at which we will check to see if our
 	// sync has stalled.
 	stallSampleInterval = 30 * time.Second
+
+	// maxHistoricalBlocks is the maximum number of blocks below the base of
+	// a utxo set snapshot which are requested or waiting to be validated at
+	// any given time.
+	maxHistoricalBlocks = 128
+
+	// historicalLogInterval is the number of blocks below the base of a utxo
+	// set snapshot after which the validation progress is logged.
+	historicalLogInterval = 10000
 )
 
 // zeroHash is the zero value hash (all zeros).  It is defined as a convenience.
@@ -190,6 +200,9 @@ type SyncManager struct {
 	wg             sync.WaitGroup
 	quit           chan struct{}
 
+	// requestProcessShutdown is sent to when the chain can't continue.
+	requestProcessShutdown chan struct{}
+
 	// These fields should only be accessed from the blockHandler thread
 	rejectedTxns     map[chainhash.Hash]struct{}
 	requestedTxns    map[chainhash.Hash]struct{}
@@ -204,6 +217,16 @@ type SyncManager struct {
 	startHeader      *list.Element
 	nextCheckpoint   *chaincfg.Checkpoint
 
+	// The following fields are used to download the blocks below the base
+	// of the utxo set snapshot the chain was started from for their
+	// background validation.  The blocks are requested from the sync peer
+	// in order of height and queued until the blocks below them have been
+	// validated.
+	historicalPeer       *peerpkg.Peer
+	historicalBlocks     map[chainhash.Hash]int32
+	historicalQueue      map[int32]*btcutil.Block
+	nextHistoricalHeight int32
+
 	// An optional fee estimator.
 	feeEstimator *mempool.FeeEstimator
 }
@@ -375,6 +398,10 @@ func (sm *SyncManager) startSync() {
 		// syncPeer to avoid instantly detecting it as stalled in the
 		// event the progress time hasn't been updated recently.
 		sm.lastProgressTime = time.Now()
+
+		// Download the blocks below the base of a utxo set snapshot
+		// from the new sync peer as well.
+		sm.fetchHistoricalBlocks()
 	} else {
 		log.Warnf("No sync peer candidates available")
 	}
@@ -451,6 +478,10 @@ func (sm *SyncManager) handleStallSample() {
 		return
 	}
 
+	// Resume the download of the blocks below the base of a utxo set
+	// snapshot in case the requests could not be served.
+	sm.fetchHistoricalBlocks()
+
 	// If we don't have an active sync peer, exit early.
 	if sm.syncPeer == nil {
 		return
@@ -513,6 +544,10 @@ func (sm *SyncManager) handleDonePeerMsg(peer *peerpkg.Peer) {
 
 	sm.clearRequestedState(state)
 
+	if peer == sm.historicalPeer {
+		sm.resetHistoricalState()
+	}
+
 	if peer == sm.syncPeer {
 		// Update the sync peer. The server has already disconnected the
 		// peer before signaling to the sync manager.
@@ -659,8 +694,28 @@ func (sm *SyncManager) handleBlockMsg(bmsg *blockMsg) {
 		return
 	}
 
-	// If we didn't ask for this block then the peer is misbehaving.
+	// Blocks below the base of a utxo set snapshot are validated in the
+	// background instead of being processed as new blocks.
 	blockHash := bmsg.block.Hash()
+	if _, exists = sm.historicalBlocks[*blockHash]; exists &&
+		peer == sm.historicalPeer {
+
+		sm.handleHistoricalBlock(bmsg)
+		return
+	}
+
+	// Blocks below the base of a utxo set snapshot whose requests were
+	// discarded in the meantime are ignored.
+	_, requested := state.requestedBlocks[*blockHash]
+	if _, _, pending := sm.chain.SnapshotValidationProgress(); pending &&
+		!requested && sm.chain.MainChainHasBlock(blockHash) {
+
+		log.Debugf("Ignoring block %v below the utxo set snapshot "+
+			"from %s", blockHash, peer)
+		return
+	}
+
+	// If we didn't ask for this block then the peer is misbehaving.
 	if _, exists = state.requestedBlocks[*blockHash]; !exists {
 		// The regression test intentionally sends some blocks twice
 		// to test duplicate block insertion fails.  Don't disconnect
@@ -911,6 +966,113 @@ func (sm *SyncManager) fetchHeaderBlocks() {
 	}
 }
 
+// resetHistoricalState discards the requested and queued blocks below the base
+// of a utxo set snapshot so they are requested again, starting with the block
+// after the last validated one.
+func (sm *SyncManager) resetHistoricalState() {
+	sm.historicalPeer = nil
+	sm.historicalBlocks = make(map[chainhash.Hash]int32)
+	sm.historicalQueue = make(map[int32]*btcutil.Block)
+	sm.nextHistoricalHeight = 0
+}
+
+// fetchHistoricalBlocks requests the next blocks below the base of the utxo set
+// snapshot the chain was started from from the sync peer when the validation of
+// its history is pending.
+func (sm *SyncManager) fetchHistoricalBlocks() {
+	validated, base, pending := sm.chain.SnapshotValidationProgress()
+	if !pending || sm.syncPeer == nil {
+		return
+	}
+	if sm.historicalPeer != sm.syncPeer {
+		sm.resetHistoricalState()
+		sm.historicalPeer = sm.syncPeer
+	}
+	if sm.nextHistoricalHeight <= validated {
+		sm.nextHistoricalHeight = validated + 1
+	}
+
+	gdmsg := wire.NewMsgGetDataSizeHint(maxHistoricalBlocks)
+	for sm.nextHistoricalHeight <= base &&
+		len(sm.historicalBlocks)+len(sm.historicalQueue) < maxHistoricalBlocks {
+
+		hash, err := sm.chain.BlockHashByHeight(sm.nextHistoricalHeight)
+		if err != nil {
+			log.Warnf("Unable to fetch hash of block %d below the "+
+				"utxo set snapshot: %v", sm.nextHistoricalHeight,
+				err)
+			break
+		}
+
+		iv := wire.NewInvVect(wire.InvTypeBlock, hash)
+		if sm.historicalPeer.IsWitnessEnabled() {
+			iv.Type = wire.InvTypeWitnessBlock
+		}
+		gdmsg.AddInvVect(iv)
+		sm.historicalBlocks[*hash] = sm.nextHistoricalHeight
+		sm.nextHistoricalHeight++
+	}
+	if len(gdmsg.InvList) > 0 {
+		sm.historicalPeer.QueueMessage(gdmsg, nil)
+	}
+}
+
+// handleHistoricalBlock queues a requested block below the base of the utxo set
+// snapshot the chain was started from and validates the queued blocks which
+// follow the last validated one in order.
+func (sm *SyncManager) handleHistoricalBlock(bmsg *blockMsg) {
+	blockHash := bmsg.block.Hash()
+	height := sm.historicalBlocks[*blockHash]
+	delete(sm.historicalBlocks, *blockHash)
+	sm.historicalQueue[height] = bmsg.block
+
+	validated, base, _ := sm.chain.SnapshotValidationProgress()
+	for {
+		block, ok := sm.historicalQueue[validated+1]
+		if !ok {
+			break
+		}
+		delete(sm.historicalQueue, validated+1)
+
+		err := sm.chain.ProcessHistoricalBlock(block)
+		if errors.Is(err, blockchain.ErrInvalidSnapshot) {
+			// The chain state was built on an invalid snapshot, so
+			// the chain must not continue.
+			log.Criticalf("%v -- shutting down", err)
+			sm.resetHistoricalState()
+			select {
+			case sm.requestProcessShutdown <- struct{}{}:
+			default:
+			}
+			return
+		}
+		if err != nil {
+			log.Errorf("Failed to validate block %v below the utxo "+
+				"set snapshot: %v", block.Hash(), err)
+			if dbErr, ok := err.(database.Error); ok &&
+				dbErr.ErrorCode == database.ErrCorruption {
+				panic(dbErr)
+			}
+
+			// The block doesn't match its already validated header
+			// when it is rejected, so the peer is misbehaving.
+			if _, ok := err.(blockchain.RuleError); ok {
+				bmsg.peer.Disconnect()
+			}
+			sm.resetHistoricalState()
+			return
+		}
+
+		validated++
+		if validated%historicalLogInterval == 0 || validated == base {
+			log.Infof("Validated blocks below the utxo set snapshot "+
+				"up to height %d of %d", validated, base)
+		}
+	}
+
+	sm.fetchHistoricalBlocks()
+}
+
 // handleHeadersMsg handles block header messages from all peers.  Headers are
 // requested when performing a headers-first sync.
 func (sm *SyncManager) handleHeadersMsg(hmsg *headersMsg) {
@@ -1039,6 +1201,16 @@ func (sm *SyncManager) handleNotFoundMsg(nfmsg *notFoundMsg) {
 				delete(sm.requestedBlocks, inv.Hash)
 			}
 
+			// The blocks below the base of a utxo set snapshot
+			// are requested again once the stall sample is taken.
+			_, exists := sm.historicalBlocks[inv.Hash]
+			if exists && peer == sm.historicalPeer {
+				log.Debugf("Peer %s does not have block %v "+
+					"below the utxo set snapshot", peer,
+					inv.Hash)
+				sm.resetHistoricalState()
+			}
+
 		case wire.InvTypeWitnessTx:
 			fallthrough
 		case wire.InvTypeTx:
@@ -1421,10 +1593,12 @@ func (sm *SyncManager) handleBlockchainNotification(notification *blockchain.Not
 		// new transactions.  Finally, remove any transaction that is
 		// no longer an orphan. Transactions which depend on a confirmed
 		// transaction are NOT removed recursively because they are still
-		// valid.
+		// valid.  InstantSend locks of the confirmed transactions and of
+		// the transactions they conflict with are no longer needed.
 		for _, tx := range block.Transactions()[1:] {
 			sm.txMemPool.RemoveTransaction(tx, false)
 			sm.txMemPool.RemoveDoubleSpends(tx)
+			sm.txMemPool.RemoveInstantLocks(tx)
 			sm.txMemPool.RemoveOrphan(tx)
 			sm.peerNotifier.TransactionConfirmed(tx)
 			acceptedTxs := sm.txMemPool.ProcessOrphans(tx)
@@ -1604,6 +1778,13 @@ func (sm *SyncManager) IsCurrent() bool {
 	return <-reply
 }
 
+// RequestedProcessShutdown returns a channel that is sent to when the chain
+// can't continue because it was started from an invalid utxo set snapshot.  If
+// the request can not be read immediately, it is dropped.
+func (sm *SyncManager) RequestedProcessShutdown() <-chan struct{} {
+	return sm.requestProcessShutdown
+}
+
 // Pause pauses the sync manager until the returned channel is closed.
 //
 // Note that while paused, all peer and block processing is halted.  The
@@ -1618,19 +1799,23 @@ func (sm *SyncManager) Pause() chan<- struct{} {
 // block, tx, and inv updates.
 func New(config *Config) (*SyncManager, error) {
 	sm := SyncManager{
-		peerNotifier:    config.PeerNotifier,
-		chain:           config.Chain,
-		txMemPool:       config.TxMemPool,
-		chainParams:     config.ChainParams,
-		rejectedTxns:    make(map[chainhash.Hash]struct{}),
-		requestedTxns:   make(map[chainhash.Hash]struct{}),
-		requestedBlocks: make(map[chainhash.Hash]struct{}),
-		peerStates:      make(map[*peerpkg.Peer]*peerSyncState),
-		progressLogger:  newBlockProgressLogger("Processed", log),
-		msgChan:         make(chan interface{}, config.MaxPeers*3),
-		headerList:      list.New(),
-		quit:            make(chan struct{}),
-		feeEstimator:    config.FeeEstimator,
+		peerNotifier:     config.PeerNotifier,
+		chain:            config.Chain,
+		txMemPool:        config.TxMemPool,
+		chainParams:      config.ChainParams,
+		rejectedTxns:     make(map[chainhash.Hash]struct{}),
+		requestedTxns:    make(map[chainhash.Hash]struct{}),
+		requestedBlocks:  make(map[chainhash.Hash]struct{}),
+		peerStates:       make(map[*peerpkg.Peer]*peerSyncState),
+		progressLogger:   newBlockProgressLogger("Processed", log),
+		msgChan:          make(chan interface{}, config.MaxPeers*3),
+		headerList:       list.New(),
+		historicalBlocks: make(map[chainhash.Hash]int32),
+		historicalQueue:  make(map[int32]*btcutil.Block),
+		quit:             make(chan struct{}),
+		feeEstimator:     config.FeeEstimator,
+
+		requestProcessShutdown: make(chan struct{}),
 	}
 
 	best := sm.chain.BestSnapshot()
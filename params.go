@@ -55,6 +55,11 @@ var simNetParams = params{
 	rpcPort: "18556",
 }
 
+// devNetRPCPort is the RPC port of the development networks (wire.DevNet).
+// Their parameters depend on the name of the devnet, see
+// chaincfg.NewDevnetParams.
+const devNetRPCPort = "19798"
+
 // sigNetParams contains parameters specific to the Signet network
 // (wire.SigNet).
 var sigNetParams = params{
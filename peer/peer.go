@@ -182,6 +182,37 @@ type MessageListeners struct {
 	// message.
 	OnMerkleBlock func(p *Peer, msg *wire.MsgMerkleBlock)
 
+	// OnGetMNListDiff is invoked when a peer receives a getmnlistd Dash
+	// message.
+	OnGetMNListDiff func(p *Peer, msg *wire.MsgGetMNListDiff)
+
+	// OnMNListDiff is invoked when a peer receives a mnlistdiff Dash
+	// message.
+	OnMNListDiff func(p *Peer, msg *wire.MsgMNListDiff)
+
+	// OnGetQuorumRotationInfo is invoked when a peer receives a qgetinfo
+	// Dash message.
+	OnGetQuorumRotationInfo func(p *Peer, msg *wire.MsgGetQuorumRotationInfo)
+
+	// OnQuorumRotationInfo is invoked when a peer receives a qrinfo Dash
+	// message.
+	OnQuorumRotationInfo func(p *Peer, msg *wire.MsgQuorumRotationInfo)
+
+	// OnCLSig is invoked when a peer receives a clsig Dash message.
+	OnCLSig func(p *Peer, msg *wire.MsgCLSig)
+
+	// OnISDLock is invoked when a peer receives an isdlock Dash message.
+	OnISDLock func(p *Peer, msg *wire.MsgISDLock)
+
+	// OnQFCommit is invoked when a peer receives a qfcommit Dash message.
+	OnQFCommit func(p *Peer, msg *wire.MsgQFCommit)
+
+	// OnSpork is invoked when a peer receives a spork Dash message.
+	OnSpork func(p *Peer, msg *wire.MsgSpork)
+
+	// OnGetSporks is invoked when a peer receives a getsporks Dash message.
+	OnGetSporks func(p *Peer, msg *wire.MsgGetSporks)
+
 	// OnVersion is invoked when a peer receives a version bitcoin message.
 	// The caller may return a reject message in which case the message will
 	// be sent to the peer and the peer will be disconnected.
@@ -1526,6 +1557,51 @@ out:
 				p.cfg.Listeners.OnMerkleBlock(p, msg)
 			}
 
+		case *wire.MsgGetMNListDiff:
+			if p.cfg.Listeners.OnGetMNListDiff != nil {
+				p.cfg.Listeners.OnGetMNListDiff(p, msg)
+			}
+
+		case *wire.MsgMNListDiff:
+			if p.cfg.Listeners.OnMNListDiff != nil {
+				p.cfg.Listeners.OnMNListDiff(p, msg)
+			}
+
+		case *wire.MsgGetQuorumRotationInfo:
+			if p.cfg.Listeners.OnGetQuorumRotationInfo != nil {
+				p.cfg.Listeners.OnGetQuorumRotationInfo(p, msg)
+			}
+
+		case *wire.MsgQuorumRotationInfo:
+			if p.cfg.Listeners.OnQuorumRotationInfo != nil {
+				p.cfg.Listeners.OnQuorumRotationInfo(p, msg)
+			}
+
+		case *wire.MsgCLSig:
+			if p.cfg.Listeners.OnCLSig != nil {
+				p.cfg.Listeners.OnCLSig(p, msg)
+			}
+
+		case *wire.MsgISDLock:
+			if p.cfg.Listeners.OnISDLock != nil {
+				p.cfg.Listeners.OnISDLock(p, msg)
+			}
+
+		case *wire.MsgQFCommit:
+			if p.cfg.Listeners.OnQFCommit != nil {
+				p.cfg.Listeners.OnQFCommit(p, msg)
+			}
+
+		case *wire.MsgSpork:
+			if p.cfg.Listeners.OnSpork != nil {
+				p.cfg.Listeners.OnSpork(p, msg)
+			}
+
+		case *wire.MsgGetSporks:
+			if p.cfg.Listeners.OnGetSporks != nil {
+				p.cfg.Listeners.OnGetSporks(p, msg)
+			}
+
 		case *wire.MsgReject:
 			if p.cfg.Listeners.OnReject != nil {
 				p.cfg.Listeners.OnReject(p, msg)
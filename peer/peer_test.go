@@ -434,6 +434,30 @@ func TestPeerListeners(t *testing.T) {
 			OnMerkleBlock: func(p *peer.Peer, msg *wire.MsgMerkleBlock) {
 				ok <- msg
 			},
+			OnGetMNListDiff: func(p *peer.Peer, msg *wire.MsgGetMNListDiff) {
+				ok <- msg
+			},
+			OnMNListDiff: func(p *peer.Peer, msg *wire.MsgMNListDiff) {
+				ok <- msg
+			},
+			OnGetQuorumRotationInfo: func(p *peer.Peer, msg *wire.MsgGetQuorumRotationInfo) {
+				ok <- msg
+			},
+			OnCLSig: func(p *peer.Peer, msg *wire.MsgCLSig) {
+				ok <- msg
+			},
+			OnISDLock: func(p *peer.Peer, msg *wire.MsgISDLock) {
+				ok <- msg
+			},
+			OnQFCommit: func(p *peer.Peer, msg *wire.MsgQFCommit) {
+				ok <- msg
+			},
+			OnSpork: func(p *peer.Peer, msg *wire.MsgSpork) {
+				ok <- msg
+			},
+			OnGetSporks: func(p *peer.Peer, msg *wire.MsgGetSporks) {
+				ok <- msg
+			},
 			OnVersion: func(p *peer.Peer, msg *wire.MsgVersion) *wire.MsgReject {
 				ok <- msg
 				return nil
@@ -587,6 +611,42 @@ func TestPeerListeners(t *testing.T) {
 			wire.NewMsgMerkleBlock(wire.NewBlockHeader(1,
 				&chainhash.Hash{}, &chainhash.Hash{}, 1, 1)),
 		},
+		{
+			"OnGetMNListDiff",
+			wire.NewMsgGetMNListDiff(&chainhash.Hash{}, &chainhash.Hash{}),
+		},
+		{
+			"OnMNListDiff",
+			wire.NewMsgMNListDiff(&chainhash.Hash{}, &chainhash.Hash{},
+				wire.NewMsgTx(wire.TxVersion)),
+		},
+		{
+			"OnGetQuorumRotationInfo",
+			wire.NewMsgGetQuorumRotationInfo(&chainhash.Hash{}, false),
+		},
+		{
+			"OnCLSig",
+			wire.NewMsgCLSig(1, &chainhash.Hash{}, [96]byte{}),
+		},
+		{
+			"OnISDLock",
+			wire.NewMsgISDLock(nil, &chainhash.Hash{}, &chainhash.Hash{},
+				[96]byte{}),
+		},
+		{
+			"OnQFCommit",
+			wire.NewMsgQFCommit(&wire.QuorumCommitment{
+				Version: wire.QuorumCommitmentVersionBasic,
+			}),
+		},
+		{
+			"OnSpork",
+			wire.NewMsgSpork(10001, 0, 1),
+		},
+		{
+			"OnGetSporks",
+			wire.NewMsgGetSporks(),
+		},
 		// only one version message is allowed
 		// only one verack message is allowed
 		{
@@ -1064,6 +1064,60 @@ func (c *Client) GetTxOutSetInfo() (*btcjson.GetTxOutSetInfoResult, error) {
 	return c.GetTxOutSetInfoAsync().Receive()
 }
 
+// GetTxOutSetInfoHashTypeAsync returns an instance of a type that can be used
+// to get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See GetTxOutSetInfoHashType for the blocking version and more details.
+func (c *Client) GetTxOutSetInfoHashTypeAsync(hashType btcjson.TxOutSetHashType) FutureGetTxOutSetInfoResult {
+	cmd := btcjson.NewGetTxOutSetInfoCmdWithHashType(&hashType)
+	return c.SendCmd(cmd)
+}
+
+// GetTxOutSetInfoHashType returns the statistics about the unspent transaction
+// output set along with the commitment of the passed hash type.
+func (c *Client) GetTxOutSetInfoHashType(hashType btcjson.TxOutSetHashType) (*btcjson.GetTxOutSetInfoResult, error) {
+	return c.GetTxOutSetInfoHashTypeAsync(hashType).Receive()
+}
+
+// FutureDumpTxOutSetResult is a future promise to deliver the result of a
+// DumpTxOutSetAsync RPC invocation (or an applicable error).
+type FutureDumpTxOutSetResult chan *Response
+
+// Receive waits for the Response promised by the future and returns the
+// results of DumpTxOutSetAsync RPC invocation.
+func (r FutureDumpTxOutSetResult) Receive() (*btcjson.DumpTxOutSetResult, error) {
+	res, err := ReceiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a dumptxoutset result object.
+	var dumpTxOutSet *btcjson.DumpTxOutSetResult
+	err = json.Unmarshal(res, &dumpTxOutSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return dumpTxOutSet, nil
+}
+
+// DumpTxOutSetAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See DumpTxOutSet for the blocking version and more details.
+func (c *Client) DumpTxOutSetAsync(path string) FutureDumpTxOutSetResult {
+	cmd := btcjson.NewDumpTxOutSetCmd(path)
+	return c.SendCmd(cmd)
+}
+
+// DumpTxOutSet writes a snapshot of the unspent transaction output set to the
+// passed path on the server.
+func (c *Client) DumpTxOutSet(path string) (*btcjson.DumpTxOutSetResult, error) {
+	return c.DumpTxOutSetAsync(path).Receive()
+}
+
 // FutureRescanBlocksResult is a future promise to deliver the result of a
 // RescanBlocksAsync RPC invocation (or an applicable error).
 //
@@ -0,0 +1,612 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcclient
+
+import (
+	"encoding/json"
+
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+// ----------------------------- getbestchainlock -----------------------------
+
+// FutureGetBestChainLockResult is a future promise to deliver the result of a
+// GetBestChainLockAsync RPC invocation (or an applicable error).
+type FutureGetBestChainLockResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the best
+// known ChainLock.
+func (r FutureGetBestChainLockResult) Receive() (*btcjson.GetBestChainLockResult, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result btcjson.GetBestChainLockResult
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetBestChainLockAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GetBestChainLockAsync() FutureGetBestChainLockResult {
+	cmd := btcjson.NewGetBestChainLockCmd()
+	return FutureGetBestChainLockResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetBestChainLock returns the best ChainLock known to the server.
+func (c *Client) GetBestChainLock() (*btcjson.GetBestChainLockResult, error) {
+	return c.GetBestChainLockAsync().Receive()
+}
+
+// ----------------------------- getislocks -----------------------------
+
+// FutureGetISLocksResult is a future promise to deliver the result of a
+// GetISLocksAsync RPC invocation (or an applicable error).
+type FutureGetISLocksResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// InstantSend locks in the order of the requested transactions.  The entry of
+// a transaction without a known lock is nil.
+func (r FutureGetISLocksResult) Receive() ([]*btcjson.ISLockResult, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []json.RawMessage
+	err = json.Unmarshal(res, &entries)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*btcjson.ISLockResult, len(entries))
+	for i, entry := range entries {
+		// Dash Core returns the string "None" for transactions
+		// without a lock.
+		var none string
+		if json.Unmarshal(entry, &none) == nil {
+			continue
+		}
+
+		var islock btcjson.ISLockResult
+		err = json.Unmarshal(entry, &islock)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = &islock
+	}
+
+	return result, nil
+}
+
+// GetISLocksAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+func (c *Client) GetISLocksAsync(txIDs []string) FutureGetISLocksResult {
+	cmd := btcjson.NewGetISLocksCmd(txIDs)
+	return FutureGetISLocksResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetISLocks returns the InstantSend locks of the passed transactions.
+func (c *Client) GetISLocks(txIDs []string) ([]*btcjson.ISLockResult, error) {
+	return c.GetISLocksAsync(txIDs).Receive()
+}
+
+// ----------------------------- spork -----------------------------
+
+// FutureGetSporkShowResult is a future promise to deliver the result of a
+// SporkShowAsync RPC invocation (or an applicable error).
+type FutureGetSporkShowResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// values of the sporks keyed by their name.
+func (r FutureGetSporkShowResult) Receive() (map[string]int64, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]int64
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SporkShowAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+func (c *Client) SporkShowAsync() FutureGetSporkShowResult {
+	cmd := btcjson.NewSporkCmd(btcjson.SporkShow)
+	return FutureGetSporkShowResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// SporkShow returns the values of the sporks keyed by their name.
+func (c *Client) SporkShow() (map[string]int64, error) {
+	return c.SporkShowAsync().Receive()
+}
+
+// FutureGetSporkActiveResult is a future promise to deliver the result of a
+// SporkActiveAsync RPC invocation (or an applicable error).
+type FutureGetSporkActiveResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns whether
+// each spork is active keyed by its name.
+func (r FutureGetSporkActiveResult) Receive() (map[string]bool, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]bool
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SporkActiveAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+func (c *Client) SporkActiveAsync() FutureGetSporkActiveResult {
+	cmd := btcjson.NewSporkCmd(btcjson.SporkActive)
+	return FutureGetSporkActiveResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// SporkActive returns whether each spork is active keyed by its name.
+func (c *Client) SporkActive() (map[string]bool, error) {
+	return c.SporkActiveAsync().Receive()
+}
+
+// ----------------------------- mnsync -----------------------------
+
+// FutureGetMnSyncStatusResult is a future promise to deliver the result of a
+// MnSyncStatusAsync RPC invocation (or an applicable error).
+type FutureGetMnSyncStatusResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// masternode sync status.
+func (r FutureGetMnSyncStatusResult) Receive() (*btcjson.MnSyncStatusResult, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result btcjson.MnSyncStatusResult
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// MnSyncStatusAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+func (c *Client) MnSyncStatusAsync() FutureGetMnSyncStatusResult {
+	cmd := btcjson.NewMnSyncCmd(btcjson.MnSyncStatus)
+	return FutureGetMnSyncStatusResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// MnSyncStatus returns the masternode sync status.
+func (c *Client) MnSyncStatus() (*btcjson.MnSyncStatusResult, error) {
+	return c.MnSyncStatusAsync().Receive()
+}
+
+// ------------------- getmerkleblocks, getspecialtxes -------------------
+
+// FutureGetStringsResult is a future promise to deliver a list of strings as
+// the result of an RPC invocation (or an applicable error).
+type FutureGetStringsResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the list
+// of strings.
+func (r FutureGetStringsResult) Receive() ([]string, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetMerkleBlocksAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GetMerkleBlocksAsync(filterHex string, blockHash *chainhash.Hash, count int) FutureGetStringsResult {
+	cmd := btcjson.NewGetMerkleBlocksCmd(filterHex, blockHash.String(), &count)
+	return FutureGetStringsResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetMerkleBlocks returns up to count hex-encoded merkle blocks matching the
+// passed hex-encoded bloom filter, starting with the passed block.
+func (c *Client) GetMerkleBlocks(filterHex string, blockHash *chainhash.Hash, count int) ([]string, error) {
+	return c.GetMerkleBlocksAsync(filterHex, blockHash, count).Receive()
+}
+
+// GetSpecialTxesAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GetSpecialTxesAsync(blockHash *chainhash.Hash, txType, count, skip int) FutureGetStringsResult {
+	cmd := btcjson.NewGetSpecialTxesCmd(blockHash.String(), &txType,
+		&count, &skip, btcjson.Int(0))
+	return FutureGetStringsResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetSpecialTxes returns the ids of the special transactions of the passed
+// type in the passed block.  A txType of -1 selects all types.
+func (c *Client) GetSpecialTxes(blockHash *chainhash.Hash, txType, count, skip int) ([]string, error) {
+	return c.GetSpecialTxesAsync(blockHash, txType, count, skip).Receive()
+}
+
+// FutureGetSpecialTxesVerboseResult is a future promise to deliver the result
+// of a GetSpecialTxesVerboseAsync RPC invocation (or an applicable error).
+type FutureGetSpecialTxesVerboseResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// special transactions as JSON objects.
+func (r FutureGetSpecialTxesVerboseResult) Receive() ([]btcjson.TxRawResult, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []btcjson.TxRawResult
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetSpecialTxesVerboseAsync returns an instance of a type that can be used to
+// get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+func (c *Client) GetSpecialTxesVerboseAsync(blockHash *chainhash.Hash, txType, count, skip int) FutureGetSpecialTxesVerboseResult {
+	cmd := btcjson.NewGetSpecialTxesCmd(blockHash.String(), &txType,
+		&count, &skip, btcjson.Int(2))
+	return FutureGetSpecialTxesVerboseResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetSpecialTxesVerbose returns the special transactions of the passed type
+// in the passed block as JSON objects.  A txType of -1 selects all types.
+func (c *Client) GetSpecialTxesVerbose(blockHash *chainhash.Hash, txType, count, skip int) ([]btcjson.TxRawResult, error) {
+	return c.GetSpecialTxesVerboseAsync(blockHash, txType, count, skip).Receive()
+}
+
+// ---------------------------- searchspecialtxes ----------------------------
+
+// searchSpecialTxesCmd returns the searchspecialtxes command for the passed
+// parameters and verbosity.  A nil proTxHash searches by type only.
+func searchSpecialTxesCmd(txType int, proTxHash *chainhash.Hash, start, end int32, count, skip, verbosity int) *btcjson.SearchSpecialTxesCmd {
+	var proTxHashStr *string
+	if proTxHash != nil {
+		proTxHashStr = btcjson.String(proTxHash.String())
+	}
+	return btcjson.NewSearchSpecialTxesCmd(&txType, proTxHashStr,
+		btcjson.Int(int(start)), btcjson.Int(int(end)), &count, &skip,
+		&verbosity)
+}
+
+// SearchSpecialTxesAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See SearchSpecialTxes for the blocking version and more details.
+//
+// NOTE: This is a dashd-go extension.
+func (c *Client) SearchSpecialTxesAsync(txType int, proTxHash *chainhash.Hash, start, end int32, count, skip int) FutureGetStringsResult {
+	cmd := searchSpecialTxesCmd(txType, proTxHash, start, end, count,
+		skip, 0)
+	return FutureGetStringsResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// SearchSpecialTxes returns the ids of the special transactions of the passed
+// type in the blocks with a height in the range [start, end] of the main chain,
+// where an end of 0 means there is no upper bound.  When proTxHash is not nil,
+// only the provider transactions referring to the masternode with that
+// proTxHash are returned and a txType of -1 selects all types.
+//
+// NOTE: This is a dashd-go extension and requires the special transaction
+// index.
+func (c *Client) SearchSpecialTxes(txType int, proTxHash *chainhash.Hash, start, end int32, count, skip int) ([]string, error) {
+	return c.SearchSpecialTxesAsync(txType, proTxHash, start, end, count,
+		skip).Receive()
+}
+
+// SearchSpecialTxesVerboseAsync returns an instance of a type that can be used
+// to get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See SearchSpecialTxesVerbose for the blocking version and more details.
+//
+// NOTE: This is a dashd-go extension.
+func (c *Client) SearchSpecialTxesVerboseAsync(txType int, proTxHash *chainhash.Hash, start, end int32, count, skip int) FutureGetSpecialTxesVerboseResult {
+	cmd := searchSpecialTxesCmd(txType, proTxHash, start, end, count,
+		skip, 2)
+	return FutureGetSpecialTxesVerboseResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// SearchSpecialTxesVerbose returns the special transactions selected like
+// SearchSpecialTxes as JSON objects.
+//
+// NOTE: This is a dashd-go extension and requires the special transaction
+// index.
+func (c *Client) SearchSpecialTxesVerbose(txType int, proTxHash *chainhash.Hash, start, end int32, count, skip int) ([]btcjson.TxRawResult, error) {
+	return c.SearchSpecialTxesVerboseAsync(txType, proTxHash, start, end,
+		count, skip).Receive()
+}
+
+// ----------------------------- getblockhashes -----------------------------
+
+// GetBlockHashesAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GetBlockHashesAsync(high, low int64, noOrphans bool) FutureGetStringsResult {
+	cmd := btcjson.NewGetBlockHashesCmd(high, low, &btcjson.GetBlockHashesOptions{
+		NoOrphans: &noOrphans,
+	})
+	return FutureGetStringsResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetBlockHashes returns the hashes of the blocks with a timestamp in the
+// range [low, high).  Blocks which are not part of the main chain are left out
+// when noOrphans is set.  It requires the server to maintain a timestamp index.
+func (c *Client) GetBlockHashes(high, low int64, noOrphans bool) ([]string, error) {
+	return c.GetBlockHashesAsync(high, low, noOrphans).Receive()
+}
+
+// FutureGetBlockHashesLogicalTimesResult is a future promise to deliver the
+// result of a GetBlockHashesLogicalTimesAsync RPC invocation (or an applicable
+// error).
+type FutureGetBlockHashesLogicalTimesResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// block hashes along with their logical timestamps.
+func (r FutureGetBlockHashesLogicalTimesResult) Receive() ([]btcjson.BlockHashLogicalTime, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []btcjson.BlockHashLogicalTime
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetBlockHashesLogicalTimesAsync returns an instance of a type that can be
+// used to get the result of the RPC at some future time by invoking the
+// Receive function on the returned instance.
+func (c *Client) GetBlockHashesLogicalTimesAsync(high, low int64, noOrphans bool) FutureGetBlockHashesLogicalTimesResult {
+	logicalTimes := true
+	cmd := btcjson.NewGetBlockHashesCmd(high, low, &btcjson.GetBlockHashesOptions{
+		NoOrphans:    &noOrphans,
+		LogicalTimes: &logicalTimes,
+	})
+	return FutureGetBlockHashesLogicalTimesResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetBlockHashesLogicalTimes is the same as GetBlockHashes except it also
+// returns the logical timestamp of each block.
+func (c *Client) GetBlockHashesLogicalTimes(high, low int64, noOrphans bool) ([]btcjson.BlockHashLogicalTime, error) {
+	return c.GetBlockHashesLogicalTimesAsync(high, low, noOrphans).Receive()
+}
+
+// ----------------------------- address index -----------------------------
+
+// FutureGetAddressBalanceResult is a future promise to deliver the result of a
+// GetAddressBalanceAsync RPC invocation (or an applicable error).
+type FutureGetAddressBalanceResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// balance of the addresses.
+func (r FutureGetAddressBalanceResult) Receive() (*btcjson.GetAddressBalanceResult, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result btcjson.GetAddressBalanceResult
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetAddressBalanceAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GetAddressBalanceAsync(addresses []string) FutureGetAddressBalanceResult {
+	cmd := btcjson.NewGetAddressBalanceCmd(addresses)
+	return FutureGetAddressBalanceResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetAddressBalance returns the combined balance of the passed addresses.  It
+// requires the server to maintain an address index.
+func (c *Client) GetAddressBalance(addresses []string) (*btcjson.GetAddressBalanceResult, error) {
+	return c.GetAddressBalanceAsync(addresses).Receive()
+}
+
+// FutureGetAddressDeltasResult is a future promise to deliver the result of a
+// GetAddressDeltasAsync RPC invocation (or an applicable error).
+type FutureGetAddressDeltasResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// balance changes of the addresses.
+func (r FutureGetAddressDeltasResult) Receive() ([]btcjson.AddressDelta, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []btcjson.AddressDelta
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAddressDeltasAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GetAddressDeltasAsync(addresses []string, start, end int32) FutureGetAddressDeltasResult {
+	cmd := btcjson.NewGetAddressDeltasCmd(addresses, start, end)
+	return FutureGetAddressDeltasResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetAddressDeltas returns all balance changes of the passed addresses
+// between the start and end heights.  The range is ignored when start or end
+// is zero.  It requires the server to maintain an address index.
+func (c *Client) GetAddressDeltas(addresses []string, start, end int32) ([]btcjson.AddressDelta, error) {
+	return c.GetAddressDeltasAsync(addresses, start, end).Receive()
+}
+
+// FutureGetAddressUtxosResult is a future promise to deliver the result of a
+// GetAddressUtxosAsync RPC invocation (or an applicable error).
+type FutureGetAddressUtxosResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// unspent outputs of the addresses.
+func (r FutureGetAddressUtxosResult) Receive() ([]btcjson.AddressUtxo, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []btcjson.AddressUtxo
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAddressUtxosAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GetAddressUtxosAsync(addresses []string) FutureGetAddressUtxosResult {
+	cmd := btcjson.NewGetAddressUtxosCmd(addresses)
+	return FutureGetAddressUtxosResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetAddressUtxos returns the unspent outputs of the passed addresses.  It
+// requires the server to maintain an address index.
+func (c *Client) GetAddressUtxos(addresses []string) ([]btcjson.AddressUtxo, error) {
+	return c.GetAddressUtxosAsync(addresses).Receive()
+}
+
+// GetAddressTxIDsAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GetAddressTxIDsAsync(addresses []string, start, end int32) FutureGetStringsResult {
+	cmd := btcjson.NewGetAddressTxIDsCmd(addresses, start, end)
+	return FutureGetStringsResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetAddressTxIDs returns the ids of the transactions involving the passed
+// addresses between the start and end heights.  The range is ignored when
+// start or end is zero.  It requires the server to maintain an address index.
+func (c *Client) GetAddressTxIDs(addresses []string, start, end int32) ([]string, error) {
+	return c.GetAddressTxIDsAsync(addresses, start, end).Receive()
+}
+
+// FutureGetSpentInfoResult is a future promise to deliver the result of a
+// GetSpentInfoAsync RPC invocation (or an applicable error).
+type FutureGetSpentInfoResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the input
+// spending the output.
+func (r FutureGetSpentInfoResult) Receive() (*btcjson.GetSpentInfoResult, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result btcjson.GetSpentInfoResult
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetSpentInfoAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GetSpentInfoAsync(txHash *chainhash.Hash, index uint32) FutureGetSpentInfoResult {
+	cmd := btcjson.NewGetSpentInfoCmd(txHash.String(), index)
+	return FutureGetSpentInfoResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetSpentInfo returns the input spending the passed output.  It requires the
+// server to maintain a spent index.
+func (c *Client) GetSpentInfo(txHash *chainhash.Hash, index uint32) (*btcjson.GetSpentInfoResult, error) {
+	return c.GetSpentInfoAsync(txHash, index).Receive()
+}
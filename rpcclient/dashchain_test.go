@@ -0,0 +1,205 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcclient
+
+import (
+	"testing"
+
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+func TestGetBestChainLock(t *testing.T) {
+	client, err := New(connCfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Shutdown()
+
+	want := btcjson.GetBestChainLockResult{
+		BlockHash:  "000000000000001c172f518793c3b9e83f202284615592f87fe3506ce964dcd4",
+		Height:     1950000,
+		Signature:  "00",
+		KnownBlock: true,
+	}
+	client.httpClient.Transport = mockRoundTripperFunc(
+		want,
+		expectBody(`{"jsonrpc":"1.0","method":"getbestchainlock","params":[],"id":1}`),
+	)
+	result, err := client.GetBestChainLock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *result != want {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	var cli btcjson.GetBestChainLockResult
+	compareWithCliCommand(t, result, &cli, "getbestchainlock")
+}
+
+func TestGetISLocks(t *testing.T) {
+	client, err := New(connCfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Shutdown()
+
+	locked := "d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357"
+	unlocked := "4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39"
+	client.httpClient.Transport = mockRoundTripperFunc(
+		[]interface{}{
+			btcjson.ISLockResult{TxID: locked, Signature: "00"},
+			"None",
+		},
+		expectBody(`{"jsonrpc":"1.0","method":"getislocks","params":[["`+locked+`","`+unlocked+`"]],"id":1}`),
+	)
+	result, err := client.GetISLocks([]string{locked, unlocked})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 2 || result[0] == nil || result[0].TxID != locked ||
+		result[1] != nil {
+
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
+
+func TestSporkActive(t *testing.T) {
+	client, err := New(connCfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Shutdown()
+
+	client.httpClient.Transport = mockRoundTripperFunc(
+		map[string]bool{"SPORK_2_INSTANTSEND_ENABLED": true},
+		expectBody(`{"jsonrpc":"1.0","method":"spork","params":["active"],"id":1}`),
+	)
+	result, err := client.SporkActive()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result["SPORK_2_INSTANTSEND_ENABLED"] {
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
+
+func TestGetSpecialTxes(t *testing.T) {
+	client, err := New(connCfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Shutdown()
+
+	blockHash, err := chainhash.NewHashFromStr("000000000000001c172f518793c3b9e83f202284615592f87fe3506ce964dcd4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	txID := "d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357"
+	client.httpClient.Transport = mockRoundTripperFunc(
+		[]string{txID},
+		expectBody(`{"jsonrpc":"1.0","method":"getspecialtxes","params":["`+blockHash.String()+`",5,10,0,0],"id":1}`),
+	)
+	result, err := client.GetSpecialTxes(blockHash, 5, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 1 || result[0] != txID {
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
+
+func TestSearchSpecialTxes(t *testing.T) {
+	client, err := New(connCfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Shutdown()
+
+	proTxHash, err := chainhash.NewHashFromStr("d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357")
+	if err != nil {
+		t.Fatal(err)
+	}
+	txID := "4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39"
+	client.httpClient.Transport = mockRoundTripperFunc(
+		[]string{txID},
+		expectBody(`{"jsonrpc":"1.0","method":"searchspecialtxes","params":[-1,"`+proTxHash.String()+`",1000,2000,10,0,0],"id":1}`),
+	)
+	result, err := client.SearchSpecialTxes(-1, proTxHash, 1000, 2000, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 1 || result[0] != txID {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	client.httpClient.Transport = mockRoundTripperFunc(
+		[]string{txID},
+		expectBody(`{"jsonrpc":"1.0","method":"searchspecialtxes","params":[1,null,0,0,10,5,0],"id":2}`),
+	)
+	result, err = client.SearchSpecialTxes(1, nil, 0, 0, 10, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 1 || result[0] != txID {
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
+
+func TestGetAddressBalance(t *testing.T) {
+	client, err := New(connCfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Shutdown()
+
+	address := "XwnLY9Tf7Zsef8gMGL2fhWA9ZmMjt4KPwg"
+	want := btcjson.GetAddressBalanceResult{
+		Balance:          150000000,
+		BalanceSpendable: 150000000,
+		Received:         250000000,
+	}
+	client.httpClient.Transport = mockRoundTripperFunc(
+		want,
+		expectBody(`{"jsonrpc":"1.0","method":"getaddressbalance","params":[{"addresses":["`+address+`"]}],"id":1}`),
+	)
+	result, err := client.GetAddressBalance([]string{address})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *result != want {
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
+
+func TestGetSpentInfo(t *testing.T) {
+	client, err := New(connCfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Shutdown()
+
+	txHash, err := chainhash.NewHashFromStr("d1be3a1aa0b9516d06ed180607c168724c21d8ccf6c5a3f5983769830724c357")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := btcjson.GetSpentInfoResult{
+		TxID:   "4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39",
+		Index:  2,
+		Height: 1950010,
+	}
+	client.httpClient.Transport = mockRoundTripperFunc(
+		want,
+		expectBody(`{"jsonrpc":"1.0","method":"getspentinfo","params":[{"txid":"`+txHash.String()+`","index":1}],"id":1}`),
+	)
+	result, err := client.GetSpentInfo(txHash, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *result != want {
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
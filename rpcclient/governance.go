@@ -0,0 +1,406 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcclient
+
+import (
+	"encoding/json"
+
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/btcutil"
+)
+
+// ----------------------------- gobject count -----------------------------
+
+// FutureGetGObjectCountResult is a future promise to deliver the result of a
+// GObjectCountAsync RPC invocation (or an applicable error).
+type FutureGetGObjectCountResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// number of governance objects and votes.
+func (r FutureGetGObjectCountResult) Receive() (*btcjson.GObjectCountResult, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result btcjson.GObjectCountResult
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GObjectCountAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GObjectCountAsync() FutureGetGObjectCountResult {
+	cmd := btcjson.NewGObjectCountCmd(btcjson.GObjectCountModeJSON)
+	return FutureGetGObjectCountResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GObjectCount returns the number of governance objects and votes.
+func (c *Client) GObjectCount() (*btcjson.GObjectCountResult, error) {
+	return c.GObjectCountAsync().Receive()
+}
+
+// ----------------------------- gobject list -----------------------------
+
+// FutureGetGObjectListResult is a future promise to deliver the result of a
+// GObjectListAsync RPC invocation (or an applicable error).
+type FutureGetGObjectListResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// governance objects keyed by their hash.
+func (r FutureGetGObjectListResult) Receive() (map[string]btcjson.GObjectListResult, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]btcjson.GObjectListResult
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GObjectListAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GObjectListAsync(signal btcjson.GObjectSignal, listType btcjson.GObjectListType) FutureGetGObjectListResult {
+	cmd := btcjson.NewGObjectListCmd(signal, listType)
+	return FutureGetGObjectListResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GObjectList returns the governance objects with the passed signal and type
+// keyed by their hash.  Empty values select the defaults of the server, which
+// are all valid objects of any type.
+func (c *Client) GObjectList(signal btcjson.GObjectSignal, listType btcjson.GObjectListType) (map[string]btcjson.GObjectListResult, error) {
+	return c.GObjectListAsync(signal, listType).Receive()
+}
+
+// ----------------------------- gobject get -----------------------------
+
+// FutureGetGObjectGetResult is a future promise to deliver the result of a
+// GObjectGetAsync RPC invocation (or an applicable error).
+type FutureGetGObjectGetResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// governance object.
+func (r FutureGetGObjectGetResult) Receive() (*btcjson.GObjectGetResult, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result btcjson.GObjectGetResult
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GObjectGetAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GObjectGetAsync(governanceHash string) FutureGetGObjectGetResult {
+	cmd := btcjson.NewGObjectGetCmd(governanceHash)
+	return FutureGetGObjectGetResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GObjectGet returns the governance object with the passed hash.
+func (c *Client) GObjectGet(governanceHash string) (*btcjson.GObjectGetResult, error) {
+	return c.GObjectGetAsync(governanceHash).Receive()
+}
+
+// ----------------------------- gobject getcurrentvotes -----------------------------
+
+// FutureGetGObjectCurrentVotesResult is a future promise to deliver the result
+// of a GObjectGetCurrentVotesAsync RPC invocation (or an applicable error).
+type FutureGetGObjectCurrentVotesResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the votes
+// keyed by their hash.  Every vote is formatted by the server as
+// "outpoint:time:outcome:signal".
+func (r FutureGetGObjectCurrentVotesResult) Receive() (map[string]string, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]string
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GObjectGetCurrentVotesAsync returns an instance of a type that can be used
+// to get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+func (c *Client) GObjectGetCurrentVotesAsync(governanceHash, txID string, vout int) FutureGetGObjectCurrentVotesResult {
+	cmd := btcjson.NewGObjectGetCurrentVotesCmd(governanceHash, txID, vout)
+	return FutureGetGObjectCurrentVotesResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GObjectGetCurrentVotes returns the current votes of the governance object
+// with the passed hash.  Unless txID is empty, only the votes of the
+// masternode with the collateral outpoint txID:vout are returned.
+func (c *Client) GObjectGetCurrentVotes(governanceHash, txID string, vout int) (map[string]string, error) {
+	return c.GObjectGetCurrentVotesAsync(governanceHash, txID, vout).Receive()
+}
+
+// ----------------------------- gobject prepare -----------------------------
+
+// FutureGetGObjectStringResult is a future promise to deliver the result of a
+// gobject RPC invocation which returns a string (or an applicable error).
+type FutureGetGObjectStringResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the hash.
+func (r FutureGetGObjectStringResult) Receive() (string, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return "", err
+	}
+
+	var result string
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+// GObjectPrepareAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GObjectPrepareAsync(parentHash string, revision int, time int64, dataHex, outputHash string, outputIndex int) FutureGetGObjectStringResult {
+	cmd := btcjson.NewGObjectPrepareCmd(parentHash, revision, time, dataHex, outputHash, outputIndex)
+	return FutureGetGObjectStringResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GObjectPrepare creates the collateral transaction of a governance object
+// with the wallet of the server and returns its id.  The collateral spends the
+// output outputHash:outputIndex unless outputHash is empty.
+func (c *Client) GObjectPrepare(parentHash string, revision int, time int64, dataHex, outputHash string, outputIndex int) (string, error) {
+	return c.GObjectPrepareAsync(parentHash, revision, time, dataHex, outputHash, outputIndex).Receive()
+}
+
+// ----------------------------- gobject submit -----------------------------
+
+// GObjectSubmitAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GObjectSubmitAsync(parentHash string, revision int, time int64, dataHex, feeTxID string) FutureGetGObjectStringResult {
+	cmd := btcjson.NewGObjectSubmitCmd(parentHash, revision, time, dataHex, feeTxID)
+	return FutureGetGObjectStringResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GObjectSubmit submits a governance object whose collateral transaction was
+// created by GObjectPrepare and returns the hash of the object.
+func (c *Client) GObjectSubmit(parentHash string, revision int, time int64, dataHex, feeTxID string) (string, error) {
+	return c.GObjectSubmitAsync(parentHash, revision, time, dataHex, feeTxID).Receive()
+}
+
+// ----------------------------- gobject vote-many -----------------------------
+
+// FutureGetGObjectVoteResult is a future promise to deliver the result of a
+// GObjectVoteManyAsync or GObjectVoteAliasAsync RPC invocation (or an
+// applicable error).
+type FutureGetGObjectVoteResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// outcome of the votes.
+func (r FutureGetGObjectVoteResult) Receive() (*btcjson.GObjectVoteResult, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result btcjson.GObjectVoteResult
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GObjectVoteManyAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GObjectVoteManyAsync(governanceHash string, signal btcjson.GObjectSignal, outcome btcjson.GObjectOutcome) FutureGetGObjectVoteResult {
+	cmd := btcjson.NewGObjectVoteManyCmd(governanceHash, signal, outcome)
+	return FutureGetGObjectVoteResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GObjectVoteMany votes on the governance object with the passed hash with all
+// masternodes whose voting key is in the wallet of the server.
+func (c *Client) GObjectVoteMany(governanceHash string, signal btcjson.GObjectSignal, outcome btcjson.GObjectOutcome) (*btcjson.GObjectVoteResult, error) {
+	return c.GObjectVoteManyAsync(governanceHash, signal, outcome).Receive()
+}
+
+// ----------------------------- gobject vote-alias -----------------------------
+
+// GObjectVoteAliasAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GObjectVoteAliasAsync(governanceHash string, signal btcjson.GObjectSignal, outcome btcjson.GObjectOutcome, proTxHash string) FutureGetGObjectVoteResult {
+	cmd := btcjson.NewGObjectVoteAliasCmd(governanceHash, signal, outcome, proTxHash)
+	return FutureGetGObjectVoteResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GObjectVoteAlias votes on the governance object with the passed hash with
+// the masternode with the passed proTxHash, whose voting key must be in the
+// wallet of the server.
+func (c *Client) GObjectVoteAlias(governanceHash string, signal btcjson.GObjectSignal, outcome btcjson.GObjectOutcome, proTxHash string) (*btcjson.GObjectVoteResult, error) {
+	return c.GObjectVoteAliasAsync(governanceHash, signal, outcome, proTxHash).Receive()
+}
+
+// ----------------------------- gobject check -----------------------------
+
+// FutureGetGObjectCheckResult is a future promise to deliver the result of a
+// GObjectCheckAsync RPC invocation (or an applicable error).
+type FutureGetGObjectCheckResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the status
+// of the governance object.
+func (r FutureGetGObjectCheckResult) Receive() (*btcjson.GObjectCheckResult, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result btcjson.GObjectCheckResult
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GObjectCheckAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GObjectCheckAsync(dataHex string) FutureGetGObjectCheckResult {
+	cmd := btcjson.NewGObjectCheckCmd(dataHex)
+	return FutureGetGObjectCheckResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GObjectCheck validates the hex encoded data of a governance object.
+func (c *Client) GObjectCheck(dataHex string) (*btcjson.GObjectCheckResult, error) {
+	return c.GObjectCheckAsync(dataHex).Receive()
+}
+
+// ----------------------------- getgovernanceinfo -----------------------------
+
+// FutureGetGovernanceInfoResult is a future promise to deliver the result of a
+// GetGovernanceInfoAsync RPC invocation (or an applicable error).
+type FutureGetGovernanceInfoResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the
+// governance parameters and the superblocks.
+func (r FutureGetGovernanceInfoResult) Receive() (*btcjson.GetGovernanceInfoResult, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result btcjson.GetGovernanceInfoResult
+	err = json.Unmarshal(res, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetGovernanceInfoAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+func (c *Client) GetGovernanceInfoAsync() FutureGetGovernanceInfoResult {
+	cmd := btcjson.NewGetGovernanceInfoCmd()
+	return FutureGetGovernanceInfoResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetGovernanceInfo returns the governance parameters and the heights of the
+// last and next superblocks.
+func (c *Client) GetGovernanceInfo() (*btcjson.GetGovernanceInfoResult, error) {
+	return c.GetGovernanceInfoAsync().Receive()
+}
+
+// ----------------------------- getsuperblockbudget -----------------------------
+
+// FutureGetSuperblockBudgetResult is a future promise to deliver the result of
+// a GetSuperblockBudgetAsync RPC invocation (or an applicable error).
+type FutureGetSuperblockBudgetResult struct {
+	client   *Client
+	Response chan *Response
+}
+
+// Receive waits for the response promised by the future and returns the budget
+// of the superblock.
+func (r FutureGetSuperblockBudgetResult) Receive() (btcutil.Amount, error) {
+	res, err := ReceiveFuture(r.Response)
+	if err != nil {
+		return 0, err
+	}
+
+	var budget float64
+	err = json.Unmarshal(res, &budget)
+	if err != nil {
+		return 0, err
+	}
+
+	return btcutil.NewAmount(budget)
+}
+
+// GetSuperblockBudgetAsync returns an instance of a type that can be used to
+// get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+func (c *Client) GetSuperblockBudgetAsync(index int) FutureGetSuperblockBudgetResult {
+	cmd := btcjson.NewGetSuperblockBudgetCmd(index)
+	return FutureGetSuperblockBudgetResult{client: c, Response: c.SendCmd(cmd)}
+}
+
+// GetSuperblockBudget returns the maximum amount the superblock at the passed
+// height may pay to governance proposals.
+func (c *Client) GetSuperblockBudget(index int) (btcutil.Amount, error) {
+	return c.GetSuperblockBudgetAsync(index).Receive()
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcclient
+
+import (
+	"testing"
+
+	"github.com/dashpay/dashd-go/btcjson"
+)
+
+func TestGObjectCount(t *testing.T) {
+	client, err := New(connCfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Shutdown()
+
+	client.httpClient.Transport = mockRoundTripperFunc(
+		btcjson.GObjectCountResult{ObjectsTotal: 3, Proposals: 2, Triggers: 1},
+		expectBody(`{"jsonrpc":"1.0","method":"gobject","params":["count","json"],"id":1}`),
+	)
+	result, err := client.GObjectCount()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ObjectsTotal != 3 || result.Proposals != 2 || result.Triggers != 1 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	var cli btcjson.GObjectCountResult
+	compareWithCliCommand(t, result, &cli, "gobject", "count")
+}
+
+func TestGObjectList(t *testing.T) {
+	client, err := New(connCfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Shutdown()
+
+	hash := "4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39"
+	client.httpClient.Transport = mockRoundTripperFunc(
+		map[string]btcjson.GObjectListResult{
+			hash: {Hash: hash, ObjectType: btcjson.GObjectTypeProposal},
+		},
+		expectBody(`{"jsonrpc":"1.0","method":"gobject","params":["list","funding","proposals"],"id":1}`),
+	)
+	result, err := client.GObjectList(btcjson.GObjectSignalFunding, btcjson.GObjectListTypeProposals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result[hash].ObjectType != btcjson.GObjectTypeProposal {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	var cli map[string]btcjson.GObjectListResult
+	compareWithCliCommand(t, &result, &cli, "gobject", "list", "funding", "proposals")
+}
+
+func TestGObjectVoteAlias(t *testing.T) {
+	client, err := New(connCfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Shutdown()
+
+	hash := "4ef5b0f4e7e2b6c8a06c6fa1e0bd0c1cdd07e3ae36c6e4d0b9b3e7f5b1b8ce39"
+	proTxHash := "04d06d16b3eca2f104ef9749d0c1c17d183eb1b4fe3a16808fd70464f03bcd63"
+	client.httpClient.Transport = mockRoundTripperFunc(
+		btcjson.GObjectVoteResult{
+			Overall: "Voted successfully 1 time(s) and failed 0 time(s).",
+			Detail: map[string]btcjson.GObjectVoteDetail{
+				proTxHash: {Result: "success"},
+			},
+		},
+		expectBody(`{"jsonrpc":"1.0","method":"gobject","params":["vote-alias","`+hash+`","funding","yes","`+proTxHash+`"],"id":1}`),
+	)
+	result, err := client.GObjectVoteAlias(hash, btcjson.GObjectSignalFunding, btcjson.GObjectOutcomeYes, proTxHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Detail[proTxHash].Result != "success" {
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
+
+func TestGetSuperblockBudget(t *testing.T) {
+	client, err := New(connCfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Shutdown()
+
+	client.httpClient.Transport = mockRoundTripperFunc(
+		6009.0816552,
+		expectBody(`{"jsonrpc":"1.0","method":"getsuperblockbudget","params":[1978040],"id":1}`),
+	)
+	result, err := client.GetSuperblockBudget(1978040)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != 600908165520 {
+		t.Fatalf("unexpected budget %v", result)
+	}
+}
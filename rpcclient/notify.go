@@ -121,6 +121,12 @@ type NotificationHandlers struct {
 	// OnBlockDisconnected: it receives the block's height and header.
 	OnFilteredBlockDisconnected func(height int32, header *wire.BlockHeader)
 
+	// OnChainLock is invoked when a block of the longest (best) chain is
+	// locked by a ChainLock, which makes it and all of its ancestors final.
+	// It will only be invoked if a preceding call to NotifyBlocks has been
+	// made to register for the notification and the function is non-nil.
+	OnChainLock func(clsig *wire.MsgCLSig)
+
 	// OnRecvTx is invoked when a transaction that receives funds to a
 	// registered address is received into the memory pool and also
 	// connected to the longest (best) chain.  It will only be invoked if a
@@ -179,6 +185,12 @@ type NotificationHandlers struct {
 	// made to register for the notification and the function is non-nil.
 	OnTxAcceptedVerbose func(txDetails *btcjson.TxRawResult)
 
+	// OnTxInstantLocked is invoked when a transaction is locked by an
+	// InstantSend lock, which makes it final before it is mined.  It will
+	// only be invoked if a preceding call to NotifyNewTransactions has been
+	// made to register for the notification and the function is non-nil.
+	OnTxInstantLocked func(hash *chainhash.Hash, islock *wire.MsgISDLock)
+
 	// OnBtcdConnected is invoked when a wallet connects or disconnects from
 	// btcd.
 	//
@@ -290,6 +302,23 @@ func (c *Client) handleNotification(ntfn *rawNotification) {
 		c.ntfnHandlers.OnFilteredBlockDisconnected(blockHeight,
 			blockHeader)
 
+	// OnChainLock
+	case btcjson.ChainLockNtfnMethod:
+		// Ignore the notification if the client is not interested in
+		// it.
+		if c.ntfnHandlers.OnChainLock == nil {
+			return
+		}
+
+		clsig, err := parseChainLockParams(ntfn.Params)
+		if err != nil {
+			log.Warnf("Received invalid chain lock notification: %v",
+				err)
+			return
+		}
+
+		c.ntfnHandlers.OnChainLock(clsig)
+
 	// OnRecvTx
 	case btcjson.RecvTxNtfnMethod:
 		// Ignore the notification if the client is not interested in
@@ -409,6 +438,23 @@ func (c *Client) handleNotification(ntfn *rawNotification) {
 
 		c.ntfnHandlers.OnTxAcceptedVerbose(rawTx)
 
+	// OnTxInstantLocked
+	case btcjson.TxInstantLockedNtfnMethod:
+		// Ignore the notification if the client is not interested in
+		// it.
+		if c.ntfnHandlers.OnTxInstantLocked == nil {
+			return
+		}
+
+		hash, islock, err := parseTxInstantLockedParams(ntfn.Params)
+		if err != nil {
+			log.Warnf("Received invalid tx instant locked "+
+				"notification: %v", err)
+			return
+		}
+
+		c.ntfnHandlers.OnTxInstantLocked(hash, islock)
+
 	// OnBtcdConnected
 	case btcjson.BtcdConnectedNtfnMethod:
 		// Ignore the notification if the client is not interested in
@@ -615,6 +661,91 @@ func parseFilteredBlockDisconnectedParams(params []json.RawMessage) (int32,
 	return blockHeight, &blockHeader, nil
 }
 
+// parseChainLockParams parses out the ChainLock from the parameters of a
+// chainlock notification.
+func parseChainLockParams(params []json.RawMessage) (*wire.MsgCLSig, error) {
+	if len(params) != 3 {
+		return nil, wrongNumParams(len(params))
+	}
+
+	// Unmarshal first parameter as an integer.
+	var height int32
+	err := json.Unmarshal(params[0], &height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal second parameter as a string.
+	var blockHashStr string
+	err = json.Unmarshal(params[1], &blockHashStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create hash from block hash string.
+	blockHash, err := chainhash.NewHashFromStr(blockHashStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal third parameter as a slice of bytes.
+	sigBytes, err := parseHexParam(params[2])
+	if err != nil {
+		return nil, err
+	}
+	var sig [96]byte
+	if len(sigBytes) != len(sig) {
+		return nil, fmt.Errorf("invalid chain lock signature length %d",
+			len(sigBytes))
+	}
+	copy(sig[:], sigBytes)
+
+	return wire.NewMsgCLSig(height, blockHash, sig), nil
+}
+
+// parseTxInstantLockedParams parses out the transaction hash and InstantSend
+// lock from the parameters of a txinstantlocked notification.
+func parseTxInstantLockedParams(params []json.RawMessage) (*chainhash.Hash,
+	*wire.MsgISDLock, error) {
+
+	if len(params) != 2 {
+		return nil, nil, wrongNumParams(len(params))
+	}
+
+	// Unmarshal first parameter as a string.
+	var txHashStr string
+	err := json.Unmarshal(params[0], &txHashStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Create hash from transaction hash string.
+	txHash, err := chainhash.NewHashFromStr(txHashStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Unmarshal second parameter as a slice of bytes.
+	islockBytes, err := parseHexParam(params[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Deserialize the InstantSend lock from the slice of bytes.
+	var islock wire.MsgISDLock
+	err = islock.BtcDecode(bytes.NewReader(islockBytes),
+		wire.ProtocolVersion, wire.BaseEncoding)
+	if err != nil {
+		return nil, nil, err
+	}
+	if islock.TxID != *txHash {
+		return nil, nil, fmt.Errorf("InstantSend lock for transaction "+
+			"%v does not lock transaction %v", islock.TxID, txHash)
+	}
+
+	return txHash, &islock, nil
+}
+
 func parseHexParam(param json.RawMessage) ([]byte, error) {
 	var s string
 	err := json.Unmarshal(param, &s)
@@ -895,7 +1026,7 @@ func (c *Client) NotifyBlocksAsync() FutureNotifyBlocksResult {
 // result in an error if the client is configured to run in HTTP POST mode.
 //
 // The notifications delivered as a result of this call will be via one of
-// OnBlockConnected or OnBlockDisconnected.
+// OnBlockConnected, OnBlockDisconnected or OnChainLock.
 //
 // NOTE: This is a btcd extension and requires a websocket connection.
 func (c *Client) NotifyBlocks() error {
@@ -1030,7 +1161,7 @@ func (c *Client) NotifyNewTransactionsAsync(verbose bool) FutureNotifyNewTransac
 //
 // The notifications delivered as a result of this call will be via one of
 // OnTxAccepted (when verbose is false) or OnTxAcceptedVerbose (when verbose is
-// true).
+// true), and via OnTxInstantLocked when a transaction is InstantSend locked.
 //
 // NOTE: This is a btcd extension and requires a websocket connection.
 func (c *Client) NotifyNewTransactions(verbose bool) error {
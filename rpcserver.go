@@ -6,6 +6,7 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"crypto/sha256"
 	"crypto/subtle"
@@ -16,11 +17,13 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"math/big"
 	"math/rand"
 	"net"
 	"net/http"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -36,12 +39,15 @@ import (
 	"github.com/dashpay/dashd-go/chaincfg"
 	"github.com/dashpay/dashd-go/chaincfg/chainhash"
 	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/masternodelist"
 	"github.com/dashpay/dashd-go/mempool"
 	"github.com/dashpay/dashd-go/mining"
 	"github.com/dashpay/dashd-go/mining/cpuminer"
 	"github.com/dashpay/dashd-go/peer"
+	"github.com/dashpay/dashd-go/spork"
 	"github.com/dashpay/dashd-go/txscript"
 	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
 )
 
 // API version constants
@@ -135,15 +141,21 @@ var rpcHandlersBeforeInit = map[string]commandHandler{
 	"debuglevel":             handleDebugLevel,
 	"decoderawtransaction":   handleDecodeRawTransaction,
 	"decodescript":           handleDecodeScript,
+	"dumptxoutset":           handleDumpTxOutSet,
 	"estimatefee":            handleEstimateFee,
 	"generate":               handleGenerate,
 	"getaddednodeinfo":       handleGetAddedNodeInfo,
+	"getaddressbalance":      handleGetAddressBalance,
+	"getaddressdeltas":       handleGetAddressDeltas,
+	"getaddresstxids":        handleGetAddressTxIDs,
+	"getaddressutxos":        handleGetAddressUtxos,
 	"getbestblock":           handleGetBestBlock,
 	"getbestblockhash":       handleGetBestBlockHash,
 	"getblock":               handleGetBlock,
 	"getblockchaininfo":      handleGetBlockChainInfo,
 	"getblockcount":          handleGetBlockCount,
 	"getblockhash":           handleGetBlockHash,
+	"getblockhashes":         handleGetBlockHashes,
 	"getblockheader":         handleGetBlockHeader,
 	"getblocktemplate":       handleGetBlockTemplate,
 	"getcfilter":             handleGetCFilter,
@@ -164,14 +176,20 @@ var rpcHandlersBeforeInit = map[string]commandHandler{
 	"getpeerinfo":            handleGetPeerInfo,
 	"getrawmempool":          handleGetRawMempool,
 	"getrawtransaction":      handleGetRawTransaction,
+	"getspecialtxes":         handleGetSpecialTxes,
+	"getspentinfo":           handleGetSpentInfo,
 	"gettxout":               handleGetTxOut,
+	"gettxoutsetinfo":        handleGetTxOutSetInfo,
 	"help":                   handleHelp,
 	"node":                   handleNode,
 	"ping":                   handlePing,
+	"protx":                  handleProTx,
 	"searchrawtransactions":  handleSearchRawTransactions,
+	"searchspecialtxes":      handleSearchSpecialTxes,
 	"sendrawtransaction":     handleSendRawTransaction,
 	"setgenerate":            handleSetGenerate,
 	"signmessagewithprivkey": handleSignMessageWithPrivKey,
+	"spork":                  handleSpork,
 	"stop":                   handleStop,
 	"submitblock":            handleSubmitBlock,
 	"uptime":                 handleUptime,
@@ -201,7 +219,6 @@ var rpcAskWallet = map[string]struct{}{
 	"getreceivedbyaccount":   {},
 	"getreceivedbyaddress":   {},
 	"gettransaction":         {},
-	"gettxoutsetinfo":        {},
 	"getunconfirmedbalance":  {},
 	"getwalletinfo":          {},
 	"importprivkey":          {},
@@ -329,6 +346,14 @@ func rpcNoTxInfoError(txHash *chainhash.Hash) *btcjson.RPCError {
 			txHash))
 }
 
+// rpcNoIndexError is a convenience function for returning a nicely formatted
+// RPC error which indicates the optional index enabled by the provided option
+// is required by the command.
+func rpcNoIndexError(index, option string) *btcjson.RPCError {
+	return btcjson.NewRPCError(btcjson.ErrRPCMisc,
+		fmt.Sprintf("%s must be enabled (--%s)", index, option))
+}
+
 // gbtWorkState houses state that is used in between multiple RPC invocations to
 // getblocktemplate.
 type gbtWorkState struct {
@@ -776,9 +801,52 @@ func createTxRawResult(chainParams *chaincfg.Params, mtx *wire.MsgTx,
 		txReply.Confirmations = uint64(1 + chainHeight - blkHeight)
 	}
 
+	if mtx.IsSpecial() {
+		txReply.Type = uint16(mtx.Type)
+		txReply.ExtraPayloadSize = len(mtx.ExtraPayload)
+		txReply.ExtraPayload = hex.EncodeToString(mtx.ExtraPayload)
+		txReply.CbTx = createCbTxResult(mtx)
+	}
+
 	return txReply, nil
 }
 
+// createCbTxResult decodes the payload of the passed coinbase special
+// transaction into a result suitable for the cbTx field of the getblock and
+// getrawtransaction commands.  It returns nil when the transaction is not a
+// coinbase special transaction or its payload can't be decoded.
+func createCbTxResult(mtx *wire.MsgTx) *btcjson.CbTxResult {
+	if !mtx.IsSpecial() || mtx.Type != wire.TxTypeCoinbase {
+		return nil
+	}
+	payload, err := evo.DecodeTxPayload(mtx)
+	if err != nil {
+		return nil
+	}
+	cbTx, ok := payload.(*evo.CbTx)
+	if !ok {
+		return nil
+	}
+
+	result := &btcjson.CbTxResult{
+		Version:          cbTx.Version,
+		Height:           cbTx.Height,
+		MerkleRootMNList: cbTx.MerkleRootMNList.String(),
+	}
+	if cbTx.Version >= evo.CbTxVersionMerkleRootQuorums {
+		result.MerkleRootQuorums = cbTx.MerkleRootQuorums.String()
+	}
+	if cbTx.Version >= evo.CbTxVersionCLSigAndBalance {
+		diff := cbTx.BestCLHeightDiff
+		balance := btcutil.Amount(cbTx.CreditPoolBalance).ToBTC()
+		result.BestCLHeightDiff = &diff
+		result.BestCLSignature = hex.EncodeToString(cbTx.BestCLSignature[:])
+		result.CreditPoolBalance = &balance
+	}
+
+	return result
+}
+
 // handleDecodeRawTransaction handles decoderawtransaction commands.
 func handleDecodeRawTransaction(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	c := cmd.(*btcjson.DecodeRawTransactionCmd)
@@ -860,6 +928,53 @@ func handleDecodeScript(s *rpcServer, cmd interface{}, closeChan <-chan struct{}
 	return reply, nil
 }
 
+// handleDumpTxOutSet implements the dumptxoutset command.  The snapshot is
+// written to a temporary file first, so an existing file is never overwritten
+// and no partial snapshot is left behind at the requested path.
+func handleDumpTxOutSet(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.DumpTxOutSetCmd)
+
+	path := cleanAndExpandPath(c.Path)
+	if _, err := os.Stat(path); err == nil {
+		return nil, &btcjson.RPCError{
+			Code: btcjson.ErrRPCInvalidParameter,
+			Message: fmt.Sprintf("%s already exists -- move it out "+
+				"of the way first", path),
+		}
+	}
+
+	tmpPath := path + ".incomplete"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return nil, internalRPCError(err.Error(), "Unable to create file")
+	}
+	w := bufio.NewWriter(f)
+	info, err := s.cfg.Chain.DumpUtxoSnapshot(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		context := "Unable to write utxo set snapshot"
+		return nil, internalRPCError(err.Error(), context)
+	}
+
+	return &btcjson.DumpTxOutSetResult{
+		CoinsWritten: info.NumUtxos,
+		BaseHash:     info.BaseHash.String(),
+		BaseHeight:   info.BaseHeight,
+		Path:         path,
+		TxOutSetHash: info.UtxoSetHash.String(),
+		NChainTx:     info.ChainTxCount,
+	}, nil
+}
+
 // handleEstimateFee handles estimatefee commands.
 func handleEstimateFee(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	c := cmd.(*btcjson.EstimateFeeCmd)
@@ -1026,6 +1141,181 @@ func handleGetAddedNodeInfo(s *rpcServer, cmd interface{}, closeChan <-chan stru
 	return results, nil
 }
 
+// decodeIndexAddresses decodes the addresses passed to the address index
+// commands.
+func decodeIndexAddresses(s *rpcServer, addresses []string) ([]btcutil.Address, error) {
+	addrs := make([]btcutil.Address, 0, len(addresses))
+	for _, address := range addresses {
+		addr, err := btcutil.DecodeAddress(address, s.cfg.ChainParams)
+		if err != nil || !addr.IsForNet(s.cfg.ChainParams) {
+			return nil, &btcjson.RPCError{
+				Code:    btcjson.ErrRPCInvalidAddressOrKey,
+				Message: "Invalid address: " + address,
+			}
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// fetchAddressDeltas returns the balance changes of the passed addresses in
+// the range of heights requested by the address index commands.  The range is
+// ignored when start or end is zero.
+func fetchAddressDeltas(s *rpcServer, param *btcjson.AddressRangeParam) ([][]indexers.AddrDelta, error) {
+	if s.cfg.AddrDeltaIndex == nil {
+		return nil, rpcNoIndexError("Address index", "addressindex")
+	}
+
+	start, end := param.Start, param.End
+	if start <= 0 || end <= 0 {
+		start, end = 0, 0
+	} else if end < start {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "End value is expected to be greater than start",
+		}
+	}
+
+	addrs, err := decodeIndexAddresses(s, param.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	deltas := make([][]indexers.AddrDelta, 0, len(addrs))
+	for _, addr := range addrs {
+		addrDeltas, err := s.cfg.AddrDeltaIndex.AddrDeltas(addr, start, end)
+		if err != nil {
+			context := "Failed to fetch address deltas"
+			return nil, internalRPCError(err.Error(), context)
+		}
+		deltas = append(deltas, addrDeltas)
+	}
+	return deltas, nil
+}
+
+// handleGetAddressBalance implements the getaddressbalance command.
+func handleGetAddressBalance(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetAddressBalanceCmd)
+	deltas, err := fetchAddressDeltas(s, &btcjson.AddressRangeParam{
+		Addresses: c.Addresses.Addresses,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Coinbase outputs can't be spent until they reach maturity.
+	best := s.cfg.Chain.BestSnapshot()
+	maturity := int32(s.cfg.ChainParams.CoinbaseMaturity)
+	var result btcjson.GetAddressBalanceResult
+	for _, addrDeltas := range deltas {
+		for _, delta := range addrDeltas {
+			result.Balance += delta.Amount
+			if delta.Amount > 0 {
+				result.Received += delta.Amount
+			}
+			if delta.TxIndex == 0 && best.Height-delta.Height < maturity {
+				result.BalanceImmature += delta.Amount
+			}
+		}
+	}
+	result.BalanceSpendable = result.Balance - result.BalanceImmature
+
+	return result, nil
+}
+
+// handleGetAddressDeltas implements the getaddressdeltas command.
+func handleGetAddressDeltas(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetAddressDeltasCmd)
+	deltas, err := fetchAddressDeltas(s, &c.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]btcjson.AddressDelta, 0)
+	for i, addrDeltas := range deltas {
+		for _, delta := range addrDeltas {
+			result = append(result, btcjson.AddressDelta{
+				Satoshis:   delta.Amount,
+				TxID:       delta.TxHash.String(),
+				Index:      delta.Index,
+				BlockIndex: delta.TxIndex,
+				Height:     delta.Height,
+				Address:    c.Addresses.Addresses[i],
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// handleGetAddressTxIDs implements the getaddresstxids command.
+func handleGetAddressTxIDs(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetAddressTxIDsCmd)
+	deltas, err := fetchAddressDeltas(s, &c.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	// Order the transactions of all addresses by their position in the
+	// chain and only list each of them once.
+	var all []indexers.AddrDelta
+	for _, addrDeltas := range deltas {
+		all = append(all, addrDeltas...)
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].Height != all[j].Height {
+			return all[i].Height < all[j].Height
+		}
+		return all[i].TxIndex < all[j].TxIndex
+	})
+	result := make([]string, 0, len(all))
+	seen := make(map[chainhash.Hash]struct{}, len(all))
+	for _, delta := range all {
+		if _, ok := seen[delta.TxHash]; ok {
+			continue
+		}
+		seen[delta.TxHash] = struct{}{}
+		result = append(result, delta.TxHash.String())
+	}
+
+	return result, nil
+}
+
+// handleGetAddressUtxos implements the getaddressutxos command.
+func handleGetAddressUtxos(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetAddressUtxosCmd)
+	if s.cfg.AddrDeltaIndex == nil {
+		return nil, rpcNoIndexError("Address index", "addressindex")
+	}
+
+	addrs, err := decodeIndexAddresses(s, c.Addresses.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]btcjson.AddressUtxo, 0)
+	for i, addr := range addrs {
+		utxos, err := s.cfg.AddrDeltaIndex.AddrUtxos(addr)
+		if err != nil {
+			context := "Failed to fetch address utxos"
+			return nil, internalRPCError(err.Error(), context)
+		}
+		for _, utxo := range utxos {
+			result = append(result, btcjson.AddressUtxo{
+				Address:     c.Addresses.Addresses[i],
+				TxID:        utxo.OutPoint.Hash.String(),
+				OutputIndex: utxo.OutPoint.Index,
+				Script:      hex.EncodeToString(utxo.PkScript),
+				Satoshis:    utxo.Amount,
+				Height:      utxo.Height,
+			})
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Height < result[j].Height
+	})
+
+	return result, nil
+}
+
 // handleGetBestBlock implements the getbestblock command.
 func handleGetBestBlock(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	// All other "get block" commands give either the height, the
@@ -1081,6 +1371,16 @@ func handleGetBlock(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (i
 		return err
 	})
 	if err != nil {
+		// The main chain still includes the blocks whose data has been
+		// pruned.
+		if s.cfg.Chain.IsPruned() {
+			if _, err := s.cfg.Chain.BlockHeightByHash(hash); err == nil {
+				return nil, &btcjson.RPCError{
+					Code:    btcjson.ErrRPCMisc,
+					Message: "Block not available (pruned data)",
+				}
+			}
+		}
 		return nil, &btcjson.RPCError{
 			Code:    btcjson.ErrRPCBlockNotFound,
 			Message: "Block not found",
@@ -1138,6 +1438,10 @@ func handleGetBlock(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (i
 		Bits:          strconv.FormatInt(int64(blockHeader.Bits), 16),
 		Difficulty:    getDifficultyRatio(blockHeader.Bits, params),
 		NextHash:      nextHashString,
+		Chainlock:     s.cfg.Chain.IsBlockChainLocked(hash),
+	}
+	if txns := blk.MsgBlock().Transactions; len(txns) > 0 {
+		blockReply.CbTx = createCbTxResult(txns[0])
 	}
 
 	if *c.Verbosity == 1 {
@@ -1200,11 +1504,19 @@ func handleGetBlockChainInfo(s *rpcServer, cmd interface{}, closeChan <-chan str
 		BestBlockHash: chainSnapshot.Hash.String(),
 		Difficulty:    getDifficultyRatio(chainSnapshot.Bits, params),
 		MedianTime:    chainSnapshot.MedianTime.Unix(),
-		Pruned:        false,
+		Pruned:        chain.IsPruned(),
 		SoftForks: &btcjson.SoftForks{
 			Bip9SoftForks: make(map[string]*btcjson.Bip9SoftForkDescription),
 		},
 	}
+	if chainInfo.Pruned {
+		pruneHeight, err := chain.PruneHeight()
+		if err != nil {
+			context := "Failed to obtain prune height"
+			return nil, internalRPCError(err.Error(), context)
+		}
+		chainInfo.PruneHeight = pruneHeight
+	}
 
 	// Next, populate the response with information describing the current
 	// status of soft-forks deployed via the super-majority block
@@ -1328,6 +1640,50 @@ func handleGetBlockHash(s *rpcServer, cmd interface{}, closeChan <-chan struct{}
 	return hash.String(), nil
 }
 
+// handleGetBlockHashes implements the getblockhashes command.
+func handleGetBlockHashes(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetBlockHashesCmd)
+	if s.cfg.TimestampIndex == nil {
+		return nil, rpcNoIndexError("Timestamp index", "timestampindex")
+	}
+	if c.High < 0 || c.Low < 0 || c.High > math.MaxUint32 ||
+		c.Low > math.MaxUint32 {
+
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "Timestamps must be between 0 and 4294967295",
+		}
+	}
+
+	// Only blocks in the main chain are indexed, so the noOrphans option
+	// has no effect.
+	blocks, err := s.cfg.TimestampIndex.BlockHashes(uint32(c.High),
+		uint32(c.Low))
+	if err != nil {
+		context := "Failed to fetch block hashes"
+		return nil, internalRPCError(err.Error(), context)
+	}
+
+	if c.Options != nil && c.Options.LogicalTimes != nil &&
+		*c.Options.LogicalTimes {
+
+		result := make([]btcjson.BlockHashLogicalTime, 0, len(blocks))
+		for _, block := range blocks {
+			result = append(result, btcjson.BlockHashLogicalTime{
+				BlockHash: block.Hash.String(),
+				LogicalTS: int64(block.LogicalTime),
+			})
+		}
+		return result, nil
+	}
+
+	result := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		result = append(result, block.Hash.String())
+	}
+	return result, nil
+}
+
 // handleGetBlockHeader implements the getblockheader command.
 func handleGetBlockHeader(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	c := cmd.(*btcjson.GetBlockHeaderCmd)
@@ -2732,9 +3088,286 @@ func handleGetRawTransaction(s *rpcServer, cmd interface{}, closeChan <-chan str
 	if err != nil {
 		return nil, err
 	}
+
+	// A transaction is final once it is locked by an InstantSend lock or
+	// its block is locked by a ChainLock.
+	rawTxn.InstantLockInternal = s.cfg.TxMemPool.IsInstantLocked(txHash)
+	if blkHash != nil {
+		rawTxn.ChainLock = s.cfg.Chain.IsBlockChainLocked(blkHash)
+	}
+	rawTxn.InstantLock = rawTxn.InstantLockInternal || rawTxn.ChainLock
+
 	return *rawTxn, nil
 }
 
+// specialTxInBlock is a special transaction along with the block it is
+// contained in.
+type specialTxInBlock struct {
+	tx    *btcutil.Tx
+	block *btcutil.Block
+}
+
+// checkSpecialTxesParams ensures the parameters shared by the getspecialtxes
+// and searchspecialtxes commands are in range.
+func checkSpecialTxesParams(txType, count, skip, verbosity int) error {
+	if txType < -1 || txType > math.MaxUint16 {
+		return &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: fmt.Sprintf("Invalid special transaction type %d", txType),
+		}
+	}
+	if count < 0 {
+		return &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "Negative count",
+		}
+	}
+	if skip < 0 {
+		return &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "Negative skip",
+		}
+	}
+	if verbosity < 0 || verbosity > 2 {
+		return &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "Verbosity must be in range 0..2",
+		}
+	}
+	return nil
+}
+
+// specialTxesResult returns the result of the getspecialtxes and
+// searchspecialtxes commands for the passed transactions and verbosity.
+func specialTxesResult(s *rpcServer, txns []specialTxInBlock, verbosity int) (interface{}, error) {
+	switch verbosity {
+	case 0:
+		result := make([]string, 0, len(txns))
+		for _, txn := range txns {
+			result = append(result, txn.tx.Hash().String())
+		}
+		return result, nil
+
+	case 1:
+		result := make([]string, 0, len(txns))
+		for _, txn := range txns {
+			mtxHex, err := messageToHex(txn.tx.MsgTx())
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, mtxHex)
+		}
+		return result, nil
+	}
+
+	best := s.cfg.Chain.BestSnapshot()
+	result := make([]btcjson.TxRawResult, 0, len(txns))
+	for _, txn := range txns {
+		rawTxn, err := createTxRawResult(s.cfg.ChainParams,
+			txn.tx.MsgTx(), txn.tx.Hash().String(),
+			&txn.block.MsgBlock().Header, txn.block.Hash().String(),
+			txn.block.Height(), best.Height)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *rawTxn)
+	}
+	return result, nil
+}
+
+// handleGetSpecialTxes implements the getspecialtxes command.
+//
+// Like Dash Core, it returns the special transactions of the passed type of the
+// block with the passed hash.
+func handleGetSpecialTxes(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetSpecialTxesCmd)
+
+	txType, count, skip, verbosity := -1, 10, 0, 0
+	if c.Type != nil {
+		txType = *c.Type
+	}
+	if c.Count != nil {
+		count = *c.Count
+	}
+	if c.Skip != nil {
+		skip = *c.Skip
+	}
+	if c.Verbosity != nil {
+		verbosity = *c.Verbosity
+	}
+	err := checkSpecialTxesParams(txType, count, skip, verbosity)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := chainhash.NewHashFromStr(c.BlockHash)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.BlockHash)
+	}
+	block, err := s.cfg.Chain.BlockByHash(hash)
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCBlockNotFound,
+			Message: "Block not found",
+		}
+	}
+
+	var txns []specialTxInBlock
+	for _, tx := range block.Transactions() {
+		if len(txns) >= count {
+			break
+		}
+		msgTx := tx.MsgTx()
+		if !msgTx.IsSpecial() ||
+			(txType != -1 && msgTx.Type != wire.TxType(txType)) {
+
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		txns = append(txns, specialTxInBlock{tx: tx, block: block})
+	}
+
+	return specialTxesResult(s, txns, verbosity)
+}
+
+// handleSearchSpecialTxes implements the searchspecialtxes command.
+//
+// It returns the special transactions of the passed type in the main chain from
+// the special transaction index, or the provider transactions referring to the
+// masternode with the passed proTxHash when one is given.
+func handleSearchSpecialTxes(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.SearchSpecialTxesCmd)
+	if s.cfg.SpecialTxIndex == nil {
+		return nil, rpcNoIndexError("Special transaction index",
+			"specialtxindex")
+	}
+
+	txType, start, end, count, skip, verbosity := -1, 0, 0, 100, 0, 0
+	if c.Type != nil {
+		txType = *c.Type
+	}
+	if c.Start != nil {
+		start = *c.Start
+	}
+	if c.End != nil {
+		end = *c.End
+	}
+	if c.Count != nil {
+		count = *c.Count
+	}
+	if c.Skip != nil {
+		skip = *c.Skip
+	}
+	if c.Verbosity != nil {
+		verbosity = *c.Verbosity
+	}
+	err := checkSpecialTxesParams(txType, count, skip, verbosity)
+	if err != nil {
+		return nil, err
+	}
+	if start < 0 || end < 0 || start > math.MaxInt32 ||
+		end > math.MaxInt32 || (end != 0 && end < start) {
+
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: fmt.Sprintf("Invalid height range %d..%d", start, end),
+		}
+	}
+
+	var entries []indexers.SpecialTxEntry
+	if c.ProTxHash != nil && *c.ProTxHash != "" {
+		proTxHash, err := chainhash.NewHashFromStr(*c.ProTxHash)
+		if err != nil {
+			return nil, rpcDecodeHexError(*c.ProTxHash)
+		}
+		var typeFilter *wire.TxType
+		if txType != -1 {
+			t := wire.TxType(txType)
+			typeFilter = &t
+		}
+		entries, err = s.cfg.SpecialTxIndex.TxnsByProTxHash(proTxHash,
+			typeFilter, int32(start), int32(end), uint32(skip),
+			uint32(count))
+		if err != nil {
+			context := "Failed to fetch special transactions"
+			return nil, internalRPCError(err.Error(), context)
+		}
+	} else {
+		if txType == -1 {
+			return nil, &btcjson.RPCError{
+				Code:    btcjson.ErrRPCInvalidParameter,
+				Message: "A type is required without a proTxHash",
+			}
+		}
+		entries, err = s.cfg.SpecialTxIndex.TxnsByType(
+			wire.TxType(txType), int32(start), int32(end),
+			uint32(skip), uint32(count))
+		if err != nil {
+			context := "Failed to fetch special transactions"
+			return nil, internalRPCError(err.Error(), context)
+		}
+	}
+
+	txns := make([]specialTxInBlock, 0, len(entries))
+	var block *btcutil.Block
+	for _, entry := range entries {
+		if block == nil || block.Height() != entry.Height {
+			block, err = s.cfg.Chain.BlockByHeight(entry.Height)
+			if err != nil {
+				context := "Failed to fetch block"
+				return nil, internalRPCError(err.Error(), context)
+			}
+		}
+		blockTxns := block.Transactions()
+		if int(entry.TxIndex) >= len(blockTxns) {
+			context := "Failed to fetch special transaction"
+			return nil, internalRPCError(fmt.Sprintf("no "+
+				"transaction %d in block %v", entry.TxIndex,
+				block.Hash()), context)
+		}
+		txns = append(txns, specialTxInBlock{
+			tx:    blockTxns[entry.TxIndex],
+			block: block,
+		})
+	}
+
+	return specialTxesResult(s, txns, verbosity)
+}
+
+// handleGetSpentInfo implements the getspentinfo command.
+func handleGetSpentInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetSpentInfoCmd)
+	if s.cfg.SpentIndex == nil {
+		return nil, rpcNoIndexError("Spent index", "spentindex")
+	}
+
+	txHash, err := chainhash.NewHashFromStr(c.Outpoint.TxID)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.Outpoint.TxID)
+	}
+	outpoint := wire.OutPoint{Hash: *txHash, Index: c.Outpoint.Index}
+	info, err := s.cfg.SpentIndex.SpentInfo(&outpoint)
+	if err != nil {
+		context := "Failed to fetch spent info"
+		return nil, internalRPCError(err.Error(), context)
+	}
+	if info == nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidAddressOrKey,
+			Message: "Unable to get spent info",
+		}
+	}
+
+	return &btcjson.GetSpentInfoResult{
+		TxID:   info.TxHash.String(),
+		Index:  info.InputIndex,
+		Height: info.Height,
+	}, nil
+}
+
 // handleGetTxOut handles gettxout commands.
 func handleGetTxOut(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	c := cmd.(*btcjson.GetTxOutCmd)
@@ -2841,6 +3474,55 @@ func handleGetTxOut(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (i
 	return txOutReply, nil
 }
 
+// handleGetTxOutSetInfo implements the gettxoutsetinfo command.  The
+// hash_serialized_2 commitment requires iterating the utxo set, while the
+// MuHash3072 commitment and the other statistics are maintained by the chain.
+func handleGetTxOutSetInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetTxOutSetInfoCmd)
+
+	hashType := btcjson.TxOutSetHashSerialized
+	if c.HashType != nil {
+		hashType = *c.HashType
+	}
+
+	var stats *blockchain.UtxoSetStats
+	switch hashType {
+	case btcjson.TxOutSetHashSerialized:
+		var err error
+		stats, err = s.cfg.Chain.ScanUtxoSet()
+		if err != nil {
+			context := "Failed to scan the utxo set"
+			return nil, internalRPCError(err.Error(), context)
+		}
+		stats.MuHash = chainhash.Hash{}
+
+	case btcjson.TxOutSetHashMuHash:
+		stats = s.cfg.Chain.UtxoSetStats()
+
+	case btcjson.TxOutSetHashNone:
+		stats = s.cfg.Chain.UtxoSetStats()
+		stats.MuHash = chainhash.Hash{}
+
+	default:
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: fmt.Sprintf("%s is not a valid hash_type", hashType),
+		}
+	}
+
+	return &btcjson.GetTxOutSetInfoResult{
+		Height:         int64(stats.Height),
+		BestBlock:      stats.Hash,
+		Transactions:   int64(stats.Transactions),
+		TxOuts:         int64(stats.TxOuts),
+		BogoSize:       int64(stats.BogoSize),
+		HashSerialized: stats.HashSerialized,
+		MuHash:         stats.MuHash,
+		DiskSize:       int64(stats.DiskSize),
+		TotalAmount:    btcutil.Amount(stats.TotalAmount),
+	}, nil
+}
+
 // handleHelp implements the help command.
 func handleHelp(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	c := cmd.(*btcjson.HelpCmd)
@@ -2893,6 +3575,174 @@ func handlePing(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (inter
 	return nil, nil
 }
 
+// handleProTx implements the protx command.  The list and info sub commands
+// are served from the deterministic masternode list.
+func handleProTx(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.ProTxCmd)
+	mnList := s.cfg.MNList
+	if mnList == nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCMisc,
+			Message: "Masternode list must be enabled (--mnlist)",
+		}
+	}
+	tip, err := mnList.Tip()
+	if err != nil {
+		context := "Masternode list is not available"
+		return nil, internalRPCError(err.Error(), context)
+	}
+
+	switch c.SubCmd {
+	case btcjson.ProTxList:
+		return proTxList(s, c, tip)
+
+	case btcjson.ProTxInfo:
+		if c.ProTxHash == nil {
+			return nil, &btcjson.RPCError{
+				Code:    btcjson.ErrRPCInvalidParameter,
+				Message: "Missing protx hash",
+			}
+		}
+		proTxHash, err := chainhash.NewHashFromStr(*c.ProTxHash)
+		if err != nil {
+			return nil, rpcDecodeHexError(*c.ProTxHash)
+		}
+		mn := tip.Get(proTxHash)
+		if mn == nil {
+			return nil, &btcjson.RPCError{
+				Code:    btcjson.ErrRPCInvalidAddressOrKey,
+				Message: fmt.Sprintf("%v not found", proTxHash),
+			}
+		}
+		return proTxInfoResult(s, mn), nil
+	}
+
+	return nil, &btcjson.RPCError{
+		Code:    btcjson.ErrRPCInvalidParameter,
+		Message: fmt.Sprintf("Unsupported protx sub command %q", c.SubCmd),
+	}
+}
+
+// proTxList returns the masternodes of the list of the requested height, or the
+// passed tip list, as hashes or detailed results.
+func proTxList(s *rpcServer, c *btcjson.ProTxCmd,
+	l *masternodelist.DeterministicMNList) (interface{}, error) {
+
+	listType := btcjson.ProTxListTypeRegistered
+	if c.Type != nil {
+		listType = *c.Type
+	}
+	if listType != btcjson.ProTxListTypeRegistered &&
+		listType != btcjson.ProTxListTypeValid {
+
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: fmt.Sprintf("Unsupported protx list type %q", listType),
+		}
+	}
+
+	if c.Height != nil && int32(*c.Height) != l.Height() {
+		hash, err := s.cfg.Chain.BlockHashByHeight(int32(*c.Height))
+		if err != nil {
+			return nil, &btcjson.RPCError{
+				Code:    btcjson.ErrRPCOutOfRange,
+				Message: "Block number out of range",
+			}
+		}
+		l, err = s.cfg.MNList.ListForBlock(hash)
+		if err != nil {
+			context := "Failed to load masternode list"
+			return nil, internalRPCError(err.Error(), context)
+		}
+	}
+
+	detailed := c.Detailed != nil && *c.Detailed
+	hashes := make([]string, 0, l.Len())
+	var results []btcjson.ProTxInfoResult
+	for _, mn := range l.Masternodes() {
+		if listType == btcjson.ProTxListTypeValid && mn.IsBanned() {
+			continue
+		}
+		if detailed {
+			results = append(results, *proTxInfoResult(s, mn))
+			continue
+		}
+		hashes = append(hashes, mn.ProTxHash.String())
+	}
+	if detailed {
+		return results, nil
+	}
+	return hashes, nil
+}
+
+// scriptAddress returns the address paid by the passed script, or an empty
+// string when it does not pay a single standard address.
+func scriptAddress(script []byte, params *chaincfg.Params) string {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(script, params)
+	if err != nil || len(addrs) != 1 {
+		return ""
+	}
+	return addrs[0].EncodeAddress()
+}
+
+// keyIDAddress returns the pay-to-pubkey-hash address of the passed key ID.
+func keyIDAddress(keyID evo.KeyID, params *chaincfg.Params) string {
+	addr, err := btcutil.NewAddressPubKeyHash(keyID[:], params)
+	if err != nil {
+		return ""
+	}
+	return addr.EncodeAddress()
+}
+
+// proTxInfoResult returns the protx info result of the passed masternode.  The
+// collateral address and confirmations are looked up in the utxo set and are
+// left empty when the collateral is not found.
+func proTxInfoResult(s *rpcServer, mn *masternodelist.Masternode) *btcjson.ProTxInfoResult {
+	params := s.cfg.ChainParams
+	result := &btcjson.ProTxInfoResult{
+		ProTxHash:       mn.ProTxHash.String(),
+		CollateralHash:  mn.CollateralOutpoint.Hash.String(),
+		CollateralIndex: int(mn.CollateralOutpoint.Index),
+		OperatorReward:  float64(mn.OperatorReward) / 100,
+		Type:            mn.Type.String(),
+		State: btcjson.ProTxState{
+			Version:               int(mn.Version),
+			Service:               mn.Service(),
+			RegisteredHeight:      int(mn.RegisteredHeight),
+			LastPaidHeight:        int(mn.LastPaidHeight),
+			ConsecutivePayments:   int(mn.ConsecutivePayments),
+			PoSePenalty:           int(mn.PoSePenalty),
+			PoSeRevivedHeight:     int(mn.PoSeRevivedHeight),
+			PoSeBanHeight:         int(mn.PoSeBanHeight),
+			RevocationReason:      int(mn.RevocationReason),
+			OwnerAddress:          keyIDAddress(mn.KeyIDOwner, params),
+			VotingAddress:         keyIDAddress(mn.KeyIDVoting, params),
+			PayoutAddress:         scriptAddress(mn.ScriptPayout, params),
+			PubKeyOperator:        hex.EncodeToString(mn.PubKeyOperator[:]),
+			OperatorPayoutAddress: scriptAddress(mn.ScriptOperatorPayout, params),
+		},
+	}
+	if mn.Type == evo.MnTypeEvo {
+		// The platform node ID is displayed in reverse byte order like
+		// other hashes.
+		var nodeID evo.KeyID
+		for i := range nodeID {
+			nodeID[i] = mn.PlatformNodeID[len(nodeID)-1-i]
+		}
+		result.State.PlatformNodeID = hex.EncodeToString(nodeID[:])
+		result.State.PlatformP2PPort = int(mn.PlatformP2PPort)
+		result.State.PlatformHTTPPort = int(mn.PlatformHTTPPort)
+	}
+
+	entry, err := s.cfg.Chain.FetchUtxoEntry(mn.CollateralOutpoint)
+	if err == nil && entry != nil && !entry.IsSpent() {
+		result.CollateralAddress = scriptAddress(entry.PkScript(), params)
+		best := s.cfg.Chain.BestSnapshot()
+		result.Confirmations = int(best.Height - entry.BlockHeight() + 1)
+	}
+	return result
+}
+
 // retrievedTx represents a transaction that was either loaded from the
 // transaction memory pool or from the database.  When a transaction is loaded
 // from the database, it is loaded with the raw serialized bytes while the
@@ -3562,6 +4412,33 @@ func handleSignMessageWithPrivKey(s *rpcServer, cmd interface{}, closeChan <-cha
 	return base64.StdEncoding.EncodeToString(sig), nil
 }
 
+// handleSpork implements the spork command.
+func handleSpork(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.SporkCmd)
+	sporks := s.cfg.SporkManager
+
+	switch c.SubCmd {
+	case btcjson.SporkShow:
+		values := make(map[string]int64)
+		for _, id := range spork.KnownIDs() {
+			values[id.String()] = sporks.Value(id)
+		}
+		return values, nil
+
+	case btcjson.SporkActive:
+		active := make(map[string]bool)
+		for _, id := range spork.KnownIDs() {
+			active[id.String()] = sporks.IsActive(id)
+		}
+		return active, nil
+	}
+
+	return nil, &btcjson.RPCError{
+		Code:    btcjson.ErrRPCInvalidParameter,
+		Message: "Updating sporks is not supported",
+	}
+}
+
 // handleStop implements the stop command.
 func handleStop(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	select {
@@ -3902,6 +4779,12 @@ func (s *rpcServer) NotifyNewTransactions(txns []*mempool.TxDesc) {
 	}
 }
 
+// NotifyTxInstantLocked notifies websocket clients that a transaction has been
+// locked by the passed InstantSend lock.
+func (s *rpcServer) NotifyTxInstantLocked(islock *wire.MsgISDLock) {
+	s.ntfnMgr.NotifyTxInstantLocked(islock)
+}
+
 // limitConnections responds with a 503 service unavailable and returns true if
 // adding another client would exceed the maximum allow RPC clients.
 //
@@ -4628,9 +5511,20 @@ type rpcserverConfig struct {
 
 	// These fields define any optional indexes the RPC server can make use
 	// of to provide additional data when queried.
-	TxIndex   *indexers.TxIndex
-	AddrIndex *indexers.AddrIndex
-	CfIndex   *indexers.CfIndex
+	TxIndex        *indexers.TxIndex
+	AddrIndex      *indexers.AddrIndex
+	CfIndex        *indexers.CfIndex
+	AddrDeltaIndex *indexers.AddrDeltaIndex
+	SpentIndex     *indexers.SpentIndex
+	TimestampIndex *indexers.TimestampIndex
+	SpecialTxIndex *indexers.SpecialTxIndex
+
+	// MNList is the deterministic masternode list the protx command is
+	// served from when it is enabled.
+	MNList *masternodelist.Manager
+
+	// SporkManager tracks the sporks the spork command is served from.
+	SporkManager *spork.Manager
 
 	// The fee estimator keeps track of how long transactions are left in
 	// the mempool before they are mined into blocks.
@@ -4698,6 +5592,16 @@ func (s *rpcServer) handleBlockchainNotification(notification *blockchain.Notifi
 
 		// Notify registered websocket clients.
 		s.ntfnMgr.NotifyBlockDisconnected(block)
+
+	case blockchain.NTChainLocked:
+		clsig, ok := notification.Data.(*wire.MsgCLSig)
+		if !ok {
+			rpcsLog.Warnf("Chain locked notification is not a clsig.")
+			break
+		}
+
+		// Notify registered websocket clients.
+		s.ntfnMgr.NotifyChainLock(clsig)
 	}
 }
 
@@ -115,6 +115,18 @@ var helpDescsEnUS = map[string]string{
 	"decodescript--synopsis": "Returns a JSON object with information about the provided hex-encoded script.",
 	"decodescript-hexscript": "Hex-encoded script",
 
+	// DumpTxOutSetResult help.
+	"dumptxoutsetresult-coins_written": "The number of unspent transaction outputs written",
+	"dumptxoutsetresult-base_hash":     "The hash of the block the utxo set snapshot is for",
+	"dumptxoutsetresult-base_height":   "The height of the block the utxo set snapshot is for",
+	"dumptxoutsetresult-path":          "The absolute path of the written snapshot",
+	"dumptxoutsetresult-txoutset_hash": "The hash of the serialized unspent transaction outputs pinned by the network parameters to load the snapshot",
+	"dumptxoutsetresult-nchaintx":      "The number of transactions in the chain up to and including the block",
+
+	// DumpTxOutSetCmd help.
+	"dumptxoutset--synopsis": "Writes a snapshot of the unspent transaction output set at the current best block to a file, which can be loaded by other nodes with the --loadsnapshot option.",
+	"dumptxoutset-path":      "The path of the file to write, which must not exist yet",
+
 	// EstimateFeeCmd help.
 	"estimatefee--synopsis": "Estimate the fee per kilobyte in satoshis " +
 		"required for a transaction to be mined before a certain number of " +
@@ -147,6 +159,53 @@ var helpDescsEnUS = map[string]string{
 	"getaddednodeinfo--condition1": "dns=true",
 	"getaddednodeinfo--result0":    "List of added peers",
 
+	// AddressesParam help.
+	"addressesparam-addresses": "The addresses",
+
+	// AddressRangeParam help.
+	"addressrangeparam-addresses": "The addresses",
+	"addressrangeparam-start":     "The start block height, which is ignored when start or end is zero",
+	"addressrangeparam-end":       "The end block height, which is ignored when start or end is zero",
+
+	// GetAddressBalanceCmd help.
+	"getaddressbalance--synopsis": "Returns the balance of the addresses.  It requires the address index (--addressindex).",
+	"getaddressbalance-addresses": "The addresses",
+
+	// GetAddressBalanceResult help.
+	"getaddressbalanceresult-balance":           "The current balance in duffs",
+	"getaddressbalanceresult-balance_immature":  "The balance of immature coinbase outputs in duffs",
+	"getaddressbalanceresult-balance_spendable": "The balance which can be spent in duffs",
+	"getaddressbalanceresult-received":          "The total amount received in duffs",
+
+	// GetAddressDeltasCmd help.
+	"getaddressdeltas--synopsis": "Returns the changes of the balance of the addresses.  It requires the address index (--addressindex).",
+	"getaddressdeltas-addresses": "The addresses and an optional range of block heights",
+
+	// AddressDelta help.
+	"addressdelta-satoshis":   "The change of the balance in duffs",
+	"addressdelta-txid":       "The id of the transaction",
+	"addressdelta-index":      "The index of the input or output",
+	"addressdelta-blockindex": "The position of the transaction in the block",
+	"addressdelta-height":     "The height of the block",
+	"addressdelta-address":    "The address",
+
+	// GetAddressTxIDsCmd help.
+	"getaddresstxids--synopsis": "Returns the ids of the transactions involving the addresses.  It requires the address index (--addressindex).",
+	"getaddresstxids-addresses": "The addresses and an optional range of block heights",
+	"getaddresstxids--result0":  "The transaction ids ordered by their position in the chain",
+
+	// GetAddressUtxosCmd help.
+	"getaddressutxos--synopsis": "Returns the unspent outputs of the addresses.  It requires the address index (--addressindex).",
+	"getaddressutxos-addresses": "The addresses",
+
+	// AddressUtxo help.
+	"addressutxo-address":     "The address",
+	"addressutxo-txid":        "The id of the transaction",
+	"addressutxo-outputIndex": "The index of the output",
+	"addressutxo-script":      "The hex-encoded public key script of the output",
+	"addressutxo-satoshis":    "The value of the output in duffs",
+	"addressutxo-height":      "The height of the block containing the output",
+
 	// GetBestBlockResult help.
 	"getbestblockresult-hash":   "Hex-encoded bytes of the best block hash",
 	"getbestblockresult-height": "Height of the best block",
@@ -223,6 +282,19 @@ var helpDescsEnUS = map[string]string{
 	"txrawresult-instantlock":          "A bool to indicate the current transaction lock state",
 	"txrawresult-instantlock_internal": "A bool to indicate the current internal transaction lock state",
 	"txrawresult-chainlock":            "A bool to indicate the state of the corresponding block chainlock",
+	"txrawresult-type":                 "The special transaction type (only for special transactions)",
+	"txrawresult-extraPayloadSize":     "The size of the extra payload in bytes (only for special transactions)",
+	"txrawresult-extraPayload":         "Hex-encoded extra payload (only for special transactions)",
+	"txrawresult-cbTx":                 "The decoded coinbase payload (only for coinbase special transactions)",
+
+	// CbTxResult help.
+	"cbtxresult-version":           "The coinbase payload version",
+	"cbtxresult-height":            "The block height committed to by the payload",
+	"cbtxresult-merkleRootMNList":  "The merkle root of the simplified masternode list",
+	"cbtxresult-merkleRootQuorums": "The merkle root of the active quorums (version 2 and later)",
+	"cbtxresult-bestCLHeightDiff":  "The distance from the parent block to the block locked by the best ChainLock (version 3 and later)",
+	"cbtxresult-bestCLSignature":   "The signature of the best ChainLock (version 3 and later)",
+	"cbtxresult-creditPoolBalance": "The balance of the Platform credit pool in DASH (version 3 and later)",
 
 	// SearchRawTransactionsResult help.
 	"searchrawtransactionsresult-hex":           "Hex-encoded transaction",
@@ -259,6 +331,7 @@ var helpDescsEnUS = map[string]string{
 	"getblockverboseresult-strippedsize":      "The size of the block without witness data",
 	"getblockverboseresult-weight":            "The weight of the block",
 	"getblockverboseresult-chainlock":         "A bool to indicate the state of the chainlock",
+	"getblockverboseresult-cbTx":              "The decoded payload of the coinbase special transaction (only if present)",
 
 	// GetBlockCountCmd help.
 	"getblockcount--synopsis": "Returns the number of blocks in the longest block chain.",
@@ -269,6 +342,23 @@ var helpDescsEnUS = map[string]string{
 	"getblockhash-index":     "The block height",
 	"getblockhash--result0":  "The block hash",
 
+	// GetBlockHashesCmd help.
+	"getblockhashes--synopsis":   "Returns the hashes of the blocks with a logical timestamp in the range [low, high).  It requires the timestamp index (--timestampindex).",
+	"getblockhashes-high":        "The end of the range of timestamps (exclusive)",
+	"getblockhashes-low":         "The start of the range of timestamps (inclusive)",
+	"getblockhashes-options":     "The options",
+	"getblockhashes--condition0": "logicalTimes=false",
+	"getblockhashes--condition1": "logicalTimes=true",
+	"getblockhashes--result0":    "The block hashes",
+
+	// GetBlockHashesOptions help.
+	"getblockhashesoptions-noOrphans":    "Only return blocks in the main chain, which is always the case",
+	"getblockhashesoptions-logicalTimes": "Return the logical timestamps along with the block hashes",
+
+	// BlockHashLogicalTime help.
+	"blockhashlogicaltime-blockhash": "The block hash",
+	"blockhashlogicaltime-logicalts": "The logical timestamp of the block",
+
 	// GetBlockHeaderCmd help.
 	"getblockheader--synopsis":   "Returns information about a block header given its hash.",
 	"getblockheader-hash":        "The hash of the block",
@@ -535,7 +625,66 @@ var helpDescsEnUS = map[string]string{
 	"gettxoutresult-version":       "The transaction version",
 	"gettxoutresult-coinbase":      "Whether or not the transaction is a coinbase",
 
+	// GetSpecialTxesCmd help.
+	"getspecialtxes--synopsis":   "Returns the special transactions of the block with the passed hash.",
+	"getspecialtxes-blockhash":   "The hash of the block",
+	"getspecialtxes-type":        "The type of the special transactions, or -1 for all types",
+	"getspecialtxes-count":       "The maximum number of transactions to return",
+	"getspecialtxes-skip":        "The number of transactions to skip",
+	"getspecialtxes-verbosity":   "0 for the transaction ids, 1 for the hex-encoded transactions and 2 for the transactions as JSON objects",
+	"getspecialtxes--condition0": "verbosity=0",
+	"getspecialtxes--condition1": "verbosity=1",
+	"getspecialtxes--condition2": "verbosity=2",
+	"getspecialtxes--result0":    "The transaction ids",
+	"getspecialtxes--result1":    "The hex-encoded transactions",
+
+	// SearchSpecialTxesCmd help.
+	"searchspecialtxes--synopsis": "Returns the special transactions of the passed type in the main chain, " +
+		"or the provider transactions referring to the masternode with the passed proTxHash.  " +
+		"It requires the special transaction index (--specialtxindex).",
+	"searchspecialtxes-type":        "The type of the special transactions, or -1 for all types when a proTxHash is passed",
+	"searchspecialtxes-protxhash":   "The proTxHash of the masternode the provider transactions refer to",
+	"searchspecialtxes-start":       "The height of the first block to search",
+	"searchspecialtxes-end":         "The height of the last block to search, or 0 to search up to the best block",
+	"searchspecialtxes-count":       "The maximum number of transactions to return",
+	"searchspecialtxes-skip":        "The number of transactions to skip",
+	"searchspecialtxes-verbosity":   "0 for the transaction ids, 1 for the hex-encoded transactions and 2 for the transactions as JSON objects",
+	"searchspecialtxes--condition0": "verbosity=0",
+	"searchspecialtxes--condition1": "verbosity=1",
+	"searchspecialtxes--condition2": "verbosity=2",
+	"searchspecialtxes--result0":    "The transaction ids",
+	"searchspecialtxes--result1":    "The hex-encoded transactions",
+
+	// GetSpentInfoCmd help.
+	"getspentinfo--synopsis": "Returns the input spending an output.  It requires the spent index (--spentindex).",
+	"getspentinfo-outpoint":  "The output",
+
+	// SpentInfoParam help.
+	"spentinfoparam-txid":  "The id of the transaction containing the output",
+	"spentinfoparam-index": "The index of the output",
+
+	// GetSpentInfoResult help.
+	"getspentinforesult-txid":   "The id of the spending transaction",
+	"getspentinforesult-index":  "The index of the spending input",
+	"getspentinforesult-height": "The height of the block containing the spending transaction",
+
 	// GetTxOutCmd help.
+	// GetTxOutSetInfoResult help.
+	"gettxoutsetinforesult-height":            "The height of the best block",
+	"gettxoutsetinforesult-bestblock":         "The hash of the best block",
+	"gettxoutsetinforesult-transactions":      "The number of transactions with unspent outputs (only with hash_type hash_serialized_2)",
+	"gettxoutsetinforesult-txouts":            "The number of unspent transaction outputs",
+	"gettxoutsetinforesult-bogosize":          "A meaningless metric for the size of the unspent transaction output set",
+	"gettxoutsetinforesult-hash_serialized_2": "The serialized hash of the unspent transaction output set (only with hash_type hash_serialized_2)",
+	"gettxoutsetinforesult-muhash":            "The rolling MuHash3072 commitment of the unspent transaction output set (only with hash_type muhash)",
+	"gettxoutsetinforesult-disk_size":         "The size of the unspent transaction output set in the database (only with hash_type hash_serialized_2)",
+	"gettxoutsetinforesult-total_amount":      "The total amount of the unspent transaction outputs in BTC",
+
+	// GetTxOutSetInfoCmd help.
+	"gettxoutsetinfo--synopsis": "Returns statistics about the unspent transaction output set.  " +
+		"The hash_serialized_2 commitment requires iterating the whole set, while the muhash commitment is maintained as blocks are connected.",
+	"gettxoutsetinfo-hashtype": "The commitment of the unspent transaction output set to return: hash_serialized_2, muhash or none",
+
 	"gettxout--synopsis":      "Returns information about an unspent transaction output.",
 	"gettxout-txid":           "The hash of the transaction",
 	"gettxout-vout":           "The index of the output",
@@ -553,6 +702,97 @@ var helpDescsEnUS = map[string]string{
 	"ping--synopsis": "Queues a ping to be sent to each connected peer.\n" +
 		"Ping times are provided by getpeerinfo via the pingtime and pingwait fields.",
 
+	// SporkCmd help.
+	"spork--synopsis":       "Returns the values of the sporks or whether they are active.",
+	"spork-subcmd":          "'show' to return the value of each spork or 'active' to return whether each spork is active",
+	"spork--condition0":     "show",
+	"spork--condition1":     "active",
+	"spork--result0--desc":  "Spork values keyed by the spork name",
+	"spork--result0--key":   "The spork name",
+	"spork--result0--value": "The spork value",
+	"spork--result1--desc":  "Spork states keyed by the spork name",
+	"spork--result1--key":   "The spork name",
+	"spork--result1--value": "Whether the spork is active",
+
+	// ProTxCmd help.
+	"protx--synopsis": "Returns masternodes of the deterministic masternode list.\n" +
+		"Only the list and info sub commands are supported and they require the optional --mnlist flag.",
+	"protx-subcmd":                "'list' to list the masternodes or 'info' to return a single masternode",
+	"protx-protxhash":             "The hash of the registration transaction of the masternode (info only)",
+	"protx-type":                  "'registered' for all masternodes or 'valid' for masternodes which are not PoSe banned (list only)",
+	"protx-detailed":              "Return details for each masternode instead of its registration transaction hash (list only)",
+	"protx-height":                "The height of the list to return, defaults to the best block (list only)",
+	"protx-baseblock":             "Not supported",
+	"protx-block":                 "Not supported",
+	"protx-collateralhash":        "Not supported",
+	"protx-collateralindex":       "Not supported",
+	"protx-collateraladdress":     "Not supported",
+	"protx-ipandport":             "Not supported",
+	"protx-owneraddress":          "Not supported",
+	"protx-operatorpubkey":        "Not supported",
+	"protx-operatorprivatekey":    "Not supported",
+	"protx-operatorpayoutaddress": "Not supported",
+	"protx-votingaddress":         "Not supported",
+	"protx-operatorreward":        "Not supported",
+	"protx-payoutaddress":         "Not supported",
+	"protx-fundaddress":           "Not supported",
+	"protx-reason":                "Not supported",
+	"protx-feesourceaddress":      "Not supported",
+	"protx-submit":                "Not supported",
+	"protx-tx":                    "Not supported",
+	"protx-sig":                   "Not supported",
+	"protx--condition0":           "list, detailed=false",
+	"protx--condition1":           "list, detailed=true",
+	"protx--condition2":           "info",
+	"protx--result0":              "The registration transaction hashes of the masternodes",
+
+	// ProTxInfoResult help.
+	"protxinforesult-proTxHash":         "The hash of the registration transaction",
+	"protxinforesult-collateralHash":    "The hash of the transaction holding the collateral",
+	"protxinforesult-collateralIndex":   "The output index of the collateral",
+	"protxinforesult-collateralAddress": "The address holding the collateral",
+	"protxinforesult-operatorReward":    "The share of the reward paid to the operator in percent",
+	"protxinforesult-type":              "The masternode type, Regular or Evo",
+	"protxinforesult-state":             "The current state of the masternode",
+	"protxinforesult-confirmations":     "The number of confirmations of the collateral",
+	"protxinforesult-wallet":            "Wallet ownership of the masternode keys, always false",
+	"protxinforesult-metaInfo":          "Network meta information, always zero",
+
+	// ProTxState help.
+	"protxstate-version":               "The provider transaction version of the operator key",
+	"protxstate-service":               "The IP address and port of the masternode",
+	"protxstate-registeredHeight":      "The height the masternode was registered at",
+	"protxstate-lastPaidHeight":        "The height the masternode was last paid at",
+	"protxstate-consecutivePayments":   "The number of consecutive payments of an evo masternode",
+	"protxstate-PoSePenalty":           "The proof of service penalty",
+	"protxstate-PoSeRevivedHeight":     "The height the masternode was last revived at, or -1",
+	"protxstate-PoSeBanHeight":         "The height the masternode was banned at, or -1",
+	"protxstate-revocationReason":      "The reason the operator was revoked",
+	"protxstate-ownerAddress":          "The address of the owner key",
+	"protxstate-votingAddress":         "The address of the voting key",
+	"protxstate-platformNodeID":        "The platform node ID of an evo masternode",
+	"protxstate-platformP2PPort":       "The platform P2P port of an evo masternode",
+	"protxstate-platformHTTPPort":      "The platform HTTP port of an evo masternode",
+	"protxstate-payoutAddress":         "The address the masternode reward is paid to",
+	"protxstate-pubKeyOperator":        "The BLS public key of the operator",
+	"protxstate-operatorPayoutAddress": "The address the operator reward is paid to",
+
+	// ProTxWallet help.
+	"protxwallet-hasOwnerKey":              "Whether the wallet holds the owner key",
+	"protxwallet-hasOperatorKey":           "Whether the wallet holds the operator key",
+	"protxwallet-hasVotingKey":             "Whether the wallet holds the voting key",
+	"protxwallet-ownsCollateral":           "Whether the wallet owns the collateral",
+	"protxwallet-ownsPayeeScript":          "Whether the wallet owns the payout script",
+	"protxwallet-ownsOperatorRewardScript": "Whether the wallet owns the operator payout script",
+
+	// ProTxMetaInfo help.
+	"protxmetainfo-lastDSQ":                    "The last CoinJoin queue announcement",
+	"protxmetainfo-mixingTxCount":              "The number of CoinJoin mixing transactions",
+	"protxmetainfo-lastOutboundAttempt":        "The time of the last outbound connection attempt",
+	"protxmetainfo-lastOutboundAttemptElapsed": "The seconds since the last outbound connection attempt",
+	"protxmetainfo-lastOutboundSuccess":        "The time of the last successful outbound connection",
+	"protxmetainfo-lastOutboundSuccessElapsed": "The seconds since the last successful outbound connection",
+
 	// SearchRawTransactionsCmd help.
 	"searchrawtransactions--synopsis": "Returns raw data for transactions involving the passed address.\n" +
 		"Returned transactions are pulled from both the database, and transactions currently in the mempool.\n" +
@@ -725,14 +965,20 @@ var rpcResultTypes = map[string][]interface{}{
 	"debuglevel":             {(*string)(nil), (*string)(nil)},
 	"decoderawtransaction":   {(*btcjson.TxRawDecodeResult)(nil)},
 	"decodescript":           {(*btcjson.DecodeScriptResult)(nil)},
+	"dumptxoutset":           {(*btcjson.DumpTxOutSetResult)(nil)},
 	"estimatefee":            {(*float64)(nil)},
 	"generate":               {(*[]string)(nil)},
 	"getaddednodeinfo":       {(*[]string)(nil), (*[]btcjson.GetAddedNodeInfoResult)(nil)},
+	"getaddressbalance":      {(*btcjson.GetAddressBalanceResult)(nil)},
+	"getaddressdeltas":       {(*[]btcjson.AddressDelta)(nil)},
+	"getaddresstxids":        {(*[]string)(nil)},
+	"getaddressutxos":        {(*[]btcjson.AddressUtxo)(nil)},
 	"getbestblock":           {(*btcjson.GetBestBlockResult)(nil)},
 	"getbestblockhash":       {(*string)(nil)},
 	"getblock":               {(*string)(nil), (*btcjson.GetBlockVerboseResult)(nil)},
 	"getblockcount":          {(*int64)(nil)},
 	"getblockhash":           {(*string)(nil)},
+	"getblockhashes":         {(*[]string)(nil), (*[]btcjson.BlockHashLogicalTime)(nil)},
 	"getblockheader":         {(*string)(nil), (*btcjson.GetBlockHeaderVerboseResult)(nil)},
 	"getblocktemplate":       {(*btcjson.GetBlockTemplateResult)(nil), (*string)(nil), nil},
 	"getblockchaininfo":      {(*btcjson.GetBlockChainInfoResult)(nil)},
@@ -754,14 +1000,20 @@ var rpcResultTypes = map[string][]interface{}{
 	"getpeerinfo":            {(*[]btcjson.GetPeerInfoResult)(nil)},
 	"getrawmempool":          {(*[]string)(nil), (*btcjson.GetRawMempoolVerboseResult)(nil)},
 	"getrawtransaction":      {(*string)(nil), (*btcjson.TxRawResult)(nil)},
+	"getspecialtxes":         {(*[]string)(nil), (*[]string)(nil), (*[]btcjson.TxRawResult)(nil)},
+	"getspentinfo":           {(*btcjson.GetSpentInfoResult)(nil)},
 	"gettxout":               {(*btcjson.GetTxOutResult)(nil)},
+	"gettxoutsetinfo":        {(*btcjson.GetTxOutSetInfoResult)(nil)},
 	"node":                   nil,
 	"help":                   {(*string)(nil), (*string)(nil)},
 	"ping":                   nil,
+	"protx":                  {(*[]string)(nil), (*[]btcjson.ProTxInfoResult)(nil), (*btcjson.ProTxInfoResult)(nil)},
 	"searchrawtransactions":  {(*string)(nil), (*[]btcjson.SearchRawTransactionsResult)(nil)},
+	"searchspecialtxes":      {(*[]string)(nil), (*[]string)(nil), (*[]btcjson.TxRawResult)(nil)},
 	"sendrawtransaction":     {(*string)(nil)},
 	"setgenerate":            nil,
 	"signmessagewithprivkey": {(*string)(nil)},
+	"spork":                  {(*map[string]int64)(nil), (*map[string]bool)(nil)},
 	"stop":                   {(*string)(nil)},
 	"submitblock":            {nil, (*string)(nil)},
 	"uptime":                 {(*int64)(nil)},
@@ -226,6 +226,32 @@ func (m *wsNotificationManager) NotifyBlockDisconnected(block *btcutil.Block) {
 	}
 }
 
+// NotifyChainLock passes a ChainLock which locks a block of the best chain to
+// the notification manager for block notification processing.
+func (m *wsNotificationManager) NotifyChainLock(clsig *wire.MsgCLSig) {
+	// As NotifyChainLock will be called by the block manager and the RPC
+	// server may no longer be running, use a select statement to unblock
+	// enqueuing the notification once the RPC server has begun shutting
+	// down.
+	select {
+	case m.queueNotification <- (*notificationChainLock)(clsig):
+	case <-m.quit:
+	}
+}
+
+// NotifyTxInstantLocked passes an InstantSend lock recorded by the mempool to
+// the notification manager for transaction notification processing.
+func (m *wsNotificationManager) NotifyTxInstantLocked(islock *wire.MsgISDLock) {
+	// As NotifyTxInstantLocked will be called by the server and the RPC
+	// server may no longer be running, use a select statement to unblock
+	// enqueuing the notification once the RPC server has begun shutting
+	// down.
+	select {
+	case m.queueNotification <- (*notificationTxInstantLocked)(islock):
+	case <-m.quit:
+	}
+}
+
 // NotifyMempoolTx passes a transaction accepted by mempool to the
 // notification manager for transaction notification processing.  If
 // isNew is true, the tx is is a new transaction, rather than one
@@ -447,6 +473,8 @@ func (f *wsClientFilter) removeUnspentOutPoint(op *wire.OutPoint) {
 // Notification types
 type notificationBlockConnected btcutil.Block
 type notificationBlockDisconnected btcutil.Block
+type notificationChainLock wire.MsgCLSig
+type notificationTxInstantLocked wire.MsgISDLock
 type notificationTxAcceptedByMempool struct {
 	isNew bool
 	tx    *btcutil.Tx
@@ -532,6 +560,18 @@ out:
 						block)
 				}
 
+			case *notificationChainLock:
+				if len(blockNotifications) != 0 {
+					m.notifyChainLock(blockNotifications,
+						(*wire.MsgCLSig)(n))
+				}
+
+			case *notificationTxInstantLocked:
+				if len(txNotifications) != 0 {
+					m.notifyTxInstantLocked(txNotifications,
+						(*wire.MsgISDLock)(n))
+				}
+
 			case *notificationTxAcceptedByMempool:
 				if n.isNew && len(txNotifications) != 0 {
 					m.notifyForNewTx(txNotifications, n.tx)
@@ -807,6 +847,25 @@ func (*wsNotificationManager) notifyFilteredBlockDisconnected(clients map[chan s
 	}
 }
 
+// notifyChainLock notifies websocket clients that have registered for block
+// updates when a block of the main chain is locked by a ChainLock.
+func (*wsNotificationManager) notifyChainLock(clients map[chan struct{}]*wsClient,
+	clsig *wire.MsgCLSig) {
+
+	// Notify interested websocket clients about the ChainLock.
+	ntfn := btcjson.NewChainLockNtfn(clsig.Height, clsig.BlockHash.String(),
+		hex.EncodeToString(clsig.Signature[:]))
+	marshalledJSON, err := btcjson.MarshalCmd(btcjson.RpcVersion1, nil, ntfn)
+	if err != nil {
+		rpcsLog.Errorf("Failed to marshal chain lock notification: "+
+			"%v", err)
+		return
+	}
+	for _, wsc := range clients {
+		wsc.QueueNotification(marshalledJSON)
+	}
+}
+
 // RegisterNewMempoolTxsUpdates requests notifications to the passed websocket
 // client when new transactions are added to the memory pool.
 func (m *wsNotificationManager) RegisterNewMempoolTxsUpdates(wsc *wsClient) {
@@ -868,6 +927,32 @@ func (m *wsNotificationManager) notifyForNewTx(clients map[chan struct{}]*wsClie
 	}
 }
 
+// notifyTxInstantLocked notifies websocket clients that have registered for
+// updates of new transactions when a transaction is locked by an InstantSend
+// lock.
+func (*wsNotificationManager) notifyTxInstantLocked(clients map[chan struct{}]*wsClient,
+	islock *wire.MsgISDLock) {
+
+	var w bytes.Buffer
+	err := islock.BtcEncode(&w, wire.ProtocolVersion, wire.BaseEncoding)
+	if err != nil {
+		rpcsLog.Errorf("Failed to serialize InstantSend lock for tx "+
+			"instant locked notification: %v", err)
+		return
+	}
+	ntfn := btcjson.NewTxInstantLockedNtfn(islock.TxID.String(),
+		hex.EncodeToString(w.Bytes()))
+	marshalledJSON, err := btcjson.MarshalCmd(btcjson.RpcVersion1, nil, ntfn)
+	if err != nil {
+		rpcsLog.Errorf("Failed to marshal tx instant locked "+
+			"notification: %v", err)
+		return
+	}
+	for _, wsc := range clients {
+		wsc.QueueNotification(marshalledJSON)
+	}
+}
+
 // RegisterSpentRequests requests a notification when each of the passed
 // outpoints is confirmed spent (contained in a block connected to the main
 // chain) for the passed websocket client.  The request is automatically
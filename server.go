@@ -6,14 +6,17 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"crypto/rand"
 	"crypto/tls"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"net"
+	"os"
 	"runtime"
 	"sort"
 	"strconv"
@@ -31,11 +34,14 @@ import (
 	"github.com/dashpay/dashd-go/chaincfg/chainhash"
 	"github.com/dashpay/dashd-go/connmgr"
 	"github.com/dashpay/dashd-go/database"
+	"github.com/dashpay/dashd-go/llmq"
+	"github.com/dashpay/dashd-go/masternodelist"
 	"github.com/dashpay/dashd-go/mempool"
 	"github.com/dashpay/dashd-go/mining"
 	"github.com/dashpay/dashd-go/mining/cpuminer"
 	"github.com/dashpay/dashd-go/netsync"
 	"github.com/dashpay/dashd-go/peer"
+	"github.com/dashpay/dashd-go/spork"
 	"github.com/dashpay/dashd-go/txscript"
 	"github.com/dashpay/dashd-go/wire"
 )
@@ -234,9 +240,26 @@ type server struct {
 	// if the associated index is not enabled.  These fields are set during
 	// initial creation of the server and never changed afterwards, so they
 	// do not need to be protected for concurrent access.
-	txIndex   *indexers.TxIndex
-	addrIndex *indexers.AddrIndex
-	cfIndex   *indexers.CfIndex
+	txIndex        *indexers.TxIndex
+	addrIndex      *indexers.AddrIndex
+	cfIndex        *indexers.CfIndex
+	addrDeltaIndex *indexers.AddrDeltaIndex
+	spentIndex     *indexers.SpentIndex
+	timestampIndex *indexers.TimestampIndex
+	specialTxIndex *indexers.SpecialTxIndex
+
+	// mnList maintains the deterministic masternode list.  It is nil if the
+	// masternode list is not enabled.
+	mnList *masternodelist.Manager
+
+	// quorumVerifier verifies the signatures of ChainLocks and InstantSend
+	// locks.  It is nil if the masternode list is disabled, in which case
+	// they are ignored.
+	quorumVerifier *llmq.Verifier
+
+	// sporkManager tracks the sporks signed by the spork keys of the
+	// network.
+	sporkManager *spork.Manager
 
 	// The fee estimator keeps track of how long transactions are left in
 	// the mempool before they are mined into blocks.
@@ -480,6 +503,9 @@ func (sp *serverPeer) OnVersion(_ *peer.Peer, msg *wire.MsgVersion) *wire.MsgRej
 // to kick start communication with them.
 func (sp *serverPeer) OnVerAck(_ *peer.Peer, _ *wire.MsgVerAck) {
 	sp.server.AddPeer(sp)
+
+	// Request the sporks known to the peer.
+	sp.QueueMessage(wire.NewMsgGetSporks(), nil)
 }
 
 // OnMemPool is invoked when a peer receives a mempool bitcoin message.
@@ -590,6 +616,22 @@ func (sp *serverPeer) OnBlock(_ *peer.Peer, msg *wire.MsgBlock, buf []byte) {
 // accordingly.  We pass the message down to blockmanager which will call
 // QueueMessage with any appropriate responses.
 func (sp *serverPeer) OnInv(_ *peer.Peer, msg *wire.MsgInv) {
+	// Sporks are not handled by the sync manager, so request the announced
+	// sporks which are not known yet here.
+	getData := wire.NewMsgGetData()
+	for _, invVect := range msg.InvList {
+		if invVect.Type != wire.InvTypeSpork {
+			continue
+		}
+		sp.AddKnownInventory(invVect)
+		if !sp.server.sporkManager.HaveSpork(&invVect.Hash) {
+			getData.AddInvVect(invVect)
+		}
+	}
+	if len(getData.InvList) > 0 {
+		sp.QueueMessage(getData, nil)
+	}
+
 	if !cfg.BlocksOnly {
 		if len(msg.InvList) > 0 {
 			sp.server.syncManager.QueueInv(msg, sp.Peer)
@@ -676,6 +718,8 @@ func (sp *serverPeer) OnGetData(_ *peer.Peer, msg *wire.MsgGetData) {
 			err = sp.server.pushMerkleBlockMsg(sp, &iv.Hash, c, waitChan, wire.WitnessEncoding)
 		case wire.InvTypeFilteredBlock:
 			err = sp.server.pushMerkleBlockMsg(sp, &iv.Hash, c, waitChan, wire.BaseEncoding)
+		case wire.InvTypeSpork:
+			err = sp.server.pushSporkMsg(sp, &iv.Hash, c, waitChan)
 		default:
 			peerLog.Warnf("Unknown type in inventory request %d",
 				iv.Type)
@@ -1153,6 +1197,61 @@ func (sp *serverPeer) OnFeeFilter(_ *peer.Peer, msg *wire.MsgFeeFilter) {
 	atomic.StoreInt64(&sp.feeFilter, msg.MinFee)
 }
 
+// OnCLSig is invoked when a peer receives a clsig Dash message and is used to
+// process ChainLocks announced by remote peers.  ChainLocks which can't be
+// verified or which lock a block that is not known yet are ignored since
+// ChainLocks are routinely received while still syncing.
+func (sp *serverPeer) OnCLSig(_ *peer.Peer, msg *wire.MsgCLSig) {
+	_, err := sp.server.chain.ProcessChainLock(msg)
+	if err != nil {
+		peerLog.Debugf("Ignoring ChainLock for block %v at height %d "+
+			"from %v: %v", msg.BlockHash, msg.Height, sp, err)
+	}
+}
+
+// OnISDLock is invoked when a peer receives an isdlock Dash message and is used
+// to process InstantSend locks announced by remote peers.  Locks which can't be
+// verified or which conflict with known locks are ignored.
+func (sp *serverPeer) OnISDLock(_ *peer.Peer, msg *wire.MsgISDLock) {
+	isNew, err := sp.server.txMemPool.ProcessInstantLock(msg)
+	if err != nil {
+		peerLog.Debugf("Ignoring InstantSend lock for transaction %v "+
+			"from %v: %v", msg.TxID, sp, err)
+		return
+	}
+
+	// Notify websocket clients of the newly locked transaction.
+	if isNew && sp.server.rpcServer != nil {
+		sp.server.rpcServer.NotifyTxInstantLocked(msg)
+	}
+}
+
+// OnSpork is invoked when a peer receives a spork Dash message and is used to
+// track and relay the sporks signed by the spork keys of the network.  Peers
+// which send sporks that are not properly signed are banned.
+func (sp *serverPeer) OnSpork(_ *peer.Peer, msg *wire.MsgSpork) {
+	hash := msg.Hash()
+	iv := wire.NewInvVect(wire.InvTypeSpork, &hash)
+	sp.AddKnownInventory(iv)
+
+	isNew, err := sp.server.sporkManager.ProcessSpork(msg)
+	if err != nil {
+		sp.addBanScore(100, 0, fmt.Sprintf("invalid spork: %v", err))
+		return
+	}
+	if isNew {
+		sp.server.RelayInventory(iv, msg)
+	}
+}
+
+// OnGetSporks is invoked when a peer receives a getsporks Dash message and is
+// used to send the latest known sporks to the peer.
+func (sp *serverPeer) OnGetSporks(_ *peer.Peer, _ *wire.MsgGetSporks) {
+	for _, msg := range sp.server.sporkManager.Sporks() {
+		sp.QueueMessage(msg, nil)
+	}
+}
+
 // OnFilterAdd is invoked when a peer receives a filteradd bitcoin
 // message and is used by remote peers to add data to an already loaded bloom
 // filter.  The peer will be disconnected if a filter is not loaded when this
@@ -1458,6 +1557,31 @@ func (s *server) pushTxMsg(sp *serverPeer, hash *chainhash.Hash, doneChan chan<-
 	return nil
 }
 
+// pushSporkMsg sends a spork message for the provided spork hash to the
+// connected peer.  An error is returned if the spork hash is not known.
+func (s *server) pushSporkMsg(sp *serverPeer, hash *chainhash.Hash, doneChan chan<- struct{},
+	waitChan <-chan struct{}) error {
+
+	msg, ok := s.sporkManager.Spork(hash)
+	if !ok {
+		peerLog.Tracef("Unable to fetch spork %v", hash)
+
+		if doneChan != nil {
+			doneChan <- struct{}{}
+		}
+		return fmt.Errorf("spork %v not found", hash)
+	}
+
+	// Once we have fetched data wait for any previous operation to finish.
+	if waitChan != nil {
+		<-waitChan
+	}
+
+	sp.QueueMessage(msg, doneChan)
+
+	return nil
+}
+
 // pushBlockMsg sends a block message for the provided block hash to the
 // connected peer.  An error is returned if the block hash is not known.
 func (s *server) pushBlockMsg(sp *serverPeer, hash *chainhash.Hash, doneChan chan<- struct{},
@@ -2039,6 +2163,10 @@ func newPeerConfig(sp *serverPeer) *peer.Config {
 			OnGetCFHeaders: sp.OnGetCFHeaders,
 			OnGetCFCheckpt: sp.OnGetCFCheckpt,
 			OnFeeFilter:    sp.OnFeeFilter,
+			OnCLSig:        sp.OnCLSig,
+			OnISDLock:      sp.OnISDLock,
+			OnSpork:        sp.OnSpork,
+			OnGetSporks:    sp.OnGetSporks,
 			OnFilterAdd:    sp.OnFilterAdd,
 			OnFilterClear:  sp.OnFilterClear,
 			OnFilterLoad:   sp.OnFilterLoad,
@@ -2618,6 +2746,44 @@ func setupRPCListeners() ([]net.Listener, error) {
 	return listeners, nil
 }
 
+// newMasternodeList returns the deterministic masternode list of the passed
+// chain, which catches up with the chain and subscribes to its notifications,
+// and the verifier of quorum signatures backed by the quorums of the list.  The
+// chain verifies ChainLocks with the verifier from then on, while InstantSend
+// locks are verified with it by the mempool.
+func newMasternodeList(db database.DB, chain *blockchain.BlockChain,
+	chainParams *chaincfg.Params, interrupt <-chan struct{}) (
+	*masternodelist.Manager, *llmq.Verifier, error) {
+
+	mnList, err := masternodelist.NewManager(&masternodelist.Config{
+		DB:          db,
+		ChainParams: chainParams,
+		Interrupt:   interrupt,
+		BestHeight: func() int32 {
+			return chain.BestSnapshot().Height
+		},
+		BlockByHeight:     chain.BlockByHeight,
+		BlockHashByHeight: chain.BlockHashByHeight,
+		BlockHeightByHash: chain.BlockHeightByHash,
+		MainChainHasBlock: chain.MainChainHasBlock,
+		Subscribe:         chain.Subscribe,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	verifier := llmq.NewVerifier(&llmq.VerifierConfig{
+		ChainParams:   chainParams,
+		ActiveQuorums: mnList.ActiveQuorums,
+		BestHeight: func() int32 {
+			return chain.BestSnapshot().Height
+		},
+		BlockHeightByHash: chain.BlockHeightByHash,
+	})
+	chain.SetChainLockVerifier(verifier)
+	return mnList, verifier, nil
+}
+
 // newServer returns a new btcd server configured to listen on addr for the
 // bitcoin network type specified by chainParams.  Use start to begin accepting
 // connections from peers.
@@ -2625,6 +2791,35 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 	db database.DB, chainParams *chaincfg.Params,
 	interrupt <-chan struct{}) (*server, error) {
 
+	// The blocks below the base of a utxo set snapshot are not available
+	// until they have been validated in the background, so the optional
+	// indexes and the masternode list which are built from all blocks
+	// can't be used until then.
+	snapshotPending := cfg.LoadSnapshot != ""
+	if !snapshotPending {
+		var err error
+		snapshotPending, err = blockchain.SnapshotValidationPending(db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if snapshotPending {
+		if cfg.TxIndex || cfg.AddrIndex || cfg.AddressIndex ||
+			cfg.SpentIndex || cfg.TimestampIndex ||
+			cfg.SpecialTxIndex || cfg.MNList {
+
+			return nil, errors.New("the optional indexes and the " +
+				"masternode list can't be enabled until the blocks " +
+				"below the utxo set snapshot have been validated")
+		}
+		if !cfg.NoCFilters {
+			indxLog.Info("Committed filter index is disabled until " +
+				"the blocks below the utxo set snapshot have been " +
+				"validated")
+			cfg.NoCFilters = true
+		}
+	}
+
 	services := defaultServices
 	if cfg.NoPeerBloomFilters {
 		services &^= wire.SFNodeBloom
@@ -2632,6 +2827,10 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 	if cfg.NoCFilters {
 		services &^= wire.SFNodeCF
 	}
+	if cfg.Prune != 0 {
+		services &^= wire.SFNodeNetwork
+		services |= wire.SFNodeNetworkLimited
+	}
 
 	amgr := addrmgr.New(cfg.DataDir, btcdLookup)
 
@@ -2704,6 +2903,26 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 		s.addrIndex = indexers.NewAddrIndex(db, chainParams)
 		indexes = append(indexes, s.addrIndex)
 	}
+	if cfg.AddressIndex {
+		indxLog.Info("Address balance index is enabled")
+		s.addrDeltaIndex = indexers.NewAddrDeltaIndex(db, chainParams)
+		indexes = append(indexes, s.addrDeltaIndex)
+	}
+	if cfg.SpentIndex {
+		indxLog.Info("Spent index is enabled")
+		s.spentIndex = indexers.NewSpentIndex(db)
+		indexes = append(indexes, s.spentIndex)
+	}
+	if cfg.TimestampIndex {
+		indxLog.Info("Timestamp index is enabled")
+		s.timestampIndex = indexers.NewTimestampIndex(db)
+		indexes = append(indexes, s.timestampIndex)
+	}
+	if cfg.SpecialTxIndex {
+		indxLog.Info("Special transaction index is enabled")
+		s.specialTxIndex = indexers.NewSpecialTxIndex(db)
+		indexes = append(indexes, s.specialTxIndex)
+	}
 	if !cfg.NoCFilters {
 		indxLog.Info("Committed filter index is enabled")
 		s.cfIndex = indexers.NewCfIndex(db, chainParams)
@@ -2722,6 +2941,17 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 		checkpoints = mergeCheckpoints(s.chainParams.Checkpoints, cfg.addCheckpoints)
 	}
 
+	// Open the utxo set snapshot to start the chain from if needed.
+	var utxoSnapshot io.Reader
+	if cfg.LoadSnapshot != "" {
+		f, err := os.Open(cfg.LoadSnapshot)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		utxoSnapshot = bufio.NewReader(f)
+	}
+
 	// Create a new block chain instance with the appropriate configuration.
 	var err error
 	s.chain, err = blockchain.New(&blockchain.Config{
@@ -2733,6 +2963,35 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 		SigCache:     s.sigCache,
 		IndexManager: indexManager,
 		HashCache:    s.hashCache,
+		Prune:        cfg.Prune * 1024 * 1024,
+		UtxoSnapshot: utxoSnapshot,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the deterministic masternode list if needed.
+	if cfg.MNList {
+		srvrLog.Info("Masternode list is enabled")
+		s.mnList, s.quorumVerifier, err = newMasternodeList(s.db,
+			s.chain, s.chainParams, interrupt)
+		if err != nil {
+			return nil, err
+		}
+
+		// Reject blocks which don't pay the masternodes selected by
+		// the list from now on when requested.
+		if cfg.EnforceMNPayments {
+			srvrLog.Info("Masternode payments are enforced")
+			s.chain.SetMasternodePayeeSource(s.mnList)
+		}
+	}
+
+	// Track the sporks relayed by peers to serve the spork command and
+	// getsporks requests.
+	s.sporkManager, err = spork.NewManager(&spork.Config{
+		ChainParams: s.chainParams,
+		TimeSource:  s.timeSource,
 	})
 	if err != nil {
 		return nil, err
@@ -2793,6 +3052,9 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 		AddrIndex:          s.addrIndex,
 		FeeEstimator:       s.feeEstimator,
 	}
+	if s.quorumVerifier != nil {
+		txC.VerifyInstantLock = s.quorumVerifier.VerifyInstantLock
+	}
 	s.txMemPool = mempool.New(&txC)
 
 	s.syncManager, err = netsync.New(&netsync.Config{
@@ -2808,6 +3070,13 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 		return nil, err
 	}
 
+	// Signal process shutdown when the sync manager requests it because
+	// the chain can't continue.
+	go func() {
+		<-s.syncManager.RequestedProcessShutdown()
+		shutdownRequestChannel <- struct{}{}
+	}()
+
 	// Create the mining policy and block template generator based on the
 	// configuration options.
 	//
@@ -2930,21 +3199,27 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 		}
 
 		s.rpcServer, err = newRPCServer(&rpcserverConfig{
-			Listeners:    rpcListeners,
-			StartupTime:  s.startupTime,
-			ConnMgr:      &rpcConnManager{&s},
-			SyncMgr:      &rpcSyncMgr{&s, s.syncManager},
-			TimeSource:   s.timeSource,
-			Chain:        s.chain,
-			ChainParams:  chainParams,
-			DB:           db,
-			TxMemPool:    s.txMemPool,
-			Generator:    blockTemplateGenerator,
-			CPUMiner:     s.cpuMiner,
-			TxIndex:      s.txIndex,
-			AddrIndex:    s.addrIndex,
-			CfIndex:      s.cfIndex,
-			FeeEstimator: s.feeEstimator,
+			Listeners:      rpcListeners,
+			StartupTime:    s.startupTime,
+			ConnMgr:        &rpcConnManager{&s},
+			SyncMgr:        &rpcSyncMgr{&s, s.syncManager},
+			TimeSource:     s.timeSource,
+			Chain:          s.chain,
+			ChainParams:    chainParams,
+			DB:             db,
+			TxMemPool:      s.txMemPool,
+			Generator:      blockTemplateGenerator,
+			CPUMiner:       s.cpuMiner,
+			TxIndex:        s.txIndex,
+			AddrIndex:      s.addrIndex,
+			CfIndex:        s.cfIndex,
+			AddrDeltaIndex: s.addrDeltaIndex,
+			SpentIndex:     s.spentIndex,
+			TimestampIndex: s.timestampIndex,
+			SpecialTxIndex: s.specialTxIndex,
+			MNList:         s.mnList,
+			SporkManager:   s.sporkManager,
+			FeeEstimator:   s.feeEstimator,
 		})
 		if err != nil {
 			return nil, err
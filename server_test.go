@@ -0,0 +1,329 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/rand"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btclog"
+	"github.com/dashpay/dashd-go/blockchain"
+	"github.com/dashpay/dashd-go/blscrypto"
+	"github.com/dashpay/dashd-go/btcjson"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/database"
+	_ "github.com/dashpay/dashd-go/database/ffldb"
+	"github.com/dashpay/dashd-go/llmq"
+	"github.com/dashpay/dashd-go/masternodelist"
+	"github.com/dashpay/dashd-go/txscript"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/dashpay/dashd-go/wire/evo"
+)
+
+// quorumTestChain builds regression test blocks with coinbase special
+// transactions committing to an empty masternode list.
+type quorumTestChain struct {
+	t        *testing.T
+	params   *chaincfg.Params
+	chain    *blockchain.BlockChain
+	prevHash chainhash.Hash
+	prevTime time.Time
+	height   int32
+}
+
+// addBlock processes the next block with the passed transactions after the
+// coinbase and returns it.
+func (c *quorumTestChain) addBlock(txs ...*wire.MsgTx) *btcutil.Block {
+	c.t.Helper()
+
+	c.height++
+	coinbaseScript, err := txscript.NewScriptBuilder().
+		AddInt64(int64(c.height)).AddInt64(0).Script()
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	coinbase := wire.NewMsgTx(wire.TxVersion)
+	coinbase.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
+			wire.MaxPrevOutIndex),
+		SignatureScript: coinbaseScript,
+		Sequence:        wire.MaxTxInSequenceNum,
+	})
+	coinbase.AddTxOut(wire.NewTxOut(0, []byte{txscript.OP_TRUE}))
+	if c.height >= c.params.DIP0003Height {
+		emptyList := masternodelist.NewDeterministicMNList(&c.prevHash,
+			c.height)
+		coinbase.Version = wire.SpecialTxVersion
+		coinbase.Type = wire.TxTypeCoinbase
+		err := evo.SetTxPayload(coinbase, &evo.CbTx{
+			Version:          evo.CbTxVersionMerkleRootMNList,
+			Height:           c.height,
+			MerkleRootMNList: emptyList.SimplifiedMNList().MerkleRoot(),
+		})
+		if err != nil {
+			c.t.Fatalf("SetTxPayload: unexpected error: %v", err)
+		}
+	}
+
+	c.prevTime = c.prevTime.Add(c.params.TargetTimePerBlock)
+	msgBlock := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:   4,
+			PrevBlock: c.prevHash,
+			Timestamp: c.prevTime,
+			Bits:      c.params.PowLimitBits,
+		},
+		Transactions: append([]*wire.MsgTx{coinbase}, txs...),
+	}
+	merkles := blockchain.BuildMerkleTreeStore(
+		btcutil.NewBlock(msgBlock).Transactions(), false)
+	msgBlock.Header.MerkleRoot = *merkles[len(merkles)-1]
+	target := blockchain.CompactToBig(c.params.PowLimitBits)
+	for {
+		powHash := msgBlock.Header.PowHash()
+		if blockchain.HashToBig(&powHash).Cmp(target) <= 0 {
+			break
+		}
+		msgBlock.Header.Nonce++
+	}
+
+	block := btcutil.NewBlock(msgBlock)
+	_, isOrphan, err := c.chain.ProcessBlock(block, blockchain.BFNone)
+	if err != nil || isOrphan {
+		c.t.Fatalf("ProcessBlock at height %d: got orphan %v, %v",
+			c.height, isOrphan, err)
+	}
+	c.prevHash = *block.Hash()
+	return block
+}
+
+// newQuorumTestChain returns a regression test chain with a masternode list
+// set up like the server does, together with the verifier of the list.
+func newQuorumTestChain(t *testing.T) (*quorumTestChain, *llmq.Verifier) {
+	// The log rotator is not initialized by tests.
+	blockchain.UseLogger(btclog.Disabled)
+	masternodelist.UseLogger(btclog.Disabled)
+
+	params := chaincfg.RegressionNetParams
+	params.DIP0003Height = 20
+	params.DIP0003EnforcementHeight = 20
+
+	db, err := database.Create("ffldb", filepath.Join(t.TempDir(), "ffldb"),
+		params.Net)
+	if err != nil {
+		t.Fatalf("database.Create: unexpected error: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	chain, err := blockchain.New(&blockchain.Config{
+		DB:          db,
+		ChainParams: &params,
+		TimeSource:  blockchain.NewMedianTime(),
+		SigCache:    txscript.NewSigCache(1000),
+	})
+	if err != nil {
+		t.Fatalf("blockchain.New: unexpected error: %v", err)
+	}
+
+	_, verifier, err := newMasternodeList(db, chain, &params, nil)
+	if err != nil {
+		t.Fatalf("newMasternodeList: unexpected error: %v", err)
+	}
+	return &quorumTestChain{
+		t:        t,
+		params:   &params,
+		chain:    chain,
+		prevHash: *params.GenesisHash,
+		prevTime: params.GenesisBlock.Header.Timestamp,
+	}, verifier
+}
+
+// commitmentTx returns a final commitment transaction of the quorum of the
+// passed type, index and hash with the public key of the passed secret key.
+func commitmentTx(t *testing.T, llmqType btcjson.LLMQType, quorumIndex int16,
+	quorumHash *chainhash.Hash, sk *blscrypto.SecretKey) *wire.MsgTx {
+
+	llmqParams, _ := llmqType.Params()
+	qc := wire.QuorumCommitment{
+		Version:      wire.QuorumCommitmentVersionBasic,
+		LLMQType:     uint8(llmqType),
+		QuorumHash:   *quorumHash,
+		QuorumIndex:  quorumIndex,
+		Signers:      make([]bool, llmqParams.Size),
+		ValidMembers: make([]bool, llmqParams.Size),
+	}
+	if llmqParams.UseRotation {
+		qc.Version = wire.QuorumCommitmentVersionBasicIndexed
+	}
+	qc.ValidMembers[0] = true
+	copy(qc.QuorumPublicKey[:],
+		sk.PublicKey().Serialize(blscrypto.SchemeBasic))
+	tx := wire.NewMsgTx(wire.SpecialTxVersion)
+	tx.Type = wire.TxTypeQuorumCommitment
+	err := evo.SetTxPayload(tx, &evo.QcTx{Version: 1, Commitment: qc})
+	if err != nil {
+		t.Fatalf("SetTxPayload: unexpected error: %v", err)
+	}
+	return tx
+}
+
+// TestNewMasternodeListChainLocks ensures the chain set up with a masternode
+// list verifies ChainLocks against the quorums mined in the chain.
+func TestNewMasternodeListChainLocks(t *testing.T) {
+	c, _ := newQuorumTestChain(t)
+	chain := c.chain
+
+	// Mine the final commitment of a ChainLocks quorum formed at the
+	// first DKG interval.
+	llmqType := c.params.LLMQTypeChainLocks
+	llmqParams, _ := llmqType.Params()
+	var quorumHash chainhash.Hash
+	for c.height < int32(llmqParams.DKGInterval) {
+		quorumHash = *c.addBlock().Hash()
+	}
+	sk, err := blscrypto.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: unexpected error: %v", err)
+	}
+	qcTx := commitmentTx(t, llmqType, 0, &quorumHash, sk)
+	for c.height < int32(llmqParams.DKGInterval+
+		llmqParams.DKGMiningWindowStart)-1 {
+
+		c.addBlock()
+	}
+	c.addBlock(qcTx)
+
+	// signedChainLock returns a ChainLock of the passed block signed by
+	// the passed key.
+	signedChainLock := func(block *btcutil.Block,
+		sk *blscrypto.SecretKey) *wire.MsgCLSig {
+
+		clsig := &wire.MsgCLSig{
+			Height:    block.Height(),
+			BlockHash: *block.Hash(),
+		}
+		requestID := clsig.RequestID()
+		signHash := llmq.SignHash(btcjson.LLMQType(llmqType),
+			&quorumHash, &requestID, &clsig.BlockHash)
+		sig, err := sk.Sign(signHash[:])
+		if err != nil {
+			t.Fatalf("Sign: unexpected error: %v", err)
+		}
+		copy(clsig.Signature[:], sig.Serialize(blscrypto.SchemeBasic))
+		return clsig
+	}
+
+	// A ChainLock whose sign height is before the quorum was mined is
+	// rejected.
+	block := c.addBlock()
+	_, err = chain.ProcessChainLock(signedChainLock(block, sk))
+	var rerr blockchain.RuleError
+	if !errors.As(err, &rerr) || rerr.ErrorCode != blockchain.ErrBadChainLockSig {
+		t.Fatalf("ProcessChainLock before quorum: got %v, want %v", err,
+			blockchain.ErrBadChainLockSig)
+	}
+
+	// Once the quorum is active, only ChainLocks signed with its key are
+	// accepted.
+	for i := 0; i < llmq.SignHeightOffset; i++ {
+		block = c.addBlock()
+	}
+	otherKey, err := blscrypto.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: unexpected error: %v", err)
+	}
+	_, err = chain.ProcessChainLock(signedChainLock(block, otherKey))
+	if !errors.As(err, &rerr) || rerr.ErrorCode != blockchain.ErrBadChainLockSig {
+		t.Fatalf("ProcessChainLock with other key: got %v, want %v", err,
+			blockchain.ErrBadChainLockSig)
+	}
+	isNew, err := chain.ProcessChainLock(signedChainLock(block, sk))
+	if err != nil || !isNew {
+		t.Fatalf("ProcessChainLock: got %v, %v, want new ChainLock",
+			isNew, err)
+	}
+	if !chain.IsBlockChainLocked(block.Hash()) {
+		t.Fatalf("block %v is not locked", block.Hash())
+	}
+}
+
+// TestNewMasternodeListInstantLocks ensures the verifier set up with a
+// masternode list verifies InstantSend locks against the rotating quorums mined
+// in the chain.
+func TestNewMasternodeListInstantLocks(t *testing.T) {
+	c, verifier := newQuorumTestChain(t)
+
+	// Mine the final commitments of the rotating InstantSend quorums of
+	// the cycle starting at the first DKG interval.
+	llmqType := c.params.LLMQTypeDIP0024InstantSend
+	llmqParams, _ := llmqType.Params()
+	for c.height < int32(llmqParams.DKGInterval)-1 {
+		c.addBlock()
+	}
+	cycleHash := *c.addBlock().Hash()
+	quorumHashes := []chainhash.Hash{cycleHash}
+	for i := 1; i < llmqParams.SigningActiveQuorumCount; i++ {
+		quorumHashes = append(quorumHashes, *c.addBlock().Hash())
+	}
+	var qcTxs []*wire.MsgTx
+	keys := make([]*blscrypto.SecretKey, 0, len(quorumHashes))
+	for i := range quorumHashes {
+		sk, err := blscrypto.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatalf("GenerateKey: unexpected error: %v", err)
+		}
+		keys = append(keys, sk)
+		qcTxs = append(qcTxs, commitmentTx(t, llmqType, int16(i),
+			&quorumHashes[i], sk))
+	}
+	for c.height < int32(llmqParams.DKGInterval+
+		llmqParams.DKGMiningWindowStart)-1 {
+
+		c.addBlock()
+	}
+	c.addBlock(qcTxs...)
+
+	// signedLock returns a lock of the cycle signed by the quorum of the
+	// request with the key of the quorum with the passed offset from it.
+	signedLock := func(offset int) *wire.MsgISDLock {
+		islock := &wire.MsgISDLock{
+			Version:   1,
+			Inputs:    []wire.OutPoint{{Hash: chainhash.Hash{0x01}}},
+			TxID:      chainhash.Hash{0x02},
+			CycleHash: cycleHash,
+		}
+		requestID := islock.RequestID()
+		quorums := make([]*llmq.Quorum, len(quorumHashes))
+		for i := range quorums {
+			quorums[i] = &llmq.Quorum{Index: int16(i)}
+		}
+		quorum, err := llmq.SelectRotatedQuorum(&requestID, quorums)
+		if err != nil {
+			t.Fatalf("SelectRotatedQuorum: unexpected error: %v", err)
+		}
+		index := (int(quorum.Index) + offset) % len(keys)
+		signHash := llmq.SignHash(llmqType, &quorumHashes[quorum.Index],
+			&requestID, &islock.TxID)
+		sig, err := keys[index].Sign(signHash[:])
+		if err != nil {
+			t.Fatalf("Sign: unexpected error: %v", err)
+		}
+		copy(islock.Signature[:], sig.Serialize(blscrypto.SchemeBasic))
+		return islock
+	}
+
+	if err := verifier.VerifyInstantLock(signedLock(0)); err != nil {
+		t.Fatalf("VerifyInstantLock: unexpected error: %v", err)
+	}
+	err := verifier.VerifyInstantLock(signedLock(1))
+	if !errors.Is(err, blscrypto.ErrInvalidSignature) {
+		t.Fatalf("VerifyInstantLock signed by other quorum: got %v, "+
+			"want %v", err, blscrypto.ErrInvalidSignature)
+	}
+}
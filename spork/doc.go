@@ -0,0 +1,26 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package spork tracks the Dash sporks of a network.
+
+Sporks are network wide switches which are flipped by the holders of the spork
+keys of a network without a new release of the node software, for example to
+enable ChainLocks or to require quorum members to connect to each other.  The
+value of a spork is usually a time: the spork is active once the network time
+passes the value.  Some sporks are used as plain numbers instead.
+
+Sporks are relayed as spork messages (wire.MsgSpork) which carry the spork id,
+its value, the time it was signed at and a compact ECDSA signature by a spork
+key.  The signature is of the signature hash of the spork, which excludes the
+signature, while sporks are announced by the hash of the whole message.  The
+P2PKH addresses of the spork keys of a network and the number of keys which
+must agree on a value are defined by the SporkAddresses and MinSporkKeys fields
+of chaincfg.Params.
+
+A Manager verifies received sporks with ProcessSpork and keeps the latest spork
+of every signer.  Value and IsActive return the resulting state of a spork, and
+Sporks returns the messages to send in response to a getsporks request.
+*/
+package spork
@@ -0,0 +1,253 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package spork
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dashpay/dashd-go/blockchain"
+	"github.com/dashpay/dashd-go/btcec/v2"
+	"github.com/dashpay/dashd-go/btcec/v2/ecdsa"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// maxTimeSignedOffset is the maximum amount of time the signing time of a
+// spork may be ahead of the adjusted time.
+const maxTimeSignedOffset = 2 * time.Hour
+
+// keyID is the hash160 of the public key of a spork signer.
+type keyID [20]byte
+
+// Config is the configuration of a Manager.
+type Config struct {
+	// ChainParams are the parameters of the network which define the
+	// spork keys.
+	ChainParams *chaincfg.Params
+
+	// TimeSource is used to reject sporks signed in the future and to
+	// decide whether a spork is active.
+	TimeSource blockchain.MedianTimeSource
+}
+
+// Manager tracks the latest spork messages signed by the spork keys of the
+// network and the spork values they result in.
+type Manager struct {
+	cfg     Config
+	signers map[keyID]struct{}
+
+	mtx    sync.RWMutex
+	sporks map[ID]map[keyID]*wire.MsgSpork
+	byHash map[chainhash.Hash]*wire.MsgSpork
+}
+
+// NewManager returns a spork manager for the network of the passed chain
+// parameters.  An error is returned when a spork address of the network is
+// not a valid P2PKH address.
+func NewManager(cfg *Config) (*Manager, error) {
+	signers := make(map[keyID]struct{}, len(cfg.ChainParams.SporkAddresses))
+	for _, encoded := range cfg.ChainParams.SporkAddresses {
+		addr, err := btcutil.DecodeAddress(encoded, cfg.ChainParams)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spork address %v: %v",
+				encoded, err)
+		}
+		pkh, ok := addr.(*btcutil.AddressPubKeyHash)
+		if !ok {
+			return nil, fmt.Errorf("spork address %v is not a "+
+				"pay-to-pubkey-hash address", encoded)
+		}
+		signers[*pkh.Hash160()] = struct{}{}
+	}
+
+	return &Manager{
+		cfg:     *cfg,
+		signers: signers,
+		sporks:  make(map[ID]map[keyID]*wire.MsgSpork),
+		byHash:  make(map[chainhash.Hash]*wire.MsgSpork),
+	}, nil
+}
+
+// Sign signs the passed spork with the passed spork key.
+func Sign(msg *wire.MsgSpork, key *btcec.PrivateKey) error {
+	hash := msg.SignatureHash()
+	sig, err := ecdsa.SignCompact(key, hash[:], true)
+	if err != nil {
+		return err
+	}
+	msg.Signature = sig
+
+	return nil
+}
+
+// signer returns the key id of the key which signed the passed spork.
+func signer(msg *wire.MsgSpork) (keyID, error) {
+	hash := msg.SignatureHash()
+	pubKey, compressed, err := ecdsa.RecoverCompact(msg.Signature, hash[:])
+	if err != nil {
+		return keyID{}, err
+	}
+
+	var serialized []byte
+	if compressed {
+		serialized = pubKey.SerializeCompressed()
+	} else {
+		serialized = pubKey.SerializeUncompressed()
+	}
+
+	var id keyID
+	copy(id[:], btcutil.Hash160(serialized))
+	return id, nil
+}
+
+// ProcessSpork verifies the passed spork and makes it the latest spork of its
+// signer for its id.  It returns whether the spork is new.  Sporks which are
+// not newer than the latest spork of the same signer are ignored.
+//
+// An error is returned when the spork is not signed by a spork key of the
+// network or was signed too far in the future.  Peers which relay such sporks
+// misbehave.
+func (m *Manager) ProcessSpork(msg *wire.MsgSpork) (bool, error) {
+	now := m.cfg.TimeSource.AdjustedTime()
+	if time.Unix(msg.TimeSigned, 0).After(now.Add(maxTimeSignedOffset)) {
+		return false, fmt.Errorf("spork %v signed too far in the "+
+			"future at %v", ID(msg.SporkID), time.Unix(msg.TimeSigned, 0))
+	}
+
+	signerID, err := signer(msg)
+	if err != nil {
+		return false, fmt.Errorf("invalid signature of spork %v: %v",
+			ID(msg.SporkID), err)
+	}
+	if _, ok := m.signers[signerID]; !ok {
+		return false, fmt.Errorf("spork %v is not signed by a spork "+
+			"key", ID(msg.SporkID))
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	id := ID(msg.SporkID)
+	bySigner := m.sporks[id]
+	if bySigner == nil {
+		bySigner = make(map[keyID]*wire.MsgSpork)
+		m.sporks[id] = bySigner
+	}
+	prev := bySigner[signerID]
+	if prev != nil && prev.TimeSigned >= msg.TimeSigned {
+		return false, nil
+	}
+
+	oldValue := m.value(id)
+	if prev != nil {
+		delete(m.byHash, prev.Hash())
+	}
+	bySigner[signerID] = msg
+	m.byHash[msg.Hash()] = msg
+
+	if newValue := m.value(id); newValue != oldValue {
+		log.Infof("Spork %v changed from %d to %d", id, oldValue,
+			newValue)
+	}
+
+	return true, nil
+}
+
+// value returns the value of the passed spork.  It is the value signed by at
+// least MinSporkKeys spork keys, or the default value of the spork when the
+// keys don't agree.  When several values reach the threshold, the most
+// recently signed one is used.
+//
+// This function MUST be called with the manager lock held (for reads).
+func (m *Manager) value(id ID) int64 {
+	counts := make(map[int64]int)
+	latest := make(map[int64]int64)
+	for _, msg := range m.sporks[id] {
+		counts[msg.Value]++
+		if msg.TimeSigned > latest[msg.Value] {
+			latest[msg.Value] = msg.TimeSigned
+		}
+	}
+
+	value, timeSigned, found := id.DefaultValue(), int64(0), false
+	for v, count := range counts {
+		if count < m.cfg.ChainParams.MinSporkKeys {
+			continue
+		}
+		if !found || latest[v] > timeSigned {
+			value, timeSigned, found = v, latest[v], true
+		}
+	}
+
+	return value
+}
+
+// Value returns the current value of the passed spork.
+//
+// This function is safe for concurrent access.
+func (m *Manager) Value(id ID) int64 {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.value(id)
+}
+
+// IsActive returns whether the passed spork is active, which is the case once
+// the adjusted time passed its value.
+//
+// This function is safe for concurrent access.
+func (m *Manager) IsActive(id ID) bool {
+	return m.Value(id) < m.cfg.TimeSource.AdjustedTime().Unix()
+}
+
+// HaveSpork returns whether the spork with the passed hash is the latest spork
+// of its signer.
+//
+// This function is safe for concurrent access.
+func (m *Manager) HaveSpork(hash *chainhash.Hash) bool {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	_, ok := m.byHash[*hash]
+	return ok
+}
+
+// Spork returns the spork with the passed hash if it is the latest spork of
+// its signer.
+//
+// This function is safe for concurrent access.
+func (m *Manager) Spork(hash *chainhash.Hash) (*wire.MsgSpork, bool) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	msg, ok := m.byHash[*hash]
+	return msg, ok
+}
+
+// Sporks returns the latest spork of every signer for every spork id ordered
+// by id.  They are sent in response to getsporks messages.
+//
+// This function is safe for concurrent access.
+func (m *Manager) Sporks() []*wire.MsgSpork {
+	m.mtx.RLock()
+	msgs := make([]*wire.MsgSpork, 0, len(m.byHash))
+	for _, msg := range m.byHash {
+		msgs = append(msgs, msg)
+	}
+	m.mtx.RUnlock()
+
+	sort.Slice(msgs, func(i, j int) bool {
+		if msgs[i].SporkID != msgs[j].SporkID {
+			return msgs[i].SporkID < msgs[j].SporkID
+		}
+		return msgs[i].TimeSigned < msgs[j].TimeSigned
+	})
+	return msgs
+}
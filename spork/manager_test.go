@@ -0,0 +1,187 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package spork
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dashpay/dashd-go/btcec/v2"
+	"github.com/dashpay/dashd-go/btcec/v2/ecdsa"
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// fixedTimeSource is a blockchain.MedianTimeSource which always returns the
+// same time.
+type fixedTimeSource struct {
+	now time.Time
+}
+
+func (s fixedTimeSource) AdjustedTime() time.Time         { return s.now }
+func (s fixedTimeSource) AddTimeSample(string, time.Time) {}
+func (s fixedTimeSource) Offset() time.Duration           { return 0 }
+
+// testKey returns a deterministic private key derived from the passed seed.
+func testKey(seed byte) *btcec.PrivateKey {
+	var b [32]byte
+	b[31] = seed
+	key, _ := btcec.PrivKeyFromBytes(b[:])
+	return key
+}
+
+// newTestManager returns a manager whose spork keys are the passed keys.
+func newTestManager(t *testing.T, now time.Time, minKeys int,
+	keys ...*btcec.PrivateKey) *Manager {
+
+	params := chaincfg.RegressionNetParams
+	params.SporkAddresses = nil
+	params.MinSporkKeys = minKeys
+	for _, key := range keys {
+		pkh := btcutil.Hash160(key.PubKey().SerializeCompressed())
+		addr, err := btcutil.NewAddressPubKeyHash(pkh, &params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		params.SporkAddresses = append(params.SporkAddresses,
+			addr.EncodeAddress())
+	}
+
+	m, err := NewManager(&Config{
+		ChainParams: &params,
+		TimeSource:  fixedTimeSource{now},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// signedSpork returns a spork signed by the passed key.
+func signedSpork(t *testing.T, key *btcec.PrivateKey, id ID, value,
+	timeSigned int64) *wire.MsgSpork {
+
+	msg := wire.NewMsgSpork(int32(id), value, timeSigned)
+	if err := Sign(msg, key); err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+// TestProcessSpork ensures sporks are only accepted from the spork keys and
+// that the latest spork of a signer determines the value.
+func TestProcessSpork(t *testing.T) {
+	now := time.Unix(1633024800, 0)
+	sporkKey, otherKey := testKey(1), testKey(2)
+	m := newTestManager(t, now, 1, sporkKey)
+
+	id := Spork21QuorumAllConnected
+	if v := m.Value(id); v != valueOff {
+		t.Fatalf("Value: got %d before any spork, want %d", v, valueOff)
+	}
+	if m.IsActive(id) {
+		t.Fatal("IsActive: spork active before any spork")
+	}
+
+	// A spork signed by the spork key changes the value.
+	msg := signedSpork(t, sporkKey, id, 0, now.Unix()-10)
+	isNew, err := m.ProcessSpork(msg)
+	if err != nil || !isNew {
+		t.Fatalf("ProcessSpork: got (%v, %v), want (true, nil)", isNew,
+			err)
+	}
+	if v := m.Value(id); v != 0 {
+		t.Fatalf("Value: got %d, want 0", v)
+	}
+	if !m.IsActive(id) {
+		t.Fatal("IsActive: spork with value 0 is not active")
+	}
+	hash := msg.Hash()
+	if !m.HaveSpork(&hash) {
+		t.Fatal("HaveSpork: processed spork not found")
+	}
+
+	// The same spork again and older sporks are ignored.
+	if isNew, err := m.ProcessSpork(msg); err != nil || isNew {
+		t.Fatalf("ProcessSpork: got (%v, %v) for known spork, want "+
+			"(false, nil)", isNew, err)
+	}
+	older := signedSpork(t, sporkKey, id, 1, now.Unix()-20)
+	if isNew, err := m.ProcessSpork(older); err != nil || isNew {
+		t.Fatalf("ProcessSpork: got (%v, %v) for older spork, want "+
+			"(false, nil)", isNew, err)
+	}
+	if v := m.Value(id); v != 0 {
+		t.Fatalf("Value: older spork changed value to %d", v)
+	}
+
+	// A newer spork replaces the previous one of the signer.
+	newer := signedSpork(t, sporkKey, id, valueOff, now.Unix())
+	if isNew, err := m.ProcessSpork(newer); err != nil || !isNew {
+		t.Fatalf("ProcessSpork: got (%v, %v) for newer spork, want "+
+			"(true, nil)", isNew, err)
+	}
+	if m.IsActive(id) || m.HaveSpork(&hash) {
+		t.Fatal("ProcessSpork: newer spork did not replace old one")
+	}
+	if sporks := m.Sporks(); len(sporks) != 1 || sporks[0] != newer {
+		t.Fatalf("Sporks: got %v, want only the newer spork", sporks)
+	}
+
+	// Sporks of other keys, with invalid signatures or signed too far in
+	// the future are rejected.
+	rejected := []*wire.MsgSpork{
+		signedSpork(t, otherKey, id, 0, now.Unix()+1),
+		signedSpork(t, sporkKey, id, 0, now.Add(3*time.Hour).Unix()),
+	}
+	invalid := signedSpork(t, sporkKey, id, 0, now.Unix()+1)
+	invalid.Value = 1
+	rejected = append(rejected, invalid)
+
+	// The signature must be of the signature hash, which excludes the
+	// signature, rather than of the hash announced in inventory vectors.
+	wrongHash := wire.NewMsgSpork(int32(id), 0, now.Unix()+1)
+	invHash := wrongHash.Hash()
+	sig, err := ecdsa.SignCompact(sporkKey, invHash[:], true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrongHash.Signature = sig
+	rejected = append(rejected, wrongHash)
+	for i, msg := range rejected {
+		if _, err := m.ProcessSpork(msg); err == nil {
+			t.Errorf("ProcessSpork #%d: unexpected success", i)
+		}
+	}
+	if v := m.Value(id); v != valueOff {
+		t.Fatalf("Value: rejected spork changed value to %d", v)
+	}
+}
+
+// TestMinSporkKeys ensures a value is only taken once enough spork keys sign
+// it.
+func TestMinSporkKeys(t *testing.T) {
+	now := time.Unix(1633024800, 0)
+	key1, key2, key3 := testKey(1), testKey(2), testKey(3)
+	m := newTestManager(t, now, 2, key1, key2, key3)
+
+	id := Spork19ChainLocksEnabled
+	sporks := []*wire.MsgSpork{
+		signedSpork(t, key1, id, 0, now.Unix()-30),
+		signedSpork(t, key2, id, 1, now.Unix()-20),
+		signedSpork(t, key3, id, 1, now.Unix()-10),
+	}
+	wantValues := []int64{valueOff, valueOff, 1}
+	for i, msg := range sporks {
+		if _, err := m.ProcessSpork(msg); err != nil {
+			t.Fatalf("ProcessSpork #%d: unexpected error: %v", i, err)
+		}
+		if v := m.Value(id); v != wantValues[i] {
+			t.Fatalf("Value #%d: got %d, want %d", i, v,
+				wantValues[i])
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package spork
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ID identifies a spork.
+type ID int32
+
+// These constants define the sporks known to the node.
+const (
+	Spork2InstantSendEnabled        ID = 10001
+	Spork3InstantSendBlockFiltering ID = 10002
+	Spork9SuperblocksEnabled        ID = 10008
+	Spork17QuorumDKGEnabled         ID = 10016
+	Spork19ChainLocksEnabled        ID = 10018
+	Spork21QuorumAllConnected       ID = 10020
+	Spork23QuorumPoSe               ID = 10022
+)
+
+// valueOff is the default value of the sporks.  It is a time far in the
+// future, so the sporks are inactive until a spork key signs a lower value.
+const valueOff = 4070908800
+
+// sporkDef describes a known spork.
+type sporkDef struct {
+	name         string
+	defaultValue int64
+}
+
+// sporkDefs maps the known sporks to their names and default values.
+var sporkDefs = map[ID]sporkDef{
+	Spork2InstantSendEnabled:        {"SPORK_2_INSTANTSEND_ENABLED", valueOff},
+	Spork3InstantSendBlockFiltering: {"SPORK_3_INSTANTSEND_BLOCK_FILTERING", valueOff},
+	Spork9SuperblocksEnabled:        {"SPORK_9_SUPERBLOCKS_ENABLED", valueOff},
+	Spork17QuorumDKGEnabled:         {"SPORK_17_QUORUM_DKG_ENABLED", valueOff},
+	Spork19ChainLocksEnabled:        {"SPORK_19_CHAINLOCKS_ENABLED", valueOff},
+	Spork21QuorumAllConnected:       {"SPORK_21_QUORUM_ALL_CONNECTED", valueOff},
+	Spork23QuorumPoSe:               {"SPORK_23_QUORUM_POSE", valueOff},
+}
+
+// String returns the name of the spork as used by Dash Core.
+func (id ID) String() string {
+	if def, ok := sporkDefs[id]; ok {
+		return def.name
+	}
+
+	return fmt.Sprintf("Unknown spork (%d)", int32(id))
+}
+
+// DefaultValue returns the value of the spork when no spork message for it has
+// been received.  Unknown sporks are off.
+func (id ID) DefaultValue() int64 {
+	if def, ok := sporkDefs[id]; ok {
+		return def.defaultValue
+	}
+
+	return valueOff
+}
+
+// KnownIDs returns the ids of all sporks known to the node in ascending order.
+func KnownIDs() []ID {
+	ids := make([]ID, 0, len(sporkDefs))
+	for id := range sporkDefs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
@@ -6,6 +6,7 @@ package txscript
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -848,6 +849,20 @@ func TestCalcSignatureHash(t *testing.T) {
 		}
 		var tx wire.MsgTx
 		rawTx, _ := hex.DecodeString(test[0].(string))
+
+		// The test vectors are randomly generated Bitcoin transactions,
+		// so some of them have version fields which Dash interprets as
+		// a DIP-2 special transaction.  Those don't carry the required
+		// extra payload and are skipped.
+		if len(rawTx) >= 4 {
+			version := binary.LittleEndian.Uint32(rawTx)
+			if int16(version) >= wire.SpecialTxVersion &&
+				version>>16 != 0 {
+
+				continue
+			}
+		}
+
 		err := tx.Deserialize(bytes.NewReader(rawTx))
 		if err != nil {
 			t.Errorf("TestCalcSignatureHash failed test #%d: "+
@@ -372,7 +372,8 @@ func calcWitnessSignatureHashRaw(scriptSig []byte, sigHashes *TxSigHashes,
 
 	// First write out, then encode the transaction's version number.
 	var bVersion [4]byte
-	binary.LittleEndian.PutUint32(bVersion[:], uint32(tx.Version))
+	binary.LittleEndian.PutUint32(bVersion[:],
+		uint32(uint16(tx.Version))|uint32(tx.Type)<<16)
 	sigHash.Write(bVersion[:])
 
 	// Next write out the possibly pre-calculated hashes for the sequence
@@ -484,10 +485,12 @@ func shallowCopyTx(tx *wire.MsgTx) wire.MsgTx {
 	// pointers into the contiguous arrays.  This avoids a lot of small
 	// allocations.
 	txCopy := wire.MsgTx{
-		Version:  tx.Version,
-		TxIn:     make([]*wire.TxIn, len(tx.TxIn)),
-		TxOut:    make([]*wire.TxOut, len(tx.TxOut)),
-		LockTime: tx.LockTime,
+		Version:      tx.Version,
+		Type:         tx.Type,
+		TxIn:         make([]*wire.TxIn, len(tx.TxIn)),
+		TxOut:        make([]*wire.TxOut, len(tx.TxOut)),
+		LockTime:     tx.LockTime,
+		ExtraPayload: tx.ExtraPayload,
 	}
 	txIns := make([]wire.TxIn, len(tx.TxIn))
 	for i, oldTxIn := range tx.TxIn {
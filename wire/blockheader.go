@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/x11"
 )
 
 // MaxBlockHeaderPayload is the maximum number of bytes a block header can be.
@@ -45,15 +46,24 @@ type BlockHeader struct {
 const blockHeaderLen = 80
 
 // BlockHash computes the block identifier hash for the given block header.
+// Dash identifies blocks by the X11 hash of their header, so this is the same
+// as PowHash.
 func (h *BlockHeader) BlockHash() chainhash.Hash {
-	// Encode the header and double sha256 everything prior to the number of
+	return h.PowHash()
+}
+
+// PowHash computes the X11 hash of the given block header.  This is the hash
+// which must be less than or equal to the target difficulty for the header to
+// satisfy the proof of work.
+func (h *BlockHeader) PowHash() chainhash.Hash {
+	// Encode the header and X11 hash everything prior to the number of
 	// transactions.  Ignore the error returns since there is no way the
 	// encode could fail except being out of memory which would cause a
 	// run-time panic.
 	buf := bytes.NewBuffer(make([]byte, 0, MaxBlockHeaderPayload))
 	_ = writeBlockHeader(buf, 0, h)
 
-	return chainhash.DoubleHashH(buf.Bytes())
+	return chainhash.Hash(x11.Sum(buf.Bytes()))
 }
 
 // BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
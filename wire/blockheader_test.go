@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
 	"github.com/davecgh/go-spew/spew"
 )
 
@@ -259,3 +260,35 @@ func TestBlockHeaderSerialize(t *testing.T) {
 		}
 	}
 }
+
+// TestBlockHeaderPowHash ensures the X11 proof of work hash of a block header
+// is calculated correctly.
+func TestBlockHeaderPowHash(t *testing.T) {
+	// Dash mainnet genesis block header.
+	merkleRoot, err := chainhash.NewHashFromStr("e0028eb9648db56b1ac77cf09" +
+		"0b99048a8007e2bb64b68f092c03c7f56a662c7")
+	if err != nil {
+		t.Fatalf("NewHashFromStr: %v", err)
+	}
+	bh := BlockHeader{
+		Version:    1,
+		PrevBlock:  chainhash.Hash{},
+		MerkleRoot: *merkleRoot,
+		Timestamp:  time.Unix(1390095618, 0),
+		Bits:       0x1e0ffff0,
+		Nonce:      28917698,
+	}
+
+	want := "00000ffd590b1485b3caadc19b22e6379c733355108f107a430458cdf3407ab6"
+	powHash := bh.PowHash()
+	if powHash.String() != want {
+		t.Errorf("PowHash: wrong hash - got %v, want %v", powHash, want)
+	}
+
+	// The proof of work hash also identifies the block.
+	blockHash := bh.BlockHash()
+	if !blockHash.IsEqual(&powHash) {
+		t.Errorf("BlockHash: got %v, want proof of work hash %v",
+			blockHash, powHash)
+	}
+}
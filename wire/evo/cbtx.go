@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+const (
+	// CbTxVersionMerkleRootMNList is the coinbase payload version which
+	// only commits to the masternode list.
+	CbTxVersionMerkleRootMNList = 1
+
+	// CbTxVersionMerkleRootQuorums is the coinbase payload version which
+	// additionally commits to the active quorums.
+	CbTxVersionMerkleRootQuorums = 2
+
+	// CbTxVersionCLSigAndBalance is the coinbase payload version which
+	// additionally carries the best known ChainLock and the credit pool
+	// balance.
+	CbTxVersionCLSigAndBalance = 3
+)
+
+// CbTx is the payload of a coinbase special transaction
+// (wire.TxTypeCoinbase).  It commits the block to its height, the merkle root
+// of the simplified masternode list and, starting with version 2, the merkle
+// root of the active quorums.
+type CbTx struct {
+	Version          uint16
+	Height           int32
+	MerkleRootMNList chainhash.Hash
+
+	// MerkleRootQuorums is only present for version 2 and later.
+	MerkleRootQuorums chainhash.Hash
+
+	// The following fields are only present for version 3 and later.
+	// BestCLHeightDiff is the distance from the parent of the block to the
+	// block locked by BestCLSignature.
+	BestCLHeightDiff  uint32
+	BestCLSignature   BLSSignature
+	CreditPoolBalance int64
+}
+
+// Deserialize decodes a CbTx payload from r into the receiver.
+func (p *CbTx) Deserialize(r io.Reader) error {
+	err := readElement(r, &p.Version)
+	if err != nil {
+		return err
+	}
+	if p.Version == 0 || p.Version > CbTxVersionCLSigAndBalance {
+		return fmt.Errorf("CbTx: unsupported version %d", p.Version)
+	}
+
+	var height uint32
+	err = readElements(r, &height, &p.MerkleRootMNList)
+	if err != nil {
+		return err
+	}
+	p.Height = int32(height)
+
+	if p.Version < CbTxVersionMerkleRootQuorums {
+		return nil
+	}
+	if err := readElement(r, &p.MerkleRootQuorums); err != nil {
+		return err
+	}
+
+	if p.Version < CbTxVersionCLSigAndBalance {
+		return nil
+	}
+	diff, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return err
+	}
+	if diff > uint64(^uint32(0)) {
+		return fmt.Errorf("CbTx: best ChainLock height diff %d is out "+
+			"of range", diff)
+	}
+	p.BestCLHeightDiff = uint32(diff)
+
+	return readElements(r, &p.BestCLSignature, &p.CreditPoolBalance)
+}
+
+// Serialize encodes the CbTx payload to w.
+func (p *CbTx) Serialize(w io.Writer) error {
+	err := writeElements(w, p.Version, uint32(p.Height),
+		&p.MerkleRootMNList)
+	if err != nil {
+		return err
+	}
+
+	if p.Version < CbTxVersionMerkleRootQuorums {
+		return nil
+	}
+	if err := writeElement(w, &p.MerkleRootQuorums); err != nil {
+		return err
+	}
+
+	if p.Version < CbTxVersionCLSigAndBalance {
+		return nil
+	}
+	err = wire.WriteVarInt(w, 0, uint64(p.BestCLHeightDiff))
+	if err != nil {
+		return err
+	}
+
+	return writeElements(w, &p.BestCLSignature, p.CreditPoolBalance)
+}
+
+// BestCLHeight returns the height of the block locked by the best known
+// ChainLock carried by the payload.  It returns false when the payload version does not carry a ChainLock.
+func (p *CbTx) BestCLHeight() (int32, bool) {
+	if p.Version < CbTxVersionCLSigAndBalance {
+		return 0, false
+	}
+
+	return p.Height - 1 - int32(p.BestCLHeightDiff), true
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestCbTxSerialize tests the encode and decode of CbTx payloads for all
+// supported versions.
+func TestCbTxSerialize(t *testing.T) {
+	v3 := &CbTx{
+		Version:           CbTxVersionCLSigAndBalance,
+		Height:            1000,
+		MerkleRootMNList:  chainhash.Hash{0x11},
+		MerkleRootQuorums: chainhash.Hash{0x22},
+		BestCLHeightDiff:  300,
+		CreditPoolBalance: 5,
+	}
+	copy(v3.BestCLSignature[:], repeat(0x33, BLSSignatureSize))
+
+	tests := []struct {
+		name string
+		in   *CbTx
+		buf  []byte
+	}{{
+		name: "version 1",
+		in: &CbTx{
+			Version:          CbTxVersionMerkleRootMNList,
+			Height:           1000,
+			MerkleRootMNList: chainhash.Hash{0x11},
+		},
+		buf: bytes.Join([][]byte{
+			{0x01, 0x00},             // Version
+			{0xe8, 0x03, 0x00, 0x00}, // Height
+			{0x11}, repeat(0x00, 31), // Masternode list merkle root
+		}, nil),
+	}, {
+		name: "version 2",
+		in: &CbTx{
+			Version:           CbTxVersionMerkleRootQuorums,
+			Height:            1000,
+			MerkleRootMNList:  chainhash.Hash{0x11},
+			MerkleRootQuorums: chainhash.Hash{0x22},
+		},
+		buf: bytes.Join([][]byte{
+			{0x02, 0x00},             // Version
+			{0xe8, 0x03, 0x00, 0x00}, // Height
+			{0x11}, repeat(0x00, 31), // Masternode list merkle root
+			{0x22}, repeat(0x00, 31), // Quorums merkle root
+		}, nil),
+	}, {
+		name: "version 3",
+		in:   v3,
+		buf: bytes.Join([][]byte{
+			{0x03, 0x00},             // Version
+			{0xe8, 0x03, 0x00, 0x00}, // Height
+			{0x11}, repeat(0x00, 31), // Masternode list merkle root
+			{0x22}, repeat(0x00, 31), // Quorums merkle root
+			{0xfd, 0x2c, 0x01},             // Best ChainLock height diff
+			repeat(0x33, BLSSignatureSize), // Best ChainLock signature
+			{0x05, 0, 0, 0, 0, 0, 0, 0},    // Credit pool balance
+		}, nil),
+	}}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := test.in.Serialize(&buf); err != nil {
+			t.Errorf("%s: Serialize: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !bytes.Equal(buf.Bytes(), test.buf) {
+			t.Errorf("%s: Serialize\n got: %s want: %s", test.name,
+				spew.Sdump(buf.Bytes()), spew.Sdump(test.buf))
+			continue
+		}
+
+		var decoded CbTx
+		err := decoded.Deserialize(bytes.NewReader(test.buf))
+		if err != nil {
+			t.Errorf("%s: Deserialize: unexpected error: %v", test.name,
+				err)
+			continue
+		}
+		if !reflect.DeepEqual(&decoded, test.in) {
+			t.Errorf("%s: Deserialize\n got: %s want: %s", test.name,
+				spew.Sdump(&decoded), spew.Sdump(test.in))
+		}
+	}
+}
+
+// TestCbTxInvalidVersion ensures payloads with unknown versions are rejected.
+func TestCbTxInvalidVersion(t *testing.T) {
+	for _, version := range []byte{0x00, 0x04} {
+		encoded := append([]byte{version, 0x00}, repeat(0x00, 36)...)
+		var p CbTx
+		if err := p.Deserialize(bytes.NewReader(encoded)); err == nil {
+			t.Errorf("Deserialize: version %d unexpectedly accepted",
+				version)
+		}
+	}
+}
+
+// TestCbTxBestCLHeight tests the height of the block locked by the best
+// ChainLock carried by a CbTx payload.
+func TestCbTxBestCLHeight(t *testing.T) {
+	p := CbTx{Version: CbTxVersionMerkleRootQuorums, Height: 1000}
+	if _, ok := p.BestCLHeight(); ok {
+		t.Errorf("BestCLHeight: unexpected ChainLock for version %d",
+			p.Version)
+	}
+
+	p.Version = CbTxVersionCLSigAndBalance
+	p.BestCLHeightDiff = 9
+	height, ok := p.BestCLHeight()
+	if !ok || height != 990 {
+		t.Errorf("BestCLHeight: got %d (%v), want 990 (true)", height, ok)
+	}
+}
+
+// TestCbTxDecodeTxPayload ensures a coinbase special transaction payload is
+// decoded into a CbTx.
+func TestCbTxDecodeTxPayload(t *testing.T) {
+	want := &CbTx{Version: CbTxVersionMerkleRootMNList, Height: 7}
+	tx := wire.NewMsgTx(wire.SpecialTxVersion)
+	tx.Type = wire.TxTypeCoinbase
+	if err := SetTxPayload(tx, want); err != nil {
+		t.Fatalf("SetTxPayload: unexpected error: %v", err)
+	}
+
+	payload, err := DecodeTxPayload(tx)
+	if err != nil {
+		t.Fatalf("DecodeTxPayload: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("DecodeTxPayload\n got: %s want: %s", spew.Sdump(payload),
+			spew.Sdump(want))
+	}
+}
@@ -0,0 +1,363 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+const (
+	// KeyIDSize is the size of the hash160 of a public key used to identify
+	// owner, voting and platform node keys.
+	KeyIDSize = 20
+
+	// BLSPublicKeySize is the size of a serialized BLS public key.
+	BLSPublicKeySize = 48
+
+	// BLSSignatureSize is the size of a serialized BLS signature.
+	BLSSignatureSize = 96
+
+	// ProTxVersionLegacyBLS is the provider transaction version which
+	// serializes BLS keys and signatures using the legacy scheme.
+	ProTxVersionLegacyBLS = 1
+
+	// ProTxVersionBasicBLS is the provider transaction version which
+	// serializes BLS keys and signatures using the basic scheme.
+	ProTxVersionBasicBLS = 2
+
+	// MessageSignatureHeader is the text prepended to a message before it
+	// is hashed and signed with a compact ECDSA signature.
+	MessageSignatureHeader = "DarkCoin Signed Message:\n"
+
+	// maxScriptSize is the maximum size of a payout script within a
+	// provider transaction payload.
+	maxScriptSize = wire.MaxTxExtraPayload
+
+	// maxSignatureSize is the maximum size of a variable length signature
+	// within a provider transaction payload.
+	maxSignatureSize = wire.MaxTxExtraPayload
+)
+
+// KeyID is the hash160 of a public key.
+type KeyID [KeyIDSize]byte
+
+// BLSPublicKey is a serialized BLS public key.
+type BLSPublicKey [BLSPublicKeySize]byte
+
+// BLSSignature is a serialized BLS signature.
+type BLSSignature [BLSSignatureSize]byte
+
+// MnType represents the type of a masternode.
+type MnType uint16
+
+// These constants define the known masternode types.
+const (
+	MnTypeRegular MnType = 0
+	MnTypeEvo     MnType = 1
+)
+
+// Map of masternode types back to their names for pretty printing.
+var mnTypeStrings = map[MnType]string{
+	MnTypeRegular: "Regular",
+	MnTypeEvo:     "Evo",
+}
+
+// String returns the MnType in human-readable form.
+func (t MnType) String() string {
+	if s, ok := mnTypeStrings[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("Unknown MnType (%d)", uint16(t))
+}
+
+// Payload is the interface implemented by all special transaction payloads.
+type Payload interface {
+	// Serialize encodes the payload to w.
+	Serialize(w io.Writer) error
+
+	// Deserialize decodes the payload from r into the receiver.
+	Deserialize(r io.Reader) error
+}
+
+// newPayload returns a new empty payload for the passed transaction type.
+func newPayload(txType wire.TxType) (Payload, error) {
+	switch txType {
+	case wire.TxTypeProRegTx:
+		return &ProRegTx{}, nil
+
+	case wire.TxTypeProUpServTx:
+		return &ProUpServTx{}, nil
+
+	case wire.TxTypeProUpRegTx:
+		return &ProUpRegTx{}, nil
+
+	case wire.TxTypeProUpRevTx:
+		return &ProUpRevTx{}, nil
+
+	case wire.TxTypeCoinbase:
+		return &CbTx{}, nil
+
+	case wire.TxTypeQuorumCommitment:
+		return &QcTx{}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported special transaction type %v",
+		txType)
+}
+
+// DecodeTxPayload decodes the extra payload of the passed special transaction
+// into the payload type which corresponds to its transaction type.  An error
+// is returned if the transaction is not a special transaction, the type is not
+// supported, or the payload is malformed or has trailing data.
+func DecodeTxPayload(tx *wire.MsgTx) (Payload, error) {
+	if !tx.IsSpecial() {
+		return nil, fmt.Errorf("transaction %v is not a special "+
+			"transaction", tx.TxHash())
+	}
+
+	payload, err := newPayload(tx.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	r := bytes.NewReader(tx.ExtraPayload)
+	if err := payload.Deserialize(r); err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%v payload has %d trailing bytes",
+			tx.Type, r.Len())
+	}
+
+	return payload, nil
+}
+
+// SetTxPayload serializes the passed payload into the extra payload of the
+// transaction.  The transaction version and type are expected to already be
+// set by the caller.
+func SetTxPayload(tx *wire.MsgTx, payload Payload) error {
+	var buf bytes.Buffer
+	if err := payload.Serialize(&buf); err != nil {
+		return err
+	}
+	if buf.Len() > wire.MaxTxExtraPayload {
+		return fmt.Errorf("payload of %d bytes is larger than the max "+
+			"allowed size of %d", buf.Len(), wire.MaxTxExtraPayload)
+	}
+
+	tx.ExtraPayload = buf.Bytes()
+	return nil
+}
+
+// CalcInputsHash returns the hash of the previous outpoints of all inputs of
+// the passed transaction.  Provider transactions commit to this value in
+// their InputsHash field to prevent their signature from being replayed.
+func CalcInputsHash(tx *wire.MsgTx) chainhash.Hash {
+	buf := make([]byte, 0, len(tx.TxIn)*(chainhash.HashSize+4))
+	for _, txIn := range tx.TxIn {
+		buf = append(buf, txIn.PreviousOutPoint.Hash[:]...)
+		buf = binary.LittleEndian.AppendUint32(buf,
+			txIn.PreviousOutPoint.Index)
+	}
+
+	return chainhash.DoubleHashH(buf)
+}
+
+// MessageHash returns the hash of the passed message prefixed with the
+// MessageSignatureHeader.  This is the hash which is signed with a compact
+// ECDSA signature when signing a message such as the one returned by
+// ProRegTx.SignMessage.
+func MessageHash(message string) chainhash.Hash {
+	var buf bytes.Buffer
+	wire.WriteVarString(&buf, 0, MessageSignatureHeader)
+	wire.WriteVarString(&buf, 0, message)
+	return chainhash.DoubleHashH(buf.Bytes())
+}
+
+// readElement reads the next sequence of bytes from r using little endian
+// depending on the concrete type of element pointed to.
+func readElement(r io.Reader, element interface{}) error {
+	switch e := element.(type) {
+	case *uint16:
+		var b [2]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return err
+		}
+		*e = binary.LittleEndian.Uint16(b[:])
+		return nil
+
+	case *MnType:
+		var rv uint16
+		if err := readElement(r, &rv); err != nil {
+			return err
+		}
+		*e = MnType(rv)
+		return nil
+
+	case *uint32:
+		var b [4]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return err
+		}
+		*e = binary.LittleEndian.Uint32(b[:])
+		return nil
+
+	case *int64:
+		var b [8]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return err
+		}
+		*e = int64(binary.LittleEndian.Uint64(b[:]))
+		return nil
+
+	case *chainhash.Hash:
+		_, err := io.ReadFull(r, e[:])
+		return err
+
+	case *wire.OutPoint:
+		if _, err := io.ReadFull(r, e.Hash[:]); err != nil {
+			return err
+		}
+		return readElement(r, &e.Index)
+
+	case *KeyID:
+		_, err := io.ReadFull(r, e[:])
+		return err
+
+	case *BLSPublicKey:
+		_, err := io.ReadFull(r, e[:])
+		return err
+
+	case *BLSSignature:
+		_, err := io.ReadFull(r, e[:])
+		return err
+	}
+
+	return fmt.Errorf("readElement: unsupported type %T", element)
+}
+
+// readElements reads multiple items from r.  It is equivalent to multiple
+// calls to readElement.
+func readElements(r io.Reader, elements ...interface{}) error {
+	for _, element := range elements {
+		if err := readElement(r, element); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeElement writes the little endian representation of element to w.
+func writeElement(w io.Writer, element interface{}) error {
+	var err error
+	switch e := element.(type) {
+	case uint16:
+		var b [2]byte
+		binary.LittleEndian.PutUint16(b[:], e)
+		_, err = w.Write(b[:])
+
+	case MnType:
+		err = writeElement(w, uint16(e))
+
+	case uint32:
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], e)
+		_, err = w.Write(b[:])
+
+	case int64:
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], uint64(e))
+		_, err = w.Write(b[:])
+
+	case *chainhash.Hash:
+		_, err = w.Write(e[:])
+
+	case *wire.OutPoint:
+		if _, err = w.Write(e.Hash[:]); err != nil {
+			return err
+		}
+		err = writeElement(w, e.Index)
+
+	case *KeyID:
+		_, err = w.Write(e[:])
+
+	case *BLSPublicKey:
+		_, err = w.Write(e[:])
+
+	case *BLSSignature:
+		_, err = w.Write(e[:])
+
+	default:
+		err = fmt.Errorf("writeElement: unsupported type %T", element)
+	}
+
+	return err
+}
+
+// writeElements writes multiple items to w.  It is equivalent to multiple
+// calls to writeElement.
+func writeElements(w io.Writer, elements ...interface{}) error {
+	for _, element := range elements {
+		if err := writeElement(w, element); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readService reads a service address, which is an IPv6 (or IPv4-mapped)
+// address followed by a big endian port, from r.
+func readService(r io.Reader) (net.IP, uint16, error) {
+	var ip [16]byte
+	if _, err := io.ReadFull(r, ip[:]); err != nil {
+		return nil, 0, err
+	}
+
+	// Sigh.  The port is big endian unlike everything else.
+	var port [2]byte
+	if _, err := io.ReadFull(r, port[:]); err != nil {
+		return nil, 0, err
+	}
+
+	return net.IP(ip[:]), binary.BigEndian.Uint16(port[:]), nil
+}
+
+// writeService writes a service address to w.  A nil IP is encoded as the
+// unspecified address.
+func writeService(w io.Writer, ip net.IP, port uint16) error {
+	var buf [18]byte
+	copy(buf[:16], ip.To16())
+	binary.BigEndian.PutUint16(buf[16:], port)
+	_, err := w.Write(buf[:])
+	return err
+}
+
+// validateProTxVersion returns an error when the passed provider transaction
+// version is not known.
+func validateProTxVersion(payload string, version uint16) error {
+	if version == 0 || version > ProTxVersionBasicBLS {
+		return fmt.Errorf("%s: unsupported version %d", payload, version)
+	}
+	return nil
+}
+
+// hashPayload returns the double sha256 hash of the passed serialize function
+// output.  It is used to compute the hash of a payload without its signature.
+func hashPayload(serialize func(w io.Writer) error) chainhash.Hash {
+	var buf bytes.Buffer
+
+	// Ignore the error since writing to a bytes.Buffer can't fail and the
+	// payload was either decoded successfully or built by the caller.
+	_ = serialize(&buf)
+	return chainhash.DoubleHashH(buf.Bytes())
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestTxPayload ensures payloads round trip through the extra payload of a
+// special transaction and that invalid transactions are rejected.
+func TestTxPayload(t *testing.T) {
+	payload := testProRegTx(MnTypeRegular)
+	payload.IPAddress = payload.IPAddress.To16()
+
+	tx := &wire.MsgTx{
+		Version: wire.SpecialTxVersion,
+		Type:    wire.TxTypeProRegTx,
+	}
+	if err := SetTxPayload(tx, payload); err != nil {
+		t.Fatalf("SetTxPayload: unexpected error: %v", err)
+	}
+
+	decoded, err := DecodeTxPayload(tx)
+	if err != nil {
+		t.Fatalf("DecodeTxPayload: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, payload) {
+		t.Fatalf("DecodeTxPayload\n got: %s want: %s",
+			spew.Sdump(decoded), spew.Sdump(payload))
+	}
+
+	// Trailing data after the payload must be rejected.
+	tx.ExtraPayload = append(tx.ExtraPayload, 0x00)
+	if _, err := DecodeTxPayload(tx); err == nil {
+		t.Errorf("DecodeTxPayload: no error for trailing data")
+	}
+
+	// A payload of the wrong type must fail to decode.
+	tx.ExtraPayload = tx.ExtraPayload[:len(tx.ExtraPayload)-1]
+	tx.Type = wire.TxTypeProUpRevTx
+	if _, err := DecodeTxPayload(tx); err == nil {
+		t.Errorf("DecodeTxPayload: no error for mismatched type")
+	}
+
+	// Transactions which are not special have no payload.
+	tx.Version = 2
+	if _, err := DecodeTxPayload(tx); err == nil {
+		t.Errorf("DecodeTxPayload: no error for normal transaction")
+	}
+
+	// Unknown transaction types are not supported.
+	tx.Version = wire.SpecialTxVersion
+	tx.Type = 0xffff
+	if _, err := DecodeTxPayload(tx); err == nil {
+		t.Errorf("DecodeTxPayload: no error for unknown type")
+	}
+}
+
+// TestCalcInputsHash ensures the inputs hash commits to the previous
+// outpoints of all inputs in order.
+func TestCalcInputsHash(t *testing.T) {
+	tx := wire.NewMsgTx(wire.SpecialTxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0x01}, 2),
+		nil, nil))
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0x03}, 4),
+		[]byte{0x51}, nil))
+
+	serialized := bytes.Join([][]byte{
+		{0x01}, repeat(0x00, 31), {0x02, 0x00, 0x00, 0x00},
+		{0x03}, repeat(0x00, 31), {0x04, 0x00, 0x00, 0x00},
+	}, nil)
+	want := chainhash.DoubleHashH(serialized)
+	if got := CalcInputsHash(tx); got != want {
+		t.Errorf("CalcInputsHash: got %v, want %v", got, want)
+	}
+}
+
+// TestMessageHash ensures the hash of a signed message includes the Dash
+// message signature header.
+func TestMessageHash(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteByte(byte(len(MessageSignatureHeader)))
+	buf.WriteString(MessageSignatureHeader)
+	buf.WriteByte(5)
+	buf.WriteString("hello")
+
+	want := chainhash.DoubleHashH(buf.Bytes())
+	if got := MessageHash("hello"); got != want {
+		t.Errorf("MessageHash: got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package evo implements the payloads carried by Dash DIP-2 special
+transactions.
+
+A special transaction is a wire.MsgTx with a version of at least
+wire.SpecialTxVersion and a type other than wire.TxTypeNormal.  Its
+type-specific data is stored in the ExtraPayload field of the transaction.
+This package provides typed structures for those payloads along with their
+serialization, so they can be inspected and built without relying on a
+remote node.
+
+# Provider Transactions
+
+The deterministic masternode list is maintained through four provider
+transactions defined in DIP-3:
+
+  - ProRegTx registers a masternode along with its collateral, service
+    address, owner, operator and voting keys and payout script
+  - ProUpServTx updates the service address and operator payout of a
+    masternode and is signed by the operator BLS key
+  - ProUpRegTx updates the operator key, voting key and payout script of a
+    masternode and is signed by the owner key
+  - ProUpRevTx revokes the operator of a masternode and is signed by the
+    operator BLS key
+
+Every provider transaction commits to the inputs of the transaction which
+carries it through its InputsHash field, see CalcInputsHash, in order to
+protect against replay.  The hash which is signed is available through the
+SignHash method of each payload.  A ProRegTx with an external collateral is
+instead signed with the collateral key over the string returned by its
+SignMessage method.
+
+# Coinbase Payload
+
+Starting with DIP-4, the coinbase transaction of every block is a special
+transaction whose CbTx payload commits to the height of the block and to the
+merkle root of the simplified masternode list.  Later versions add the merkle
+root of the active quorums, the best known ChainLock and the credit pool
+balance.  This allows a light client to verify a masternode list against a
+block header and its coinbase transaction.
+
+# Quorum Commitments
+
+The final commitment of a long living masternode quorum is mined in a QcTx
+payload once its distributed key generation has completed.  The commitment
+itself is a wire.QuorumCommitment, which is also relayed on its own in
+qfcommit messages and as part of mnlistdiff and qrinfo messages.  Validation
+of a commitment against the parameters of its quorum type and the operator
+keys of its members is provided by the llmq package.
+
+# Decoding
+
+DecodeTxPayload selects the payload type based on the transaction type and
+decodes the extra payload of a transaction, while SetTxPayload does the
+reverse when building a transaction.
+*/
+package evo
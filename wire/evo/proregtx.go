@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/dashpay/dashd-go/btcutil"
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/txscript"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// ProRegTx is the payload of a provider registration transaction
+// (wire.TxTypeProRegTx) which registers a new masternode.
+type ProRegTx struct {
+	Version            uint16
+	Type               MnType
+	Mode               uint16
+	CollateralOutpoint wire.OutPoint
+	IPAddress          net.IP
+	Port               uint16
+	KeyIDOwner         KeyID
+	PubKeyOperator     BLSPublicKey
+	KeyIDVoting        KeyID
+	OperatorReward     uint16
+	ScriptPayout       []byte
+	InputsHash         chainhash.Hash
+
+	// The platform fields are only present for evo masternodes.
+	PlatformNodeID   KeyID
+	PlatformP2PPort  uint16
+	PlatformHTTPPort uint16
+
+	// Signature is the compact signature of the collateral key over the
+	// message returned by SignMessage.  It is empty when the collateral
+	// is created by the registration transaction itself.
+	Signature []byte
+}
+
+// Deserialize decodes a ProRegTx payload from r into the receiver.
+func (p *ProRegTx) Deserialize(r io.Reader) error {
+	err := readElement(r, &p.Version)
+	if err != nil {
+		return err
+	}
+	if err := validateProTxVersion("ProRegTx", p.Version); err != nil {
+		return err
+	}
+
+	err = readElements(r, &p.Type, &p.Mode, &p.CollateralOutpoint)
+	if err != nil {
+		return err
+	}
+
+	p.IPAddress, p.Port, err = readService(r)
+	if err != nil {
+		return err
+	}
+
+	err = readElements(r, &p.KeyIDOwner, &p.PubKeyOperator,
+		&p.KeyIDVoting, &p.OperatorReward)
+	if err != nil {
+		return err
+	}
+
+	p.ScriptPayout, err = wire.ReadVarBytes(r, 0, maxScriptSize,
+		"ProRegTx payout script")
+	if err != nil {
+		return err
+	}
+
+	err = readElement(r, &p.InputsHash)
+	if err != nil {
+		return err
+	}
+
+	if p.Type == MnTypeEvo {
+		err = readElements(r, &p.PlatformNodeID, &p.PlatformP2PPort,
+			&p.PlatformHTTPPort)
+		if err != nil {
+			return err
+		}
+	}
+
+	p.Signature, err = wire.ReadVarBytes(r, 0, maxSignatureSize,
+		"ProRegTx signature")
+	return err
+}
+
+// Serialize encodes the ProRegTx payload to w.
+func (p *ProRegTx) Serialize(w io.Writer) error {
+	if err := p.serializeUnsigned(w); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, p.Signature)
+}
+
+// serializeUnsigned encodes all fields of the payload except for the
+// signature to w.
+func (p *ProRegTx) serializeUnsigned(w io.Writer) error {
+	err := writeElements(w, p.Version, p.Type, p.Mode,
+		&p.CollateralOutpoint)
+	if err != nil {
+		return err
+	}
+
+	if err := writeService(w, p.IPAddress, p.Port); err != nil {
+		return err
+	}
+
+	err = writeElements(w, &p.KeyIDOwner, &p.PubKeyOperator,
+		&p.KeyIDVoting, p.OperatorReward)
+	if err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarBytes(w, 0, p.ScriptPayout); err != nil {
+		return err
+	}
+
+	if err := writeElement(w, &p.InputsHash); err != nil {
+		return err
+	}
+
+	if p.Type == MnTypeEvo {
+		return writeElements(w, &p.PlatformNodeID, p.PlatformP2PPort,
+			p.PlatformHTTPPort)
+	}
+
+	return nil
+}
+
+// SignHash returns the hash of the payload without its signature.
+func (p *ProRegTx) SignHash() chainhash.Hash {
+	return hashPayload(p.serializeUnsigned)
+}
+
+// SignMessage returns the message which must be signed by the collateral key
+// when the collateral of the masternode is not created by the registration
+// transaction itself.  The message consists of the payout address, the
+// operator reward, the owner and voting addresses and the hash of the payload,
+// separated by '|'.  The signature is created over MessageHash of the
+// returned string.
+func (p *ProRegTx) SignMessage(params *chaincfg.Params) (string, error) {
+	payout, err := scriptPayoutString(p.ScriptPayout, params)
+	if err != nil {
+		return "", err
+	}
+
+	owner, err := btcutil.NewAddressPubKeyHash(p.KeyIDOwner[:], params)
+	if err != nil {
+		return "", err
+	}
+	voting, err := btcutil.NewAddressPubKeyHash(p.KeyIDVoting[:], params)
+	if err != nil {
+		return "", err
+	}
+
+	signHash := p.SignHash()
+	return payout + "|" + strconv.Itoa(int(p.OperatorReward)) + "|" +
+		owner.EncodeAddress() + "|" + voting.EncodeAddress() + "|" +
+		signHash.String(), nil
+}
+
+// scriptPayoutString returns the address paid by the passed script for the
+// given network, or the hex encoding of the script when it does not pay to a
+// single address.
+func scriptPayoutString(script []byte, params *chaincfg.Params) (string, error) {
+	class, addrs, _, err := txscript.ExtractPkScriptAddrs(script, params)
+	if err != nil {
+		return "", fmt.Errorf("invalid payout script: %v", err)
+	}
+
+	switch class {
+	case txscript.PubKeyHashTy, txscript.ScriptHashTy, txscript.PubKeyTy:
+		if len(addrs) == 1 {
+			return addrs[0].EncodeAddress(), nil
+		}
+	}
+
+	return hex.EncodeToString(script), nil
+}
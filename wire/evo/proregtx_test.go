@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg"
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// repeat returns a byte slice of the passed length filled with b.
+func repeat(b byte, n int) []byte {
+	return bytes.Repeat([]byte{b}, n)
+}
+
+// testProRegTx returns a ProRegTx of the passed masternode type with all
+// fields populated.
+func testProRegTx(mnType MnType) *ProRegTx {
+	p := &ProRegTx{
+		Version: ProTxVersionBasicBLS,
+		Type:    mnType,
+		CollateralOutpoint: wire.OutPoint{
+			Hash:  chainhash.Hash{0xaa},
+			Index: 1,
+		},
+		IPAddress:      net.ParseIP("1.2.3.4"),
+		Port:           9999,
+		OperatorReward: 150,
+		ScriptPayout: []byte{
+			0x76, 0xa9, 0x14, // OP_DUP OP_HASH160 OP_DATA_20
+			0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+			0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+			0x88, 0xac, // OP_EQUALVERIFY OP_CHECKSIG
+		},
+		InputsHash: chainhash.Hash{0xbb},
+		Signature:  repeat(0x05, 65),
+	}
+	copy(p.KeyIDOwner[:], repeat(0x01, KeyIDSize))
+	copy(p.PubKeyOperator[:], repeat(0x04, BLSPublicKeySize))
+	copy(p.KeyIDVoting[:], repeat(0x02, KeyIDSize))
+	if mnType == MnTypeEvo {
+		copy(p.PlatformNodeID[:], repeat(0x06, KeyIDSize))
+		p.PlatformP2PPort = 26656
+		p.PlatformHTTPPort = 443
+	}
+	return p
+}
+
+// TestProRegTxSerialize tests the encode and decode of ProRegTx payloads.
+func TestProRegTxSerialize(t *testing.T) {
+	regular := testProRegTx(MnTypeRegular)
+	regularEncoded := bytes.Join([][]byte{
+		{0x02, 0x00},             // Version
+		{0x00, 0x00},             // Type
+		{0x00, 0x00},             // Mode
+		{0xaa}, repeat(0x00, 31), // Collateral hash
+		{0x01, 0x00, 0x00, 0x00},                               // Collateral index
+		repeat(0x00, 10), {0xff, 0xff, 0x01, 0x02, 0x03, 0x04}, // IP
+		{0x27, 0x0f},                 // Port (big endian)
+		repeat(0x01, KeyIDSize),      // Owner key ID
+		repeat(0x04, 48),             // Operator public key
+		repeat(0x02, KeyIDSize),      // Voting key ID
+		{0x96, 0x00},                 // Operator reward
+		{0x19}, regular.ScriptPayout, // Payout script
+		{0xbb}, repeat(0x00, 31), // Inputs hash
+		{0x41}, repeat(0x05, 65), // Signature
+	}, nil)
+
+	evo := testProRegTx(MnTypeEvo)
+	evoEncoded := bytes.Join([][]byte{
+		regularEncoded[:2],
+		{0x01, 0x00}, // Type
+		regularEncoded[4 : len(regularEncoded)-66],
+		repeat(0x06, KeyIDSize),  // Platform node ID
+		{0x20, 0x68},             // Platform P2P port
+		{0xbb, 0x01},             // Platform HTTP port
+		{0x41}, repeat(0x05, 65), // Signature
+	}, nil)
+
+	tests := []struct {
+		in  *ProRegTx
+		buf []byte
+	}{
+		{regular, regularEncoded},
+		{evo, evoEncoded},
+	}
+
+	for i, test := range tests {
+		var buf bytes.Buffer
+		if err := test.in.Serialize(&buf); err != nil {
+			t.Errorf("Serialize #%d error %v", i, err)
+			continue
+		}
+		if !bytes.Equal(buf.Bytes(), test.buf) {
+			t.Errorf("Serialize #%d\n got: %s want: %s", i,
+				spew.Sdump(buf.Bytes()), spew.Sdump(test.buf))
+			continue
+		}
+
+		var p ProRegTx
+		if err := p.Deserialize(bytes.NewReader(test.buf)); err != nil {
+			t.Errorf("Deserialize #%d error %v", i, err)
+			continue
+		}
+		p.IPAddress = p.IPAddress.To4()
+		want := *test.in
+		want.IPAddress = want.IPAddress.To4()
+		if !reflect.DeepEqual(&p, &want) {
+			t.Errorf("Deserialize #%d\n got: %s want: %s", i,
+				spew.Sdump(&p), spew.Sdump(&want))
+			continue
+		}
+
+		// The sign hash excludes the trailing signature.
+		unsigned := test.buf[:len(test.buf)-66]
+		wantHash := chainhash.DoubleHashH(unsigned)
+		if signHash := p.SignHash(); signHash != wantHash {
+			t.Errorf("SignHash #%d got: %v want: %v", i, signHash,
+				wantHash)
+		}
+
+		// Every truncation of the payload must fail to decode.
+		for j := 0; j < len(test.buf); j++ {
+			r := bytes.NewReader(test.buf[:j])
+			if err := new(ProRegTx).Deserialize(r); err == nil {
+				t.Errorf("Deserialize #%d: no error for payload "+
+					"truncated to %d bytes", i, j)
+				break
+			}
+		}
+	}
+
+	// Unknown versions must be rejected.
+	badVersion := append([]byte{0x03, 0x00}, regularEncoded[2:]...)
+	err := new(ProRegTx).Deserialize(bytes.NewReader(badVersion))
+	if err == nil {
+		t.Errorf("Deserialize: no error for unknown version")
+	}
+}
+
+// TestProRegTxSignMessage ensures the message signed by the collateral key of
+// a ProRegTx is built correctly.
+func TestProRegTxSignMessage(t *testing.T) {
+	p := testProRegTx(MnTypeRegular)
+	params := &chaincfg.MainNetParams
+
+	msg, err := p.SignMessage(params)
+	if err != nil {
+		t.Fatalf("SignMessage: unexpected error: %v", err)
+	}
+
+	signHash := p.SignHash()
+	want := "XaxmU6B884p2KNTVThgbubBdpQLY9tAZ5Y|150|" +
+		"Xan9iCVe1q5jYRDZ4VSMCtBjq2VyQA3Dge|" +
+		"XasTb9LP4wwsvtqXG6ZUZEggpiRFot8E4F|" + signHash.String()
+	if msg != want {
+		t.Errorf("SignMessage: got %q, want %q", msg, want)
+	}
+
+	// Payout scripts which do not pay to a single address are encoded as
+	// hex.
+	p.ScriptPayout = []byte{0x6a, 0x01, 0x01}
+	msg, err = p.SignMessage(params)
+	if err != nil {
+		t.Fatalf("SignMessage: unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(msg, "6a0101|150|") {
+		t.Errorf("SignMessage: got %q, want hex payout script", msg)
+	}
+}
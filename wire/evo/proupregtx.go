@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"io"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// ProUpRegTx is the payload of a provider update registrar transaction
+// (wire.TxTypeProUpRegTx) which updates the operator key, voting key and
+// payout script of a masternode.  It is signed by the owner key.
+type ProUpRegTx struct {
+	Version        uint16
+	ProTxHash      chainhash.Hash
+	Mode           uint16
+	PubKeyOperator BLSPublicKey
+	KeyIDVoting    KeyID
+	ScriptPayout   []byte
+	InputsHash     chainhash.Hash
+
+	// Signature is the compact signature of the owner key over the hash
+	// returned by SignHash.
+	Signature []byte
+}
+
+// Deserialize decodes a ProUpRegTx payload from r into the receiver.
+func (p *ProUpRegTx) Deserialize(r io.Reader) error {
+	err := readElement(r, &p.Version)
+	if err != nil {
+		return err
+	}
+	if err := validateProTxVersion("ProUpRegTx", p.Version); err != nil {
+		return err
+	}
+
+	err = readElements(r, &p.ProTxHash, &p.Mode, &p.PubKeyOperator,
+		&p.KeyIDVoting)
+	if err != nil {
+		return err
+	}
+
+	p.ScriptPayout, err = wire.ReadVarBytes(r, 0, maxScriptSize,
+		"ProUpRegTx payout script")
+	if err != nil {
+		return err
+	}
+
+	if err := readElement(r, &p.InputsHash); err != nil {
+		return err
+	}
+
+	p.Signature, err = wire.ReadVarBytes(r, 0, maxSignatureSize,
+		"ProUpRegTx signature")
+	return err
+}
+
+// Serialize encodes the ProUpRegTx payload to w.
+func (p *ProUpRegTx) Serialize(w io.Writer) error {
+	if err := p.serializeUnsigned(w); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, p.Signature)
+}
+
+// serializeUnsigned encodes all fields of the payload except for the
+// signature to w.
+func (p *ProUpRegTx) serializeUnsigned(w io.Writer) error {
+	err := writeElements(w, p.Version, &p.ProTxHash, p.Mode,
+		&p.PubKeyOperator, &p.KeyIDVoting)
+	if err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarBytes(w, 0, p.ScriptPayout); err != nil {
+		return err
+	}
+
+	return writeElement(w, &p.InputsHash)
+}
+
+// SignHash returns the hash of the payload without its signature.  This is
+// the hash which is signed by the owner key.
+func (p *ProUpRegTx) SignHash() chainhash.Hash {
+	return hashPayload(p.serializeUnsigned)
+}
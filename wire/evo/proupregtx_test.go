@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestProUpRegTxSerialize tests the encode and decode of ProUpRegTx payloads.
+func TestProUpRegTxSerialize(t *testing.T) {
+	p := &ProUpRegTx{
+		Version:      ProTxVersionBasicBLS,
+		ProTxHash:    chainhash.Hash{0x11},
+		ScriptPayout: []byte{0x51, 0x52},
+		InputsHash:   chainhash.Hash{0x22},
+		Signature:    repeat(0x33, 65),
+	}
+	copy(p.PubKeyOperator[:], repeat(0x44, BLSPublicKeySize))
+	copy(p.KeyIDVoting[:], repeat(0x55, KeyIDSize))
+	encoded := bytes.Join([][]byte{
+		{0x02, 0x00},             // Version
+		{0x11}, repeat(0x00, 31), // ProTx hash
+		{0x00, 0x00},                   // Mode
+		repeat(0x44, BLSPublicKeySize), // Operator public key
+		repeat(0x55, KeyIDSize),        // Voting key ID
+		{0x02, 0x51, 0x52},             // Payout script
+		{0x22}, repeat(0x00, 31),       // Inputs hash
+		{0x41}, repeat(0x33, 65), // Signature
+	}, nil)
+
+	var buf bytes.Buffer
+	if err := p.Serialize(&buf); err != nil {
+		t.Fatalf("Serialize: unexpected error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), encoded) {
+		t.Fatalf("Serialize\n got: %s want: %s", spew.Sdump(buf.Bytes()),
+			spew.Sdump(encoded))
+	}
+
+	var decoded ProUpRegTx
+	if err := decoded.Deserialize(bytes.NewReader(encoded)); err != nil {
+		t.Fatalf("Deserialize: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(&decoded, p) {
+		t.Fatalf("Deserialize\n got: %s want: %s", spew.Sdump(&decoded),
+			spew.Sdump(p))
+	}
+
+	wantHash := chainhash.DoubleHashH(encoded[:len(encoded)-66])
+	if signHash := decoded.SignHash(); signHash != wantHash {
+		t.Errorf("SignHash: got %v, want %v", signHash, wantHash)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+// RevocationReason represents the reason given by an operator for revoking
+// its service through a ProUpRevTx.
+type RevocationReason uint16
+
+// These constants define the known revocation reasons.
+const (
+	RevocationReasonNotSpecified      RevocationReason = 0
+	RevocationReasonTerminationOfServ RevocationReason = 1
+	RevocationReasonCompromisedKeys   RevocationReason = 2
+	RevocationReasonChangeOfKeys      RevocationReason = 3
+)
+
+// Map of revocation reasons back to their names for pretty printing.
+var revocationReasonStrings = map[RevocationReason]string{
+	RevocationReasonNotSpecified:      "NotSpecified",
+	RevocationReasonTerminationOfServ: "TerminationOfService",
+	RevocationReasonCompromisedKeys:   "CompromisedKeys",
+	RevocationReasonChangeOfKeys:      "ChangeOfKeys",
+}
+
+// String returns the RevocationReason in human-readable form.
+func (r RevocationReason) String() string {
+	if s, ok := revocationReasonStrings[r]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("Unknown RevocationReason (%d)", uint16(r))
+}
+
+// ProUpRevTx is the payload of a provider update revocation transaction
+// (wire.TxTypeProUpRevTx) through which the operator of a masternode revokes
+// its service.  It is signed by the operator key.
+type ProUpRevTx struct {
+	Version    uint16
+	ProTxHash  chainhash.Hash
+	Reason     RevocationReason
+	InputsHash chainhash.Hash
+	Signature  BLSSignature
+}
+
+// Deserialize decodes a ProUpRevTx payload from r into the receiver.
+func (p *ProUpRevTx) Deserialize(r io.Reader) error {
+	err := readElement(r, &p.Version)
+	if err != nil {
+		return err
+	}
+	if err := validateProTxVersion("ProUpRevTx", p.Version); err != nil {
+		return err
+	}
+
+	var reason uint16
+	err = readElements(r, &p.ProTxHash, &reason, &p.InputsHash,
+		&p.Signature)
+	p.Reason = RevocationReason(reason)
+	return err
+}
+
+// Serialize encodes the ProUpRevTx payload to w.
+func (p *ProUpRevTx) Serialize(w io.Writer) error {
+	if err := p.serializeUnsigned(w); err != nil {
+		return err
+	}
+
+	return writeElement(w, &p.Signature)
+}
+
+// serializeUnsigned encodes all fields of the payload except for the
+// signature to w.
+func (p *ProUpRevTx) serializeUnsigned(w io.Writer) error {
+	return writeElements(w, p.Version, &p.ProTxHash, uint16(p.Reason),
+		&p.InputsHash)
+}
+
+// SignHash returns the hash of the payload without its signature.  This is
+// the hash which is signed by the operator BLS key.
+func (p *ProUpRevTx) SignHash() chainhash.Hash {
+	return hashPayload(p.serializeUnsigned)
+}
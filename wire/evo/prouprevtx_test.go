@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestProUpRevTxSerialize tests the encode and decode of ProUpRevTx payloads.
+func TestProUpRevTxSerialize(t *testing.T) {
+	p := &ProUpRevTx{
+		Version:    ProTxVersionLegacyBLS,
+		ProTxHash:  chainhash.Hash{0x11},
+		Reason:     RevocationReasonChangeOfKeys,
+		InputsHash: chainhash.Hash{0x22},
+	}
+	copy(p.Signature[:], repeat(0x33, BLSSignatureSize))
+	encoded := bytes.Join([][]byte{
+		{0x01, 0x00},             // Version
+		{0x11}, repeat(0x00, 31), // ProTx hash
+		{0x03, 0x00},             // Reason
+		{0x22}, repeat(0x00, 31), // Inputs hash
+		repeat(0x33, BLSSignatureSize), // Signature
+	}, nil)
+
+	var buf bytes.Buffer
+	if err := p.Serialize(&buf); err != nil {
+		t.Fatalf("Serialize: unexpected error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), encoded) {
+		t.Fatalf("Serialize\n got: %s want: %s", spew.Sdump(buf.Bytes()),
+			spew.Sdump(encoded))
+	}
+
+	var decoded ProUpRevTx
+	if err := decoded.Deserialize(bytes.NewReader(encoded)); err != nil {
+		t.Fatalf("Deserialize: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(&decoded, p) {
+		t.Fatalf("Deserialize\n got: %s want: %s", spew.Sdump(&decoded),
+			spew.Sdump(p))
+	}
+
+	wantHash := chainhash.DoubleHashH(encoded[:len(encoded)-BLSSignatureSize])
+	if signHash := decoded.SignHash(); signHash != wantHash {
+		t.Errorf("SignHash: got %v, want %v", signHash, wantHash)
+	}
+
+	if s := decoded.Reason.String(); s != "ChangeOfKeys" {
+		t.Errorf("Reason: got %q, want %q", s, "ChangeOfKeys")
+	}
+}
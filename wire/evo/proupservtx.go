@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"io"
+	"net"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// ProUpServTx is the payload of a provider update service transaction
+// (wire.TxTypeProUpServTx) which updates the service address and operator
+// payout script of a masternode.  It is signed by the operator key.
+type ProUpServTx struct {
+	Version uint16
+
+	// Type is only serialized for ProTxVersionBasicBLS and later.
+	Type MnType
+
+	ProTxHash            chainhash.Hash
+	IPAddress            net.IP
+	Port                 uint16
+	ScriptOperatorPayout []byte
+	InputsHash           chainhash.Hash
+
+	// The platform fields are only present for evo masternodes.
+	PlatformNodeID   KeyID
+	PlatformP2PPort  uint16
+	PlatformHTTPPort uint16
+
+	Signature BLSSignature
+}
+
+// Deserialize decodes a ProUpServTx payload from r into the receiver.
+func (p *ProUpServTx) Deserialize(r io.Reader) error {
+	err := readElement(r, &p.Version)
+	if err != nil {
+		return err
+	}
+	if err := validateProTxVersion("ProUpServTx", p.Version); err != nil {
+		return err
+	}
+
+	p.Type = MnTypeRegular
+	if p.Version >= ProTxVersionBasicBLS {
+		if err := readElement(r, &p.Type); err != nil {
+			return err
+		}
+	}
+
+	if err := readElement(r, &p.ProTxHash); err != nil {
+		return err
+	}
+
+	p.IPAddress, p.Port, err = readService(r)
+	if err != nil {
+		return err
+	}
+
+	p.ScriptOperatorPayout, err = wire.ReadVarBytes(r, 0, maxScriptSize,
+		"ProUpServTx operator payout script")
+	if err != nil {
+		return err
+	}
+
+	if err := readElement(r, &p.InputsHash); err != nil {
+		return err
+	}
+
+	if p.Type == MnTypeEvo {
+		err = readElements(r, &p.PlatformNodeID, &p.PlatformP2PPort,
+			&p.PlatformHTTPPort)
+		if err != nil {
+			return err
+		}
+	}
+
+	return readElement(r, &p.Signature)
+}
+
+// Serialize encodes the ProUpServTx payload to w.
+func (p *ProUpServTx) Serialize(w io.Writer) error {
+	if err := p.serializeUnsigned(w); err != nil {
+		return err
+	}
+
+	return writeElement(w, &p.Signature)
+}
+
+// serializeUnsigned encodes all fields of the payload except for the
+// signature to w.
+func (p *ProUpServTx) serializeUnsigned(w io.Writer) error {
+	if err := writeElement(w, p.Version); err != nil {
+		return err
+	}
+
+	if p.Version >= ProTxVersionBasicBLS {
+		if err := writeElement(w, p.Type); err != nil {
+			return err
+		}
+	}
+
+	if err := writeElement(w, &p.ProTxHash); err != nil {
+		return err
+	}
+
+	if err := writeService(w, p.IPAddress, p.Port); err != nil {
+		return err
+	}
+
+	err := wire.WriteVarBytes(w, 0, p.ScriptOperatorPayout)
+	if err != nil {
+		return err
+	}
+
+	if err := writeElement(w, &p.InputsHash); err != nil {
+		return err
+	}
+
+	if p.Type == MnTypeEvo {
+		return writeElements(w, &p.PlatformNodeID, p.PlatformP2PPort,
+			p.PlatformHTTPPort)
+	}
+
+	return nil
+}
+
+// SignHash returns the hash of the payload without its signature.  This is
+// the hash which is signed by the operator BLS key.
+func (p *ProUpServTx) SignHash() chainhash.Hash {
+	return hashPayload(p.serializeUnsigned)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestProUpServTxSerialize tests the encode and decode of ProUpServTx
+// payloads for the legacy and basic BLS versions.
+func TestProUpServTxSerialize(t *testing.T) {
+	legacy := &ProUpServTx{
+		Version:              ProTxVersionLegacyBLS,
+		ProTxHash:            chainhash.Hash{0x11},
+		IPAddress:            net.ParseIP("1.2.3.4"),
+		Port:                 9999,
+		ScriptOperatorPayout: []byte{0x51},
+		InputsHash:           chainhash.Hash{0x22},
+	}
+	copy(legacy.Signature[:], repeat(0x33, BLSSignatureSize))
+	legacyEncoded := bytes.Join([][]byte{
+		{0x01, 0x00},             // Version
+		{0x11}, repeat(0x00, 31), // ProTx hash
+		repeat(0x00, 10), {0xff, 0xff, 0x01, 0x02, 0x03, 0x04}, // IP
+		{0x27, 0x0f},             // Port (big endian)
+		{0x01, 0x51},             // Operator payout script
+		{0x22}, repeat(0x00, 31), // Inputs hash
+		repeat(0x33, BLSSignatureSize), // Signature
+	}, nil)
+
+	evo := &ProUpServTx{
+		Version:          ProTxVersionBasicBLS,
+		Type:             MnTypeEvo,
+		ProTxHash:        legacy.ProTxHash,
+		IPAddress:        legacy.IPAddress,
+		Port:             legacy.Port,
+		InputsHash:       legacy.InputsHash,
+		PlatformP2PPort:  26656,
+		PlatformHTTPPort: 443,
+		Signature:        legacy.Signature,
+	}
+	copy(evo.PlatformNodeID[:], repeat(0x44, KeyIDSize))
+	evoEncoded := bytes.Join([][]byte{
+		{0x02, 0x00},             // Version
+		{0x01, 0x00},             // Type
+		{0x11}, repeat(0x00, 31), // ProTx hash
+		repeat(0x00, 10), {0xff, 0xff, 0x01, 0x02, 0x03, 0x04}, // IP
+		{0x27, 0x0f},             // Port (big endian)
+		{0x00},                   // Operator payout script
+		{0x22}, repeat(0x00, 31), // Inputs hash
+		repeat(0x44, KeyIDSize),        // Platform node ID
+		{0x20, 0x68},                   // Platform P2P port
+		{0xbb, 0x01},                   // Platform HTTP port
+		repeat(0x33, BLSSignatureSize), // Signature
+	}, nil)
+
+	tests := []struct {
+		in  *ProUpServTx
+		buf []byte
+	}{
+		{legacy, legacyEncoded},
+		{evo, evoEncoded},
+	}
+
+	for i, test := range tests {
+		var buf bytes.Buffer
+		if err := test.in.Serialize(&buf); err != nil {
+			t.Errorf("Serialize #%d error %v", i, err)
+			continue
+		}
+		if !bytes.Equal(buf.Bytes(), test.buf) {
+			t.Errorf("Serialize #%d\n got: %s want: %s", i,
+				spew.Sdump(buf.Bytes()), spew.Sdump(test.buf))
+			continue
+		}
+
+		var p ProUpServTx
+		if err := p.Deserialize(bytes.NewReader(test.buf)); err != nil {
+			t.Errorf("Deserialize #%d error %v", i, err)
+			continue
+		}
+		p.IPAddress = p.IPAddress.To4()
+		want := *test.in
+		want.IPAddress = want.IPAddress.To4()
+		if want.ScriptOperatorPayout == nil {
+			want.ScriptOperatorPayout = []byte{}
+		}
+		if !reflect.DeepEqual(&p, &want) {
+			t.Errorf("Deserialize #%d\n got: %s want: %s", i,
+				spew.Sdump(&p), spew.Sdump(&want))
+			continue
+		}
+
+		unsigned := test.buf[:len(test.buf)-BLSSignatureSize]
+		wantHash := chainhash.DoubleHashH(unsigned)
+		if signHash := p.SignHash(); signHash != wantHash {
+			t.Errorf("SignHash #%d got: %v want: %v", i, signHash,
+				wantHash)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dashpay/dashd-go/wire"
+)
+
+// QcTxVersion is the only known version of the quorum commitment payload.
+const QcTxVersion = 1
+
+// QcTx is the payload of a quorum commitment special transaction
+// (wire.TxTypeQuorumCommitment).  It mines the final commitment of a quorum
+// in the block at the given height.  Such transactions have neither inputs
+// nor outputs and are only valid within the mining window of the DKG.
+type QcTx struct {
+	Version    uint16
+	Height     int32
+	Commitment wire.QuorumCommitment
+}
+
+// Deserialize decodes a QcTx payload from r into the receiver.
+func (p *QcTx) Deserialize(r io.Reader) error {
+	err := readElement(r, &p.Version)
+	if err != nil {
+		return err
+	}
+	if p.Version != QcTxVersion {
+		return fmt.Errorf("QcTx: unsupported version %d", p.Version)
+	}
+
+	var height uint32
+	if err := readElement(r, &height); err != nil {
+		return err
+	}
+	p.Height = int32(height)
+
+	return p.Commitment.Deserialize(r)
+}
+
+// Serialize encodes the QcTx payload to w.
+func (p *QcTx) Serialize(w io.Writer) error {
+	if err := writeElements(w, p.Version, uint32(p.Height)); err != nil {
+		return err
+	}
+
+	return p.Commitment.Serialize(w)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package evo
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/dashpay/dashd-go/wire"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestQcTxSerialize tests the encode and decode of QcTx payloads.
+func TestQcTxSerialize(t *testing.T) {
+	want := &QcTx{
+		Version: QcTxVersion,
+		Height:  1000,
+		Commitment: wire.QuorumCommitment{
+			Version:         wire.QuorumCommitmentVersionBasicIndexed,
+			LLMQType:        103,
+			QuorumHash:      chainhash.Hash{0x11},
+			QuorumIndex:     1,
+			Signers:         []bool{true, true, false, true},
+			ValidMembers:    []bool{true, true, true, true},
+			QuorumPublicKey: [48]byte{0x22},
+			QuorumVvecHash:  chainhash.Hash{0x33},
+			QuorumSig:       [96]byte{0x44},
+			MembersSig:      [96]byte{0x55},
+		},
+	}
+	encoded := bytes.Join([][]byte{
+		{0x01, 0x00},             // Version
+		{0xe8, 0x03, 0x00, 0x00}, // Height
+		{0x04, 0x00},             // Commitment version
+		{0x67},                   // LLMQ type
+		{0x11}, repeat(0x00, 31), // Quorum hash
+		{0x01, 0x00},             // Quorum index
+		{0x04, 0x0b},             // Signers
+		{0x04, 0x0f},             // Valid members
+		{0x22}, repeat(0x00, 47), // Quorum public key
+		{0x33}, repeat(0x00, 31), // Quorum vvec hash
+		{0x44}, repeat(0x00, 95), // Quorum signature
+		{0x55}, repeat(0x00, 95), // Members signature
+	}, nil)
+
+	var buf bytes.Buffer
+	if err := want.Serialize(&buf); err != nil {
+		t.Fatalf("Serialize: unexpected error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), encoded) {
+		t.Errorf("Serialize\n got: %s want: %s", spew.Sdump(buf.Bytes()),
+			spew.Sdump(encoded))
+	}
+
+	tx := wire.NewMsgTx(wire.SpecialTxVersion)
+	tx.Type = wire.TxTypeQuorumCommitment
+	tx.ExtraPayload = encoded
+	payload, err := DecodeTxPayload(tx)
+	if err != nil {
+		t.Fatalf("DecodeTxPayload: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("DecodeTxPayload\n got: %s want: %s", spew.Sdump(payload),
+			spew.Sdump(want))
+	}
+
+	// Unknown payload versions are rejected.
+	encoded[0] = 0x02
+	var p QcTx
+	if err := p.Deserialize(bytes.NewReader(encoded)); err == nil {
+		t.Errorf("Deserialize: version 2 unexpectedly accepted")
+	}
+}
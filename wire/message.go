@@ -58,6 +58,17 @@ const (
 	CmdCFHeaders    = "cfheaders"
 	CmdCFCheckpt    = "cfcheckpt"
 	CmdSendAddrV2   = "sendaddrv2"
+
+	// Dash specific commands.
+	CmdGetMNListDiff         = "getmnlistd"
+	CmdMNListDiff            = "mnlistdiff"
+	CmdGetQuorumRotationInfo = "qgetinfo"
+	CmdQuorumRotationInfo    = "qrinfo"
+	CmdCLSig                 = "clsig"
+	CmdISDLock               = "isdlock"
+	CmdQFCommit              = "qfcommit"
+	CmdSpork                 = "spork"
+	CmdGetSporks             = "getsporks"
 )
 
 // MessageEncoding represents the wire message encoding format to be used.
@@ -184,6 +195,33 @@ func makeEmptyMessage(command string) (Message, error) {
 	case CmdCFCheckpt:
 		msg = &MsgCFCheckpt{}
 
+	case CmdGetMNListDiff:
+		msg = &MsgGetMNListDiff{}
+
+	case CmdMNListDiff:
+		msg = &MsgMNListDiff{}
+
+	case CmdGetQuorumRotationInfo:
+		msg = &MsgGetQuorumRotationInfo{}
+
+	case CmdQuorumRotationInfo:
+		msg = &MsgQuorumRotationInfo{}
+
+	case CmdCLSig:
+		msg = &MsgCLSig{}
+
+	case CmdISDLock:
+		msg = &MsgISDLock{}
+
+	case CmdQFCommit:
+		msg = &MsgQFCommit{}
+
+	case CmdSpork:
+		msg = &MsgSpork{}
+
+	case CmdGetSporks:
+		msg = &MsgGetSporks{}
+
 	default:
 		return nil, fmt.Errorf("unhandled command [%s]", command)
 	}
@@ -76,6 +76,24 @@ func TestMessage(t *testing.T) {
 		[]byte("payload"))
 	msgCFHeaders := NewMsgCFHeaders()
 	msgCFCheckpt := NewMsgCFCheckpt(GCSFilterRegular, &chainhash.Hash{}, 0)
+	msgGetMNListDiff := NewMsgGetMNListDiff(&chainhash.Hash{},
+		&chainhash.Hash{})
+	msgMNListDiff := NewMsgMNListDiff(&chainhash.Hash{}, &chainhash.Hash{},
+		NewMsgTx(1))
+	msgGetQuorumRotationInfo := NewMsgGetQuorumRotationInfo(
+		&chainhash.Hash{}, false)
+	msgCLSig := NewMsgCLSig(1, &chainhash.Hash{}, [96]byte{})
+	msgISDLock := NewMsgISDLock([]OutPoint{}, &chainhash.Hash{},
+		&chainhash.Hash{}, [96]byte{})
+	msgQFCommit := NewMsgQFCommit(&QuorumCommitment{
+		Version:      QuorumCommitmentVersionBasic,
+		LLMQType:     1,
+		Signers:      make([]bool, 3),
+		ValidMembers: make([]bool, 3),
+	})
+	msgSpork := NewMsgSpork(10020, 0, 1633024800)
+	msgSpork.Signature = make([]byte, MaxSporkSignatureSize)
+	msgGetSporks := NewMsgGetSporks()
 
 	tests := []struct {
 		in     Message    // Value to encode
@@ -111,6 +129,14 @@ func TestMessage(t *testing.T) {
 		{msgCFilter, msgCFilter, pver, MainNet, 65},
 		{msgCFHeaders, msgCFHeaders, pver, MainNet, 90},
 		{msgCFCheckpt, msgCFCheckpt, pver, MainNet, 58},
+		{msgGetMNListDiff, msgGetMNListDiff, pver, MainNet, 88},
+		{msgMNListDiff, msgMNListDiff, pver, MainNet, 111},
+		{msgGetQuorumRotationInfo, msgGetQuorumRotationInfo, pver, MainNet, 58},
+		{msgCLSig, msgCLSig, pver, MainNet, 156},
+		{msgISDLock, msgISDLock, pver, MainNet, 186},
+		{msgQFCommit, msgQFCommit, pver, MainNet, 335},
+		{msgSpork, msgSpork, pver, MainNet, 110},
+		{msgGetSporks, msgGetSporks, pver, MainNet, 24},
 	}
 
 	t.Logf("Running %d tests", len(tests))
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+const (
+	// MNListEntryVersionBasicBLS is the simplified masternode list entry
+	// version which serializes the operator key using the basic BLS scheme
+	// and carries the masternode type.
+	MNListEntryVersionBasicBLS = 2
+
+	// MNTypeEvo is the masternode type of evolution (platform) masternodes.
+	// Entries of this type carry the platform HTTP port and node ID.
+	MNTypeEvo = 1
+)
+
+// MNListEntry describes a single masternode of the simplified masternode list
+// (SML) as relayed in mnlistdiff messages.
+type MNListEntry struct {
+	// Version of the entry.  It determines the BLS scheme of the operator
+	// key and whether the masternode type is present.
+	Version uint16
+
+	// ProRegTxHash is the hash of the provider registration transaction
+	// which uniquely identifies the masternode.
+	ProRegTxHash chainhash.Hash
+
+	// ConfirmedHash is the hash of the block at which the registration was
+	// confirmed or the zero hash if it is not confirmed yet.
+	ConfirmedHash chainhash.Hash
+
+	// IP address and port of the masternode.  The port is encoded in big
+	// endian on the wire which differs from most everything else.
+	IP   net.IP
+	Port uint16
+
+	// PubKeyOperator is the serialized BLS public key of the operator.
+	PubKeyOperator [48]byte
+
+	// KeyIDVoting is the hash160 of the voting key.
+	KeyIDVoting [20]byte
+
+	// IsValid is false when the masternode is PoSe banned.
+	IsValid bool
+
+	// The following fields are only present for version
+	// MNListEntryVersionBasicBLS and later, and the platform fields only for
+	// entries of type MNTypeEvo.
+	Type             uint16
+	PlatformHTTPPort uint16
+	PlatformNodeID   [20]byte
+}
+
+// readMNListEntry reads a simplified masternode list entry from r using the
+// encoding of the passed protocol version.
+func readMNListEntry(r io.Reader, pver uint32, e *MNListEntry) error {
+	e.Version = 1
+	if pver >= SMNLEVersionedVersion {
+		version, err := binarySerializer.Uint16(r, littleEndian)
+		if err != nil {
+			return err
+		}
+		e.Version = version
+	}
+
+	err := readElements(r, &e.ProRegTxHash, &e.ConfirmedHash)
+	if err != nil {
+		return err
+	}
+
+	var ip [16]byte
+	if _, err := io.ReadFull(r, ip[:]); err != nil {
+		return err
+	}
+	e.IP = net.IP(ip[:])
+	e.Port, err = binarySerializer.Uint16(r, bigEndian)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.ReadFull(r, e.PubKeyOperator[:]); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(r, e.KeyIDVoting[:]); err != nil {
+		return err
+	}
+	if err := readElement(r, &e.IsValid); err != nil {
+		return err
+	}
+
+	if pver < DMNTypeVersion || e.Version < MNListEntryVersionBasicBLS {
+		return nil
+	}
+	e.Type, err = binarySerializer.Uint16(r, littleEndian)
+	if err != nil {
+		return err
+	}
+	if e.Type != MNTypeEvo {
+		return nil
+	}
+	e.PlatformHTTPPort, err = binarySerializer.Uint16(r, littleEndian)
+	if err != nil {
+		return err
+	}
+	_, err = io.ReadFull(r, e.PlatformNodeID[:])
+	return err
+}
+
+// writeMNListEntry writes a simplified masternode list entry to w using the
+// encoding of the passed protocol version.
+func writeMNListEntry(w io.Writer, pver uint32, e *MNListEntry) error {
+	if pver >= SMNLEVersionedVersion {
+		err := binarySerializer.PutUint16(w, littleEndian, e.Version)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Entries encoded for peers which predate the masternode type are
+	// serialized without it like legacy entries.
+	if pver < DMNTypeVersion {
+		legacy := *e
+		legacy.Version = 1
+		return legacy.serializeHashed(w)
+	}
+
+	return e.serializeHashed(w)
+}
+
+// serializeHashed encodes the entry to w in the format which is used to
+// calculate its hash.  Unlike the network encoding it never includes the entry
+// version.
+func (e *MNListEntry) serializeHashed(w io.Writer) error {
+	err := writeElements(w, &e.ProRegTxHash, &e.ConfirmedHash)
+	if err != nil {
+		return err
+	}
+
+	var service [18]byte
+	copy(service[:16], e.IP.To16())
+	binary.BigEndian.PutUint16(service[16:], e.Port)
+	if _, err := w.Write(service[:]); err != nil {
+		return err
+	}
+
+	if _, err := w.Write(e.PubKeyOperator[:]); err != nil {
+		return err
+	}
+	if _, err := w.Write(e.KeyIDVoting[:]); err != nil {
+		return err
+	}
+	if err := writeElement(w, e.IsValid); err != nil {
+		return err
+	}
+
+	if e.Version < MNListEntryVersionBasicBLS {
+		return nil
+	}
+	if err := binarySerializer.PutUint16(w, littleEndian, e.Type); err != nil {
+		return err
+	}
+	if e.Type != MNTypeEvo {
+		return nil
+	}
+	err = binarySerializer.PutUint16(w, littleEndian, e.PlatformHTTPPort)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(e.PlatformNodeID[:])
+	return err
+}
+
+// Hash returns the hash of the entry.  It is the leaf hash of the entry in the
+// merkle tree the coinbase payload commits to.
+func (e *MNListEntry) Hash() chainhash.Hash {
+	var buf bytes.Buffer
+
+	// Ignore the error since writing to a bytes.Buffer can't fail.
+	_ = e.serializeHashed(&buf)
+	return chainhash.DoubleHashH(buf.Bytes())
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestMNListEntryHash ensures simplified masternode list entries are
+// serialized and hashed as expected for all versions and types.
+func TestMNListEntryHash(t *testing.T) {
+	legacy := MNListEntry{
+		Version:       1,
+		ProRegTxHash:  chainhash.Hash{0x01},
+		ConfirmedHash: chainhash.Hash{0x02},
+		IP:            net.ParseIP("1.2.3.4"),
+		Port:          9999,
+		IsValid:       true,
+	}
+	copy(legacy.PubKeyOperator[:], bytes.Repeat([]byte{0x03}, 48))
+	copy(legacy.KeyIDVoting[:], bytes.Repeat([]byte{0x04}, 20))
+	legacyEncoded := bytes.Join([][]byte{
+		{0x01}, make([]byte, 31), // ProRegTx hash
+		{0x02}, make([]byte, 31), // Confirmed hash
+		make([]byte, 10), {0xff, 0xff, 0x01, 0x02, 0x03, 0x04}, // IP
+		{0x27, 0x0f},                   // Port (big endian)
+		bytes.Repeat([]byte{0x03}, 48), // Operator public key
+		bytes.Repeat([]byte{0x04}, 20), // Voting key ID
+		{0x01},                         // Is valid
+	}, nil)
+
+	regular := legacy
+	regular.Version = MNListEntryVersionBasicBLS
+	regularEncoded := bytes.Join([][]byte{
+		legacyEncoded,
+		{0x00, 0x00}, // Type
+	}, nil)
+
+	evo := regular
+	evo.Type = MNTypeEvo
+	evo.PlatformHTTPPort = 443
+	copy(evo.PlatformNodeID[:], bytes.Repeat([]byte{0x05}, 20))
+	evoEncoded := bytes.Join([][]byte{
+		legacyEncoded,
+		{0x01, 0x00},                   // Type
+		{0xbb, 0x01},                   // Platform HTTP port
+		bytes.Repeat([]byte{0x05}, 20), // Platform node ID
+	}, nil)
+
+	tests := []struct {
+		name  string
+		entry MNListEntry
+		buf   []byte
+	}{
+		{"legacy BLS", legacy, legacyEncoded},
+		{"basic BLS regular", regular, regularEncoded},
+		{"basic BLS evo", evo, evoEncoded},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := test.entry.serializeHashed(&buf); err != nil {
+			t.Errorf("%s: serializeHashed: unexpected error: %v",
+				test.name, err)
+			continue
+		}
+		if !bytes.Equal(buf.Bytes(), test.buf) {
+			t.Errorf("%s: serializeHashed\n got: %s want: %s",
+				test.name, spew.Sdump(buf.Bytes()),
+				spew.Sdump(test.buf))
+			continue
+		}
+
+		want := chainhash.DoubleHashH(test.buf)
+		if hash := test.entry.Hash(); hash != want {
+			t.Errorf("%s: Hash: got %v, want %v", test.name, hash,
+				want)
+		}
+	}
+}
@@ -91,8 +91,8 @@ func TestBlockTxHashes(t *testing.T) {
 
 // TestBlockHash tests the ability to generate the hash of a block accurately.
 func TestBlockHash(t *testing.T) {
-	// Block 1 hash.
-	hashStr := "839a8e6886ab5951d76f411475428afc90947ee320161bbf18eb6048"
+	// X11 hash of the block 1 test data.
+	hashStr := "0211087be858e9a6d99efce4d1ce3f16d6cfb62226406866b83d606b87fdc04a"
 	wantHash, err := chainhash.NewHashFromStr(hashStr)
 	if err != nil {
 		t.Errorf("NewHashFromStr: %v", err)
@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+// clsigRequestIDPrefix is the prefix of the serialized data which is hashed to
+// obtain the signing request id of a ChainLock.
+const clsigRequestIDPrefix = "clsig"
+
+// MsgCLSig implements the Message interface and represents a Dash clsig
+// message.  It carries a ChainLock as defined by DIP-8: the recovered threshold
+// signature of the ChainLocks quorum which locks the block with the given hash
+// at the given height, making it and all of its ancestors final.
+type MsgCLSig struct {
+	Height    int32
+	BlockHash chainhash.Hash
+	Signature [96]byte
+}
+
+// RequestID returns the id of the signing request the quorum signed to create
+// the ChainLock.  It only depends on the height of the locked block.
+func (msg *MsgCLSig) RequestID() chainhash.Hash {
+	var buf bytes.Buffer
+	buf.Grow(1 + len(clsigRequestIDPrefix) + 4)
+
+	// Writing to a bytes.Buffer never fails.
+	_ = WriteVarString(&buf, 0, clsigRequestIDPrefix)
+	_ = writeElement(&buf, msg.Height)
+
+	return chainhash.DoubleHashH(buf.Bytes())
+}
+
+// BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgCLSig) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	if err := readElements(r, &msg.Height, &msg.BlockHash); err != nil {
+		return err
+	}
+	_, err := io.ReadFull(r, msg.Signature[:])
+	return err
+}
+
+// BtcEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgCLSig) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	if err := writeElements(w, msg.Height, &msg.BlockHash); err != nil {
+		return err
+	}
+	_, err := w.Write(msg.Signature[:])
+	return err
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgCLSig) Command() string {
+	return CmdCLSig
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgCLSig) MaxPayloadLength(pver uint32) uint32 {
+	// Height 4 bytes + block hash + signature 96 bytes.
+	return 4 + chainhash.HashSize + 96
+}
+
+// NewMsgCLSig returns a new Dash clsig message that conforms to the Message
+// interface using the passed parameters.  See MsgCLSig for details.
+func NewMsgCLSig(height int32, blockHash *chainhash.Hash, signature [96]byte) *MsgCLSig {
+	return &MsgCLSig{
+		Height:    height,
+		BlockHash: *blockHash,
+		Signature: signature,
+	}
+}
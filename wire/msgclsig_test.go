@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestCLSig tests the MsgCLSig API.
+func TestCLSig(t *testing.T) {
+	blockHash := chainhash.Hash{0x01}
+	msg := NewMsgCLSig(0x010203, &blockHash, [96]byte{0x02})
+
+	if cmd := msg.Command(); cmd != "clsig" {
+		t.Errorf("NewMsgCLSig: wrong command - got %v want %v", cmd,
+			"clsig")
+	}
+	if maxLen := msg.MaxPayloadLength(ProtocolVersion); maxLen != 132 {
+		t.Errorf("MaxPayloadLength: got %d, want 132", maxLen)
+	}
+
+	// The request id is the hash of the "clsig" var string followed by the
+	// height.
+	want := chainhash.DoubleHashH([]byte{
+		0x05, 'c', 'l', 's', 'i', 'g', 0x03, 0x02, 0x01, 0x00,
+	})
+	if id := msg.RequestID(); id != want {
+		t.Errorf("RequestID: got %v, want %v", id, want)
+	}
+}
+
+// TestCLSigWire tests the MsgCLSig wire encode and decode.
+func TestCLSigWire(t *testing.T) {
+	blockHash := chainhash.Hash{0x01}
+	msg := NewMsgCLSig(0x010203, &blockHash, [96]byte{0x02, 0x03})
+
+	encoded := []byte{0x03, 0x02, 0x01, 0x00}
+	encoded = append(encoded, blockHash[:]...)
+	encoded = append(encoded, msg.Signature[:]...)
+
+	var buf bytes.Buffer
+	if err := msg.BtcEncode(&buf, ProtocolVersion, BaseEncoding); err != nil {
+		t.Fatalf("BtcEncode: unexpected error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), encoded) {
+		t.Errorf("BtcEncode\n got: %s want: %s", spew.Sdump(buf.Bytes()),
+			spew.Sdump(encoded))
+	}
+
+	var decoded MsgCLSig
+	rbuf := bytes.NewReader(encoded)
+	if err := decoded.BtcDecode(rbuf, ProtocolVersion, BaseEncoding); err != nil {
+		t.Fatalf("BtcDecode: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(&decoded, msg) {
+		t.Errorf("BtcDecode\n got: %s want: %s", spew.Sdump(&decoded),
+			spew.Sdump(msg))
+	}
+
+	// Every truncation of the encoded message must fail to decode.
+	for i := 0; i < len(encoded); i++ {
+		var msg MsgCLSig
+		err := msg.BtcDecode(bytes.NewReader(encoded[:i]), ProtocolVersion,
+			BaseEncoding)
+		if err == nil {
+			t.Fatalf("BtcDecode: unexpected success for %d of %d "+
+				"bytes", i, len(encoded))
+		}
+	}
+}
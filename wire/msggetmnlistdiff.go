@@ -0,0 +1,56 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"io"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+// MsgGetMNListDiff implements the Message interface and represents a Dash
+// getmnlistd message.  It is used to request the changes of the simplified
+// masternode list between two blocks, which are returned in a mnlistdiff
+// message (MsgMNListDiff).  Passing the zero hash as base block requests the
+// full list.
+type MsgGetMNListDiff struct {
+	BaseBlockHash chainhash.Hash
+	BlockHash     chainhash.Hash
+}
+
+// BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgGetMNListDiff) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	return readElements(r, &msg.BaseBlockHash, &msg.BlockHash)
+}
+
+// BtcEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgGetMNListDiff) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	return writeElements(w, &msg.BaseBlockHash, &msg.BlockHash)
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgGetMNListDiff) Command() string {
+	return CmdGetMNListDiff
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgGetMNListDiff) MaxPayloadLength(pver uint32) uint32 {
+	// Base block hash + block hash.
+	return chainhash.HashSize * 2
+}
+
+// NewMsgGetMNListDiff returns a new Dash getmnlistd message that conforms to
+// the Message interface using the passed parameters.  See MsgGetMNListDiff for
+// details.
+func NewMsgGetMNListDiff(baseBlockHash, blockHash *chainhash.Hash) *MsgGetMNListDiff {
+	return &MsgGetMNListDiff{
+		BaseBlockHash: *baseBlockHash,
+		BlockHash:     *blockHash,
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+// MaxQRInfoBaseBlockHashes is the maximum number of base block hashes of a
+// qgetinfo message.  A client needs at most one base block per list it already
+// knows, which is well below this limit.
+const MaxQRInfoBaseBlockHashes = 16
+
+// MsgGetQuorumRotationInfo implements the Message interface and represents a
+// Dash qgetinfo message.  It is used to request the information needed to
+// verify the rotating quorums active at the block identified by
+// BlockRequestHash, which is returned in a qrinfo message
+// (MsgQuorumRotationInfo).  The returned diffs build on the most recent of the
+// passed base blocks which is known to the remote peer.
+type MsgGetQuorumRotationInfo struct {
+	BaseBlockHashes  []chainhash.Hash
+	BlockRequestHash chainhash.Hash
+
+	// ExtraShare requests the information of one additional rotation
+	// cycle.
+	ExtraShare bool
+}
+
+// AddBaseBlockHash adds a new base block hash to the message.
+func (msg *MsgGetQuorumRotationInfo) AddBaseBlockHash(hash *chainhash.Hash) error {
+	if len(msg.BaseBlockHashes)+1 > MaxQRInfoBaseBlockHashes {
+		str := fmt.Sprintf("too many base block hashes for message "+
+			"[max %v]", MaxQRInfoBaseBlockHashes)
+		return messageError("MsgGetQuorumRotationInfo.AddBaseBlockHash",
+			str)
+	}
+
+	msg.BaseBlockHashes = append(msg.BaseBlockHashes, *hash)
+	return nil
+}
+
+// BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgGetQuorumRotationInfo) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	count, err := readListCount(r, pver, MaxQRInfoBaseBlockHashes,
+		"MsgGetQuorumRotationInfo.BtcDecode")
+	if err != nil {
+		return err
+	}
+	msg.BaseBlockHashes = make([]chainhash.Hash, count)
+	for i := range msg.BaseBlockHashes {
+		if err := readElement(r, &msg.BaseBlockHashes[i]); err != nil {
+			return err
+		}
+	}
+
+	return readElements(r, &msg.BlockRequestHash, &msg.ExtraShare)
+}
+
+// BtcEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgGetQuorumRotationInfo) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	count := len(msg.BaseBlockHashes)
+	if count > MaxQRInfoBaseBlockHashes {
+		str := fmt.Sprintf("too many base block hashes for message "+
+			"[count %v, max %v]", count, MaxQRInfoBaseBlockHashes)
+		return messageError("MsgGetQuorumRotationInfo.BtcEncode", str)
+	}
+
+	if err := WriteVarInt(w, pver, uint64(count)); err != nil {
+		return err
+	}
+	for i := range msg.BaseBlockHashes {
+		if err := writeElement(w, &msg.BaseBlockHashes[i]); err != nil {
+			return err
+		}
+	}
+
+	return writeElements(w, &msg.BlockRequestHash, msg.ExtraShare)
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgGetQuorumRotationInfo) Command() string {
+	return CmdGetQuorumRotationInfo
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgGetQuorumRotationInfo) MaxPayloadLength(pver uint32) uint32 {
+	// Num base block hashes (varInt) + max allowed base block hashes +
+	// block request hash + extra share flag.
+	return MaxVarIntPayload + (MaxQRInfoBaseBlockHashes *
+		chainhash.HashSize) + chainhash.HashSize + 1
+}
+
+// NewMsgGetQuorumRotationInfo returns a new Dash qgetinfo message that conforms
+// to the Message interface using the passed parameters and defaults for the
+// remaining fields.  See MsgGetQuorumRotationInfo for details.
+func NewMsgGetQuorumRotationInfo(blockRequestHash *chainhash.Hash,
+	extraShare bool) *MsgGetQuorumRotationInfo {
+
+	return &MsgGetQuorumRotationInfo{
+		BaseBlockHashes: make([]chainhash.Hash, 0,
+			MaxQRInfoBaseBlockHashes),
+		BlockRequestHash: *blockRequestHash,
+		ExtraShare:       extraShare,
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import "io"
+
+// MsgGetSporks implements the Message interface and represents a Dash
+// getsporks message.  It is used to request the latest spork messages the
+// peer knows about, which it responds to with a spork message for each.
+//
+// This message has no payload.
+type MsgGetSporks struct{}
+
+// BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgGetSporks) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	return nil
+}
+
+// BtcEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgGetSporks) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	return nil
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgGetSporks) Command() string {
+	return CmdGetSporks
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgGetSporks) MaxPayloadLength(pver uint32) uint32 {
+	return 0
+}
+
+// NewMsgGetSporks returns a new Dash getsporks message that conforms to the
+// Message interface.  See MsgGetSporks for details.
+func NewMsgGetSporks() *MsgGetSporks {
+	return &MsgGetSporks{}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+const (
+	// ISDLockVersion is the current version of deterministic InstantSend
+	// locks.
+	ISDLockVersion = 1
+
+	// islockRequestIDPrefix is the prefix of the serialized data which is
+	// hashed to obtain the signing request id of an InstantSend lock.
+	islockRequestIDPrefix = "islock"
+)
+
+// MsgISDLock implements the Message interface and represents a Dash isdlock
+// message.  It carries a deterministic InstantSend lock as defined by DIP-10
+// and DIP-22: the recovered threshold signature of the InstantSend quorum of
+// the rotation cycle starting at the block with hash CycleHash, which locks the
+// transaction with hash TxID and thereby all of its inputs.  Once locked, no
+// other transaction spending any of the inputs can be accepted.
+type MsgISDLock struct {
+	Version   uint8
+	Inputs    []OutPoint
+	TxID      chainhash.Hash
+	CycleHash chainhash.Hash
+	Signature [96]byte
+}
+
+// RequestID returns the id of the signing request the quorum signed to create
+// the InstantSend lock.  It only depends on the locked inputs.
+func (msg *MsgISDLock) RequestID() chainhash.Hash {
+	var buf bytes.Buffer
+	buf.Grow(1 + len(islockRequestIDPrefix) + MaxVarIntPayload +
+		len(msg.Inputs)*(chainhash.HashSize+4))
+
+	// Writing to a bytes.Buffer never fails.
+	_ = WriteVarString(&buf, 0, islockRequestIDPrefix)
+	_ = WriteVarInt(&buf, 0, uint64(len(msg.Inputs)))
+	for i := range msg.Inputs {
+		_ = writeOutPoint(&buf, 0, 0, &msg.Inputs[i])
+	}
+
+	return chainhash.DoubleHashH(buf.Bytes())
+}
+
+// BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgISDLock) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	if err := readElement(r, &msg.Version); err != nil {
+		return err
+	}
+	if msg.Version != ISDLockVersion {
+		str := fmt.Sprintf("unsupported InstantSend lock version %d",
+			msg.Version)
+		return messageError("MsgISDLock.BtcDecode", str)
+	}
+
+	count, err := readListCount(r, pver, maxTxInPerMessage,
+		"MsgISDLock.Inputs")
+	if err != nil {
+		return err
+	}
+	msg.Inputs = make([]OutPoint, count)
+	for i := range msg.Inputs {
+		if err := readOutPoint(r, pver, 0, &msg.Inputs[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := readElements(r, &msg.TxID, &msg.CycleHash); err != nil {
+		return err
+	}
+	_, err = io.ReadFull(r, msg.Signature[:])
+	return err
+}
+
+// BtcEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgISDLock) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	if err := writeElement(w, msg.Version); err != nil {
+		return err
+	}
+
+	if err := WriteVarInt(w, pver, uint64(len(msg.Inputs))); err != nil {
+		return err
+	}
+	for i := range msg.Inputs {
+		if err := writeOutPoint(w, pver, 0, &msg.Inputs[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := writeElements(w, &msg.TxID, &msg.CycleHash); err != nil {
+		return err
+	}
+	_, err := w.Write(msg.Signature[:])
+	return err
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgISDLock) Command() string {
+	return CmdISDLock
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgISDLock) MaxPayloadLength(pver uint32) uint32 {
+	return MaxMessagePayload
+}
+
+// NewMsgISDLock returns a new Dash isdlock message that conforms to the
+// Message interface using the passed parameters.  See MsgISDLock for details.
+func NewMsgISDLock(inputs []OutPoint, txID, cycleHash *chainhash.Hash, signature [96]byte) *MsgISDLock {
+	return &MsgISDLock{
+		Version:   ISDLockVersion,
+		Inputs:    inputs,
+		TxID:      *txID,
+		CycleHash: *cycleHash,
+		Signature: signature,
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// testISDLock returns an isdlock message which locks two inputs.
+func testISDLock() *MsgISDLock {
+	inputs := []OutPoint{
+		{Hash: chainhash.Hash{0x01}, Index: 1},
+		{Hash: chainhash.Hash{0x02}, Index: 0x0201},
+	}
+	return NewMsgISDLock(inputs, &chainhash.Hash{0x03},
+		&chainhash.Hash{0x04}, [96]byte{0x05})
+}
+
+// TestISDLock tests the MsgISDLock API.
+func TestISDLock(t *testing.T) {
+	msg := testISDLock()
+
+	if cmd := msg.Command(); cmd != "isdlock" {
+		t.Errorf("NewMsgISDLock: wrong command - got %v want %v", cmd,
+			"isdlock")
+	}
+	if msg.Version != ISDLockVersion {
+		t.Errorf("NewMsgISDLock: wrong version - got %d want %d",
+			msg.Version, ISDLockVersion)
+	}
+
+	// The request id is the hash of the "islock" var string followed by
+	// the locked inputs.
+	data := []byte{0x06, 'i', 's', 'l', 'o', 'c', 'k', 0x02}
+	data = append(data, msg.Inputs[0].Hash[:]...)
+	data = append(data, 0x01, 0x00, 0x00, 0x00)
+	data = append(data, msg.Inputs[1].Hash[:]...)
+	data = append(data, 0x01, 0x02, 0x00, 0x00)
+	if id, want := msg.RequestID(), chainhash.DoubleHashH(data); id != want {
+		t.Errorf("RequestID: got %v, want %v", id, want)
+	}
+}
+
+// TestISDLockWire tests the MsgISDLock wire encode and decode.
+func TestISDLockWire(t *testing.T) {
+	msg := testISDLock()
+
+	encoded := []byte{0x01, 0x02}
+	encoded = append(encoded, msg.Inputs[0].Hash[:]...)
+	encoded = append(encoded, 0x01, 0x00, 0x00, 0x00)
+	encoded = append(encoded, msg.Inputs[1].Hash[:]...)
+	encoded = append(encoded, 0x01, 0x02, 0x00, 0x00)
+	encoded = append(encoded, msg.TxID[:]...)
+	encoded = append(encoded, msg.CycleHash[:]...)
+	encoded = append(encoded, msg.Signature[:]...)
+
+	var buf bytes.Buffer
+	if err := msg.BtcEncode(&buf, ProtocolVersion, BaseEncoding); err != nil {
+		t.Fatalf("BtcEncode: unexpected error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), encoded) {
+		t.Errorf("BtcEncode\n got: %s want: %s", spew.Sdump(buf.Bytes()),
+			spew.Sdump(encoded))
+	}
+
+	var decoded MsgISDLock
+	rbuf := bytes.NewReader(encoded)
+	if err := decoded.BtcDecode(rbuf, ProtocolVersion, BaseEncoding); err != nil {
+		t.Fatalf("BtcDecode: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(&decoded, msg) {
+		t.Errorf("BtcDecode\n got: %s want: %s", spew.Sdump(&decoded),
+			spew.Sdump(msg))
+	}
+
+	// Every truncation of the encoded message must fail to decode.
+	for i := 0; i < len(encoded); i++ {
+		var msg MsgISDLock
+		err := msg.BtcDecode(bytes.NewReader(encoded[:i]), ProtocolVersion,
+			BaseEncoding)
+		if err == nil {
+			t.Fatalf("BtcDecode: unexpected success for %d of %d "+
+				"bytes", i, len(encoded))
+		}
+	}
+
+	// Non-deterministic locks are not supported.
+	legacy := append([]byte{0x00}, encoded[1:]...)
+	err := decoded.BtcDecode(bytes.NewReader(legacy), ProtocolVersion,
+		BaseEncoding)
+	if _, ok := err.(*MessageError); !ok {
+		t.Errorf("BtcDecode: wrong error for version 0 - got %T, want "+
+			"*MessageError", err)
+	}
+}
@@ -0,0 +1,404 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+const (
+	// minMNListEntryPayload is the minimum payload size of a simplified
+	// masternode list entry.  ProRegTx hash 32 bytes + confirmed hash 32
+	// bytes + service 18 bytes + operator key 48 bytes + voting key ID 20
+	// bytes + is valid 1 byte.
+	minMNListEntryPayload = 151
+
+	// minQuorumCommitmentPayload is the minimum payload size of a final
+	// commitment.  Version 2 bytes + LLMQ type 1 byte + quorum hash 32
+	// bytes + 2 empty bit sets 2 bytes + public key 48 bytes + vvec hash 32
+	// bytes + 2 signatures 192 bytes.
+	minQuorumCommitmentPayload = 309
+
+	// maxMNListDiffItems is the maximum number of items of any list within
+	// a mnlistdiff message.  It is the number of the smallest item, a
+	// quorum index of the ChainLock signatures, that fits into the max
+	// message payload.
+	maxMNListDiffItems = MaxMessagePayload / 2
+)
+
+// PartialMerkleTree is a merkle proof of a subset of the transactions of a
+// block.  It is encoded like the transactions, hashes and flags of a
+// merkleblock message (MsgMerkleBlock).
+type PartialMerkleTree struct {
+	Transactions uint32
+	Hashes       []*chainhash.Hash
+	Flags        []byte
+}
+
+// DeletedQuorum identifies a quorum which was removed in a mnlistdiff message.
+type DeletedQuorum struct {
+	LLMQType   uint8
+	QuorumHash chainhash.Hash
+}
+
+// QuorumCLSig is the ChainLock signature of the quorums identified by their
+// indexes into the new quorums of a mnlistdiff message.
+type QuorumCLSig struct {
+	Signature     [96]byte
+	QuorumIndexes []uint16
+}
+
+// MsgMNListDiff implements the Message interface and represents a Dash
+// mnlistdiff message.  It is sent in response to a getmnlistd message
+// (MsgGetMNListDiff) and describes the changes of the simplified masternode
+// list and of the active quorums between two blocks along with the coinbase
+// transaction of the target block, which commits to the resulting list, and a
+// merkle proof of it.
+//
+// The fields which are present depend on the protocol version:
+//   - Version is only encoded starting with BLSSchemeVersion
+//   - QuorumsCLSigs is only encoded starting with MNListDiffChainLocksVersion
+type MsgMNListDiff struct {
+	Version        uint16
+	BaseBlockHash  chainhash.Hash
+	BlockHash      chainhash.Hash
+	CbTxMerkleTree PartialMerkleTree
+	CbTx           *MsgTx
+	DeletedMNs     []chainhash.Hash
+	MNList         []*MNListEntry
+	DeletedQuorums []DeletedQuorum
+	NewQuorums     []*QuorumCommitment
+	QuorumsCLSigs  []QuorumCLSig
+}
+
+// readListCount reads the number of items of a list from r and ensures it does
+// not exceed the passed maximum.
+func readListCount(r io.Reader, pver uint32, max uint64, field string) (uint64, error) {
+	count, err := ReadVarInt(r, pver)
+	if err != nil {
+		return 0, err
+	}
+	if count > max {
+		str := fmt.Sprintf("too many items in list [count %v, max %v]",
+			count, max)
+		return 0, messageError(field, str)
+	}
+
+	return count, nil
+}
+
+// BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgMNListDiff) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	msg.Version = 1
+	if pver >= MNListDiffVersionOrderVersion {
+		version, err := binarySerializer.Uint16(r, littleEndian)
+		if err != nil {
+			return err
+		}
+		msg.Version = version
+	}
+
+	err := readElements(r, &msg.BaseBlockHash, &msg.BlockHash,
+		&msg.CbTxMerkleTree.Transactions)
+	if err != nil {
+		return err
+	}
+	count, err := readListCount(r, pver, maxTxPerBlock,
+		"MsgMNListDiff.CbTxMerkleTree.Hashes")
+	if err != nil {
+		return err
+	}
+	hashes := make([]chainhash.Hash, count)
+	msg.CbTxMerkleTree.Hashes = make([]*chainhash.Hash, 0, count)
+	for i := range hashes {
+		if err := readElement(r, &hashes[i]); err != nil {
+			return err
+		}
+		msg.CbTxMerkleTree.Hashes = append(msg.CbTxMerkleTree.Hashes,
+			&hashes[i])
+	}
+	msg.CbTxMerkleTree.Flags, err = ReadVarBytes(r, pver,
+		maxFlagsPerMerkleBlock, "coinbase merkle tree flags size")
+	if err != nil {
+		return err
+	}
+
+	msg.CbTx = &MsgTx{}
+	if err := msg.CbTx.BtcDecode(r, pver, BaseEncoding); err != nil {
+		return err
+	}
+
+	if pver >= BLSSchemeVersion && pver < MNListDiffVersionOrderVersion {
+		msg.Version, err = binarySerializer.Uint16(r, littleEndian)
+		if err != nil {
+			return err
+		}
+	}
+
+	count, err = readListCount(r, pver,
+		MaxMessagePayload/chainhash.HashSize, "MsgMNListDiff.DeletedMNs")
+	if err != nil {
+		return err
+	}
+	msg.DeletedMNs = make([]chainhash.Hash, count)
+	for i := range msg.DeletedMNs {
+		if err := readElement(r, &msg.DeletedMNs[i]); err != nil {
+			return err
+		}
+	}
+
+	count, err = readListCount(r, pver,
+		MaxMessagePayload/minMNListEntryPayload, "MsgMNListDiff.MNList")
+	if err != nil {
+		return err
+	}
+	entries := make([]MNListEntry, count)
+	msg.MNList = make([]*MNListEntry, 0, count)
+	for i := range entries {
+		if err := readMNListEntry(r, pver, &entries[i]); err != nil {
+			return err
+		}
+		msg.MNList = append(msg.MNList, &entries[i])
+	}
+
+	count, err = readListCount(r, pver,
+		MaxMessagePayload/(chainhash.HashSize+1),
+		"MsgMNListDiff.DeletedQuorums")
+	if err != nil {
+		return err
+	}
+	msg.DeletedQuorums = make([]DeletedQuorum, count)
+	for i := range msg.DeletedQuorums {
+		dq := &msg.DeletedQuorums[i]
+		dq.LLMQType, err = binarySerializer.Uint8(r)
+		if err != nil {
+			return err
+		}
+		if err := readElement(r, &dq.QuorumHash); err != nil {
+			return err
+		}
+	}
+
+	msg.NewQuorums, err = readQuorumCommitments(r, pver,
+		"MsgMNListDiff.NewQuorums")
+	if err != nil {
+		return err
+	}
+
+	msg.QuorumsCLSigs = nil
+	if pver < MNListDiffChainLocksVersion {
+		return nil
+	}
+	count, err = readListCount(r, pver, maxMNListDiffItems,
+		"MsgMNListDiff.QuorumsCLSigs")
+	if err != nil {
+		return err
+	}
+	msg.QuorumsCLSigs = make([]QuorumCLSig, count)
+	for i := range msg.QuorumsCLSigs {
+		clSig := &msg.QuorumsCLSigs[i]
+		if _, err := io.ReadFull(r, clSig.Signature[:]); err != nil {
+			return err
+		}
+		count, err := readListCount(r, pver, maxMNListDiffItems,
+			"MsgMNListDiff.QuorumsCLSigs.QuorumIndexes")
+		if err != nil {
+			return err
+		}
+		clSig.QuorumIndexes = make([]uint16, count)
+		for j := range clSig.QuorumIndexes {
+			clSig.QuorumIndexes[j], err = binarySerializer.Uint16(r,
+				littleEndian)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// BtcEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgMNListDiff) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	if msg.CbTx == nil {
+		return messageError("MsgMNListDiff.BtcEncode",
+			"missing coinbase transaction")
+	}
+	numHashes := len(msg.CbTxMerkleTree.Hashes)
+	if numHashes > maxTxPerBlock {
+		str := fmt.Sprintf("too many coinbase merkle tree hashes for "+
+			"message [count %v, max %v]", numHashes, maxTxPerBlock)
+		return messageError("MsgMNListDiff.BtcEncode", str)
+	}
+
+	if pver >= MNListDiffVersionOrderVersion {
+		err := binarySerializer.PutUint16(w, littleEndian, msg.Version)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := writeElements(w, &msg.BaseBlockHash, &msg.BlockHash,
+		msg.CbTxMerkleTree.Transactions)
+	if err != nil {
+		return err
+	}
+	if err := WriteVarInt(w, pver, uint64(numHashes)); err != nil {
+		return err
+	}
+	for _, hash := range msg.CbTxMerkleTree.Hashes {
+		if err := writeElement(w, hash); err != nil {
+			return err
+		}
+	}
+	if err := WriteVarBytes(w, pver, msg.CbTxMerkleTree.Flags); err != nil {
+		return err
+	}
+
+	if err := msg.CbTx.BtcEncode(w, pver, BaseEncoding); err != nil {
+		return err
+	}
+
+	if pver >= BLSSchemeVersion && pver < MNListDiffVersionOrderVersion {
+		err := binarySerializer.PutUint16(w, littleEndian, msg.Version)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := WriteVarInt(w, pver, uint64(len(msg.DeletedMNs))); err != nil {
+		return err
+	}
+	for i := range msg.DeletedMNs {
+		if err := writeElement(w, &msg.DeletedMNs[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := WriteVarInt(w, pver, uint64(len(msg.MNList))); err != nil {
+		return err
+	}
+	for _, entry := range msg.MNList {
+		if err := writeMNListEntry(w, pver, entry); err != nil {
+			return err
+		}
+	}
+
+	err = WriteVarInt(w, pver, uint64(len(msg.DeletedQuorums)))
+	if err != nil {
+		return err
+	}
+	for i := range msg.DeletedQuorums {
+		dq := &msg.DeletedQuorums[i]
+		if err := binarySerializer.PutUint8(w, dq.LLMQType); err != nil {
+			return err
+		}
+		if err := writeElement(w, &dq.QuorumHash); err != nil {
+			return err
+		}
+	}
+
+	if err := writeQuorumCommitments(w, pver, msg.NewQuorums); err != nil {
+		return err
+	}
+
+	if pver < MNListDiffChainLocksVersion {
+		return nil
+	}
+	err = WriteVarInt(w, pver, uint64(len(msg.QuorumsCLSigs)))
+	if err != nil {
+		return err
+	}
+	for i := range msg.QuorumsCLSigs {
+		clSig := &msg.QuorumsCLSigs[i]
+		if _, err := w.Write(clSig.Signature[:]); err != nil {
+			return err
+		}
+		err := WriteVarInt(w, pver, uint64(len(clSig.QuorumIndexes)))
+		if err != nil {
+			return err
+		}
+		for _, index := range clSig.QuorumIndexes {
+			err := binarySerializer.PutUint16(w, littleEndian, index)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// readQuorumCommitments reads a list of final commitments from r.
+func readQuorumCommitments(r io.Reader, pver uint32, field string) ([]*QuorumCommitment, error) {
+	count, err := readListCount(r, pver,
+		MaxMessagePayload/minQuorumCommitmentPayload, field)
+	if err != nil {
+		return nil, err
+	}
+
+	commitments := make([]QuorumCommitment, count)
+	result := make([]*QuorumCommitment, 0, count)
+	for i := range commitments {
+		err := readQuorumCommitment(r, pver, &commitments[i])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &commitments[i])
+	}
+
+	return result, nil
+}
+
+// writeQuorumCommitments writes a list of final commitments to w.
+func writeQuorumCommitments(w io.Writer, pver uint32, commitments []*QuorumCommitment) error {
+	if err := WriteVarInt(w, pver, uint64(len(commitments))); err != nil {
+		return err
+	}
+	for _, qc := range commitments {
+		if err := writeQuorumCommitment(w, pver, qc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgMNListDiff) Command() string {
+	return CmdMNListDiff
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgMNListDiff) MaxPayloadLength(pver uint32) uint32 {
+	return MaxMessagePayload
+}
+
+// NewMsgMNListDiff returns a new Dash mnlistdiff message that conforms to the
+// Message interface.  See MsgMNListDiff for details.
+func NewMsgMNListDiff(baseBlockHash, blockHash *chainhash.Hash, cbTx *MsgTx) *MsgMNListDiff {
+	return &MsgMNListDiff{
+		Version:       1,
+		BaseBlockHash: *baseBlockHash,
+		BlockHash:     *blockHash,
+		CbTxMerkleTree: PartialMerkleTree{
+			Hashes: make([]*chainhash.Hash, 0),
+			Flags:  make([]byte, 0),
+		},
+		CbTx:           cbTx,
+		DeletedMNs:     make([]chainhash.Hash, 0),
+		MNList:         make([]*MNListEntry, 0),
+		DeletedQuorums: make([]DeletedQuorum, 0),
+		NewQuorums:     make([]*QuorumCommitment, 0),
+		QuorumsCLSigs:  make([]QuorumCLSig, 0),
+	}
+}
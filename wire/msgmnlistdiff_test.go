@@ -0,0 +1,196 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// testMNListDiff returns a mnlistdiff message which makes use of every field.
+func testMNListDiff() *MsgMNListDiff {
+	cbTx := NewMsgTx(SpecialTxVersion)
+	cbTx.Type = TxTypeCoinbase
+	cbTx.AddTxIn(NewTxIn(NewOutPoint(&chainhash.Hash{}, MaxPrevOutIndex),
+		[]byte{0x51}, nil))
+	cbTx.AddTxOut(NewTxOut(500, []byte{0x51}))
+	cbTx.ExtraPayload = []byte{0x02, 0x00, 0x01, 0x00, 0x00, 0x00}
+
+	msg := NewMsgMNListDiff(&chainhash.Hash{0x01}, &chainhash.Hash{0x02},
+		cbTx)
+	msg.Version = 2
+	msg.CbTxMerkleTree.Transactions = 3
+	msg.CbTxMerkleTree.Hashes = []*chainhash.Hash{{0x03}, {0x04}}
+	msg.CbTxMerkleTree.Flags = []byte{0x1d}
+	msg.DeletedMNs = []chainhash.Hash{{0x05}}
+	msg.MNList = []*MNListEntry{{
+		Version:      MNListEntryVersionBasicBLS,
+		ProRegTxHash: chainhash.Hash{0x06},
+		IP:           net.ParseIP("1.2.3.4"),
+		Port:         9999,
+		IsValid:      true,
+	}, {
+		Version:          MNListEntryVersionBasicBLS,
+		ProRegTxHash:     chainhash.Hash{0x07},
+		IP:               net.ParseIP("2001:db8::1"),
+		Port:             19999,
+		Type:             MNTypeEvo,
+		PlatformHTTPPort: 443,
+		PlatformNodeID:   [20]byte{0x08},
+	}}
+	msg.DeletedQuorums = []DeletedQuorum{{
+		LLMQType:   1,
+		QuorumHash: chainhash.Hash{0x09},
+	}}
+	msg.NewQuorums = []*QuorumCommitment{{
+		Version:      QuorumCommitmentVersionBasicIndexed,
+		LLMQType:     103,
+		QuorumHash:   chainhash.Hash{0x0a},
+		QuorumIndex:  2,
+		Signers:      []bool{true, false, true},
+		ValidMembers: []bool{true, true, true},
+	}}
+	msg.QuorumsCLSigs = []QuorumCLSig{{
+		Signature:     [96]byte{0x0b},
+		QuorumIndexes: []uint16{0},
+	}}
+
+	return msg
+}
+
+// TestMNListDiffWire tests the MsgMNListDiff wire encode and decode for the
+// protocol versions which changed its encoding.
+func TestMNListDiffWire(t *testing.T) {
+	latest := testMNListDiff()
+
+	// Peers which predate the ChainLock signatures don't receive them.
+	noCLSigs := testMNListDiff()
+	noCLSigs.QuorumsCLSigs = nil
+
+	// Peers which predate the versioned entries receive neither the
+	// version nor the type of the entries, and peers which also predate the
+	// BLS scheme signaling don't receive the version of the diff.
+	legacyEntries := func(version uint16) *MsgMNListDiff {
+		msg := testMNListDiff()
+		msg.Version = version
+		msg.QuorumsCLSigs = nil
+		for _, entry := range msg.MNList {
+			entry.Version = 1
+			entry.Type = 0
+			entry.PlatformHTTPPort = 0
+			entry.PlatformNodeID = [20]byte{}
+		}
+		return msg
+	}
+
+	tests := []struct {
+		name string
+		out  *MsgMNListDiff
+		pver uint32
+	}{
+		{"latest protocol version", latest, ProtocolVersion},
+		{"version order", noCLSigs, MNListDiffVersionOrderVersion},
+		{"versioned entries", noCLSigs, SMNLEVersionedVersion},
+		{"BLS scheme", legacyEntries(2), BLSSchemeVersion},
+		{"fee filter", legacyEntries(1), FeeFilterVersion},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		err := testMNListDiff().BtcEncode(&buf, test.pver, BaseEncoding)
+		if err != nil {
+			t.Errorf("%s: BtcEncode: unexpected error: %v", test.name,
+				err)
+			continue
+		}
+
+		var msg MsgMNListDiff
+		rbuf := bytes.NewReader(buf.Bytes())
+		if err := msg.BtcDecode(rbuf, test.pver, BaseEncoding); err != nil {
+			t.Errorf("%s: BtcDecode: unexpected error: %v", test.name,
+				err)
+			continue
+		}
+		if rbuf.Len() != 0 {
+			t.Errorf("%s: BtcDecode: %d bytes left", test.name,
+				rbuf.Len())
+		}
+
+		if !reflect.DeepEqual(&msg, test.out) {
+			t.Errorf("%s: BtcDecode\n got: %s want: %s", test.name,
+				spew.Sdump(&msg), spew.Sdump(test.out))
+		}
+	}
+}
+
+// TestMNListDiffWireErrors performs negative tests against wire encode and
+// decode of MsgMNListDiff to confirm error paths work correctly.
+func TestMNListDiffWireErrors(t *testing.T) {
+	pver := ProtocolVersion
+
+	var buf bytes.Buffer
+	if err := testMNListDiff().BtcEncode(&buf, pver, BaseEncoding); err != nil {
+		t.Fatalf("BtcEncode: unexpected error: %v", err)
+	}
+	encoded := buf.Bytes()
+
+	// Every truncation of the encoded message must fail to decode.
+	for i := 0; i < len(encoded); i++ {
+		var msg MsgMNListDiff
+		err := msg.BtcDecode(bytes.NewReader(encoded[:i]), pver,
+			BaseEncoding)
+		if err == nil {
+			t.Fatalf("BtcDecode: unexpected success for %d of %d "+
+				"bytes", i, len(encoded))
+		}
+	}
+
+	// A message without a coinbase transaction can't be encoded.
+	msg := testMNListDiff()
+	msg.CbTx = nil
+	if err := msg.BtcEncode(&buf, pver, BaseEncoding); err == nil {
+		t.Errorf("BtcEncode: unexpected success without coinbase " +
+			"transaction")
+	}
+
+	// A bit set which exceeds the max quorum size must be rejected.
+	msg = testMNListDiff()
+	msg.NewQuorums[0].Signers = make([]bool, MaxQuorumSize+1)
+	err := msg.BtcEncode(&buf, pver, BaseEncoding)
+	if _, ok := err.(*MessageError); !ok {
+		t.Errorf("BtcEncode: wrong error for oversized bit set - got "+
+			"%T, want *MessageError", err)
+	}
+}
+
+// TestGetMNListDiff tests the MsgGetMNListDiff API.
+func TestGetMNListDiff(t *testing.T) {
+	baseBlockHash := chainhash.Hash{0x01}
+	blockHash := chainhash.Hash{0x02}
+	msg := NewMsgGetMNListDiff(&baseBlockHash, &blockHash)
+
+	if cmd := msg.Command(); cmd != "getmnlistd" {
+		t.Errorf("NewMsgGetMNListDiff: wrong command - got %v want %v",
+			cmd, "getmnlistd")
+	}
+
+	encoded := append(append([]byte{}, baseBlockHash[:]...), blockHash[:]...)
+	var buf bytes.Buffer
+	if err := msg.BtcEncode(&buf, ProtocolVersion, BaseEncoding); err != nil {
+		t.Fatalf("BtcEncode: unexpected error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), encoded) {
+		t.Errorf("BtcEncode\n got: %s want: %s", spew.Sdump(buf.Bytes()),
+			spew.Sdump(encoded))
+	}
+	if maxLen := msg.MaxPayloadLength(ProtocolVersion); maxLen != 64 {
+		t.Errorf("MaxPayloadLength: got %d, want 64", maxLen)
+	}
+}
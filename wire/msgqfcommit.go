@@ -0,0 +1,56 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"io"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+// MsgQFCommit implements the Message interface and represents a Dash qfcommit
+// message.  It relays the final commitment of a quorum which completed its
+// distributed key generation so that miners can include it in a quorum
+// commitment special transaction.
+type MsgQFCommit struct {
+	QuorumCommitment
+}
+
+// BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgQFCommit) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	return readQuorumCommitment(r, pver, &msg.QuorumCommitment)
+}
+
+// BtcEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgQFCommit) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	return writeQuorumCommitment(w, pver, &msg.QuorumCommitment)
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgQFCommit) Command() string {
+	return CmdQFCommit
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgQFCommit) MaxPayloadLength(pver uint32) uint32 {
+	// Version 2 bytes + LLMQ type 1 byte + quorum hash + quorum index
+	// 2 bytes + two bit sets of up to MaxQuorumSize bits with their var
+	// int counts + public key 48 bytes + vvec hash + two signatures of 96
+	// bytes each.
+	bitSet := uint32(MaxVarIntPayload + (MaxQuorumSize+7)/8)
+	return 2 + 1 + chainhash.HashSize + 2 + 2*bitSet + 48 +
+		chainhash.HashSize + 2*96
+}
+
+// NewMsgQFCommit returns a new Dash qfcommit message that conforms to the
+// Message interface using the passed final commitment.  See MsgQFCommit for
+// details.
+func NewMsgQFCommit(qc *QuorumCommitment) *MsgQFCommit {
+	return &MsgQFCommit{QuorumCommitment: *qc}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestQFCommit tests the MsgQFCommit API.
+func TestQFCommit(t *testing.T) {
+	qc := QuorumCommitment{
+		Version:      QuorumCommitmentVersionBasicIndexed,
+		LLMQType:     103,
+		QuorumHash:   chainhash.Hash{0x01},
+		QuorumIndex:  2,
+		Signers:      make([]bool, MaxQuorumSize),
+		ValidMembers: make([]bool, MaxQuorumSize),
+	}
+	msg := NewMsgQFCommit(&qc)
+
+	if cmd := msg.Command(); cmd != "qfcommit" {
+		t.Errorf("NewMsgQFCommit: wrong command - got %v want %v", cmd,
+			"qfcommit")
+	}
+	if !reflect.DeepEqual(msg.QuorumCommitment, qc) {
+		t.Errorf("NewMsgQFCommit: wrong commitment - got %v want %v",
+			spew.Sdump(msg.QuorumCommitment), spew.Sdump(qc))
+	}
+
+	// The largest commitment must fit in the maximum payload.
+	var buf bytes.Buffer
+	if err := msg.BtcEncode(&buf, ProtocolVersion, BaseEncoding); err != nil {
+		t.Fatalf("BtcEncode: unexpected error: %v", err)
+	}
+	maxPayload := msg.MaxPayloadLength(ProtocolVersion)
+	if uint32(buf.Len()) > maxPayload {
+		t.Errorf("MaxPayloadLength: %d bytes encoded, max %d", buf.Len(),
+			maxPayload)
+	}
+}
+
+// TestQFCommitWire tests the MsgQFCommit wire encode and decode.
+func TestQFCommitWire(t *testing.T) {
+	msg := NewMsgQFCommit(&QuorumCommitment{
+		Version:         QuorumCommitmentVersionLegacy,
+		LLMQType:        1,
+		QuorumHash:      chainhash.Hash{0x01},
+		Signers:         []bool{true, true, false},
+		ValidMembers:    []bool{true, true, true},
+		QuorumPublicKey: [48]byte{0x02},
+		QuorumVvecHash:  chainhash.Hash{0x03},
+		QuorumSig:       [96]byte{0x04},
+		MembersSig:      [96]byte{0x05},
+	})
+
+	encoded := []byte{0x01, 0x00, 0x01}
+	encoded = append(encoded, msg.QuorumHash[:]...)
+	encoded = append(encoded, 0x03, 0x03, 0x03, 0x07)
+	encoded = append(encoded, msg.QuorumPublicKey[:]...)
+	encoded = append(encoded, msg.QuorumVvecHash[:]...)
+	encoded = append(encoded, msg.QuorumSig[:]...)
+	encoded = append(encoded, msg.MembersSig[:]...)
+
+	var buf bytes.Buffer
+	if err := msg.BtcEncode(&buf, ProtocolVersion, BaseEncoding); err != nil {
+		t.Fatalf("BtcEncode: unexpected error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), encoded) {
+		t.Errorf("BtcEncode\n got: %s want: %s", spew.Sdump(buf.Bytes()),
+			spew.Sdump(encoded))
+	}
+
+	var decoded MsgQFCommit
+	rbuf := bytes.NewReader(encoded)
+	if err := decoded.BtcDecode(rbuf, ProtocolVersion, BaseEncoding); err != nil {
+		t.Fatalf("BtcDecode: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(&decoded, msg) {
+		t.Errorf("BtcDecode\n got: %s want: %s", spew.Sdump(&decoded),
+			spew.Sdump(msg))
+	}
+
+	// Every truncation of the encoded message must fail to decode.
+	for i := 0; i < len(encoded); i++ {
+		var msg MsgQFCommit
+		err := msg.BtcDecode(bytes.NewReader(encoded[:i]), ProtocolVersion,
+			BaseEncoding)
+		if err == nil {
+			t.Fatalf("BtcDecode: unexpected success for %d of %d "+
+				"bytes", i, len(encoded))
+		}
+	}
+}
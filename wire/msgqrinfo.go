@@ -0,0 +1,269 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"io"
+)
+
+// QuorumSnapshot describes which members of the quorums of a rotation cycle
+// were active and which masternodes were skipped when they were built.
+type QuorumSnapshot struct {
+	// SkipListMode determines how SkipList is interpreted.
+	SkipListMode int32
+
+	// ActiveQuorumMembers holds one flag per masternode of the list the
+	// quorums were built from.
+	ActiveQuorumMembers []bool
+
+	SkipList []int32
+}
+
+// readQuorumSnapshot reads a quorum snapshot from r.
+func readQuorumSnapshot(r io.Reader, pver uint32, qs *QuorumSnapshot) error {
+	mode, err := binarySerializer.Uint32(r, littleEndian)
+	if err != nil {
+		return err
+	}
+	qs.SkipListMode = int32(mode)
+
+	qs.ActiveQuorumMembers, err = readDynBitSet(r, pver,
+		"QuorumSnapshot.ActiveQuorumMembers")
+	if err != nil {
+		return err
+	}
+
+	count, err := readListCount(r, pver, MaxQuorumSize,
+		"QuorumSnapshot.SkipList")
+	if err != nil {
+		return err
+	}
+	qs.SkipList = make([]int32, count)
+	for i := range qs.SkipList {
+		if err := readElement(r, &qs.SkipList[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeQuorumSnapshot writes a quorum snapshot to w.
+func writeQuorumSnapshot(w io.Writer, pver uint32, qs *QuorumSnapshot) error {
+	if err := writeElement(w, qs.SkipListMode); err != nil {
+		return err
+	}
+	err := writeDynBitSet(w, pver, qs.ActiveQuorumMembers,
+		"QuorumSnapshot.ActiveQuorumMembers")
+	if err != nil {
+		return err
+	}
+
+	if err := WriteVarInt(w, pver, uint64(len(qs.SkipList))); err != nil {
+		return err
+	}
+	for _, skip := range qs.SkipList {
+		if err := writeElement(w, skip); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MsgQuorumRotationInfo implements the Message interface and represents a Dash
+// qrinfo message.  It is sent in response to a qgetinfo message
+// (MsgGetQuorumRotationInfo) and carries the quorum snapshots and masternode
+// list diffs at the tip, at the block H the last rotation cycle started and at
+// the three (or four when ExtraShare is set) previous cycles, each C blocks
+// apart, which are needed to verify the active rotating quorums.
+type MsgQuorumRotationInfo struct {
+	QuorumSnapshotAtHMinusC  QuorumSnapshot
+	QuorumSnapshotAtHMinus2C QuorumSnapshot
+	QuorumSnapshotAtHMinus3C QuorumSnapshot
+
+	MNListDiffTip        MsgMNListDiff
+	MNListDiffH          MsgMNListDiff
+	MNListDiffAtHMinusC  MsgMNListDiff
+	MNListDiffAtHMinus2C MsgMNListDiff
+	MNListDiffAtHMinus3C MsgMNListDiff
+
+	// The following fields are only present when ExtraShare is set.
+	ExtraShare               bool
+	QuorumSnapshotAtHMinus4C QuorumSnapshot
+	MNListDiffAtHMinus4C     MsgMNListDiff
+
+	// LastCommitmentPerIndex holds the most recent final commitment of each
+	// quorum index of the rotating quorum type.
+	LastCommitmentPerIndex []*QuorumCommitment
+
+	// QuorumSnapshotList and MNListDiffList hold the snapshots and diffs
+	// of the quorums of LastCommitmentPerIndex which are not covered by
+	// the fields above.
+	QuorumSnapshotList []QuorumSnapshot
+	MNListDiffList     []MsgMNListDiff
+}
+
+// BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgQuorumRotationInfo) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	snapshots := []*QuorumSnapshot{
+		&msg.QuorumSnapshotAtHMinusC,
+		&msg.QuorumSnapshotAtHMinus2C,
+		&msg.QuorumSnapshotAtHMinus3C,
+	}
+	for _, qs := range snapshots {
+		if err := readQuorumSnapshot(r, pver, qs); err != nil {
+			return err
+		}
+	}
+
+	diffs := []*MsgMNListDiff{
+		&msg.MNListDiffTip,
+		&msg.MNListDiffH,
+		&msg.MNListDiffAtHMinusC,
+		&msg.MNListDiffAtHMinus2C,
+		&msg.MNListDiffAtHMinus3C,
+	}
+	for _, diff := range diffs {
+		if err := diff.BtcDecode(r, pver, enc); err != nil {
+			return err
+		}
+	}
+
+	if err := readElement(r, &msg.ExtraShare); err != nil {
+		return err
+	}
+	msg.QuorumSnapshotAtHMinus4C = QuorumSnapshot{}
+	msg.MNListDiffAtHMinus4C = MsgMNListDiff{}
+	if msg.ExtraShare {
+		err := readQuorumSnapshot(r, pver, &msg.QuorumSnapshotAtHMinus4C)
+		if err != nil {
+			return err
+		}
+		err = msg.MNListDiffAtHMinus4C.BtcDecode(r, pver, enc)
+		if err != nil {
+			return err
+		}
+	}
+
+	var err error
+	msg.LastCommitmentPerIndex, err = readQuorumCommitments(r, pver,
+		"MsgQuorumRotationInfo.LastCommitmentPerIndex")
+	if err != nil {
+		return err
+	}
+
+	count, err := readListCount(r, pver, maxMNListDiffItems,
+		"MsgQuorumRotationInfo.QuorumSnapshotList")
+	if err != nil {
+		return err
+	}
+	msg.QuorumSnapshotList = make([]QuorumSnapshot, count)
+	for i := range msg.QuorumSnapshotList {
+		err := readQuorumSnapshot(r, pver, &msg.QuorumSnapshotList[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	count, err = readListCount(r, pver, maxMNListDiffItems,
+		"MsgQuorumRotationInfo.MNListDiffList")
+	if err != nil {
+		return err
+	}
+	msg.MNListDiffList = make([]MsgMNListDiff, count)
+	for i := range msg.MNListDiffList {
+		err := msg.MNListDiffList[i].BtcDecode(r, pver, enc)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BtcEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgQuorumRotationInfo) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	snapshots := []*QuorumSnapshot{
+		&msg.QuorumSnapshotAtHMinusC,
+		&msg.QuorumSnapshotAtHMinus2C,
+		&msg.QuorumSnapshotAtHMinus3C,
+	}
+	for _, qs := range snapshots {
+		if err := writeQuorumSnapshot(w, pver, qs); err != nil {
+			return err
+		}
+	}
+
+	diffs := []*MsgMNListDiff{
+		&msg.MNListDiffTip,
+		&msg.MNListDiffH,
+		&msg.MNListDiffAtHMinusC,
+		&msg.MNListDiffAtHMinus2C,
+		&msg.MNListDiffAtHMinus3C,
+	}
+	for _, diff := range diffs {
+		if err := diff.BtcEncode(w, pver, enc); err != nil {
+			return err
+		}
+	}
+
+	if err := writeElement(w, msg.ExtraShare); err != nil {
+		return err
+	}
+	if msg.ExtraShare {
+		err := writeQuorumSnapshot(w, pver, &msg.QuorumSnapshotAtHMinus4C)
+		if err != nil {
+			return err
+		}
+		err = msg.MNListDiffAtHMinus4C.BtcEncode(w, pver, enc)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := writeQuorumCommitments(w, pver, msg.LastCommitmentPerIndex)
+	if err != nil {
+		return err
+	}
+
+	err = WriteVarInt(w, pver, uint64(len(msg.QuorumSnapshotList)))
+	if err != nil {
+		return err
+	}
+	for i := range msg.QuorumSnapshotList {
+		err := writeQuorumSnapshot(w, pver, &msg.QuorumSnapshotList[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	err = WriteVarInt(w, pver, uint64(len(msg.MNListDiffList)))
+	if err != nil {
+		return err
+	}
+	for i := range msg.MNListDiffList {
+		err := msg.MNListDiffList[i].BtcEncode(w, pver, enc)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgQuorumRotationInfo) Command() string {
+	return CmdQuorumRotationInfo
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgQuorumRotationInfo) MaxPayloadLength(pver uint32) uint32 {
+	return MaxMessagePayload
+}
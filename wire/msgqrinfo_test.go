@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// testQuorumRotationInfo returns a qrinfo message with the passed extra share
+// flag which makes use of every other field.
+func testQuorumRotationInfo(extraShare bool) *MsgQuorumRotationInfo {
+	snapshot := func(mode int32) QuorumSnapshot {
+		return QuorumSnapshot{
+			SkipListMode:        mode,
+			ActiveQuorumMembers: []bool{true, false, true},
+			SkipList:            []int32{1, -2},
+		}
+	}
+
+	msg := &MsgQuorumRotationInfo{
+		QuorumSnapshotAtHMinusC:  snapshot(0),
+		QuorumSnapshotAtHMinus2C: snapshot(1),
+		QuorumSnapshotAtHMinus3C: snapshot(2),
+		MNListDiffTip:            *testMNListDiff(),
+		MNListDiffH:              *testMNListDiff(),
+		MNListDiffAtHMinusC:      *testMNListDiff(),
+		MNListDiffAtHMinus2C:     *testMNListDiff(),
+		MNListDiffAtHMinus3C:     *testMNListDiff(),
+		ExtraShare:               extraShare,
+		LastCommitmentPerIndex:   testMNListDiff().NewQuorums,
+		QuorumSnapshotList:       []QuorumSnapshot{snapshot(3)},
+		MNListDiffList:           []MsgMNListDiff{*testMNListDiff()},
+	}
+	if extraShare {
+		msg.QuorumSnapshotAtHMinus4C = snapshot(1)
+		msg.MNListDiffAtHMinus4C = *testMNListDiff()
+	}
+
+	return msg
+}
+
+// TestQuorumRotationInfoWire tests the MsgQuorumRotationInfo wire encode and
+// decode with and without the extra share.
+func TestQuorumRotationInfoWire(t *testing.T) {
+	pver := ProtocolVersion
+
+	for _, extraShare := range []bool{false, true} {
+		in := testQuorumRotationInfo(extraShare)
+
+		var buf bytes.Buffer
+		if err := in.BtcEncode(&buf, pver, BaseEncoding); err != nil {
+			t.Errorf("BtcEncode (extra share %v): unexpected error: %v",
+				extraShare, err)
+			continue
+		}
+
+		var msg MsgQuorumRotationInfo
+		rbuf := bytes.NewReader(buf.Bytes())
+		if err := msg.BtcDecode(rbuf, pver, BaseEncoding); err != nil {
+			t.Errorf("BtcDecode (extra share %v): unexpected error: %v",
+				extraShare, err)
+			continue
+		}
+		if rbuf.Len() != 0 {
+			t.Errorf("BtcDecode (extra share %v): %d bytes left",
+				extraShare, rbuf.Len())
+		}
+		if !reflect.DeepEqual(&msg, in) {
+			t.Errorf("BtcDecode (extra share %v)\n got: %s want: %s",
+				extraShare, spew.Sdump(&msg), spew.Sdump(in))
+		}
+	}
+
+	if cmd := (&MsgQuorumRotationInfo{}).Command(); cmd != "qrinfo" {
+		t.Errorf("Command: wrong command - got %v want %v", cmd,
+			"qrinfo")
+	}
+}
+
+// TestGetQuorumRotationInfoWire tests the MsgGetQuorumRotationInfo wire encode
+// and decode.
+func TestGetQuorumRotationInfoWire(t *testing.T) {
+	msg := NewMsgGetQuorumRotationInfo(&chainhash.Hash{0x02}, true)
+	if err := msg.AddBaseBlockHash(&chainhash.Hash{0x01}); err != nil {
+		t.Fatalf("AddBaseBlockHash: unexpected error: %v", err)
+	}
+	encoded := bytes.Join([][]byte{
+		{0x01},                   // Num base block hashes
+		{0x01}, make([]byte, 31), // Base block hash
+		{0x02}, make([]byte, 31), // Block request hash
+		{0x01}, // Extra share
+	}, nil)
+
+	var buf bytes.Buffer
+	if err := msg.BtcEncode(&buf, ProtocolVersion, BaseEncoding); err != nil {
+		t.Fatalf("BtcEncode: unexpected error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), encoded) {
+		t.Fatalf("BtcEncode\n got: %s want: %s", spew.Sdump(buf.Bytes()),
+			spew.Sdump(encoded))
+	}
+
+	var decoded MsgGetQuorumRotationInfo
+	err := decoded.BtcDecode(bytes.NewReader(encoded), ProtocolVersion,
+		BaseEncoding)
+	if err != nil {
+		t.Fatalf("BtcDecode: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.BaseBlockHashes, msg.BaseBlockHashes) ||
+		decoded.BlockRequestHash != msg.BlockRequestHash ||
+		decoded.ExtraShare != msg.ExtraShare {
+
+		t.Errorf("BtcDecode\n got: %s want: %s", spew.Sdump(&decoded),
+			spew.Sdump(msg))
+	}
+
+	// Adding more than the max allowed base block hashes must fail.
+	for i := 1; i < MaxQRInfoBaseBlockHashes; i++ {
+		if err := msg.AddBaseBlockHash(&chainhash.Hash{}); err != nil {
+			t.Fatalf("AddBaseBlockHash: unexpected error: %v", err)
+		}
+	}
+	if err := msg.AddBaseBlockHash(&chainhash.Hash{}); err == nil {
+		t.Errorf("AddBaseBlockHash: unexpected success past the max " +
+			"allowed base block hashes")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+// MaxSporkSignatureSize is the size of the compact ECDSA signature of a spork.
+const MaxSporkSignatureSize = 65
+
+// MsgSpork implements the Message interface and represents a Dash spork
+// message.  Sporks are network wide switches which are set by the holders of
+// the spork keys of the network.  A spork carries the value of the switch with
+// the given id, the time it was signed at and a compact ECDSA signature of its
+// signature hash by a spork key.
+type MsgSpork struct {
+	SporkID    int32
+	Value      int64
+	TimeSigned int64
+	Signature  []byte
+}
+
+// Hash returns the hash of the serialized spork including the signature.  It
+// is used to announce the spork in inventory vectors.
+func (msg *MsgSpork) Hash() chainhash.Hash {
+	var buf bytes.Buffer
+	buf.Grow(4 + 8 + 8 + VarIntSerializeSize(uint64(len(msg.Signature))) +
+		len(msg.Signature))
+
+	// Writing to a bytes.Buffer never fails.
+	_ = msg.BtcEncode(&buf, 0, BaseEncoding)
+
+	return chainhash.DoubleHashH(buf.Bytes())
+}
+
+// SignatureHash returns the hash of the spork which is signed by the spork key.
+// It covers the spork id, value and signing time but not the signature.
+func (msg *MsgSpork) SignatureHash() chainhash.Hash {
+	var buf bytes.Buffer
+	buf.Grow(4 + 8 + 8)
+
+	// Writing to a bytes.Buffer never fails.
+	_ = writeElements(&buf, msg.SporkID, msg.Value, msg.TimeSigned)
+
+	return chainhash.DoubleHashH(buf.Bytes())
+}
+
+// BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgSpork) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) error {
+	err := readElements(r, &msg.SporkID, &msg.Value, &msg.TimeSigned)
+	if err != nil {
+		return err
+	}
+
+	msg.Signature, err = ReadVarBytes(r, pver, MaxSporkSignatureSize,
+		"spork signature")
+	return err
+}
+
+// BtcEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgSpork) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
+	err := writeElements(w, msg.SporkID, msg.Value, msg.TimeSigned)
+	if err != nil {
+		return err
+	}
+
+	return WriteVarBytes(w, pver, msg.Signature)
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgSpork) Command() string {
+	return CmdSpork
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgSpork) MaxPayloadLength(pver uint32) uint32 {
+	// Spork id 4 bytes + value 8 bytes + time signed 8 bytes + signature
+	// length 1 byte + signature.
+	return 4 + 8 + 8 + 1 + MaxSporkSignatureSize
+}
+
+// NewMsgSpork returns a new Dash spork message that conforms to the Message
+// interface using the passed parameters.  The signature is left empty.  See
+// MsgSpork for details.
+func NewMsgSpork(sporkID int32, value, timeSigned int64) *MsgSpork {
+	return &MsgSpork{
+		SporkID:    sporkID,
+		Value:      value,
+		TimeSigned: timeSigned,
+	}
+}
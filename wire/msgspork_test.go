@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestSpork tests the MsgSpork API.
+func TestSpork(t *testing.T) {
+	msg := NewMsgSpork(10020, 0x0102, 0x0304)
+
+	if cmd := msg.Command(); cmd != "spork" {
+		t.Errorf("NewMsgSpork: wrong command - got %v want %v", cmd,
+			"spork")
+	}
+	if maxLen := msg.MaxPayloadLength(ProtocolVersion); maxLen != 86 {
+		t.Errorf("MaxPayloadLength: got %d, want 86", maxLen)
+	}
+
+	// The signature hash covers the id, value and signing time but not
+	// the signature.
+	serialized := []byte{
+		0x24, 0x27, 0x00, 0x00,
+		0x02, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	wantSigHash := chainhash.DoubleHashH(serialized)
+	if hash := msg.SignatureHash(); hash != wantSigHash {
+		t.Errorf("SignatureHash: got %v, want %v", hash, wantSigHash)
+	}
+
+	// The hash covers the whole serialized spork including the signature,
+	// so it changes with the signature while the signature hash does not.
+	wantHash := chainhash.DoubleHashH(append(serialized, 0x00))
+	if hash := msg.Hash(); hash != wantHash {
+		t.Errorf("Hash: got %v, want %v", hash, wantHash)
+	}
+	msg.Signature = []byte{0x01}
+	wantHash = chainhash.DoubleHashH(append(serialized, 0x01, 0x01))
+	if hash := msg.Hash(); hash != wantHash {
+		t.Errorf("Hash with signature: got %v, want %v", hash, wantHash)
+	}
+	if hash := msg.SignatureHash(); hash != wantSigHash {
+		t.Errorf("SignatureHash: signature changed hash to %v", hash)
+	}
+}
+
+// TestSporkWire tests the MsgSpork wire encode and decode.
+func TestSporkWire(t *testing.T) {
+	msg := NewMsgSpork(10020, 0x0102, 0x0304)
+	msg.Signature = bytes.Repeat([]byte{0x1f}, MaxSporkSignatureSize)
+
+	encoded := []byte{
+		0x24, 0x27, 0x00, 0x00,
+		0x02, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x41,
+	}
+	encoded = append(encoded, msg.Signature...)
+
+	var buf bytes.Buffer
+	if err := msg.BtcEncode(&buf, ProtocolVersion, BaseEncoding); err != nil {
+		t.Fatalf("BtcEncode: unexpected error: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), encoded) {
+		t.Errorf("BtcEncode\n got: %s want: %s", spew.Sdump(buf.Bytes()),
+			spew.Sdump(encoded))
+	}
+
+	var decoded MsgSpork
+	rbuf := bytes.NewReader(encoded)
+	if err := decoded.BtcDecode(rbuf, ProtocolVersion, BaseEncoding); err != nil {
+		t.Fatalf("BtcDecode: unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(&decoded, msg) {
+		t.Errorf("BtcDecode\n got: %s want: %s", spew.Sdump(&decoded),
+			spew.Sdump(msg))
+	}
+
+	// Every truncation of the encoded message must fail to decode.
+	for i := 0; i < len(encoded); i++ {
+		var msg MsgSpork
+		err := msg.BtcDecode(bytes.NewReader(encoded[:i]), ProtocolVersion,
+			BaseEncoding)
+		if err == nil {
+			t.Fatalf("BtcDecode: unexpected success for %d of %d "+
+				"bytes", i, len(encoded))
+		}
+	}
+
+	// Signatures larger than a compact signature are rejected.
+	oversized := append([]byte(nil), encoded[:20]...)
+	oversized = append(oversized, MaxSporkSignatureSize+1)
+	oversized = append(oversized, make([]byte, MaxSporkSignatureSize+1)...)
+	err := decoded.BtcDecode(bytes.NewReader(oversized), ProtocolVersion,
+		BaseEncoding)
+	if err == nil {
+		t.Fatal("BtcDecode: unexpected success for oversized signature")
+	}
+}
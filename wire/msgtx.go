@@ -312,11 +312,25 @@ func NewTxOut(value int64, pkScript []byte) *TxOut {
 //
 // Use the AddTxIn and AddTxOut functions to build up the list of transaction
 // inputs and outputs.
+//
+// The Version and Type fields share the 32-bit version field on the wire, with
+// the version in the lower 16 bits and the DIP-2 type in the upper 16 bits.
+// When the transaction is a special transaction (see IsSpecial), the
+// ExtraPayload is serialized after the lock time.
 type MsgTx struct {
-	Version  int32
-	TxIn     []*TxIn
-	TxOut    []*TxOut
-	LockTime uint32
+	Version      int32
+	Type         TxType
+	TxIn         []*TxIn
+	TxOut        []*TxOut
+	LockTime     uint32
+	ExtraPayload []byte
+}
+
+// IsSpecial returns whether or not the transaction is a DIP-2 special
+// transaction, which is the case when it has a version of at least
+// SpecialTxVersion and a type other than TxTypeNormal.
+func (msg *MsgTx) IsSpecial() bool {
+	return msg.Version >= SpecialTxVersion && msg.Type != TxTypeNormal
 }
 
 // AddTxIn adds a transaction input to the message.
@@ -362,11 +376,18 @@ func (msg *MsgTx) Copy() *MsgTx {
 	// for the transaction inputs and outputs.
 	newTx := MsgTx{
 		Version:  msg.Version,
+		Type:     msg.Type,
 		TxIn:     make([]*TxIn, 0, len(msg.TxIn)),
 		TxOut:    make([]*TxOut, 0, len(msg.TxOut)),
 		LockTime: msg.LockTime,
 	}
 
+	// Deep copy the extra payload of special transactions.
+	if msg.ExtraPayload != nil {
+		newTx.ExtraPayload = make([]byte, len(msg.ExtraPayload))
+		copy(newTx.ExtraPayload, msg.ExtraPayload)
+	}
+
 	// Deep copy the old TxIn data.
 	for _, oldTxIn := range msg.TxIn {
 		// Deep copy the old previous outpoint.
@@ -439,7 +460,12 @@ func (msg *MsgTx) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) error
 	if err != nil {
 		return err
 	}
-	msg.Version = int32(version)
+
+	// The lower 16 bits hold the signed transaction version while the
+	// upper 16 bits hold the DIP-2 transaction type.
+	msg.Version = int32(int16(version))
+	msg.Type = TxType(version >> 16)
+	msg.ExtraPayload = nil
 
 	count, err := ReadVarInt(r, pver)
 	if err != nil {
@@ -448,8 +474,11 @@ func (msg *MsgTx) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) error
 
 	// A count of zero (meaning no TxIn's to the uninitiated) means that the
 	// value is a TxFlagMarker, and hence indicates the presence of a flag.
+	// Special transactions never carry witness data, and some of them, such
+	// as quorum commitments, legitimately have no inputs, so the marker is
+	// not checked for them.
 	var flag [1]TxFlag
-	if count == TxFlagMarker && enc == WitnessEncoding {
+	if count == TxFlagMarker && enc == WitnessEncoding && !msg.IsSpecial() {
 		// The count varint was in fact the flag marker byte. Next, we need to
 		// read the flag value, which is a single byte.
 		if _, err = io.ReadFull(r, flag[:]); err != nil {
@@ -605,6 +634,16 @@ func (msg *MsgTx) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) error
 		return err
 	}
 
+	// Special transactions carry an extra payload after the lock time.
+	if msg.IsSpecial() {
+		msg.ExtraPayload, err = ReadVarBytes(r, pver, MaxTxExtraPayload,
+			"special transaction extra payload")
+		if err != nil {
+			returnScriptBuffers()
+			return err
+		}
+	}
+
 	// Create a single allocation to house all of the scripts and set each
 	// input signature script and output public key script to the
 	// appropriate subslice of the overall contiguous buffer.  Then, return
@@ -706,7 +745,8 @@ func (msg *MsgTx) DeserializeNoWitness(r io.Reader) error {
 // See Serialize for encoding transactions to be stored to disk, such as in a
 // database, as opposed to encoding transactions for the wire.
 func (msg *MsgTx) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) error {
-	err := binarySerializer.PutUint32(w, littleEndian, uint32(msg.Version))
+	version := uint32(uint16(msg.Version)) | uint32(msg.Type)<<16
+	err := binarySerializer.PutUint32(w, littleEndian, version)
 	if err != nil {
 		return err
 	}
@@ -764,7 +804,17 @@ func (msg *MsgTx) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) error
 		}
 	}
 
-	return binarySerializer.PutUint32(w, littleEndian, msg.LockTime)
+	err = binarySerializer.PutUint32(w, littleEndian, msg.LockTime)
+	if err != nil {
+		return err
+	}
+
+	// Special transactions carry an extra payload after the lock time.
+	if msg.IsSpecial() {
+		return WriteVarBytes(w, pver, msg.ExtraPayload)
+	}
+
+	return nil
 }
 
 // HasWitness returns false if none of the inputs within the transaction
@@ -824,6 +874,12 @@ func (msg *MsgTx) baseSize() int {
 		n += txOut.SerializeSize()
 	}
 
+	// Special transactions carry a varint length prefixed extra payload.
+	if msg.IsSpecial() {
+		n += VarIntSerializeSize(uint64(len(msg.ExtraPayload))) +
+			len(msg.ExtraPayload)
+	}
+
 	return n
 }
 
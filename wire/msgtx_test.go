@@ -721,6 +721,135 @@ func TestTxOverflowErrors(t *testing.T) {
 	}
 }
 
+// TestTxSpecial tests the MsgTx encode and decode of DIP-2 special
+// transactions which carry a transaction type and an extra payload.
+func TestTxSpecial(t *testing.T) {
+	// Quorum commitment style special transaction with no inputs or
+	// outputs.
+	qcTx := &MsgTx{
+		Version:      3,
+		Type:         TxTypeQuorumCommitment,
+		TxIn:         []*TxIn{},
+		TxOut:        []*TxOut{},
+		ExtraPayload: []byte{0x01, 0x00, 0x02, 0x03},
+	}
+	qcTxEncoded := []byte{
+		0x03, 0x00, 0x06, 0x00, // Version and type
+		0x00,                   // Varint for number of input transactions
+		0x00,                   // Varint for number of output transactions
+		0x00, 0x00, 0x00, 0x00, // Lock time
+		0x04,                   // Varint for length of extra payload
+		0x01, 0x00, 0x02, 0x03, // Extra payload
+	}
+
+	// Version 3 transaction of the normal type carries no extra payload.
+	normalTx := &MsgTx{
+		Version: 3,
+		Type:    TxTypeNormal,
+		TxIn:    []*TxIn{},
+		TxOut:   []*TxOut{},
+	}
+	normalTxEncoded := []byte{
+		0x03, 0x00, 0x00, 0x00, // Version and type
+		0x00,                   // Varint for number of input transactions
+		0x00,                   // Varint for number of output transactions
+		0x00, 0x00, 0x00, 0x00, // Lock time
+	}
+
+	// Coinbase special transaction with an input and an output.
+	cbTx := multiTx.Copy()
+	cbTx.Version = 3
+	cbTx.Type = TxTypeCoinbase
+	cbTx.ExtraPayload = []byte{0x02, 0x00, 0x10, 0x27, 0x00, 0x00}
+	cbTxEncoded := append([]byte{0x03, 0x00, 0x05, 0x00},
+		multiTxEncoded[4:]...)
+	cbTxEncoded = append(cbTxEncoded, 0x06)
+	cbTxEncoded = append(cbTxEncoded, cbTx.ExtraPayload...)
+
+	tests := []struct {
+		in   *MsgTx          // Message to encode
+		buf  []byte          // Wire encoding
+		enc  MessageEncoding // Message encoding format
+		size int             // Expected serialized size
+	}{
+		{qcTx, qcTxEncoded, BaseEncoding, 15},
+		{qcTx, qcTxEncoded, WitnessEncoding, 15},
+		{normalTx, normalTxEncoded, BaseEncoding, 10},
+		{cbTx, cbTxEncoded, BaseEncoding, 217},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		// Encode the message to wire format.
+		var buf bytes.Buffer
+		err := test.in.BtcEncode(&buf, ProtocolVersion, test.enc)
+		if err != nil {
+			t.Errorf("BtcEncode #%d error %v", i, err)
+			continue
+		}
+		if !bytes.Equal(buf.Bytes(), test.buf) {
+			t.Errorf("BtcEncode #%d\n got: %s want: %s", i,
+				spew.Sdump(buf.Bytes()), spew.Sdump(test.buf))
+			continue
+		}
+
+		if size := test.in.SerializeSize(); size != test.size {
+			t.Errorf("SerializeSize #%d got: %d, want: %d", i,
+				size, test.size)
+			continue
+		}
+
+		// Decode the message from wire format.
+		var msg MsgTx
+		rbuf := bytes.NewReader(test.buf)
+		err = msg.BtcDecode(rbuf, ProtocolVersion, test.enc)
+		if err != nil {
+			t.Errorf("BtcDecode #%d error %v", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(&msg, test.in) {
+			t.Errorf("BtcDecode #%d\n got: %s want: %s", i,
+				spew.Sdump(&msg), spew.Sdump(test.in))
+			continue
+		}
+
+		// The transaction hash commits to the type and extra payload.
+		wantHash := chainhash.DoubleHashH(test.buf)
+		if txHash := msg.TxHash(); !txHash.IsEqual(&wantHash) {
+			t.Errorf("TxHash #%d got: %v, want: %v", i, txHash,
+				wantHash)
+			continue
+		}
+	}
+
+	// Ensure an extra payload larger than the max allowed is rejected.
+	oversized := []byte{
+		0x03, 0x00, 0x05, 0x00, // Version and type
+		0x00,                   // Varint for number of input transactions
+		0x00,                   // Varint for number of output transactions
+		0x00, 0x00, 0x00, 0x00, // Lock time
+		0xfe, 0x11, 0x27, 0x00, 0x00, // Varint for length of extra payload
+	}
+	var msg MsgTx
+	err := msg.BtcDecode(bytes.NewReader(oversized), ProtocolVersion,
+		BaseEncoding)
+	if _, ok := err.(*MessageError); !ok {
+		t.Errorf("BtcDecode oversized payload: got %v, want "+
+			"*MessageError", err)
+	}
+
+	// Ensure the copy of a special transaction is deep.
+	cbCopy := cbTx.Copy()
+	if !reflect.DeepEqual(cbCopy, cbTx) {
+		t.Errorf("Copy: got %s, want %s", spew.Sdump(cbCopy),
+			spew.Sdump(cbTx))
+	}
+	cbCopy.ExtraPayload[0] ^= 0xff
+	if cbCopy.ExtraPayload[0] == cbTx.ExtraPayload[0] {
+		t.Errorf("Copy: extra payload is not a deep copy")
+	}
+}
+
 // TestTxSerializeSizeStripped performs tests to ensure the serialize size for
 // various transactions is accurate.
 func TestTxSerializeSizeStripped(t *testing.T) {
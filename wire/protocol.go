@@ -10,10 +10,9 @@ import (
 	"strings"
 )
 
-// XXX pedro: we will probably need to bump this.
 const (
 	// ProtocolVersion is the latest protocol version this package supports.
-	ProtocolVersion uint32 = 70013
+	ProtocolVersion uint32 = 70230
 
 	// MultipleAddressVersion is the protocol version which added multiple
 	// addresses per message (pver >= MultipleAddressVersion).
@@ -51,6 +50,27 @@ const (
 	// FeeFilterVersion is the protocol version which added a new
 	// feefilter message.
 	FeeFilterVersion uint32 = 70013
+
+	// BLSSchemeVersion is the protocol version which added the version
+	// field to mnlistdiff messages in order to signal the BLS scheme used
+	// by their keys and signatures.
+	BLSSchemeVersion uint32 = 70225
+
+	// DMNTypeVersion is the protocol version which added the masternode
+	// type and platform fields to simplified masternode list entries.
+	DMNTypeVersion uint32 = 70227
+
+	// SMNLEVersionedVersion is the protocol version which added the
+	// version field to simplified masternode list entries.
+	SMNLEVersionedVersion uint32 = 70228
+
+	// MNListDiffVersionOrderVersion is the protocol version which moved the
+	// version field to the start of mnlistdiff messages.
+	MNListDiffVersionOrderVersion uint32 = 70229
+
+	// MNListDiffChainLocksVersion is the protocol version which added the
+	// ChainLock signatures of the quorums to mnlistdiff messages.
+	MNListDiffChainLocksVersion uint32 = 70230
 )
 
 // ServiceFlag identifies services supported by a bitcoin peer.
@@ -86,18 +106,23 @@ const (
 	// SFNode2X is a flag used to indicate a peer is running the Segwit2X
 	// software.
 	SFNode2X
+
+	// SFNodeNetworkLimited is a flag used to indicate a peer is a pruned
+	// node which is only able to serve the last 288 blocks (BIP0159).
+	SFNodeNetworkLimited ServiceFlag = 1 << 10
 )
 
 // Map of service flags back to their constant names for pretty printing.
 var sfStrings = map[ServiceFlag]string{
-	SFNodeNetwork: "SFNodeNetwork",
-	SFNodeGetUTXO: "SFNodeGetUTXO",
-	SFNodeBloom:   "SFNodeBloom",
-	SFNodeWitness: "SFNodeWitness",
-	SFNodeXthin:   "SFNodeXthin",
-	SFNodeBit5:    "SFNodeBit5",
-	SFNodeCF:      "SFNodeCF",
-	SFNode2X:      "SFNode2X",
+	SFNodeNetwork:        "SFNodeNetwork",
+	SFNodeGetUTXO:        "SFNodeGetUTXO",
+	SFNodeBloom:          "SFNodeBloom",
+	SFNodeWitness:        "SFNodeWitness",
+	SFNodeXthin:          "SFNodeXthin",
+	SFNodeBit5:           "SFNodeBit5",
+	SFNodeCF:             "SFNodeCF",
+	SFNode2X:             "SFNode2X",
+	SFNodeNetworkLimited: "SFNodeNetworkLimited",
 }
 
 // orderedSFStrings is an ordered list of service flags from highest to
@@ -111,6 +136,7 @@ var orderedSFStrings = []ServiceFlag{
 	SFNodeBit5,
 	SFNodeCF,
 	SFNode2X,
+	SFNodeNetworkLimited,
 }
 
 // String returns the ServiceFlag in human-readable form.
@@ -157,6 +183,11 @@ const (
 
 	// SimNet represents the simulation test network.
 	SimNet BitcoinNet = 0x12141c16
+
+	// DevNet represents the development networks.  All devnets share the
+	// same magic and are told apart by the name in their devnet genesis
+	// block.
+	DevNet BitcoinNet = 0xceffcae2
 )
 
 // bnStrings is a map of bitcoin networks back to their constant names for
@@ -166,6 +197,7 @@ var bnStrings = map[BitcoinNet]string{
 	TestNet:  "TestNet",
 	TestNet3: "TestNet3",
 	SimNet:   "SimNet",
+	DevNet:   "DevNet",
 }
 
 // String returns the BitcoinNet in human-readable form.
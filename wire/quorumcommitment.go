@@ -0,0 +1,264 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+)
+
+const (
+	// QuorumCommitmentVersionLegacy is the final commitment version of
+	// non-rotating quorums using the legacy BLS scheme.
+	QuorumCommitmentVersionLegacy = 1
+
+	// QuorumCommitmentVersionLegacyIndexed is the final commitment version
+	// of rotating quorums using the legacy BLS scheme.
+	QuorumCommitmentVersionLegacyIndexed = 2
+
+	// QuorumCommitmentVersionBasic is the final commitment version of
+	// non-rotating quorums using the basic BLS scheme.
+	QuorumCommitmentVersionBasic = 3
+
+	// QuorumCommitmentVersionBasicIndexed is the final commitment version
+	// of rotating quorums using the basic BLS scheme.
+	QuorumCommitmentVersionBasicIndexed = 4
+
+	// MaxQuorumSize is the maximum number of members of a quorum that can
+	// be represented in the bit sets of a final commitment or quorum
+	// snapshot.  It is well above the size of the largest known quorum.
+	MaxQuorumSize = 1000
+)
+
+// QuorumCommitment is the final commitment of a long living masternode quorum
+// (LLMQ) as produced by the distributed key generation of its members.  It is
+// relayed in mnlistdiff and qrinfo messages and mined in quorum commitment
+// special transactions.
+type QuorumCommitment struct {
+	Version    uint16
+	LLMQType   uint8
+	QuorumHash chainhash.Hash
+
+	// QuorumIndex is the index of the quorum within its rotation cycle.
+	// It is only present for the indexed versions.
+	QuorumIndex int16
+
+	// Signers and ValidMembers hold one flag per member of the quorum.
+	Signers      []bool
+	ValidMembers []bool
+
+	QuorumPublicKey [48]byte
+	QuorumVvecHash  chainhash.Hash
+	QuorumSig       [96]byte
+	MembersSig      [96]byte
+}
+
+// IsIndexed returns whether the commitment belongs to a rotating quorum and
+// therefore carries a quorum index.
+func (qc *QuorumCommitment) IsIndexed() bool {
+	return qc.Version == QuorumCommitmentVersionLegacyIndexed ||
+		qc.Version == QuorumCommitmentVersionBasicIndexed
+}
+
+// IsNull returns whether the commitment is a null commitment, which is mined
+// when the DKG of a quorum failed.  It has no signers, no valid members and
+// neither keys nor signatures.
+func (qc *QuorumCommitment) IsNull() bool {
+	if countBits(qc.Signers) != 0 || countBits(qc.ValidMembers) != 0 {
+		return false
+	}
+	return qc.QuorumPublicKey == [48]byte{} &&
+		qc.QuorumVvecHash == chainhash.Hash{} &&
+		qc.QuorumSig == [96]byte{} && qc.MembersSig == [96]byte{}
+}
+
+// CountSigners returns the number of members which signed the commitment.
+func (qc *QuorumCommitment) CountSigners() int {
+	return countBits(qc.Signers)
+}
+
+// CountValidMembers returns the number of members which completed the DKG.
+func (qc *QuorumCommitment) CountValidMembers() int {
+	return countBits(qc.ValidMembers)
+}
+
+// CommitmentHash returns the hash the members sign to commit to the result of
+// the DKG.  It covers the LLMQ type, the quorum hash, the valid members, the
+// quorum public key and the verification vector hash.
+func (qc *QuorumCommitment) CommitmentHash() chainhash.Hash {
+	var buf bytes.Buffer
+	buf.Grow(1 + 2*chainhash.HashSize + 3 + (len(qc.ValidMembers)+7)/8 +
+		len(qc.QuorumPublicKey))
+
+	// Writing to a bytes.Buffer never fails, but the bit set is rejected
+	// when it is too large.  Such a commitment can't be valid anyway.
+	_ = binarySerializer.PutUint8(&buf, qc.LLMQType)
+	_ = writeElement(&buf, &qc.QuorumHash)
+	_ = writeDynBitSet(&buf, 0, qc.ValidMembers,
+		"QuorumCommitment.ValidMembers")
+	buf.Write(qc.QuorumPublicKey[:])
+	_ = writeElement(&buf, &qc.QuorumVvecHash)
+
+	return chainhash.DoubleHashH(buf.Bytes())
+}
+
+// Deserialize decodes a final commitment from r into the receiver.
+func (qc *QuorumCommitment) Deserialize(r io.Reader) error {
+	return readQuorumCommitment(r, 0, qc)
+}
+
+// Serialize encodes the final commitment to w.
+func (qc *QuorumCommitment) Serialize(w io.Writer) error {
+	return writeQuorumCommitment(w, 0, qc)
+}
+
+// readQuorumCommitment reads a final commitment from r.
+func readQuorumCommitment(r io.Reader, pver uint32, qc *QuorumCommitment) error {
+	var err error
+	qc.Version, err = binarySerializer.Uint16(r, littleEndian)
+	if err != nil {
+		return err
+	}
+	qc.LLMQType, err = binarySerializer.Uint8(r)
+	if err != nil {
+		return err
+	}
+	if err := readElement(r, &qc.QuorumHash); err != nil {
+		return err
+	}
+	if qc.IsIndexed() {
+		index, err := binarySerializer.Uint16(r, littleEndian)
+		if err != nil {
+			return err
+		}
+		qc.QuorumIndex = int16(index)
+	}
+
+	qc.Signers, err = readDynBitSet(r, pver, "QuorumCommitment.Signers")
+	if err != nil {
+		return err
+	}
+	qc.ValidMembers, err = readDynBitSet(r, pver,
+		"QuorumCommitment.ValidMembers")
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.ReadFull(r, qc.QuorumPublicKey[:]); err != nil {
+		return err
+	}
+	if err := readElement(r, &qc.QuorumVvecHash); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(r, qc.QuorumSig[:]); err != nil {
+		return err
+	}
+	_, err = io.ReadFull(r, qc.MembersSig[:])
+	return err
+}
+
+// writeQuorumCommitment writes a final commitment to w.
+func writeQuorumCommitment(w io.Writer, pver uint32, qc *QuorumCommitment) error {
+	err := binarySerializer.PutUint16(w, littleEndian, qc.Version)
+	if err != nil {
+		return err
+	}
+	if err := binarySerializer.PutUint8(w, qc.LLMQType); err != nil {
+		return err
+	}
+	if err := writeElement(w, &qc.QuorumHash); err != nil {
+		return err
+	}
+	if qc.IsIndexed() {
+		err := binarySerializer.PutUint16(w, littleEndian,
+			uint16(qc.QuorumIndex))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = writeDynBitSet(w, pver, qc.Signers, "QuorumCommitment.Signers")
+	if err != nil {
+		return err
+	}
+	err = writeDynBitSet(w, pver, qc.ValidMembers,
+		"QuorumCommitment.ValidMembers")
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(qc.QuorumPublicKey[:]); err != nil {
+		return err
+	}
+	if err := writeElement(w, &qc.QuorumVvecHash); err != nil {
+		return err
+	}
+	if _, err := w.Write(qc.QuorumSig[:]); err != nil {
+		return err
+	}
+	_, err = w.Write(qc.MembersSig[:])
+	return err
+}
+
+// countBits returns the number of set bits in a bit set.
+func countBits(bits []bool) int {
+	var n int
+	for _, bit := range bits {
+		if bit {
+			n++
+		}
+	}
+	return n
+}
+
+// readDynBitSet reads a dynamically sized bit set, which is encoded as the
+// number of bits followed by the bits packed least significant bit first, from
+// r.
+func readDynBitSet(r io.Reader, pver uint32, field string) ([]bool, error) {
+	count, err := ReadVarInt(r, pver)
+	if err != nil {
+		return nil, err
+	}
+	if count > MaxQuorumSize {
+		str := fmt.Sprintf("too many bits in bit set [count %v, max %v]",
+			count, MaxQuorumSize)
+		return nil, messageError(field, str)
+	}
+
+	packed := make([]byte, (count+7)/8)
+	if _, err := io.ReadFull(r, packed); err != nil {
+		return nil, err
+	}
+	bits := make([]bool, count)
+	for i := range bits {
+		bits[i] = packed[i/8]&(1<<(i%8)) != 0
+	}
+
+	return bits, nil
+}
+
+// writeDynBitSet writes a dynamically sized bit set to w.
+func writeDynBitSet(w io.Writer, pver uint32, bits []bool, field string) error {
+	if len(bits) > MaxQuorumSize {
+		str := fmt.Sprintf("too many bits in bit set [count %v, max %v]",
+			len(bits), MaxQuorumSize)
+		return messageError(field, str)
+	}
+
+	if err := WriteVarInt(w, pver, uint64(len(bits))); err != nil {
+		return err
+	}
+	packed := make([]byte, (len(bits)+7)/8)
+	for i, bit := range bits {
+		if bit {
+			packed[i/8] |= 1 << (i % 8)
+		}
+	}
+	_, err := w.Write(packed)
+	return err
+}
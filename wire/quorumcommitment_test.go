@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dashpay/dashd-go/chaincfg/chainhash"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestQuorumCommitmentSerialize tests the serialize and deserialize of final
+// commitments with and without a quorum index.
+func TestQuorumCommitmentSerialize(t *testing.T) {
+	nonIndexed := QuorumCommitment{
+		Version:    QuorumCommitmentVersionBasic,
+		LLMQType:   1,
+		QuorumHash: chainhash.Hash{0x01},
+
+		// Nine members span two bytes with the first and the last
+		// member flagged.
+		Signers: []bool{true, false, false, false, false, false, false,
+			false, true},
+		ValidMembers:    []bool{false, true},
+		QuorumPublicKey: [48]byte{0x02},
+		QuorumVvecHash:  chainhash.Hash{0x03},
+		QuorumSig:       [96]byte{0x04},
+		MembersSig:      [96]byte{0x05},
+	}
+	nonIndexedEncoded := bytes.Join([][]byte{
+		{0x03, 0x00},             // Version
+		{0x01},                   // LLMQ type
+		{0x01}, make([]byte, 31), // Quorum hash
+		{0x09, 0x01, 0x01},       // Signers
+		{0x02, 0x02},             // Valid members
+		{0x02}, make([]byte, 47), // Quorum public key
+		{0x03}, make([]byte, 31), // Quorum vvec hash
+		{0x04}, make([]byte, 95), // Quorum signature
+		{0x05}, make([]byte, 95), // Members signature
+	}, nil)
+
+	indexed := nonIndexed
+	indexed.Version = QuorumCommitmentVersionBasicIndexed
+	indexed.QuorumIndex = 3
+	indexedEncoded := bytes.Join([][]byte{
+		{0x04, 0x00},             // Version
+		{0x01},                   // LLMQ type
+		{0x01}, make([]byte, 31), // Quorum hash
+		{0x03, 0x00}, // Quorum index
+		nonIndexedEncoded[35:],
+	}, nil)
+
+	tests := []struct {
+		name string
+		in   QuorumCommitment
+		buf  []byte
+	}{
+		{"non-indexed", nonIndexed, nonIndexedEncoded},
+		{"indexed", indexed, indexedEncoded},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := test.in.Serialize(&buf); err != nil {
+			t.Errorf("%s: Serialize: unexpected error: %v", test.name,
+				err)
+			continue
+		}
+		if !bytes.Equal(buf.Bytes(), test.buf) {
+			t.Errorf("%s: Serialize\n got: %s want: %s", test.name,
+				spew.Sdump(buf.Bytes()), spew.Sdump(test.buf))
+			continue
+		}
+
+		var qc QuorumCommitment
+		if err := qc.Deserialize(bytes.NewReader(test.buf)); err != nil {
+			t.Errorf("%s: Deserialize: unexpected error: %v", test.name,
+				err)
+			continue
+		}
+		if !reflect.DeepEqual(qc, test.in) {
+			t.Errorf("%s: Deserialize\n got: %s want: %s", test.name,
+				spew.Sdump(qc), spew.Sdump(test.in))
+		}
+	}
+}
+
+// TestQuorumCommitmentCounts tests the member counts, the null commitment and
+// the commitment hash.
+func TestQuorumCommitmentCounts(t *testing.T) {
+	qc := QuorumCommitment{
+		Version:      QuorumCommitmentVersionBasic,
+		LLMQType:     100,
+		Signers:      []bool{true, false, true},
+		ValidMembers: []bool{true, true, true},
+	}
+	if got := qc.CountSigners(); got != 2 {
+		t.Errorf("CountSigners: got %d, want 2", got)
+	}
+	if got := qc.CountValidMembers(); got != 3 {
+		t.Errorf("CountValidMembers: got %d, want 3", got)
+	}
+	if qc.IsNull() {
+		t.Errorf("IsNull: commitment with signers is null")
+	}
+
+	null := QuorumCommitment{
+		Version:      QuorumCommitmentVersionBasic,
+		LLMQType:     100,
+		Signers:      make([]bool, 3),
+		ValidMembers: make([]bool, 3),
+	}
+	if !null.IsNull() {
+		t.Errorf("IsNull: null commitment is not null")
+	}
+	null.QuorumVvecHash[0] = 0x01
+	if null.IsNull() {
+		t.Errorf("IsNull: commitment with vvec hash is null")
+	}
+
+	// The commitment hash covers the type, quorum hash, valid members,
+	// public key and vvec hash, but neither the signers nor the version.
+	encoded := bytes.Join([][]byte{
+		{0x64},           // LLMQ type
+		make([]byte, 32), // Quorum hash
+		{0x03, 0x07},     // Valid members
+		make([]byte, 48), // Quorum public key
+		make([]byte, 32), // Quorum vvec hash
+	}, nil)
+	want := chainhash.DoubleHashH(encoded)
+	if got := qc.CommitmentHash(); got != want {
+		t.Errorf("CommitmentHash: got %v, want %v", got, want)
+	}
+	other := qc
+	other.Version = QuorumCommitmentVersionBasicIndexed
+	other.Signers = []bool{false, false, false}
+	if got := other.CommitmentHash(); got != want {
+		t.Errorf("CommitmentHash: depends on signers or version")
+	}
+	other.ValidMembers = []bool{true, true, false}
+	if got := other.CommitmentHash(); got == want {
+		t.Errorf("CommitmentHash: does not depend on valid members")
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import "fmt"
+
+const (
+	// SpecialTxVersion is the minimum transaction version for which a
+	// non-zero transaction type is interpreted as a DIP-2 special
+	// transaction carrying an extra payload.
+	SpecialTxVersion = 3
+
+	// MaxTxExtraPayload is the maximum allowed size of the extra payload of
+	// a special transaction.
+	MaxTxExtraPayload = 10000
+)
+
+// TxType represents the DIP-2 type of a transaction.  It is encoded in the
+// upper 16 bits of the 32-bit version field on the wire.
+type TxType uint16
+
+// These constants define the known transaction types.
+const (
+	TxTypeNormal           TxType = 0
+	TxTypeProRegTx         TxType = 1
+	TxTypeProUpServTx      TxType = 2
+	TxTypeProUpRegTx       TxType = 3
+	TxTypeProUpRevTx       TxType = 4
+	TxTypeCoinbase         TxType = 5
+	TxTypeQuorumCommitment TxType = 6
+	TxTypeMnHardFork       TxType = 7
+	TxTypeAssetLock        TxType = 8
+	TxTypeAssetUnlock      TxType = 9
+)
+
+// Map of transaction types back to their constant names for pretty printing.
+var txTypeStrings = map[TxType]string{
+	TxTypeNormal:           "TRANSACTION_NORMAL",
+	TxTypeProRegTx:         "TRANSACTION_PROVIDER_REGISTER",
+	TxTypeProUpServTx:      "TRANSACTION_PROVIDER_UPDATE_SERVICE",
+	TxTypeProUpRegTx:       "TRANSACTION_PROVIDER_UPDATE_REGISTRAR",
+	TxTypeProUpRevTx:       "TRANSACTION_PROVIDER_UPDATE_REVOKE",
+	TxTypeCoinbase:         "TRANSACTION_COINBASE",
+	TxTypeQuorumCommitment: "TRANSACTION_QUORUM_COMMITMENT",
+	TxTypeMnHardFork:       "TRANSACTION_MNHF_SIGNAL",
+	TxTypeAssetLock:        "TRANSACTION_ASSET_LOCK",
+	TxTypeAssetUnlock:      "TRANSACTION_ASSET_UNLOCK",
+}
+
+// String returns the TxType in human-readable form.
+func (t TxType) String() string {
+	if s, ok := txTypeStrings[t]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("Unknown TxType (%d)", uint16(t))
+}
@@ -0,0 +1,13 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package x11 implements the X11 chained hash used by Dash for proof of work.
+
+X11 runs its input through eleven of the SHA-3 competition candidates in turn:
+blake, bmw, groestl, skein, jh, keccak, luffa, cubehash, shavite, simd and
+echo.  All of them are implemented in pure Go in the internal packages of this
+package, so no cgo is required.
+*/
+package x11
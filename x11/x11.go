@@ -0,0 +1,83 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x11
+
+import (
+	"github.com/dashpay/dashd-go/x11/internal/blake"
+	"github.com/dashpay/dashd-go/x11/internal/bmw"
+	"github.com/dashpay/dashd-go/x11/internal/cubed"
+	"github.com/dashpay/dashd-go/x11/internal/echo"
+	"github.com/dashpay/dashd-go/x11/internal/groest"
+	"github.com/dashpay/dashd-go/x11/internal/hash"
+	"github.com/dashpay/dashd-go/x11/internal/jhash"
+	"github.com/dashpay/dashd-go/x11/internal/keccak"
+	"github.com/dashpay/dashd-go/x11/internal/luffa"
+	"github.com/dashpay/dashd-go/x11/internal/shavite"
+	"github.com/dashpay/dashd-go/x11/internal/simd"
+	"github.com/dashpay/dashd-go/x11/internal/skein"
+)
+
+// HashSize is the size in bytes of an X11 hash.
+const HashSize = 32
+
+// Hasher holds the state of the eleven chained digests which make up the X11
+// hash.  A Hasher may be reused for any number of calls to Hash, but it is not
+// safe for concurrent use.
+type Hasher struct {
+	tha [64]byte
+	thb [64]byte
+
+	chain [11]hash.Digest
+}
+
+// New returns a new Hasher ready to compute X11 hashes.
+func New() *Hasher {
+	return &Hasher{
+		chain: [11]hash.Digest{
+			blake.New(),
+			bmw.New(),
+			groest.New(),
+			skein.New(),
+			jhash.New(),
+			keccak.New(),
+			luffa.New(),
+			cubed.New(),
+			shavite.New(),
+			simd.New(),
+			echo.New(),
+		},
+	}
+}
+
+// Hash computes the X11 hash of src and returns it.
+//
+// The data is run through blake, bmw, groestl, skein, jh, keccak, luffa,
+// cubehash, shavite, simd and echo, in that order, with each stage consuming
+// the full 512-bit output of the one before.  The result is the first 256 bits
+// of the final echo digest.
+func (h *Hasher) Hash(src []byte) [HashSize]byte {
+	in, out := h.tha[:], h.thb[:]
+	for i, d := range h.chain {
+		if i == 0 {
+			d.Write(src)
+		} else {
+			d.Write(in)
+		}
+
+		// Close can only fail when the destination buffer is smaller
+		// than the digest size, which is never the case here.
+		_ = d.Close(out, 0, 0)
+		in, out = out, in
+	}
+
+	var res [HashSize]byte
+	copy(res[:], in)
+	return res
+}
+
+// Sum returns the X11 hash of the passed data.
+func Sum(data []byte) [HashSize]byte {
+	return New().Hash(data)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Dash Core Group
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x11
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// TestHash ensures the X11 hash produces the expected results for a set of
+// known inputs, including reuse of the same Hasher.
+func TestHash(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "empty",
+			in:   "",
+			want: "51b572209083576ea221c27e62b4e22063257571ccb6cc3dc3cd17eb67584eba",
+		},
+		{
+			name: "DASH",
+			in:   "DASH",
+			want: "fe809ebca8753d907f6ad32cdcf8e5c4e090d7bece5df35b2147e10b88c12d26",
+		},
+		{
+			name: "fox",
+			in:   "The quick brown fox jumps over the lazy dog",
+			want: "534536a4e4f16b32447f02f77200449dc2f23b532e3d9878fe111c9de666bc5c",
+		},
+	}
+
+	h := New()
+	for _, test := range tests {
+		for i := 0; i < 2; i++ {
+			got := h.Hash([]byte(test.in))
+			if hex.EncodeToString(got[:]) != test.want {
+				t.Errorf("%s (pass %d): got %x, want %s", test.name,
+					i, got, test.want)
+			}
+		}
+
+		got := Sum([]byte(test.in))
+		if hex.EncodeToString(got[:]) != test.want {
+			t.Errorf("%s: Sum got %x, want %s", test.name, got,
+				test.want)
+		}
+	}
+}